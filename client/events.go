@@ -0,0 +1,560 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	amino "github.com/tendermint/go-amino"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// eventsBufferSize is the number of events buffered by a subscription.
+	eventsBufferSize = 100
+
+	minEventsReconnectInterval = time.Second
+	maxEventsReconnectInterval = 30 * time.Second
+)
+
+// TxEvent defines a transaction matching an event subscription.
+type TxEvent struct {
+	Height    int64               `json:"height"`
+	Index     uint32              `json:"index"`
+	TxHash    string              `json:"txhash"`
+	Codespace string              `json:"codespace,omitempty"`
+	Code      uint32              `json:"code,omitempty"`
+	Data      string              `json:"data,omitempty"`
+	RawLog    string              `json:"raw_log,omitempty"`
+	Logs      sdk.ABCIMessageLogs `json:"logs,omitempty"`
+	Info      string              `json:"info,omitempty"`
+	GasWanted int64               `json:"gas_wanted,omitempty"`
+	GasUsed   int64               `json:"gas_used,omitempty"`
+	// Tx is the decoded transaction, nil if it couldn't be decoded.
+	Tx sdk.Tx `json:"-"`
+	// TxJSON is Tx encoded with the JSON encoder of the TxGenerator.
+	TxJSON json.RawMessage `json:"tx,omitempty"`
+}
+
+// BlockEvent defines a block matching an event subscription.
+type BlockEvent struct {
+	Height          int64     `json:"height"`
+	Hash            string    `json:"hash"`
+	Time            time.Time `json:"time"`
+	ProposerAddress string    `json:"proposer_address"`
+	// Txs are the transactions of the block encoded with the JSON encoder of
+	// the TxGenerator, null if they couldn't be decoded.
+	Txs []json.RawMessage `json:"txs"`
+}
+
+// Event defines an event matching a subscription query, either a transaction
+// or a block.
+type Event struct {
+	Query  string              `json:"query"`
+	Height int64               `json:"height"`
+	Events map[string][]string `json:"events"`
+	Tx     *TxEvent            `json:"tx,omitempty"`
+	Block  *BlockEvent         `json:"block,omitempty"`
+}
+
+// eventPosition orders the events of the chain, the event of a block comes
+// before the events of its transactions.
+type eventPosition struct {
+	height int64
+	index  int64
+}
+
+const (
+	blockEventIndex = -1
+	endOfBlockIndex = math.MaxInt64
+)
+
+func (p eventPosition) after(other eventPosition) bool {
+	return p.height > other.height || (p.height == other.height && p.index > other.index)
+}
+
+type eventSubscription struct {
+	query     *tmquery.Query
+	eventType string
+	// position is the position of the last sent event, set when subscribing
+	// from the latest height.
+	position    eventPosition
+	hasPosition bool
+}
+
+// SubscribeEvents subscribes to the transactions or blocks matching the given
+// Tendermint event query, e.g. "message.action='send' AND transfer.recipient='...'".
+// Transactions are subscribed to unless the query sets tm.event to NewBlock.
+// Events are sent on the returned channel, with their transactions decoded by
+// the TxGenerator, until goCtx is done.
+//
+// If fromHeight is positive, the events matching the query since fromHeight
+// are sent first. The subscription is renewed when the connection to the node
+// is lost, with an exponential backoff between attempts, and the events missed
+// in the meantime are then sent in order. The errors causing the subscription
+// to be renewed are sent on the returned error channel, they are dropped while
+// a previous error hasn't been received. Both channels are closed once goCtx is
+// done.
+func (ctx Context) SubscribeEvents(goCtx context.Context, query string, fromHeight int64) (<-chan Event, <-chan error, error) {
+	if ctx.TxGenerator == nil {
+		return nil, nil, errors.New("a tx generator is required to decode events")
+	}
+
+	q, eventType, err := parseEventsQuery(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sub := eventSubscription{query: q, eventType: eventType}
+	if fromHeight > 0 {
+		sub.position = eventPosition{height: fromHeight - 1, index: endOfBlockIndex}
+		sub.hasPosition = true
+	}
+
+	out := make(chan Event, eventsBufferSize)
+	errs := make(chan error, 1)
+	go ctx.runEventSubscription(goCtx, &sub, out, errs)
+
+	return out, errs, nil
+}
+
+// parseEventsQuery parses an event query, restricting it to transactions if it
+// doesn't set tm.event.
+func parseEventsQuery(query string) (*tmquery.Query, string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		query = fmt.Sprintf("%s='%s'", tmtypes.EventTypeKey, tmtypes.EventTx)
+	}
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid event query: %w", err)
+	}
+
+	conditions, err := q.Conditions()
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid event query: %w", err)
+	}
+
+	for _, c := range conditions {
+		if c.CompositeKey != tmtypes.EventTypeKey {
+			continue
+		}
+
+		eventType, ok := c.Operand.(string)
+		if c.Op != tmquery.OpEqual || !ok || (eventType != tmtypes.EventTx && eventType != tmtypes.EventNewBlock) {
+			return nil, "", fmt.Errorf("unsupported %s condition; supported event types: %s, %s",
+				tmtypes.EventTypeKey, tmtypes.EventTx, tmtypes.EventNewBlock)
+		}
+
+		return q, eventType, nil
+	}
+
+	q, err = tmquery.New(fmt.Sprintf("%s AND %s='%s'", query, tmtypes.EventTypeKey, tmtypes.EventTx))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return q, tmtypes.EventTx, nil
+}
+
+func (ctx Context) runEventSubscription(goCtx context.Context, sub *eventSubscription, out chan<- Event, errs chan<- error) {
+	defer close(errs)
+	defer close(out)
+
+	backoff := minEventsReconnectInterval
+	for {
+		live, cancel, err := ctx.subscribeEvents(goCtx, sub.query.String())
+		if err == nil {
+			// events are replayed once subscribed so that none is missed
+			if err = ctx.replayEvents(goCtx, sub, out); err == nil {
+				backoff = minEventsReconnectInterval
+				ctx.forwardEvents(goCtx, sub, live, out)
+				err = errors.New("connection to the node lost")
+			}
+			cancel()
+		}
+
+		if goCtx.Err() != nil {
+			return
+		}
+
+		select {
+		case errs <- fmt.Errorf("event subscription interrupted, retrying in %s: %w", backoff, err):
+		default:
+		}
+
+		select {
+		case <-goCtx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > maxEventsReconnectInterval {
+			backoff = maxEventsReconnectInterval
+		}
+	}
+}
+
+// subscribeEvents subscribes to the events matching query on the node. The
+// returned channel is closed when the connection to the node is lost.
+func (ctx Context) subscribeEvents(goCtx context.Context, query string) (<-chan ctypes.ResultEvent, func(), error) {
+	if ctx.NodeURI != "" {
+		return subscribeWebsocketEvents(goCtx, ctx.NodeURI, query)
+	}
+
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subscriber := fmt.Sprintf("client-%s", tmrand.Str(8))
+	live, err := node.Subscribe(goCtx, subscriber, query, eventsBufferSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cancel := func() {
+		_ = node.Unsubscribe(context.Background(), subscriber, query)
+	}
+
+	return live, cancel, nil
+}
+
+// subscribeWebsocketEvents subscribes to events through a websocket connection
+// to the node. Unlike the events of the HTTP client, the connection isn't
+// restored silently, so that the events missed while reconnecting are replayed.
+func subscribeWebsocketEvents(goCtx context.Context, nodeURI, query string) (<-chan ctypes.ResultEvent, func(), error) {
+	disconnected := make(chan struct{})
+	var once sync.Once
+
+	ws, err := jsonrpcclient.NewWS(nodeURI, "/websocket",
+		jsonrpcclient.MaxReconnectAttempts(0),
+		// a restored connection isn't subscribed to the query anymore
+		jsonrpcclient.OnReconnect(func() { once.Do(func() { close(disconnected) }) }),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cdc := amino.NewCodec()
+	ctypes.RegisterAmino(cdc)
+	ws.SetCodec(cdc)
+
+	if err := ws.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := ws.Subscribe(goCtx, query); err != nil {
+		_ = ws.Stop()
+		return nil, nil, err
+	}
+
+	live := make(chan ctypes.ResultEvent)
+	go func() {
+		defer close(live)
+
+		for {
+			select {
+			case resp, ok := <-ws.ResponsesCh:
+				if !ok || resp.Error != nil {
+					return
+				}
+
+				var result ctypes.ResultEvent
+				// the subscription is confirmed by an empty result
+				if err := cdc.UnmarshalJSON(resp.Result, &result); err != nil || result.Query == "" {
+					continue
+				}
+
+				select {
+				case live <- result:
+				case <-disconnected:
+					return
+				case <-goCtx.Done():
+					return
+				}
+
+			case <-disconnected:
+				return
+
+			case <-goCtx.Done():
+				return
+			}
+		}
+	}()
+
+	cancel := func() { _ = ws.Stop() }
+
+	return live, cancel, nil
+}
+
+// replayEvents sends the events matching the subscription query since its
+// position, up to the latest block.
+func (ctx Context) replayEvents(goCtx context.Context, sub *eventSubscription, out chan<- Event) error {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return err
+	}
+
+	latest := status.SyncInfo.LatestBlockHeight
+	if !sub.hasPosition {
+		sub.position = eventPosition{height: latest, index: endOfBlockIndex}
+		sub.hasPosition = true
+	}
+
+	height := sub.position.height
+	if sub.position.index == endOfBlockIndex {
+		height++
+	}
+
+	for ; height <= latest; height++ {
+		events, err := ctx.blockEvents(sub, height)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			select {
+			case out <- event:
+			case <-goCtx.Done():
+				return goCtx.Err()
+			}
+		}
+
+		sub.position = eventPosition{height: height, index: endOfBlockIndex}
+	}
+
+	return nil
+}
+
+// blockEvents returns the events of the block at the given height matching
+// the subscription query and positioned after the last sent event.
+func (ctx Context) blockEvents(sub *eventSubscription, height int64) ([]Event, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	h := height
+	results, err := node.BlockResults(&h)
+	if err != nil {
+		return nil, err
+	}
+
+	var block *ctypes.ResultBlock
+	getBlock := func() (*tmtypes.Block, error) {
+		if block == nil {
+			if block, err = node.Block(&h); err != nil {
+				return nil, err
+			}
+		}
+		return block.Block, nil
+	}
+
+	var events []Event
+
+	switch sub.eventType {
+	case tmtypes.EventNewBlock:
+		if !(eventPosition{height: height, index: blockEventIndex}).after(sub.position) {
+			return nil, nil
+		}
+
+		attrs := stringifyEvents(append(append([]abci.Event{}, results.BeginBlockEvents...), results.EndBlockEvents...))
+		attrs[tmtypes.EventTypeKey] = append(attrs[tmtypes.EventTypeKey], tmtypes.EventNewBlock)
+
+		if ok, err := sub.query.Matches(attrs); err != nil || !ok {
+			return nil, err
+		}
+
+		b, err := getBlock()
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, Event{
+			Query:  sub.query.String(),
+			Height: height,
+			Events: attrs,
+			Block:  ctx.newBlockEvent(b),
+		})
+
+	case tmtypes.EventTx:
+		for i, result := range results.TxsResults {
+			if !(eventPosition{height: height, index: int64(i)}).after(sub.position) {
+				continue
+			}
+
+			b, err := getBlock()
+			if err != nil {
+				return nil, err
+			}
+			if i >= len(b.Txs) {
+				return nil, fmt.Errorf("block %d has %d txs but %d results", height, len(b.Txs), len(results.TxsResults))
+			}
+
+			attrs := stringifyEvents(result.Events)
+			attrs[tmtypes.EventTypeKey] = append(attrs[tmtypes.EventTypeKey], tmtypes.EventTx)
+			attrs[tmtypes.TxHashKey] = append(attrs[tmtypes.TxHashKey], fmt.Sprintf("%X", b.Txs[i].Hash()))
+			attrs[tmtypes.TxHeightKey] = append(attrs[tmtypes.TxHeightKey], fmt.Sprintf("%d", height))
+
+			if ok, err := sub.query.Matches(attrs); err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+
+			events = append(events, Event{
+				Query:  sub.query.String(),
+				Height: height,
+				Events: attrs,
+				Tx:     ctx.newTxEvent(height, uint32(i), b.Txs[i], *result),
+			})
+		}
+	}
+
+	return events, nil
+}
+
+// forwardEvents sends the events received from the node until the connection
+// is lost or goCtx is done. Events already sent by replayEvents are skipped.
+func (ctx Context) forwardEvents(goCtx context.Context, sub *eventSubscription, live <-chan ctypes.ResultEvent, out chan<- Event) {
+	for {
+		var result ctypes.ResultEvent
+		select {
+		case r, ok := <-live:
+			if !ok {
+				return
+			}
+			result = r
+
+		case <-goCtx.Done():
+			return
+		}
+
+		event := Event{Query: sub.query.String(), Events: result.Events}
+
+		var position eventPosition
+		switch data := result.Data.(type) {
+		case tmtypes.EventDataTx:
+			position = eventPosition{height: data.Height, index: int64(data.Index)}
+			event.Height = data.Height
+			event.Tx = ctx.newTxEvent(data.Height, data.Index, data.Tx, data.Result)
+
+		case tmtypes.EventDataNewBlock:
+			position = eventPosition{height: data.Block.Height, index: blockEventIndex}
+			event.Height = data.Block.Height
+			event.Block = ctx.newBlockEvent(data.Block)
+
+		default:
+			continue
+		}
+
+		if !position.after(sub.position) {
+			continue
+		}
+
+		select {
+		case out <- event:
+			sub.position = position
+
+		case <-goCtx.Done():
+			return
+		}
+	}
+}
+
+func (ctx Context) newTxEvent(height int64, index uint32, txBytes tmtypes.Tx, result abci.ResponseDeliverTx) *TxEvent {
+	logs, _ := sdk.ParseABCILogs(result.Log)
+
+	event := &TxEvent{
+		Height:    height,
+		Index:     index,
+		TxHash:    fmt.Sprintf("%X", txBytes.Hash()),
+		Codespace: result.Codespace,
+		Code:      result.Code,
+		Data:      strings.ToUpper(hex.EncodeToString(result.Data)),
+		RawLog:    result.Log,
+		Logs:      logs,
+		Info:      result.Info,
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+	}
+
+	event.Tx, event.TxJSON = ctx.decodeEventTx(txBytes)
+
+	return event
+}
+
+func (ctx Context) newBlockEvent(block *tmtypes.Block) *BlockEvent {
+	event := &BlockEvent{
+		Height:          block.Height,
+		Hash:            block.Hash().String(),
+		Time:            block.Time,
+		ProposerAddress: block.ProposerAddress.String(),
+		Txs:             make([]json.RawMessage, len(block.Txs)),
+	}
+
+	for i, txBytes := range block.Txs {
+		_, event.Txs[i] = ctx.decodeEventTx(txBytes)
+	}
+
+	return event
+}
+
+// decodeEventTx decodes a transaction with the TxGenerator and encodes it to
+// JSON, nil values are returned if it can't be decoded.
+func (ctx Context) decodeEventTx(txBytes tmtypes.Tx) (sdk.Tx, json.RawMessage) {
+	tx, err := ctx.TxGenerator.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, nil
+	}
+
+	bz, err := ctx.TxGenerator.TxJSONEncoder()(tx)
+	if err != nil {
+		return tx, nil
+	}
+
+	return tx, bz
+}
+
+// stringifyEvents flattens ABCI events to their composite keys the way
+// Tendermint matches them against subscription queries.
+func stringifyEvents(events []abci.Event) map[string][]string {
+	result := make(map[string][]string)
+
+	for _, event := range events {
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+
+			key := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+			result[key] = append(result[key], string(attr.Value))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,197 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// eventsNode serves blocks of transfers and the subscriptions to their events.
+type eventsNode struct {
+	rpcclient.Client
+
+	mtx     sync.Mutex
+	txs     [][]tmtypes.Tx
+	results [][]*abci.ResponseDeliverTx
+
+	subscriptions chan chan ctypes.ResultEvent
+	failSubscribe int
+}
+
+func (n *eventsNode) addBlock(t *testing.T, recipients ...string) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	var (
+		txs     []tmtypes.Tx
+		results []*abci.ResponseDeliverTx
+	)
+	for _, recipient := range recipients {
+		txs = append(txs, newTransferTx(t, recipient))
+		results = append(results, &abci.ResponseDeliverTx{
+			Events: []abci.Event{{
+				Type:       "transfer",
+				Attributes: []kv.Pair{{Key: []byte("recipient"), Value: []byte(recipient)}},
+			}},
+		})
+	}
+
+	n.txs = append(n.txs, txs)
+	n.results = append(n.results, results)
+}
+
+func (n *eventsNode) txEvent(height int64, index uint32) ctypes.ResultEvent {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	result := *n.results[height-1][index]
+
+	return ctypes.ResultEvent{
+		Data: tmtypes.EventDataTx{TxResult: tmtypes.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     n.txs[height-1][index],
+			Result: result,
+		}},
+		Events: map[string][]string{"transfer.recipient": {string(result.Events[0].Attributes[0].Value)}},
+	}
+}
+
+func (n *eventsNode) Status() (*ctypes.ResultStatus, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: int64(len(n.txs))}}, nil
+}
+
+func (n *eventsNode) Block(height *int64) (*ctypes.ResultBlock, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	block := &tmtypes.Block{}
+	block.Height = *height
+	block.Txs = n.txs[*height-1]
+
+	return &ctypes.ResultBlock{Block: block}, nil
+}
+
+func (n *eventsNode) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	return &ctypes.ResultBlockResults{Height: *height, TxsResults: n.results[*height-1]}, nil
+}
+
+func (n *eventsNode) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	n.mtx.Lock()
+	if n.failSubscribe > 0 {
+		n.failSubscribe--
+		n.mtx.Unlock()
+		return nil, errors.New("node unavailable")
+	}
+	n.mtx.Unlock()
+
+	live := make(chan ctypes.ResultEvent, 10)
+	n.subscriptions <- live
+
+	return live, nil
+}
+
+func (n *eventsNode) Unsubscribe(context.Context, string, string) error {
+	return nil
+}
+
+func newTransferTx(t *testing.T, recipient string) tmtypes.Tx {
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress(recipient), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	bz, err := newEventsTxGenerator().TxEncoder()(authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.StdFee{}, nil, recipient))
+	require.NoError(t, err)
+
+	return bz
+}
+
+func newEventsTxGenerator() client.TxGenerator {
+	_, cdc := simapp.MakeCodecs()
+	return authtypes.StdTxGenerator{Cdc: cdc}
+}
+
+func requireTxEvent(t *testing.T, events <-chan client.Event, height int64, index uint32) {
+	select {
+	case event := <-events:
+		require.Equal(t, height, event.Height)
+		require.NotNil(t, event.Tx)
+		require.Equal(t, index, event.Tx.Index)
+		require.Equal(t, []string{"bob"}, event.Events["transfer.recipient"])
+		require.Len(t, event.Tx.Tx.GetMsgs(), 1)
+		require.Equal(t, "bob", event.Tx.Tx.(authtypes.StdTx).Memo)
+		require.Contains(t, string(event.Tx.TxJSON), `"memo":"bob"`)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no event at height %d", height)
+	}
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	node := &eventsNode{subscriptions: make(chan chan ctypes.ResultEvent, 1)}
+	node.addBlock(t, "bob", "alice")
+	node.addBlock(t, "alice", "bob")
+
+	clientCtx := client.Context{}.WithClient(node)
+	_, _, err := clientCtx.SubscribeEvents(context.Background(), "", 0)
+	require.Error(t, err)
+
+	clientCtx = clientCtx.WithTxGenerator(newEventsTxGenerator())
+	_, _, err = clientCtx.SubscribeEvents(context.Background(), "tm.event='Vote'", 0)
+	require.Error(t, err)
+	_, _, err = clientCtx.SubscribeEvents(context.Background(), "transfer.recipient=", 0)
+	require.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, errs, err := clientCtx.SubscribeEvents(ctx, "transfer.recipient='bob'", 1)
+	require.NoError(t, err)
+	live := <-node.subscriptions
+
+	// the events since the first block are replayed
+	requireTxEvent(t, events, 1, 0)
+	requireTxEvent(t, events, 2, 1)
+
+	// replayed events are skipped
+	node.addBlock(t, "bob")
+	live <- node.txEvent(2, 1)
+	live <- node.txEvent(3, 0)
+	requireTxEvent(t, events, 3, 0)
+
+	// the events missed while disconnected are replayed, the errors
+	// interrupting the subscription are reported
+	node.mtx.Lock()
+	node.failSubscribe = 1
+	node.mtx.Unlock()
+	close(live)
+	node.addBlock(t, "alice", "bob")
+	require.Contains(t, (<-errs).Error(), "connection to the node lost")
+	require.Contains(t, (<-errs).Error(), "node unavailable")
+	<-node.subscriptions
+	requireTxEvent(t, events, 4, 1)
+
+	cancel()
+	for range events {
+	}
+	for range errs {
+	}
+}
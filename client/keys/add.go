@@ -60,13 +60,19 @@ multisig transactions.
 You can add a multisig key by passing the list of key names you want the public
 key to be composed of to the --multisig flag and the minimum number of signatures
 required through --multisig-threshold. The keys are sorted by address, unless
-the flag --nosort is set.
+the flag --nosort is set. The keys passed to --multisig may themselves be multisig
+keys.
+
+A weighted threshold multisig key is created by passing the weight of each key,
+in the order of --multisig, to the --multisig-weights flag. The threshold is then
+the minimum sum of the weights of the signers.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmd,
 	}
 	cmd.Flags().StringSlice(flagMultisig, nil, "Construct and store a multisig public key (implies --pubkey)")
 	cmd.Flags().Uint(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
+	cmd.Flags().IntSlice(flagMultisigWeights, nil, "Weights of the keys passed to --multisig, the threshold is then the minimum weight of the signers")
 	cmd.Flags().Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	cmd.Flags().String(FlagPublicKey, "", "Parse a public key in bech32 format and save it to disk")
 	cmd.Flags().BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
@@ -149,27 +155,39 @@ func RunAddCmd(cmd *cobra.Command, args []string, kb keyring.Keyring, inBuf *buf
 			var pks []crypto.PubKey
 
 			multisigThreshold := viper.GetInt(flagMultiSigThreshold)
-			if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
+			multisigWeights := viper.GetIntSlice(flagMultisigWeights)
+			if len(multisigWeights) == 0 {
+				if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
+					return err
+				}
+			} else if err := validateMultisigWeights(multisigThreshold, multisigWeights, len(multisigKeys)); err != nil {
 				return err
 			}
 
-			for _, keyname := range multisigKeys {
+			weights := make([]uint, len(multisigKeys))
+			for i, keyname := range multisigKeys {
 				k, err := kb.Key(keyname)
 				if err != nil {
 					return err
 				}
 
 				pks = append(pks, k.GetPubKey())
+				if len(multisigWeights) != 0 {
+					weights[i] = uint(multisigWeights[i])
+				}
 			}
 
-			// Handle --nosort
+			// Handle --nosort, weights follow their keys
 			if !viper.GetBool(flagNoSort) {
-				sort.Slice(pks, func(i, j int) bool {
-					return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
-				})
+				sort.Sort(weightedPubKeys{pks, weights})
 			}
 
-			pk := multisig.NewPubKeyMultisigThreshold(multisigThreshold, pks)
+			var pk crypto.PubKey
+			if len(multisigWeights) == 0 {
+				pk = multisig.NewPubKeyMultisigThreshold(multisigThreshold, pks)
+			} else {
+				pk = multisig.NewPubKeyMultisigWeighted(uint(multisigThreshold), pks, weights)
+			}
 			if _, err := kb.SaveMultisig(name, pk); err != nil {
 				return err
 			}
@@ -323,3 +341,20 @@ func printCreate(cmd *cobra.Command, info keyring.Info, showMnemonic bool, mnemo
 
 	return nil
 }
+
+// weightedPubKeys sorts public keys by address along with their weights.
+type weightedPubKeys struct {
+	pubKeys []crypto.PubKey
+	weights []uint
+}
+
+func (w weightedPubKeys) Len() int { return len(w.pubKeys) }
+
+func (w weightedPubKeys) Less(i, j int) bool {
+	return bytes.Compare(w.pubKeys[i].Address(), w.pubKeys[j].Address()) < 0
+}
+
+func (w weightedPubKeys) Swap(i, j int) {
+	w.pubKeys[i], w.pubKeys[j] = w.pubKeys[j], w.pubKeys[i]
+	w.weights[i], w.weights[j] = w.weights[j], w.weights[i]
+}
@@ -1,16 +1,19 @@
 package keys
 
 import (
+	"bufio"
 	"testing"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -55,3 +58,76 @@ func Test_runAddCmdBasic(t *testing.T) {
 	viper.Set(flags.FlagDryRun, true)
 	require.NoError(t, runAddCmd(cmd, []string{"keyname4"}))
 }
+
+func Test_runAddCmdMultisig(t *testing.T) {
+	cmd := AddKeyCommand()
+	mockIn, _, _ := tests.ApplyMockIO(cmd)
+	inBuf := bufio.NewReader(mockIn)
+	kb := keyring.NewInMemory()
+
+	var pubKeys []crypto.PubKey
+	for _, name := range []string{"k1", "k2", "k3"} {
+		info, _, err := kb.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, info.GetPubKey())
+	}
+	viper.Set(flagKeyAlgo, string(hd.Secp256k1Type))
+	viper.Set(flags.FlagDryRun, false)
+	t.Cleanup(func() {
+		viper.Set(flagMultisig, nil)
+		viper.Set(flagMultiSigThreshold, 0)
+		viper.Set(flagMultisigWeights, nil)
+		viper.Set(flagNoSort, false)
+	})
+
+	viper.Set(flagMultisig, []string{"k1", "k2"})
+	viper.Set(flagMultiSigThreshold, 2)
+	viper.Set(flagNoSort, true)
+	require.NoError(t, RunAddCmd(cmd, []string{"k1k2"}, kb, inBuf))
+	info, err := kb.Key("k1k2")
+	require.NoError(t, err)
+	require.Equal(t, multisig.NewPubKeyMultisigThreshold(2, pubKeys[:2]), info.GetPubKey())
+
+	// weights
+	viper.Set(flagMultisig, []string{"k1", "k2", "k3"})
+	viper.Set(flagMultisigWeights, []int{1, 2})
+	require.Error(t, RunAddCmd(cmd, []string{"weighted"}, kb, inBuf))
+	viper.Set(flagMultisigWeights, []int{1, 0, 1})
+	require.Error(t, RunAddCmd(cmd, []string{"weighted"}, kb, inBuf))
+	viper.Set(flagMultiSigThreshold, 5)
+	viper.Set(flagMultisigWeights, []int{2, 1, 1})
+	require.Error(t, RunAddCmd(cmd, []string{"weighted"}, kb, inBuf))
+	viper.Set(flagMultiSigThreshold, 3)
+	require.NoError(t, RunAddCmd(cmd, []string{"weighted"}, kb, inBuf))
+	info, err = kb.Key("weighted")
+	require.NoError(t, err)
+	require.Equal(t, multisig.NewPubKeyMultisigWeighted(3, pubKeys, []uint{2, 1, 1}), info.GetPubKey())
+
+	// weights follow their keys once sorted
+	viper.Set(flagNoSort, false)
+	require.NoError(t, RunAddCmd(cmd, []string{"sorted"}, kb, inBuf))
+	info, err = kb.Key("sorted")
+	require.NoError(t, err)
+	sorted := info.GetPubKey().(multisig.PubKeyMultisigWeighted)
+	for i, pk := range sorted.PubKeys {
+		weight := uint(1)
+		if pk.Equals(pubKeys[0]) {
+			weight = 2
+		}
+		require.Equal(t, weight, sorted.Weights[i])
+	}
+
+	// nested multisig
+	viper.Set(flagMultisig, []string{"k1k2", "k3"})
+	viper.Set(flagMultisigWeights, nil)
+	viper.Set(flagMultiSigThreshold, 1)
+	viper.Set(flagNoSort, true)
+	require.NoError(t, RunAddCmd(cmd, []string{"nested"}, kb, inBuf))
+	info, err = kb.Key("nested")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeMulti, info.GetType())
+	require.Equal(t,
+		multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{multisig.NewPubKeyMultisigThreshold(2, pubKeys[:2]), pubKeys[2]}),
+		info.GetPubKey(),
+	)
+}
@@ -43,3 +43,37 @@ func runExportCmd(cmd *cobra.Command, args []string) error {
 	cmd.Println(armored)
 	return nil
 }
+
+// ExportAllKeysCommand exports every key of the key store.
+func ExportAllKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-all",
+		Short: "Export all keys",
+		Long: `Export every key of the local keybase in a single ASCII-armored encrypted bundle.
+The bundle contains the private keys as well as the ledger, offline and multisig references
+and can be imported with import-all.`,
+		Args: cobra.NoArgs,
+		RunE: runExportAllCmd,
+	}
+}
+
+func runExportAllCmd(cmd *cobra.Command, _ []string) error {
+	buf := bufio.NewReader(cmd.InOrStdin())
+	kb, err := keyring.New(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), buf)
+	if err != nil {
+		return err
+	}
+
+	encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported keys:", buf)
+	if err != nil {
+		return err
+	}
+
+	armored, err := kb.ExportAllArmor(encryptPassword)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(armored)
+	return nil
+}
@@ -43,3 +43,41 @@ func runImportCmd(cmd *cobra.Command, args []string) error {
 
 	return kb.ImportPrivKey(args[0], string(bz), passphrase)
 }
+
+// ImportAllKeysCommand imports every key of a bundle created by export-all.
+func ImportAllKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-all <bundlefile>",
+		Short: "Import all keys of a bundle into the local keybase",
+		Long: `Import every key of an ASCII-armored encrypted bundle created by export-all into the
+local keybase. No key is imported if any of them already exists.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runImportAllCmd,
+	}
+}
+
+func runImportAllCmd(cmd *cobra.Command, args []string) error {
+	buf := bufio.NewReader(cmd.InOrStdin())
+	kb, err := keyring.New(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), buf)
+	if err != nil {
+		return err
+	}
+
+	bz, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := input.GetPassword("Enter passphrase to decrypt the keys:", buf)
+	if err != nil {
+		return err
+	}
+
+	infos, err := kb.ImportAllArmor(string(bz), passphrase)
+	if err != nil {
+		return err
+	}
+
+	printInfos(cmd.OutOrStdout(), infos)
+	return nil
+}
@@ -7,9 +7,12 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -45,3 +48,51 @@ HbP+c6JmeJy9JXe2rbbF1QtCX1gLqGcDQPBXiCtFvP7/8wTZtVOPj8vREzhZ9ElO
 	mockIn.Reset("123456789\n")
 	require.NoError(t, runImportCmd(importKeyCommand, []string{"keyname1", keyfile}))
 }
+
+func Test_runExportAllImportAllCmd(t *testing.T) {
+	kbHome, cleanUp := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanUp)
+	viper.Set(flags.FlagHome, kbHome)
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), kbHome, nil)
+	require.NoError(t, err)
+
+	path := sdk.GetConfig().GetFullFundraiserPath()
+	local, err := kb.NewAccount("keyname1", tests.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.SaveMultisig("multi", multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{local.GetPubKey()}))
+	require.NoError(t, err)
+
+	exportAllCommand := ExportAllKeysCommand()
+	mockIn, mockOut, _ := tests.ApplyMockIO(exportAllCommand)
+	mockIn.Reset("123456789\n")
+	require.NoError(t, runExportAllCmd(exportAllCommand, []string{}))
+
+	bundleFile := filepath.Join(kbHome, "keys.asc")
+	require.NoError(t, ioutil.WriteFile(bundleFile, mockOut.Bytes(), 0600))
+
+	// import the bundle in another home
+	otherHome, otherCleanUp := tests.NewTestCaseDir(t)
+	t.Cleanup(otherCleanUp)
+	viper.Set(flags.FlagHome, otherHome)
+
+	importAllCommand := ImportAllKeysCommand()
+	mockIn, _, _ = tests.ApplyMockIO(importAllCommand)
+	mockIn.Reset("wrong\n")
+	require.Error(t, runImportAllCmd(importAllCommand, []string{bundleFile}))
+	mockIn.Reset("123456789\n")
+	require.NoError(t, runImportAllCmd(importAllCommand, []string{bundleFile}))
+
+	other, err := keyring.New(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), otherHome, nil)
+	require.NoError(t, err)
+	infos, err := other.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	require.Equal(t, keyring.TypeLocal, infos[0].GetType())
+	require.Equal(t, local.GetPubKey(), infos[0].GetPubKey())
+	require.Equal(t, keyring.TypeMulti, infos[1].GetType())
+
+	// keys are not imported twice
+	mockIn.Reset("123456789\n")
+	require.Error(t, runImportAllCmd(importAllCommand, []string{bundleFile}))
+}
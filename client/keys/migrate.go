@@ -131,3 +131,67 @@ func runMigrateCmd(cmd *cobra.Command, args []string) error {
 
 	return err
 }
+
+const (
+	flagMigrateFrom = "from"
+	flagMigrateTo   = "to"
+)
+
+// MigrateBackendCommand copies all keys from one keyring backend to another.
+func MigrateBackendCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-backend",
+		Short: "Migrate all keys from a keyring backend to another",
+		Long: `Copy every key, including ledger, offline and multisig references, from the keyring
+backend given with --from to the one given with --to, e.g. --from file --to os. No key is
+copied if any of them already exists in the destination backend. Keys are not removed
+from the source backend.
+`,
+		Args: cobra.NoArgs,
+		RunE: runMigrateBackendCmd,
+	}
+
+	cmd.Flags().String(flagMigrateFrom, "", "Keyring backend to migrate the keys from (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagMigrateTo, "", "Keyring backend to migrate the keys to (os|file|kwallet|pass|test)")
+	cmd.Flags().Bool(flags.FlagDryRun, false, "Run migration without actually persisting any changes to the destination backend")
+	cmd.MarkFlagRequired(flagMigrateFrom) // nolint: errcheck
+	cmd.MarkFlagRequired(flagMigrateTo)   // nolint: errcheck
+
+	return cmd
+}
+
+func runMigrateBackendCmd(cmd *cobra.Command, _ []string) error {
+	from, _ := cmd.Flags().GetString(flagMigrateFrom)
+	to, _ := cmd.Flags().GetString(flagMigrateTo)
+	if from == to {
+		return fmt.Errorf("source and destination backends must differ")
+	}
+
+	rootDir := viper.GetString(flags.FlagHome)
+	buf := bufio.NewReader(cmd.InOrStdin())
+
+	src, err := keyring.New(sdk.KeyringServiceName(), from, rootDir, buf)
+	if err != nil {
+		return err
+	}
+
+	var dst keyring.Keyring
+	if dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun); dryRun {
+		dst = keyring.NewInMemory()
+	} else {
+		dst, err = keyring.New(sdk.KeyringServiceName(), to, rootDir, buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	infos, err := keyring.MigrateBackend(src, dst)
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("Migrated %d keys from the %s backend to the %s backend\n", len(infos), from, to)
+	printInfos(cmd.OutOrStdout(), infos)
+
+	return nil
+}
@@ -1,15 +1,21 @@
 package keys
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/otiai10/copy"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/libs/cli"
 )
@@ -34,3 +40,42 @@ func Test_runMigrateCmd(t *testing.T) {
 	mockIn.Reset("test1234\ntest1234\n")
 	assert.NoError(t, runMigrateCmd(cmd, []string{}))
 }
+
+func Test_runMigrateBackendCmd(t *testing.T) {
+	kbHome, kbCleanUp := tests.NewTestCaseDir(t)
+	t.Cleanup(kbCleanUp)
+	viper.Set(flags.FlagHome, kbHome)
+	viper.Set(cli.OutputFlag, OutputFormatText)
+
+	src, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+	require.NoError(t, err)
+	path := sdk.GetConfig().GetFullFundraiserPath()
+	info, err := src.NewAccount("keyname1", tests.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+
+	cmd := MigrateBackendCommand()
+	mockIn, _, _ := tests.ApplyMockIO(cmd)
+
+	// the backends must differ
+	require.NoError(t, cmd.Flags().Set(flagMigrateFrom, keyring.BackendTest))
+	require.NoError(t, cmd.Flags().Set(flagMigrateTo, keyring.BackendTest))
+	require.Error(t, runMigrateBackendCmd(cmd, []string{}))
+
+	// nothing is written in dry-run mode
+	require.NoError(t, cmd.Flags().Set(flagMigrateTo, keyring.BackendFile))
+	require.NoError(t, cmd.Flags().Set(flags.FlagDryRun, "true"))
+	require.NoError(t, runMigrateBackendCmd(cmd, []string{}))
+	_, err = os.Stat(filepath.Join(kbHome, "keyring-file"))
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, cmd.Flags().Set(flags.FlagDryRun, "false"))
+	mockIn.Reset("test1234\ntest1234\n")
+	require.NoError(t, runMigrateBackendCmd(cmd, []string{}))
+
+	mockIn.Reset("test1234\n")
+	dst, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, mockIn)
+	require.NoError(t, err)
+	migrated, err := dst.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), migrated.GetPubKey())
+}
@@ -27,6 +27,9 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Forwards listing keys and signing to a gRPC signing service, such as the one
+                started with the signer-server command. It reads its configuration from
+                keyring-remote/config.toml within the app's configuration directory.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -41,14 +44,18 @@ The pass backend requires GnuPG: https://gnupg.org/
 		AddKeyCommand(),
 		ExportKeyCommand(),
 		ImportKeyCommand(),
+		ExportAllKeysCommand(),
+		ImportAllKeysCommand(),
 		ListKeysCmd(),
 		ShowKeysCmd(),
 		flags.LineBreak,
 		DeleteKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		MigrateBackendCommand(),
+		SignerServerCommand(),
 	)
-	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test|remote)")
 	viper.BindPFlag(flags.FlagKeyringBackend, cmd.Flags().Lookup(flags.FlagKeyringBackend))
 	return cmd
 }
@@ -17,7 +17,7 @@ func TestCommands(t *testing.T) {
 	assert.NotNil(t, rootCommands)
 
 	// Commands are registered
-	assert.Equal(t, 10, len(rootCommands.Commands()))
+	assert.Equal(t, 14, len(rootCommands.Commands()))
 }
 
 func TestMain(m *testing.M) {
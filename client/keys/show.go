@@ -28,6 +28,7 @@ const (
 	FlagDevice = "device"
 
 	flagMultiSigThreshold = "multisig-threshold"
+	flagMultisigWeights   = "multisig-weights"
 
 	defaultMultiSigKeyName = "multi"
 )
@@ -168,6 +169,27 @@ func validateMultisigThreshold(k, nKeys int) error {
 	return nil
 }
 
+func validateMultisigWeights(k int, weights []int, nKeys int) error {
+	if k <= 0 {
+		return fmt.Errorf("threshold must be a positive integer")
+	}
+	if len(weights) != nKeys {
+		return fmt.Errorf("%d weights for %d multisig keys", len(weights), nKeys)
+	}
+	total := 0
+	for _, weight := range weights {
+		if weight <= 0 {
+			return fmt.Errorf("weights must be positive integers")
+		}
+		total += weight
+	}
+	if total < k {
+		return fmt.Errorf(
+			"threshold k of weighted multisignature: sum(weights) %d < %d", total, k)
+	}
+	return nil
+}
+
 func getBechKeyOut(bechPrefix string) (bechKeyOutFn, error) {
 	switch bechPrefix {
 	case sdk.PrefixAccount:
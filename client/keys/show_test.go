@@ -131,6 +131,31 @@ func Test_validateMultisigThreshold(t *testing.T) {
 	}
 }
 
+func Test_validateMultisigWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		k       int
+		weights []int
+		nKeys   int
+		wantErr bool
+	}{
+		{"zero threshold", 0, []int{1, 1}, 2, true},
+		{"too few weights", 1, []int{1}, 2, true},
+		{"zero weight", 1, []int{1, 0}, 2, true},
+		{"negative weight", 1, []int{2, -1}, 2, true},
+		{"weight below threshold", 4, []int{2, 1}, 2, true},
+		{"valid", 3, []int{2, 1}, 2, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateMultisigWeights(tt.k, tt.weights, tt.nKeys); (err != nil) != tt.wantErr {
+				t.Errorf("validateMultisigWeights() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func Test_getBechKeyOut(t *testing.T) {
 	type args struct {
 		bechPrefix string
@@ -0,0 +1,114 @@
+package keys
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagSignerAddress  = "address"
+	flagSignerTLSCert  = "tls-cert"
+	flagSignerTLSKey   = "tls-key"
+	flagSignerClientCA = "client-ca"
+	flagSignerInsecure = "insecure"
+)
+
+// SignerServerCommand serves the keys of the local keyring to remote keyring
+// backends over gRPC.
+func SignerServerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-server",
+		Short: "Serve the keys of the keyring to remote keyring backends",
+		Long: `Start a gRPC signing service backed by the keyring selected with --keyring-backend.
+Clients configured with the remote keyring backend can list the keys of the keyring and
+sign with them, private keys never leave this process.
+
+The service uses mutual TLS with the certificate provided with --tls-cert and --tls-key,
+clients must present a certificate signed by the CA provided with --client-ca. --insecure
+disables TLS and must only be used for testing.
+
+Clients read their configuration from keyring-remote/config.toml in their home directory:
+
+    address = "localhost:26660"
+    ca-cert = "ca.pem"
+    client-cert = "client.pem"
+    client-key = "client-key.pem"
+`,
+		Args: cobra.NoArgs,
+		RunE: runSignerServerCmd,
+	}
+
+	cmd.Flags().String(flagSignerAddress, "localhost:26660", "Address the signing service listens on")
+	cmd.Flags().String(flagSignerTLSCert, "", "PEM encoded TLS certificate of the signing service")
+	cmd.Flags().String(flagSignerTLSKey, "", "PEM encoded TLS key of the signing service")
+	cmd.Flags().String(flagSignerClientCA, "", "PEM encoded CA certificate clients must present a certificate of")
+	cmd.Flags().Bool(flagSignerInsecure, false, "Serve without TLS, for testing only")
+
+	return cmd
+}
+
+func runSignerServerCmd(cmd *cobra.Command, _ []string) error {
+	backend := viper.GetString(flags.FlagKeyringBackend)
+	if backend == keyring.BackendRemote {
+		return fmt.Errorf("the signing service cannot be backed by the %s keyring backend", keyring.BackendRemote)
+	}
+
+	var opts []grpc.ServerOption
+
+	insecure, _ := cmd.Flags().GetBool(flagSignerInsecure)
+	if !insecure {
+		certFile, _ := cmd.Flags().GetString(flagSignerTLSCert)
+		keyFile, _ := cmd.Flags().GetString(flagSignerTLSKey)
+		clientCAFile, _ := cmd.Flags().GetString(flagSignerClientCA)
+
+		if certFile == "" || keyFile == "" || clientCAFile == "" {
+			return fmt.Errorf(
+				"--%s, --%s and --%s are required unless --%s is set",
+				flagSignerTLSCert, flagSignerTLSKey, flagSignerClientCA, flagSignerInsecure,
+			)
+		}
+
+		tlsConfig, err := keyring.NewSignerServerTLSConfig(certFile, keyFile, clientCAFile)
+		if err != nil {
+			return err
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), backend, viper.GetString(flags.FlagHome), cmd.InOrStdin())
+	if err != nil {
+		return err
+	}
+
+	address, _ := cmd.Flags().GetString(flagSignerAddress)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer(opts...)
+	keyring.RegisterSignerServer(server, keyring.NewSignerServer(kb))
+
+	go func() {
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+		<-sigs
+		server.GracefulStop()
+	}()
+
+	cmd.PrintErrf("Signing service listening on %s\n", listener.Addr())
+	return server.Serve(listener)
+}
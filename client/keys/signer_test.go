@@ -0,0 +1,37 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+func Test_runSignerServerCmd(t *testing.T) {
+	viper.Set(flags.FlagKeyringBackend, keyring.BackendMemory)
+	t.Cleanup(func() { viper.Set(flags.FlagKeyringBackend, "") })
+
+	testCases := map[string][]string{
+		"without certificate": {"--client-ca=ca.pem"},
+		"without key":         {"--tls-cert=server.pem", "--client-ca=ca.pem"},
+		"without client CA":   {"--tls-cert=server.pem", "--tls-key=server-key.pem"},
+	}
+
+	for name, args := range testCases {
+		args := args
+		t.Run(name, func(t *testing.T) {
+			cmd := SignerServerCommand()
+			require.NoError(t, cmd.ParseFlags(args))
+
+			err := runSignerServerCmd(cmd, nil)
+			require.EqualError(t, err, "--tls-cert, --tls-key and --client-ca are required unless --insecure is set")
+		})
+	}
+
+	// the signing service can't be backed by another signing service
+	viper.Set(flags.FlagKeyringBackend, keyring.BackendRemote)
+	require.Error(t, runSignerServerCmd(SignerServerCommand(), nil))
+}
@@ -27,6 +27,8 @@ type Factory struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	gasPricesAuto      bool
+	feeSpeed           FeeSpeed
 	signMode           signing.SignMode
 	simulateAndExecute bool
 }
@@ -51,6 +53,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	accSeq, _ := flagSet.GetUint64(flags.FlagSequence)
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagMemo)
+	feeSpeed, _ := flagSet.GetString(flags.FlagFeeSpeed)
 
 	f := Factory{
 		txGenerator:        clientCtx.TxGenerator,
@@ -63,6 +66,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		sequence:           accSeq,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
+		feeSpeed:           FeeSpeed(feeSpeed),
 		signMode:           signMode,
 	}
 
@@ -105,6 +109,7 @@ func NewFactoryFromDeprecated(input io.Reader) Factory {
 		gasAdjustment:      viper.GetFloat64(flags.FlagGasAdjustment),
 		simulateAndExecute: flags.GasFlagVar.Simulate,
 		memo:               viper.GetString(flags.FlagMemo),
+		feeSpeed:           FeeSpeed(viper.GetString(flags.FlagFeeSpeed)),
 		signMode:           signMode,
 	}
 
@@ -124,6 +129,11 @@ func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
+func (f Factory) FeeSpeed() FeeSpeed                        { return f.feeSpeed }
+
+// GasPricesAuto returns the option to estimate the gas prices from the
+// transactions of recent blocks.
+func (f Factory) GasPricesAuto() bool { return f.gasPricesAuto }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -164,8 +174,16 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
-// WithGasPrices returns a copy of the Factory with updated gas prices.
+// WithGasPrices returns a copy of the Factory with updated gas prices. Gas
+// prices set to auto are estimated from the transactions of recent blocks by
+// PrepareGasPrices.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
+	f.gasPricesAuto = gasPrices == flags.GasPricesAuto
+	if f.gasPricesAuto {
+		f.gasPrices = nil
+		return f
+	}
+
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
 	if err != nil {
 		panic(err)
@@ -175,6 +193,12 @@ func (f Factory) WithGasPrices(gasPrices string) Factory {
 	return f
 }
 
+// WithFeeSpeed returns a copy of the Factory with an updated fee speed.
+func (f Factory) WithFeeSpeed(speed FeeSpeed) Factory {
+	f.feeSpeed = speed
+	return f
+}
+
 // WithKeybase returns a copy of the Factory with updated Keybase.
 func (f Factory) WithKeybase(keybase keyring.Keyring) Factory {
 	f.keybase = keybase
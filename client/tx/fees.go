@@ -0,0 +1,180 @@
+package tx
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultFeeEstimationBlocks is the number of recent blocks sampled to
+// estimate gas prices set to auto.
+const DefaultFeeEstimationBlocks = 20
+
+// FeeSpeed defines how fast a transaction should be included in a block, the
+// faster the higher its gas price.
+type FeeSpeed string
+
+// Fee speeds, slow, average and fast transactions pay the 25th, 50th and 75th
+// percentile of the gas prices of recent transactions.
+const (
+	FeeSpeedSlow    FeeSpeed = "slow"
+	FeeSpeedAverage FeeSpeed = "average"
+	FeeSpeedFast    FeeSpeed = "fast"
+)
+
+// feeTx defines the fee getters of a decoded transaction.
+type feeTx interface {
+	GetGas() uint64
+	GetFee() sdk.Coins
+}
+
+// GasPriceEstimate defines the gas prices paid in a denomination by the
+// transactions of recent blocks.
+type GasPriceEstimate struct {
+	Denom   string  `json:"denom" yaml:"denom"`
+	Txs     int     `json:"txs" yaml:"txs"`
+	Slow    sdk.Dec `json:"slow" yaml:"slow"`
+	Average sdk.Dec `json:"average" yaml:"average"`
+	Fast    sdk.Dec `json:"fast" yaml:"fast"`
+}
+
+// FeeEstimate defines the gas prices paid by the transactions of the blocks up
+// to Height. Transactions without fees are not taken into account.
+type FeeEstimate struct {
+	Height int64 `json:"height" yaml:"height"`
+	Blocks int64 `json:"blocks" yaml:"blocks"`
+	// GasPrices are sorted by decreasing number of transactions.
+	GasPrices []GasPriceEstimate `json:"gas_prices" yaml:"gas_prices"`
+}
+
+// GasPrice returns the gas price of the given speed in the denomination most
+// transactions paid their fees in.
+func (fe FeeEstimate) GasPrice(speed FeeSpeed) (sdk.DecCoin, error) {
+	if len(fe.GasPrices) == 0 {
+		return sdk.DecCoin{}, fmt.Errorf("no fees paid in the last %d blocks", fe.Blocks)
+	}
+
+	estimate := fe.GasPrices[0]
+	switch speed {
+	case FeeSpeedSlow:
+		return sdk.NewDecCoinFromDec(estimate.Denom, estimate.Slow), nil
+
+	case FeeSpeedAverage, "":
+		return sdk.NewDecCoinFromDec(estimate.Denom, estimate.Average), nil
+
+	case FeeSpeedFast:
+		return sdk.NewDecCoinFromDec(estimate.Denom, estimate.Fast), nil
+
+	default:
+		return sdk.DecCoin{}, fmt.Errorf("invalid fee speed %q; supported speeds: %s, %s, %s",
+			speed, FeeSpeedSlow, FeeSpeedAverage, FeeSpeedFast)
+	}
+}
+
+// EstimateFees samples the gas prices paid by the transactions of the given
+// number of latest blocks. The blocks are queried from the Tendermint node of
+// clientCtx and decoded with its TxGenerator, transactions that can't be
+// decoded are skipped.
+func EstimateFees(clientCtx client.Context, blocks int64) (FeeEstimate, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	estimate := FeeEstimate{Height: status.SyncInfo.LatestBlockHeight}
+	txDecoder := clientCtx.TxGenerator.TxDecoder()
+	gasPrices := make(map[string][]sdk.Dec)
+
+	for height := estimate.Height; height > 0 && height > estimate.Height-blocks; height-- {
+		h := height
+		block, err := node.Block(&h)
+		if err != nil {
+			return FeeEstimate{}, err
+		}
+
+		estimate.Blocks++
+
+		for _, txBytes := range block.Block.Txs {
+			tx, err := txDecoder(txBytes)
+			if err != nil {
+				continue
+			}
+
+			fTx, ok := tx.(feeTx)
+			if !ok || fTx.GetGas() == 0 {
+				continue
+			}
+
+			gas := sdk.NewIntFromUint64(fTx.GetGas()).ToDec()
+			for _, fee := range fTx.GetFee() {
+				gasPrices[fee.Denom] = append(gasPrices[fee.Denom], fee.Amount.ToDec().Quo(gas))
+			}
+		}
+	}
+
+	for denom, prices := range gasPrices {
+		sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+
+		estimate.GasPrices = append(estimate.GasPrices, GasPriceEstimate{
+			Denom:   denom,
+			Txs:     len(prices),
+			Slow:    percentile(prices, 25),
+			Average: percentile(prices, 50),
+			Fast:    percentile(prices, 75),
+		})
+	}
+
+	sort.Slice(estimate.GasPrices, func(i, j int) bool {
+		if estimate.GasPrices[i].Txs != estimate.GasPrices[j].Txs {
+			return estimate.GasPrices[i].Txs > estimate.GasPrices[j].Txs
+		}
+		return estimate.GasPrices[i].Denom < estimate.GasPrices[j].Denom
+	})
+
+	return estimate, nil
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []sdk.Dec, p int) sdk.Dec {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// PrepareGasPrices estimates the gas prices of the Factory from the transactions
+// of recent blocks if they are set to auto. A new Factory with the estimated gas
+// prices is returned.
+func PrepareGasPrices(clientCtx client.Context, txf Factory) (Factory, error) {
+	if !txf.gasPricesAuto {
+		return txf, nil
+	}
+
+	if !txf.fees.IsZero() {
+		return txf, errors.New("cannot provide both fees and gas prices")
+	}
+
+	estimate, err := EstimateFees(clientCtx, DefaultFeeEstimationBlocks)
+	if err != nil {
+		return txf, err
+	}
+
+	gasPrice, err := estimate.GasPrice(txf.feeSpeed)
+	if err != nil {
+		return txf, err
+	}
+
+	txf.gasPrices = sdk.NewDecCoins(gasPrice)
+
+	return txf, nil
+}
@@ -0,0 +1,141 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// blocksNode serves blocks of transactions, the latest block last.
+type blocksNode struct {
+	rpcclient.Client
+
+	blocks [][]tmtypes.Tx
+}
+
+func (n *blocksNode) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: int64(len(n.blocks))}}, nil
+}
+
+func (n *blocksNode) Block(height *int64) (*ctypes.ResultBlock, error) {
+	block := &tmtypes.Block{}
+	block.Height = *height
+	block.Txs = n.blocks[*height-1]
+
+	return &ctypes.ResultBlock{Block: block}, nil
+}
+
+func newFeesClientContext(t *testing.T, blocks ...[]sdk.Coins) client.Context {
+	txGenerator := NewTestTxGenerator()
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	node := &blocksNode{}
+	for _, fees := range blocks {
+		var txs []tmtypes.Tx
+		for _, fee := range fees {
+			bz, err := txGenerator.TxEncoder()(types.NewStdTx([]sdk.Msg{msg}, types.NewStdFee(100, fee), nil, ""))
+			require.NoError(t, err)
+			txs = append(txs, bz)
+		}
+
+		node.blocks = append(node.blocks, append(txs, []byte("not a tx")))
+	}
+
+	return client.Context{}.WithClient(node).WithTxGenerator(txGenerator)
+}
+
+func TestEstimateFees(t *testing.T) {
+	clientCtx := newFeesClientContext(t,
+		// not sampled
+		[]sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("stake", 100000))},
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 200), sdk.NewInt64Coin("atom", 10)),
+			nil,
+		},
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 400)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 300)),
+		},
+	)
+
+	estimate, err := tx.EstimateFees(clientCtx, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), estimate.Height)
+	require.Equal(t, int64(2), estimate.Blocks)
+	require.Len(t, estimate.GasPrices, 2)
+
+	stake := estimate.GasPrices[0]
+	require.Equal(t, "stake", stake.Denom)
+	require.Equal(t, 4, stake.Txs)
+	require.Equal(t, sdk.NewDec(1), stake.Slow)
+	require.Equal(t, sdk.NewDec(2), stake.Average)
+	require.Equal(t, sdk.NewDec(3), stake.Fast)
+
+	atom := estimate.GasPrices[1]
+	require.Equal(t, "atom", atom.Denom)
+	require.Equal(t, 1, atom.Txs)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), atom.Average)
+
+	gasPrice, err := estimate.GasPrice(tx.FeeSpeedFast)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("stake", sdk.NewDec(3)), gasPrice)
+	_, err = estimate.GasPrice("instant")
+	require.Error(t, err)
+
+	// more blocks than the chain has
+	estimate, err = tx.EstimateFees(clientCtx, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), estimate.Blocks)
+	require.Equal(t, 5, estimate.GasPrices[0].Txs)
+	require.Equal(t, sdk.NewDec(3), estimate.GasPrices[0].Average)
+	require.Equal(t, sdk.NewDec(4), estimate.GasPrices[0].Fast)
+
+	// no fees paid
+	estimate, err = tx.EstimateFees(newFeesClientContext(t, []sdk.Coins{nil}), 10)
+	require.NoError(t, err)
+	require.Empty(t, estimate.GasPrices)
+	_, err = estimate.GasPrice(tx.FeeSpeedAverage)
+	require.Error(t, err)
+}
+
+func TestPrepareGasPrices(t *testing.T) {
+	clientCtx := newFeesClientContext(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+	})
+	txf := tx.Factory{}.WithTxGenerator(clientCtx.TxGenerator).WithChainID("test-chain").WithGas(1000)
+
+	// gas prices are left untouched unless set to auto
+	prepared, err := tx.PrepareGasPrices(clientCtx, txf.WithGasPrices("0.5stake"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1))), prepared.GasPrices())
+
+	txf = txf.WithGasPrices("auto")
+	require.True(t, txf.GasPricesAuto())
+	require.True(t, txf.GasPrices().IsZero())
+
+	prepared, err = tx.PrepareGasPrices(clientCtx, txf)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDec(1))), prepared.GasPrices())
+
+	prepared, err = tx.PrepareGasPrices(clientCtx, txf.WithFeeSpeed(tx.FeeSpeedFast))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDec(2))), prepared.GasPrices())
+
+	unsignedTx, err := tx.BuildUnsignedTx(prepared, banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)), unsignedTx.GetTx().(types.StdTx).Fee.Amount)
+
+	_, err = tx.PrepareGasPrices(clientCtx, txf.WithFees("10stake"))
+	require.Error(t, err)
+}
@@ -0,0 +1,354 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ErrServiceStopped is returned for messages queued after the service stopped
+// running.
+var ErrServiceStopped = errors.New("tx service stopped")
+
+// ServiceConfig defines how a Service batches queued messages and retries
+// transactions rejected by the node.
+type ServiceConfig struct {
+	// MaxMsgs is the maximum number of queued messages included in a single
+	// transaction. Messages queued together are never split across transactions.
+	MaxMsgs int
+	// BatchInterval is the longest time queued messages wait for more messages
+	// before being broadcast.
+	BatchInterval time.Duration
+	// MaxRetries is the number of times a transaction is broadcast again after
+	// a sequence mismatch or a full mempool.
+	MaxRetries int
+	// RetryInterval is the time waited before broadcasting a transaction again.
+	RetryInterval time.Duration
+}
+
+// DefaultServiceConfig returns the default ServiceConfig.
+func DefaultServiceConfig() ServiceConfig {
+	return ServiceConfig{
+		MaxMsgs:       10,
+		BatchInterval: time.Second,
+		MaxRetries:    5,
+		RetryInterval: time.Second,
+	}
+}
+
+// ServiceResult is the outcome of the broadcast of queued messages.
+type ServiceResult struct {
+	Response sdk.TxResponse
+	Err      error
+}
+
+type queuedMsgs struct {
+	msgs   []sdk.Msg
+	result chan ServiceResult
+}
+
+// Service signs and broadcasts transactions with the key of the client context
+// from account. Unlike BroadcastTx, it queries the account number and sequence
+// once and then tracks the sequence locally, so that several transactions of
+// the account can be in the mempool at the same time.
+//
+// The sequence, and gas prices set to auto, are queried again whenever a
+// transaction fails. As the account query doesn't reflect the transactions
+// still in the mempool, the local sequence is only increased by these queries.
+// A wrong sequence fails signature verification, so transactions rejected with
+// ErrInvalidSequence or ErrUnauthorized are signed again with the sequence the
+// node expected, which is the only way the local sequence is decreased.
+// Transactions rejected because the mempool is full are broadcast again after
+// ServiceConfig.RetryInterval.
+type Service struct {
+	clientCtx client.Context
+	txf       Factory
+	config    ServiceConfig
+
+	mtx         sync.Mutex
+	synced      bool
+	hasSequence bool
+
+	queue chan queuedMsgs
+	done  chan struct{}
+}
+
+// NewService returns a Service signing transactions built with txf with the
+// key of the clientCtx from account.
+func NewService(clientCtx client.Context, txf Factory, config ServiceConfig) *Service {
+	if config.MaxMsgs <= 0 {
+		config.MaxMsgs = 1
+	}
+
+	return &Service{
+		clientCtx: clientCtx,
+		txf:       txf,
+		config:    config,
+		queue:     make(chan queuedMsgs),
+		done:      make(chan struct{}),
+	}
+}
+
+// Sync queries the account number and sequence of the from account, and
+// estimates the gas prices if they are set to auto.
+func (s *Service) Sync() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.sync()
+}
+
+func (s *Service) sync() error {
+	num, seq, err := s.txf.accountRetriever.GetAccountNumberSequence(s.clientCtx, s.clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	// the transactions sent earlier may still be in the mempool
+	if s.hasSequence && s.txf.Sequence() > seq {
+		seq = s.txf.Sequence()
+	}
+
+	txf, err := PrepareGasPrices(s.clientCtx, s.txf.WithAccountNumber(num).WithSequence(seq))
+	if err != nil {
+		return err
+	}
+
+	s.txf = txf
+	s.synced = true
+	s.hasSequence = true
+
+	return nil
+}
+
+// Sequence returns the sequence the next transaction will be signed with.
+func (s *Service) Sequence() uint64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.txf.Sequence()
+}
+
+// BroadcastMsgs signs and broadcasts a transaction with the given messages,
+// retrying it as configured. An error is returned when the transaction couldn't
+// be built or sent, rejected transactions are reported by the response code.
+func (s *Service) BroadcastMsgs(msgs ...sdk.Msg) (sdk.TxResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for retries := 0; ; retries++ {
+		if !s.synced {
+			if err := s.sync(); err != nil {
+				return sdk.TxResponse{}, err
+			}
+		}
+
+		sequence := s.txf.Sequence()
+		res, err := s.broadcast(msgs)
+		if err != nil {
+			// the transaction may or may not have reached the mempool
+			s.synced = false
+			return res, err
+		}
+
+		switch {
+		case res.Code == abci.CodeTypeOK, isTxResponseError(res, sdkerrors.ErrTxInMempoolCache):
+			s.txf = s.txf.WithSequence(sequence + 1)
+			return res, nil
+
+		case retries >= s.config.MaxRetries:
+			s.synced = false
+			return res, nil
+
+		case isTxResponseError(res, sdkerrors.ErrMempoolIsFull):
+			time.Sleep(s.config.RetryInterval)
+
+		case isTxResponseError(res, sdkerrors.ErrInvalidSequence), isTxResponseError(res, sdkerrors.ErrUnauthorized):
+			expected, ok := expectedSequence(res)
+			if !ok {
+				// without the expected sequence the account is queried, its
+				// sequence only moves forward as it doesn't reflect the
+				// transactions still in the mempool
+				_, chainSequence, err := s.txf.accountRetriever.GetAccountNumberSequence(s.clientCtx, s.clientCtx.GetFromAddress())
+				if err != nil {
+					return res, err
+				}
+				if chainSequence > sequence {
+					expected = chainSequence
+				} else {
+					expected = sequence
+				}
+			}
+
+			if expected != sequence {
+				// another client used the account, or transactions sent earlier
+				// were dropped from the mempool
+				s.txf = s.txf.WithSequence(expected)
+			} else {
+				// transactions sent earlier are not committed yet
+				time.Sleep(s.config.RetryInterval)
+			}
+
+		default:
+			// the transaction might have consumed its sequence during DeliverTx
+			s.synced = false
+			return res, nil
+		}
+	}
+}
+
+func (s *Service) broadcast(msgs []sdk.Msg) (sdk.TxResponse, error) {
+	txf := s.txf
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(s.clientCtx.QueryWithData, txf, msgs...)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	tx, err := BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	if err := Sign(txf, s.clientCtx.GetFromName(), tx); err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	txBytes, err := s.clientCtx.TxGenerator.TxEncoder()(tx.GetTx())
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return s.clientCtx.BroadcastTx(txBytes)
+}
+
+// Queue queues messages to be broadcast by Run in the same transaction as other
+// queued messages. The result of the transaction is sent on the returned
+// channel. Queue blocks while Run is broadcasting a transaction.
+func (s *Service) Queue(msgs ...sdk.Msg) <-chan ServiceResult {
+	q := queuedMsgs{msgs: msgs, result: make(chan ServiceResult, 1)}
+
+	select {
+	case s.queue <- q:
+	case <-s.done:
+		q.result <- ServiceResult{Err: ErrServiceStopped}
+	}
+
+	return q.result
+}
+
+// Run broadcasts the queued messages in batches of at most ServiceConfig.MaxMsgs
+// messages until ctx is done. Messages still queued then fail with
+// ErrServiceStopped. Run must only be called once.
+func (s *Service) Run(ctx context.Context) error {
+	defer close(s.done)
+
+	var (
+		batch []queuedMsgs
+		size  int
+		timer *time.Timer
+	)
+
+	flush := func() {
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		if len(batch) == 0 {
+			return
+		}
+
+		var msgs []sdk.Msg
+		for _, q := range batch {
+			msgs = append(msgs, q.msgs...)
+		}
+
+		res, err := s.BroadcastMsgs(msgs...)
+		for _, q := range batch {
+			q.result <- ServiceResult{Response: res, Err: err}
+		}
+
+		batch, size = nil, 0
+	}
+
+	for {
+		var timeout <-chan time.Time
+		if timer != nil {
+			timeout = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			for _, q := range batch {
+				q.result <- ServiceResult{Err: ErrServiceStopped}
+			}
+			for {
+				select {
+				case q := <-s.queue:
+					q.result <- ServiceResult{Err: ErrServiceStopped}
+				default:
+					return ctx.Err()
+				}
+			}
+
+		case q := <-s.queue:
+			if size > 0 && size+len(q.msgs) > s.config.MaxMsgs {
+				flush()
+			}
+
+			batch = append(batch, q)
+			size += len(q.msgs)
+
+			if size >= s.config.MaxMsgs {
+				flush()
+			} else if timer == nil {
+				timer = time.NewTimer(s.config.BatchInterval)
+			}
+
+		case <-timeout:
+			timer = nil
+			flush()
+		}
+	}
+}
+
+// expectedSequenceRegexp matches the account sequence reported by the signature
+// verification ante handler.
+var expectedSequenceRegexp = regexp.MustCompile(`account sequence \((\d+)\)`)
+
+// expectedSequence returns the account sequence the node expected a rejected
+// transaction to be signed with, if reported.
+func expectedSequence(res sdk.TxResponse) (uint64, bool) {
+	match := expectedSequenceRegexp.FindStringSubmatch(res.RawLog)
+	if match == nil {
+		return 0, false
+	}
+
+	seq, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return seq, true
+}
+
+// isTxResponseError returns true if the transaction was rejected with the
+// given error.
+func isTxResponseError(res sdk.TxResponse, err *sdkerrors.Error) bool {
+	return res.Codespace == err.Codespace() && res.Code == err.ABCICode()
+}
@@ -0,0 +1,247 @@
+package tx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockNode accepts transactions signed with the expected account sequence.
+type mockNode struct {
+	rpcclient.Client
+
+	mtx         sync.Mutex
+	txGenerator client.TxGenerator
+	chainID     string
+	accNum      uint64
+	sequence    uint64
+	mempoolFull int
+	fail        int
+	rejectNext  int
+	reject      bool
+	pending     uint64
+	txs         []types.StdTx
+	syncs       int
+}
+
+func (n *mockNode) BroadcastTxSync(txBytes tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	if n.mempoolFull > 0 {
+		n.mempoolFull--
+		return nil, errors.New("mempool is full")
+	}
+	if n.fail > 0 {
+		n.fail--
+		return nil, errors.New("connection refused")
+	}
+
+	decoded, err := n.txGenerator.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	stdTx := decoded.(types.StdTx)
+	signBytes := types.StdSignBytes(n.chainID, n.accNum, n.sequence, stdTx.Fee, stdTx.Msgs, stdTx.Memo)
+	if n.rejectNext > 0 || n.reject || !stdTx.Signatures[0].GetPubKey().VerifyBytes(signBytes, stdTx.Signatures[0].Signature) {
+		if n.rejectNext > 0 {
+			n.rejectNext--
+		}
+
+		return &ctypes.ResultBroadcastTx{
+			Code:      sdkerrors.ErrUnauthorized.ABCICode(),
+			Codespace: sdkerrors.ErrUnauthorized.Codespace(),
+			Log:       fmt.Sprintf("signature verification failed; verify correct account sequence (%d) and chain-id (%s): unauthorized", n.sequence, n.chainID),
+		}, nil
+	}
+
+	n.sequence++
+	n.txs = append(n.txs, stdTx)
+
+	return &ctypes.ResultBroadcastTx{Hash: txBytes.Hash()}, nil
+}
+
+func (n *mockNode) EnsureExists(client.NodeQuerier, sdk.AccAddress) error {
+	return nil
+}
+
+func (n *mockNode) GetAccountNumberSequence(client.NodeQuerier, sdk.AccAddress) (uint64, uint64, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.syncs++
+	return n.accNum, n.sequence - n.pending, nil
+}
+
+func newTestService(t *testing.T, config tx.ServiceConfig) (*tx.Service, *mockNode, sdk.AccAddress) {
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("service", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	node := &mockNode{txGenerator: NewTestTxGenerator(), chainID: "test-chain", accNum: 7, sequence: 3}
+	clientCtx := client.Context{}.
+		WithClient(node).
+		WithTxGenerator(node.txGenerator).
+		WithBroadcastMode(flags.BroadcastSync).
+		WithFromName("service").
+		WithFromAddress(info.GetAddress())
+	txf := tx.Factory{}.
+		WithTxGenerator(node.txGenerator).
+		WithAccountRetriever(node).
+		WithKeybase(kr).
+		WithChainID("test-chain").
+		WithGas(200000)
+
+	return tx.NewService(clientCtx, txf, config), node, info.GetAddress()
+}
+
+func TestServiceBroadcastMsgs(t *testing.T) {
+	config := tx.DefaultServiceConfig()
+	config.RetryInterval = time.Millisecond
+	service, node, addr := newTestService(t, config)
+	msg := banktypes.NewMsgSend(addr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	// the sequence is tracked locally
+	for i := 0; i < 3; i++ {
+		res, err := service.BroadcastMsgs(msg)
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), res.Code)
+	}
+	require.Equal(t, uint64(6), service.Sequence())
+	require.Len(t, node.txs, 3)
+	require.Equal(t, 1, node.syncs)
+
+	// the sequence expected by the node is used after another client used the
+	// account
+	node.sequence += 2
+	res, err := service.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, uint64(9), service.Sequence())
+	require.Equal(t, 1, node.syncs)
+
+	// full mempools are retried
+	node.mempoolFull = 2
+	res, err = service.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, uint64(10), service.Sequence())
+	require.Len(t, node.txs, 5)
+
+	// up to MaxRetries times
+	node.mempoolFull = config.MaxRetries + 1
+	res, err = service.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.True(t, res.Code == sdkerrors.ErrMempoolIsFull.ABCICode())
+	require.Len(t, node.txs, 5)
+
+	// the local sequence is kept while the transactions sent earlier are not
+	// committed
+	res, err = service.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), service.Sequence())
+	node.pending = 2
+	node.rejectNext = 2
+	syncs := node.syncs
+	res, err = service.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, syncs, node.syncs)
+	require.Equal(t, uint64(12), service.Sequence())
+	require.Len(t, node.txs, 7)
+
+	// rejected transactions are retried up to MaxRetries times
+	node.pending = 0
+	node.reject = true
+	syncs = node.syncs
+	res, err = service.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+	require.Equal(t, syncs, node.syncs)
+	require.Equal(t, uint64(12), service.Sequence())
+	require.Len(t, node.txs, 7)
+}
+
+func TestServiceResync(t *testing.T) {
+	config := tx.DefaultServiceConfig()
+	config.RetryInterval = time.Millisecond
+	service, node, addr := newTestService(t, config)
+	msg := banktypes.NewMsgSend(addr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	res, err := service.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, uint64(4), service.Sequence())
+
+	// a failed broadcast makes the service sync again, the account query
+	// lagging behind the mempool doesn't decrease the local sequence
+	node.fail = 1
+	_, err = service.BroadcastMsgs(msg)
+	require.Error(t, err)
+	node.pending = 1
+	res, err = service.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, 2, node.syncs)
+	require.Equal(t, uint64(5), service.Sequence())
+	require.Len(t, node.txs, 2)
+
+	// the local sequence is decreased to the one expected by the node once
+	// a transaction was dropped from the mempool
+	node.pending = 0
+	node.sequence--
+	res, err = service.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, uint64(5), service.Sequence())
+	require.Len(t, node.txs, 3)
+}
+
+func TestServiceRun(t *testing.T) {
+	config := tx.DefaultServiceConfig()
+	config.MaxMsgs = 2
+	config.BatchInterval = 200 * time.Millisecond
+	service, node, addr := newTestService(t, config)
+	msg := banktypes.NewMsgSend(addr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- service.Run(ctx) }()
+
+	// the first two messages fill a transaction, the third one is sent once
+	// the batch interval elapsed
+	results := []<-chan tx.ServiceResult{service.Queue(msg), service.Queue(msg), service.Queue(msg)}
+	for _, result := range results {
+		res := <-result
+		require.NoError(t, res.Err)
+		require.Equal(t, uint32(0), res.Response.Code)
+	}
+	require.Len(t, node.txs, 2)
+	require.Len(t, node.txs[0].Msgs, 2)
+	require.Len(t, node.txs[1].Msgs, 1)
+
+	cancel()
+	require.Equal(t, context.Canceled, <-done)
+
+	res := <-service.Queue(msg)
+	require.Equal(t, tx.ErrServiceStopped, res.Err)
+}
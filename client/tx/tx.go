@@ -66,6 +66,17 @@ func GenerateTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
 	}
 
+	if txf.GasPricesAuto() {
+		if clientCtx.Offline {
+			return errors.New("cannot estimate gas prices in offline mode")
+		}
+
+		var err error
+		if txf, err = PrepareGasPrices(clientCtx, txf); err != nil {
+			return err
+		}
+	}
+
 	tx, err := BuildUnsignedTx(txf, msgs...)
 	if err != nil {
 		return err
@@ -97,6 +108,11 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return nil
 	}
 
+	txf, err = PrepareGasPrices(clientCtx, txf)
+	if err != nil {
+		return err
+	}
+
 	tx, err := BuildUnsignedTx(txf, msgs...)
 	if err != nil {
 		return err
@@ -8,7 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/tests"
 
-	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
 	"github.com/stretchr/testify/require"
 
@@ -111,7 +111,7 @@ func TestBuildUnsignedTx(t *testing.T) {
 	tx, err := tx.BuildUnsignedTx(txf, msg)
 	require.NoError(t, err)
 	require.NotNil(t, tx)
-	require.Empty(t, tx.GetTx().(ante.SigVerifiableTx).GetSignatures())
+	require.Empty(t, tx.GetTx().(authsigning.SigVerifiableTx).GetSignatures())
 }
 
 func TestSign(t *testing.T) {
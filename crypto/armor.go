@@ -18,6 +18,8 @@ const (
 	blockTypeKeyInfo = "TENDERMINT KEY INFO"
 	blockTypePubKey  = "TENDERMINT PUBLIC KEY"
 
+	blockTypeKeyringBundle = "TENDERMINT KEYRING BUNDLE"
+
 	defaultAlgo = "secp256k1"
 
 	headerVersion = "version"
@@ -143,6 +145,13 @@ func EncryptArmorPrivKey(privKey crypto.PrivKey, passphrase string, algo string)
 // generated salt and the xsalsa20 cipher. returns the salt and the
 // encrypted priv key.
 func encryptPrivKey(privKey crypto.PrivKey, passphrase string) (saltBytes []byte, encBytes []byte) {
+	return encryptBytes(privKey.Bytes(), passphrase)
+}
+
+// encrypt the given bytes with the passphrase using a randomly
+// generated salt and the xsalsa20 cipher. returns the salt and the
+// encrypted bytes.
+func encryptBytes(bz []byte, passphrase string) (saltBytes []byte, encBytes []byte) {
 	saltBytes = crypto.CRandBytes(16)
 	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
 
@@ -151,9 +160,8 @@ func encryptPrivKey(privKey crypto.PrivKey, passphrase string) (saltBytes []byte
 	}
 
 	key = crypto.Sha256(key) // get 32 bytes
-	privKeyBytes := privKey.Bytes()
 
-	return saltBytes, xsalsa20symmetric.EncryptSymmetric(privKeyBytes, key)
+	return saltBytes, xsalsa20symmetric.EncryptSymmetric(bz, key)
 }
 
 // UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error
@@ -190,19 +198,69 @@ func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKey crypto.P
 }
 
 func decryptPrivKey(saltBytes []byte, encBytes []byte, passphrase string) (privKey crypto.PrivKey, err error) {
+	privKeyBytes, err := decryptBytes(saltBytes, encBytes, passphrase)
+	if err != nil {
+		return privKey, err
+	}
+
+	return cryptoAmino.PrivKeyFromBytes(privKeyBytes)
+}
+
+func decryptBytes(saltBytes []byte, encBytes []byte, passphrase string) ([]byte, error) {
 	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
 	if err != nil {
-		return privKey, sdkerrors.Wrap(err, "error generating bcrypt key from passphrase")
+		return nil, sdkerrors.Wrap(err, "error generating bcrypt key from passphrase")
 	}
 
 	key = crypto.Sha256(key) // Get 32 bytes
 
-	privKeyBytes, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
+	bz, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
 	if err != nil && err.Error() == "Ciphertext decryption failed" {
-		return privKey, sdkerrors.ErrWrongPassword
+		return nil, sdkerrors.ErrWrongPassword
 	} else if err != nil {
-		return privKey, err
+		return nil, err
 	}
 
-	return cryptoAmino.PrivKeyFromBytes(privKeyBytes)
+	return bz, nil
+}
+
+// EncryptArmorKeyringBundle encrypts and armors the serialized keys of a
+// keyring.
+func EncryptArmorKeyringBundle(bz []byte, passphrase string) string {
+	saltBytes, encBytes := encryptBytes(bz, passphrase)
+	header := map[string]string{
+		"kdf":         "bcrypt",
+		"salt":        fmt.Sprintf("%X", saltBytes),
+		headerVersion: "0.0.1",
+	}
+
+	return armor.EncodeArmor(blockTypeKeyringBundle, header, encBytes)
+}
+
+// UnarmorDecryptKeyringBundle returns the serialized keys of a keyring bundle
+// armored by EncryptArmorKeyringBundle.
+func UnarmorDecryptKeyringBundle(armorStr string, passphrase string) ([]byte, error) {
+	encBytes, header, err := unarmorBytes(armorStr, blockTypeKeyringBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	if header[headerVersion] != "0.0.1" {
+		return nil, fmt.Errorf("unrecognized version: %v", header[headerVersion])
+	}
+
+	if header["kdf"] != "bcrypt" {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", header["kdf"])
+	}
+
+	if header["salt"] == "" {
+		return nil, fmt.Errorf("missing salt bytes")
+	}
+
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding salt: %v", err.Error())
+	}
+
+	return decryptBytes(saltBytes, encBytes, passphrase)
 }
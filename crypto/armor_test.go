@@ -156,6 +156,32 @@ func TestUnarmorInfoBytesErrors(t *testing.T) {
 	require.Nil(t, unarmoredBytes)
 }
 
+func TestArmorUnarmorKeyringBundle(t *testing.T) {
+	bz := []byte("keys")
+	armored := crypto.EncryptArmorKeyringBundle(bz, "passphrase")
+
+	_, err := crypto.UnarmorDecryptKeyringBundle(armored, "wrongpassphrase")
+	require.Error(t, err)
+	decrypted, err := crypto.UnarmorDecryptKeyringBundle(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, bz, decrypted)
+
+	// wrong armor type
+	_, err = crypto.UnarmorDecryptKeyringBundle(crypto.EncryptArmorPrivKey(secp256k1.GenPrivKey(), "passphrase", ""), "passphrase")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unrecognized armor type")
+
+	// wrong version
+	header := map[string]string{
+		"kdf":     "bcrypt",
+		"salt":    "00",
+		"version": "0.0.0",
+	}
+	_, err = crypto.UnarmorDecryptKeyringBundle(armor.EncodeArmor("TENDERMINT KEYRING BUNDLE", header, bz), "passphrase")
+	require.Error(t, err)
+	require.Equal(t, "unrecognized version: 0.0.0", err.Error())
+}
+
 func BenchmarkBcryptGenerateFromPassword(b *testing.B) {
 	passphrase := []byte("passphrase")
 	for securityParam := 9; securityParam < 16; securityParam++ {
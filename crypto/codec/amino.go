@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/secp256r1"
 )
 
 var amino *codec.Codec
@@ -27,8 +28,12 @@ func RegisterCrypto(cdc *codec.Codec) {
 		sr25519.PubKeyAminoName, nil)
 	cdc.RegisterConcrete(secp256k1.PubKeySecp256k1{},
 		secp256k1.PubKeyAminoName, nil)
+	cdc.RegisterConcrete(secp256r1.PubKeySecp256r1{},
+		secp256r1.PubKeyAminoName, nil)
 	cdc.RegisterConcrete(multisig.PubKeyMultisigThreshold{},
 		multisig.PubKeyAminoRoute, nil)
+	cdc.RegisterConcrete(multisig.PubKeyMultisigWeighted{},
+		multisig.PubKeyWeightedAminoRoute, nil)
 
 	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
 	cdc.RegisterConcrete(ed25519.PrivKeyEd25519{},
@@ -37,6 +42,8 @@ func RegisterCrypto(cdc *codec.Codec) {
 		sr25519.PrivKeyAminoName, nil)
 	cdc.RegisterConcrete(secp256k1.PrivKeySecp256k1{},
 		secp256k1.PrivKeyAminoName, nil)
+	cdc.RegisterConcrete(secp256r1.PrivKeySecp256r1{},
+		secp256r1.PrivKeyAminoName, nil)
 }
 
 // PrivKeyFromBytes unmarshals private key bytes and returns a PrivKey
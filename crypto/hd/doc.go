@@ -4,6 +4,9 @@
 //  https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki
 //  https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki
 //
+// secp256r1 keys are derived following SLIP-10 for the nist256p1 curve:
+//  https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+//
 // In combination with the bip39 package in go-crypto this package provides the functionality for
 // deriving keys using a BIP 44 HD path, or, more general, by passing a BIP 32 path.
 //
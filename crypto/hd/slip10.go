@@ -0,0 +1,101 @@
+package hd
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types/secp256r1"
+)
+
+// nist256p1Order is the order of the NIST P-256 curve.
+var nist256p1Order = elliptic.P256().Params().N
+
+// ComputeNist256p1MastersFromSeed returns the SLIP-10 nist256p1 master secret
+// and chain code for the given seed. For more information see:
+//  - https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+func ComputeNist256p1MastersFromSeed(seed []byte) (secret [32]byte, chainCode [32]byte) {
+	key := []byte("Nist256p1 seed")
+	secret, chainCode = i64(key, seed)
+
+	// retry with the whole HMAC output while the secret is not a valid scalar
+	for !validNist256p1Scalar(secret) {
+		secret, chainCode = i64(key, append(secret[:], chainCode[:]...))
+	}
+
+	return
+}
+
+// DeriveNist256p1PrivateKeyForPath derives the nist256p1 private key by
+// following the SLIP-10 path from privKeyBytes, using the given chainCode.
+func DeriveNist256p1PrivateKeyForPath(privKeyBytes [32]byte, chainCode [32]byte, path string) ([32]byte, error) {
+	data := privKeyBytes
+	parts := strings.Split(path, "/")
+
+	for _, part := range parts {
+		harden := strings.HasSuffix(part, "'")
+		if harden {
+			part = part[:len(part)-1]
+		}
+
+		idx, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return [32]byte{}, fmt.Errorf("invalid BIP 32 path: %s", err)
+		}
+
+		data, chainCode = deriveNist256p1PrivateKey(data, chainCode, uint32(idx), harden)
+	}
+
+	if !validNist256p1Scalar(data) {
+		return [32]byte{}, errors.New("invalid nist256p1 private key")
+	}
+
+	return data, nil
+}
+
+// deriveNist256p1PrivateKey derives the child private key with index and
+// chainCode. If harden is true, the derivation is 'hardened'.
+// It returns the new private key and new chain code.
+func deriveNist256p1PrivateKey(privKeyBytes [32]byte, chainCode [32]byte, index uint32, harden bool) ([32]byte, [32]byte) {
+	var data []byte
+
+	if harden {
+		index |= 0x80000000
+		data = append([]byte{byte(0)}, privKeyBytes[:]...)
+	} else {
+		pubKey := secp256r1.PrivKeySecp256r1(privKeyBytes).PubKey().(secp256r1.PubKeySecp256r1)
+		data = pubKey[:]
+	}
+
+	indexBytes := uint32ToBytes(index)
+
+	for {
+		il, ir := i64(chainCode[:], append(data, indexBytes...))
+
+		// SLIP-10 retries with the right half of the HMAC output, instead of
+		// skipping the index as BIP 32 does, when the child key is invalid
+		if validNist256p1Scalar(il) {
+			child := new(big.Int).Add(new(big.Int).SetBytes(il[:]), new(big.Int).SetBytes(privKeyBytes[:]))
+			child.Mod(child, nist256p1Order)
+
+			var childKey [32]byte
+			if child.Sign() != 0 {
+				bz := child.Bytes()
+				copy(childKey[32-len(bz):], bz)
+				return childKey, ir
+			}
+		}
+
+		data = append([]byte{byte(1)}, ir[:]...)
+	}
+}
+
+// validNist256p1Scalar returns true if the big-endian scalar is in the
+// [1, N-1] range of valid nist256p1 private keys.
+func validNist256p1Scalar(bz [32]byte) bool {
+	d := new(big.Int).SetBytes(bz[:])
+	return d.Sign() > 0 && d.Cmp(nist256p1Order) < 0
+}
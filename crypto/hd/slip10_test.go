@@ -0,0 +1,41 @@
+package hd_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+)
+
+// Test vectors from https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+func TestDeriveNist256p1PrivateKeyForPath(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	master, ch := hd.ComputeNist256p1MastersFromSeed(seed)
+	require.Equal(t, "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2", hex.EncodeToString(master[:]))
+	require.Equal(t, "beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea", hex.EncodeToString(ch[:]))
+
+	testCases := []struct {
+		path    string
+		privKey string
+	}{
+		{"0'", "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"},
+		// both derivations need a retry
+		{"28578'", "06f0db126f023755d0b8d86d4591718a5210dd8d024e3e14b6159d63f53aa669"},
+		{"28578'/33941", "092154eed4af83e078ff9b84322015aefe5769e31270f62c3f66c33888335f3a"},
+	}
+
+	for _, tc := range testCases {
+		derived, err := hd.DeriveNist256p1PrivateKeyForPath(master, ch, tc.path)
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.privKey, hex.EncodeToString(derived[:]), tc.path)
+	}
+
+	_, err = hd.DeriveNist256p1PrivateKeyForPath(master, ch, "0'/x")
+	require.Error(t, err)
+	_, err = hd.DeriveNist256p1PrivateKeyForPath(master, ch, "2147483648")
+	require.Error(t, err)
+}
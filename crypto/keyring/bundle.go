@@ -0,0 +1,32 @@
+package keyring
+
+import (
+	"fmt"
+)
+
+// keyringBundle holds the keys exported by ExportAllArmor
+type keyringBundle struct {
+	Infos []Info `json:"infos"`
+}
+
+// MigrateBackend copies every key of src, including private keys as well as
+// ledger, offline and multisig references, to dst and returns them. dst must be
+// one of the local keyring backends. No key is copied if any of them already
+// exists in dst. Keys are not removed from src.
+func MigrateBackend(src, dst Keyring) ([]Info, error) {
+	ks, ok := dst.(keystore)
+	if !ok {
+		return nil, fmt.Errorf("cannot migrate keys to a %T keyring", dst)
+	}
+
+	infos, err := src.List()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ks.writeInfos(infos); err != nil {
+		return nil, err
+	}
+
+	return infos, nil
+}
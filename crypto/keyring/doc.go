@@ -43,4 +43,8 @@
 // 			be unlocked and it should be use only for testing purposes.
 // 	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 // 			are discarded when the process terminates or the type instance is garbage collected.
+// 	remote	Same instance as returned by NewRemote. Keys are held by a gRPC signing service, such
+// 			as the one returned by NewSignerServer, which lists them and signs with them. The
+// 			connection is configured in keyring-remote/config.toml within the apps configuration
+// 			directory.
 package keyring
@@ -10,4 +10,9 @@ var (
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrUnsupportedByRemote is raised when the caller tries to manage keys
+	// or export private keys through the remote keyring backend. Keys are
+	// managed by the signing service itself.
+	ErrUnsupportedByRemote = errors.New("operation not supported by the remote keyring backend")
 )
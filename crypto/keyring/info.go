@@ -31,6 +31,7 @@ var (
 	_ Info = &ledgerInfo{}
 	_ Info = &offlineInfo{}
 	_ Info = &multiInfo{}
+	_ Info = &remoteInfo{}
 )
 
 // localInfo is the public information about a locally stored key
@@ -189,22 +190,50 @@ type multiInfo struct {
 	PubKeys   []multisigPubKeyInfo `json:"pubkeys"`
 }
 
-// NewMultiInfo creates a new multiInfo instance
+// NewMultiInfo creates a new multiInfo instance. It panics if pub is not a
+// threshold or weighted multisig public key.
 func NewMultiInfo(name string, pub crypto.PubKey) Info {
-	multiPK := pub.(multisig.PubKeyMultisigThreshold)
+	info, err := newMultiInfo(name, pub)
+	if err != nil {
+		panic(err)
+	}
 
-	pubKeys := make([]multisigPubKeyInfo, len(multiPK.PubKeys))
-	for i, pk := range multiPK.PubKeys {
-		// TODO: Recursively check pk for total weight?
-		pubKeys[i] = multisigPubKeyInfo{pk, 1}
+	return info
+}
+
+// newMultiInfo creates a new multiInfo instance. Members of weighted multisig
+// keys keep their weight, members of threshold multisig keys have a weight of 1.
+func newMultiInfo(name string, pub crypto.PubKey) (Info, error) {
+	var (
+		threshold uint
+		pubKeys   []multisigPubKeyInfo
+	)
+
+	switch multiPK := pub.(type) {
+	case multisig.PubKeyMultisigThreshold:
+		threshold = multiPK.K
+		pubKeys = make([]multisigPubKeyInfo, len(multiPK.PubKeys))
+		for i, pk := range multiPK.PubKeys {
+			pubKeys[i] = multisigPubKeyInfo{pk, 1}
+		}
+
+	case multisig.PubKeyMultisigWeighted:
+		threshold = multiPK.Threshold
+		pubKeys = make([]multisigPubKeyInfo, len(multiPK.PubKeys))
+		for i, pk := range multiPK.PubKeys {
+			pubKeys[i] = multisigPubKeyInfo{pk, multiPK.Weights[i]}
+		}
+
+	default:
+		return nil, fmt.Errorf("expected a multisig public key, got %T", pub)
 	}
 
 	return &multiInfo{
 		Name:      name,
 		PubKey:    pub,
-		Threshold: multiPK.K,
+		Threshold: threshold,
 		PubKeys:   pubKeys,
-	}
+	}, nil
 }
 
 // GetType implements Info interface
@@ -237,6 +266,51 @@ func (i multiInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// remoteInfo is the public information about a key held by a remote signing service
+type remoteInfo struct {
+	Name   string        `json:"name"`
+	PubKey crypto.PubKey `json:"pubkey"`
+	Algo   hd.PubKeyType `json:"algo"`
+}
+
+func newRemoteInfo(name string, pub crypto.PubKey, algo hd.PubKeyType) Info {
+	return &remoteInfo{
+		Name:   name,
+		PubKey: pub,
+		Algo:   algo,
+	}
+}
+
+// GetType implements Info interface
+func (i remoteInfo) GetType() KeyType {
+	return TypeRemote
+}
+
+// GetName implements Info interface
+func (i remoteInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i remoteInfo) GetPubKey() crypto.PubKey {
+	return i.PubKey
+}
+
+// GetAddress implements Info interface
+func (i remoteInfo) GetAddress() types.AccAddress {
+	return i.PubKey.Address().Bytes()
+}
+
+// GetAlgo implements Info interface
+func (i remoteInfo) GetAlgo() hd.PubKeyType {
+	return i.Algo
+}
+
+// GetPath implements Info interface
+func (i remoteInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
 // encoding info
 func marshalInfo(i Info) []byte {
 	return CryptoCdc.MustMarshalBinaryLengthPrefixed(i)
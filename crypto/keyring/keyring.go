@@ -16,10 +16,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tendermint/crypto/bcrypt"
 	tmcrypto "github.com/tendermint/tendermint/crypto"
-	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -33,6 +33,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -101,6 +102,10 @@ type Importer interface {
 	ImportPrivKey(uid, armor, passphrase string) error
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
+	// ImportAllArmor imports all the keys of a passphrase-encrypted bundle
+	// created by ExportAllArmor and returns them. No key is imported if any of
+	// them already exists.
+	ImportAllArmor(armor, passphrase string) ([]Info, error)
 }
 
 // Exporter is implemented by key stores that support export of public and private keys.
@@ -112,6 +117,10 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
+	// ExportAllArmor returns every key of the keyring, including private keys
+	// as well as ledger, offline and multisig references, in a single ASCII
+	// armored passphrase-encrypted bundle.
+	ExportAllArmor(encryptPassphrase string) (armor string, err error)
 }
 
 // Option overrides keyring configuration options.
@@ -134,7 +143,9 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
+// The "remote" backend reads its configuration from keyring-remote/config.toml
+// in rootDir, see RemoteConfig.
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -146,6 +157,13 @@ func New(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(opts...), err
+	case BackendRemote:
+		config, err := LoadRemoteConfig(rootDir)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewRemote(config, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -175,7 +193,7 @@ type keystore struct {
 func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Secp256r1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -238,7 +256,7 @@ func (ks keystore) ExportPrivateKeyObject(uid string) (tmcrypto.PrivKey, error)
 			return nil, err
 		}
 
-		priv, err = cryptoamino.PrivKeyFromBytes([]byte(linfo.PrivKeyArmor))
+		priv, err = cryptocodec.PrivKeyFromBytes([]byte(linfo.PrivKeyArmor))
 		if err != nil {
 			return nil, err
 		}
@@ -259,6 +277,20 @@ func (ks keystore) ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassp
 	return ks.ExportPrivKeyArmor(byAddress.GetName(), encryptPassphrase)
 }
 
+func (ks keystore) ExportAllArmor(encryptPassphrase string) (armor string, err error) {
+	infos, err := ks.List()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := CryptoCdc.MarshalBinaryBare(keyringBundle{Infos: infos})
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.EncryptArmorKeyringBundle(bz, encryptPassphrase), nil
+}
+
 func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return fmt.Errorf("cannot overwrite key: %s", uid)
@@ -287,7 +319,7 @@ func (ks keystore) ImportPubKey(uid string, armor string) error {
 		return err
 	}
 
-	pubKey, err := cryptoamino.PubKeyFromBytes(pubBytes)
+	pubKey, err := cryptocodec.PubKeyFromBytes(pubBytes)
 	if err != nil {
 		return err
 	}
@@ -300,6 +332,24 @@ func (ks keystore) ImportPubKey(uid string, armor string) error {
 	return nil
 }
 
+func (ks keystore) ImportAllArmor(armor, passphrase string) ([]Info, error) {
+	bz, err := crypto.UnarmorDecryptKeyringBundle(armor, passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt keyring bundle")
+	}
+
+	var bundle keyringBundle
+	if err := CryptoCdc.UnmarshalBinaryBare(bz, &bundle); err != nil {
+		return nil, err
+	}
+
+	if err := ks.writeInfos(bundle.Infos); err != nil {
+		return nil, err
+	}
+
+	return bundle.Infos, nil
+}
+
 func (ks keystore) Sign(uid string, msg []byte) ([]byte, tmcrypto.PubKey, error) {
 	info, err := ks.Key(uid)
 	if err != nil {
@@ -314,7 +364,7 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, tmcrypto.PubKey, error)
 			return nil, nil, fmt.Errorf("private key not available")
 		}
 
-		priv, err = cryptoamino.PrivKeyFromBytes([]byte(i.PrivKeyArmor))
+		priv, err = cryptocodec.PrivKeyFromBytes([]byte(i.PrivKeyArmor))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -744,6 +794,33 @@ func (ks keystore) existsInDb(info Info) (bool, error) {
 	return false, nil
 }
 
+// writeInfos writes all the given infos, after checking that none of them
+// already exists so that either all or none of them are written.
+func (ks keystore) writeInfos(infos []Info) error {
+	for _, info := range infos {
+		if _, ok := info.(remoteInfo); ok {
+			return fmt.Errorf("cannot import remote key %s", info.GetName())
+		}
+
+		exists, err := ks.existsInDb(info)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("cannot overwrite key: %s", info.GetName())
+		}
+	}
+
+	for _, info := range infos {
+		if err := ks.writeInfo(info); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (ks keystore) writeOfflineKey(name string, pub tmcrypto.PubKey, algo hd.PubKeyType) (Info, error) {
 	info := newOfflineInfo(name, pub, algo)
 	err := ks.writeInfo(info)
@@ -755,8 +832,12 @@ func (ks keystore) writeOfflineKey(name string, pub tmcrypto.PubKey, algo hd.Pub
 }
 
 func (ks keystore) writeMultisigKey(name string, pub tmcrypto.PubKey) (Info, error) {
-	info := NewMultiInfo(name, pub)
-	err := ks.writeInfo(info)
+	info, err := newMultiInfo(name, pub)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ks.writeInfo(info)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/secp256r1"
 	"github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -628,6 +629,104 @@ func TestInMemoryExportImportPrivKey(t *testing.T) {
 	require.True(t, priv1.GetPubKey().Equals(priv2.GetPubKey()))
 }
 
+func TestInMemoryExportImportAll(t *testing.T) {
+	kb := NewInMemory()
+	infos := newTestInfos(t, kb)
+
+	armor, err := kb.ExportAllArmor("passphrase")
+	require.NoError(t, err)
+
+	// the bundle requires the passphrase
+	other := NewInMemory()
+	_, err = other.ImportAllArmor(armor, "wrong")
+	require.Error(t, err)
+
+	imported, err := other.ImportAllArmor(armor, "passphrase")
+	require.NoError(t, err)
+	require.Len(t, imported, len(infos))
+	requireEqualInfos(t, kb, other)
+
+	// private keys can sign once imported
+	sig, pub, err := other.Sign("local", []byte("msg"))
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes([]byte("msg"), sig))
+
+	// no key is imported when any of them already exists
+	partial := NewInMemory()
+	_, err = partial.SavePubKey("offline", infos[3].GetPubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+	_, err = partial.ImportAllArmor(armor, "passphrase")
+	require.EqualError(t, err, "cannot overwrite key: offline")
+	list, err := partial.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
+func TestMigrateBackend(t *testing.T) {
+	src := NewInMemory()
+	infos := newTestInfos(t, src)
+
+	dst := NewInMemory()
+	migrated, err := MigrateBackend(src, dst)
+	require.NoError(t, err)
+	require.Len(t, migrated, len(infos))
+	requireEqualInfos(t, src, dst)
+
+	// keys are kept in the source backend
+	list, err := src.List()
+	require.NoError(t, err)
+	require.Len(t, list, len(infos))
+
+	// keys are not migrated twice
+	_, err = MigrateBackend(src, dst)
+	require.Error(t, err)
+
+	// keys can't be migrated to a remote keyring
+	remote, err := NewRemote(RemoteConfig{Address: "localhost:26660", Insecure: true})
+	require.NoError(t, err)
+	_, err = MigrateBackend(src, remote)
+	require.Error(t, err)
+}
+
+// newTestInfos stores a key of every type in kb and returns them sorted by name
+func newTestInfos(t *testing.T, kb Keyring) []Info {
+	local, _, err := kb.NewMnemonic("local", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	ledger := newLedgerInfo("ledger", secp256k1.GenPrivKey().PubKey(), *hd.NewFundraiserParams(0, sdk.CoinType, 1), hd.Secp256k1Type)
+	require.NoError(t, kb.(keystore).writeInfo(ledger))
+
+	multi, err := kb.SaveMultisig("multi", multisig.NewPubKeyMultisigThreshold(1, []tmcrypto.PubKey{local.GetPubKey()}))
+	require.NoError(t, err)
+
+	offline, err := kb.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	return []Info{ledger, local, multi, offline}
+}
+
+// requireEqualInfos checks that both keyrings hold the same keys
+func requireEqualInfos(t *testing.T, expected, actual Keyring) {
+	expInfos, err := expected.List()
+	require.NoError(t, err)
+	actInfos, err := actual.List()
+	require.NoError(t, err)
+	require.Equal(t, expInfos, actInfos)
+
+	for _, info := range expInfos {
+		byAddress, err := actual.KeyByAddress(info.GetAddress())
+		require.NoError(t, err)
+		require.Equal(t, info, byAddress)
+	}
+
+	ledger, err := actual.Key("ledger")
+	require.NoError(t, err)
+	require.Equal(t, TypeLedger, ledger.GetType())
+	path, err := ledger.GetPath()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), path.AddressIndex)
+}
+
 func TestInMemoryExportImportPubKey(t *testing.T) {
 	// make the storage with reasonable defaults
 	cstore := NewInMemory()
@@ -728,6 +827,52 @@ func TestInMemorySeedPhrase(t *testing.T) {
 	require.Equal(t, info.GetPubKey(), newInfo.GetPubKey())
 }
 
+func TestInMemorySecp256r1(t *testing.T) {
+	cstore := NewInMemory()
+	algo := hd.Secp256r1
+
+	info, mnemonic, err := cstore.NewMnemonic("r1", English, sdk.FullFundraiserPath, algo)
+	require.NoError(t, err)
+	require.Equal(t, hd.Secp256r1Type, info.GetAlgo())
+	require.IsType(t, secp256r1.PubKeySecp256r1{}, info.GetPubKey())
+
+	// keys sign with their own algorithm
+	msg := []byte("message")
+	sig, pub, err := cstore.Sign("r1", msg)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// the same key is recovered from the mnemonic
+	recovered, err := cstore.NewAccount("recovered", mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, algo)
+	require.Error(t, err, "public key already exist in keybase")
+	require.Nil(t, recovered)
+	require.NoError(t, cstore.Delete("r1"))
+	recovered, err = cstore.NewAccount("recovered", mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, algo)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), recovered.GetPubKey())
+
+	// private keys are exported and imported
+	armor, err := cstore.ExportPrivKeyArmor("recovered", "passphrase")
+	require.NoError(t, err)
+	other := NewInMemory()
+	require.NoError(t, other.ImportPrivKey("imported", armor, "passphrase"))
+	imported, err := other.Key("imported")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+	require.Equal(t, hd.Secp256r1Type, imported.GetAlgo())
+
+	sig, _, err = other.Sign("imported", msg)
+	require.NoError(t, err)
+	require.True(t, info.GetPubKey().VerifyBytes(msg, sig))
+
+	// public keys are saved as offline keys
+	pubKey := secp256r1.GenPrivKey().PubKey()
+	offline, err := other.SavePubKey("offline", pubKey, hd.Secp256r1Type)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, offline.GetPubKey())
+}
+
 func TestKeyChain_ShouldFailWhenAddingSameGeneratedAccount(t *testing.T) {
 	dir, clean := tests.NewTestCaseDir(t)
 	t.Cleanup(clean)
@@ -992,6 +1137,46 @@ func TestAltKeyring_SaveMultisig(t *testing.T) {
 	require.Len(t, list, 3)
 }
 
+func TestAltKeyring_SaveNestedWeightedMultisig(t *testing.T) {
+	dir, clean := tests.NewTestCaseDir(t)
+	t.Cleanup(clean)
+
+	keyring, err := New(t.Name(), BackendTest, dir, nil)
+	require.NoError(t, err)
+
+	pubKeys := make([]tmcrypto.PubKey, 3)
+	for i := range pubKeys {
+		info, _, err := keyring.NewMnemonic(fmt.Sprintf("key%d", i), English, sdk.FullFundraiserPath, hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i] = info.GetPubKey()
+	}
+
+	nested := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	pub := multisig.NewPubKeyMultisigWeighted(3, []tmcrypto.PubKey{nested, pubKeys[0], pubKeys[1]}, []uint{2, 1, 1})
+
+	info, err := keyring.SaveMultisig("multi", pub)
+	require.NoError(t, err)
+	require.Equal(t, TypeMulti, info.GetType())
+	require.Equal(t, pub, info.GetPubKey())
+
+	// the key, its threshold and weights survive a round trip through the keyring
+	info, err = keyring.Key("multi")
+	require.NoError(t, err)
+	require.Equal(t, pub, info.GetPubKey())
+
+	out, err := Bech32KeyOutput(info)
+	require.NoError(t, err)
+	require.Equal(t, uint(3), out.Threshold)
+	require.Len(t, out.PubKeys, 3)
+	require.Equal(t, uint(2), out.PubKeys[0].Weight)
+	require.Equal(t, sdk.AccAddress(nested.Address()).String(), out.PubKeys[0].Address)
+	require.Equal(t, uint(1), out.PubKeys[2].Weight)
+
+	// only multisig public keys can be saved as multisig keys
+	_, err = keyring.SaveMultisig("single", pubKeys[0])
+	require.Error(t, err)
+}
+
 func TestAltKeyring_Sign(t *testing.T) {
 	dir, clean := tests.NewTestCaseDir(t)
 	t.Cleanup(clean)
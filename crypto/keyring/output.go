@@ -84,7 +84,13 @@ func Bech32KeyOutput(keyInfo Info) (KeyOutput, error) {
 
 	ko := NewKeyOutput(keyInfo.GetName(), keyInfo.GetType().String(), accAddr.String(), bechPubKey)
 
-	if mInfo, ok := keyInfo.(*multiInfo); ok {
+	// multisig keys read back from a keyring are not pointers
+	mInfo, ok := keyInfo.(*multiInfo)
+	if info, isValue := keyInfo.(multiInfo); isValue {
+		mInfo, ok = &info, true
+	}
+
+	if ok {
 		pubKeys := make([]multisigPubKeyOutput, len(mInfo.PubKeys))
 
 		for i, pk := range mInfo.PubKeys {
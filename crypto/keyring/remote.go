@@ -0,0 +1,371 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/viper"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	keyringRemoteDirName = "keyring-remote"
+	remoteConfigFileName = "config.toml"
+
+	defaultRemoteTimeout = 10 * time.Second
+)
+
+var _ Keyring = remoteKeystore{}
+
+// RemoteConfig defines the connection of the remote keyring backend to a
+// signing service. Unless Insecure is set, the connection uses mutual TLS and
+// the client presents ClientCert to the signing service.
+type RemoteConfig struct {
+	// Address is the gRPC address (host:port) of the signing service
+	Address string `mapstructure:"address"`
+
+	// CACert is the PEM encoded CA certificate used to verify the signing service.
+	// The system roots are used when empty.
+	CACert string `mapstructure:"ca-cert"`
+
+	// ClientCert and ClientKey are the PEM encoded certificate and key of the client
+	ClientCert string `mapstructure:"client-cert"`
+	ClientKey  string `mapstructure:"client-key"`
+
+	// ServerName overrides the name used to verify the certificate of the signing service
+	ServerName string `mapstructure:"server-name"`
+
+	// Insecure disables TLS. It must only be used for testing.
+	Insecure bool `mapstructure:"insecure"`
+
+	// Timeout is the timeout of every request to the signing service
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// LoadRemoteConfig reads the remote keyring backend configuration from
+// keyring-remote/config.toml in the provided directory. Relative certificate
+// paths are resolved against the directory of the configuration file.
+func LoadRemoteConfig(rootDir string) (RemoteConfig, error) {
+	dir := filepath.Join(rootDir, keyringRemoteDirName)
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(dir, remoteConfigFileName))
+	if err := v.ReadInConfig(); err != nil {
+		return RemoteConfig{}, fmt.Errorf("failed to read remote keyring config: %w", err)
+	}
+
+	var config RemoteConfig
+	if err := v.Unmarshal(&config); err != nil {
+		return RemoteConfig{}, fmt.Errorf("failed to parse remote keyring config: %w", err)
+	}
+
+	for _, path := range []*string{&config.CACert, &config.ClientCert, &config.ClientKey} {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(dir, *path)
+		}
+	}
+
+	return config, nil
+}
+
+// dialOptions returns the gRPC dial options of the configuration
+func (c RemoteConfig) dialOptions() ([]grpc.DialOption, error) {
+	if c.Insecure {
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+
+	if c.ClientCert == "" || c.ClientKey == "" {
+		return nil, fmt.Errorf("client-cert and client-key are required unless insecure is set")
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.ClientCert, c.ClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ServerName:   c.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if c.CACert != "" {
+		pool, err := loadCertPool(c.CACert)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	bz, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no valid certificate found in %s", caFile)
+	}
+
+	return pool, nil
+}
+
+// remoteKeystore is a Keyring whose keys are held by a remote signing service.
+// Only listing keys and signing go through the service, keys cannot be created,
+// imported, deleted or have their private key exported.
+type remoteKeystore struct {
+	client  SignerClient
+	timeout time.Duration
+	options Options
+}
+
+// NewRemote creates a keyring connected to the signing service described by
+// the provided configuration. The connection is established lazily.
+func NewRemote(config RemoteConfig, opts ...Option) (Keyring, error) {
+	if config.Address == "" {
+		return nil, fmt.Errorf("remote keyring signing service address is required")
+	}
+
+	dialOpts, err := config.dialOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(config.Address, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = defaultRemoteTimeout
+	}
+
+	return remoteKeystore{
+		client:  NewSignerClient(conn),
+		timeout: timeout,
+		options: newKeystore(nil, opts...).options,
+	}, nil
+}
+
+func (ks remoteKeystore) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), ks.timeout)
+}
+
+func (ks remoteKeystore) List() ([]Info, error) {
+	ctx, cancel := ks.context()
+	defer cancel()
+
+	res, err := ks.client.List(ctx, &ListRequest{})
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	infos := make([]Info, len(res.Keys))
+	for i, key := range res.Keys {
+		infos[i], err = infoFromKeyInfo(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return infos, nil
+}
+
+// SupportedAlgorithms returns the keystore Options' supported signing algorithm.
+// for the keyring and Ledger.
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (Info, error) {
+	ctx, cancel := ks.context()
+	defer cancel()
+
+	res, err := ks.client.Key(ctx, &KeyRequest{Name: uid})
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	return infoFromKeyInfo(res.Key)
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (Info, error) {
+	ctx, cancel := ks.context()
+	defer cancel()
+
+	res, err := ks.client.KeyByAddress(ctx, &KeyByAddressRequest{Address: address.Bytes()})
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	return infoFromKeyInfo(res.Key)
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	ctx, cancel := ks.context()
+	defer cancel()
+
+	res, err := ks.client.Sign(ctx, &SignRequest{Name: uid, Msg: msg})
+	if err != nil {
+		return nil, nil, fromRemoteError(err)
+	}
+
+	return signatureFromSignResponse(res)
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	ctx, cancel := ks.context()
+	defer cancel()
+
+	res, err := ks.client.SignByAddress(ctx, &SignByAddressRequest{Address: address.Bytes(), Msg: msg})
+	if err != nil {
+		return nil, nil, fromRemoteError(err)
+	}
+
+	return signatureFromSignResponse(res)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(info.GetPubKey().Bytes(), string(info.GetAlgo())), nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	info, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(info.GetPubKey().Bytes(), string(info.GetAlgo())), nil
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, SignatureAlgo) (Info, string, error) {
+	return nil, "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SavePubKey(string, tmcrypto.PubKey, hd.PubKeyType) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SaveMultisig(string, tmcrypto.PubKey) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportAllArmor(string, string) ([]Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportAllArmor(string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
+
+// keyInfoFromInfo returns the public information of a key sent by a signing service
+func keyInfoFromInfo(info Info) *KeyInfo {
+	return &KeyInfo{
+		Name:   info.GetName(),
+		Type:   info.GetType().String(),
+		PubKey: info.GetPubKey().Bytes(),
+		Algo:   string(info.GetAlgo()),
+	}
+}
+
+// infoFromKeyInfo converts a key received from a signing service to an Info.
+// Keys the signing service can sign with are returned as remote keys, while
+// offline and multisig keys keep their type.
+func infoFromKeyInfo(key *KeyInfo) (Info, error) {
+	if key == nil {
+		return nil, fmt.Errorf("signing service returned an empty key")
+	}
+
+	pub, err := cryptocodec.PubKeyFromBytes(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.Type {
+	case TypeOffline.String():
+		return newOfflineInfo(key.Name, pub, hd.PubKeyType(key.Algo)), nil
+
+	case TypeMulti.String():
+		return newMultiInfo(key.Name, pub)
+
+	default:
+		return newRemoteInfo(key.Name, pub, hd.PubKeyType(key.Algo)), nil
+	}
+}
+
+func signatureFromSignResponse(res *SignResponse) ([]byte, tmcrypto.PubKey, error) {
+	pub, err := cryptocodec.PubKeyFromBytes(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Signature, pub, nil
+}
+
+// fromRemoteError converts the errors of a signing service so that missing
+// keys are reported as sdkerrors.ErrKeyNotFound, as for local backends.
+func fromRemoteError(err error) error {
+	if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, s.Message())
+	}
+
+	return err
+}
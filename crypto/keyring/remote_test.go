@@ -0,0 +1,255 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// startSigner serves the provided keyring on a local port and returns its address
+func startSigner(t *testing.T, kr Keyring, opts ...grpc.ServerOption) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(opts...)
+	RegisterSignerServer(server, NewSignerServer(kr))
+	go server.Serve(listener) // nolint: errcheck
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	local := NewInMemory()
+	algo := hd.Secp256k1
+
+	info, _, err := local.NewMnemonic("local", English, sdk.FullFundraiserPath, algo)
+	require.NoError(t, err)
+	offline, err := local.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), algo.Name())
+	require.NoError(t, err)
+	multi, err := local.SaveMultisig("multi", multisig.NewPubKeyMultisigThreshold(1, []tmcrypto.PubKey{info.GetPubKey()}))
+	require.NoError(t, err)
+
+	kr, err := NewRemote(RemoteConfig{Address: startSigner(t, local), Insecure: true})
+	require.NoError(t, err)
+
+	// list returns all keys, local keys are reported as remote ones
+	infos, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+	require.Equal(t, "local", infos[0].GetName())
+	require.Equal(t, TypeRemote, infos[0].GetType())
+	require.Equal(t, info.GetPubKey(), infos[0].GetPubKey())
+	require.Equal(t, algo.Name(), infos[0].GetAlgo())
+	require.Equal(t, multi, infos[1])
+	require.Equal(t, offline, infos[2])
+
+	// keys are retrieved by name and address
+	key, err := kr.Key("local")
+	require.NoError(t, err)
+	require.Equal(t, infos[0], key)
+	key, err = kr.KeyByAddress(info.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, infos[0], key)
+
+	_, err = kr.Key("missing")
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err))
+	_, err = kr.KeyByAddress(sdk.AccAddress("missing"))
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err))
+
+	// signatures are produced by the signing service
+	msg := []byte("message")
+	sig, pub, err := kr.Sign("local", msg)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	sig, pub, err = kr.SignByAddress(info.GetAddress(), msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	_, _, err = kr.Sign("offline", msg)
+	require.Error(t, err)
+	_, _, err = kr.Sign("missing", msg)
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err))
+
+	// public keys can be exported, private keys can't
+	armor, err := kr.ExportPubKeyArmor("local")
+	require.NoError(t, err)
+	bz, armorAlgo, err := crypto.UnarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey().Bytes(), bz)
+	require.Equal(t, string(algo.Name()), armorAlgo)
+
+	_, err = kr.ExportPrivKeyArmor("local", "passphrase")
+	require.Equal(t, ErrUnsupportedByRemote, err)
+	require.Equal(t, ErrUnsupportedByRemote, kr.Delete("local"))
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, algo)
+	require.Equal(t, ErrUnsupportedByRemote, err)
+
+	// remote keys can't be re-exported
+	_, err = infos[0].GetPath()
+	require.Error(t, err)
+}
+
+func TestRemoteKeyringMutualTLS(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	ca, caKey := writeCert(t, dir, "ca", nil, nil)
+	writeCert(t, dir, "server", ca, caKey)
+	writeCert(t, dir, "client", ca, caKey)
+	otherCA, otherCAKey := writeCert(t, dir, "other-ca", nil, nil)
+	writeCert(t, dir, "other-client", otherCA, otherCAKey)
+
+	local := NewInMemory()
+	info, _, err := local.NewMnemonic("local", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	tlsConfig, err := NewSignerServerTLSConfig(
+		filepath.Join(dir, "server.pem"), filepath.Join(dir, "server-key.pem"), filepath.Join(dir, "ca.pem"),
+	)
+	require.NoError(t, err)
+	address := startSigner(t, local, grpc.Creds(credentials.NewTLS(tlsConfig)))
+
+	// the remote backend reads relative paths from its configuration directory
+	remoteDir := filepath.Join(dir, keyringRemoteDirName)
+	require.NoError(t, os.MkdirAll(remoteDir, 0700))
+	writeConfig := func(client string) {
+		config := fmt.Sprintf(`address = "%s"
+ca-cert = "../ca.pem"
+client-cert = "../%s.pem"
+client-key = "../%s-key.pem"
+server-name = "localhost"
+timeout = "5s"
+`, address, client, client)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(remoteDir, remoteConfigFileName), []byte(config), 0600))
+	}
+
+	writeConfig("client")
+	kr, err := New("cosmos", BackendRemote, dir, strings.NewReader(""))
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, kr.(remoteKeystore).timeout)
+
+	msg := []byte("message")
+	sig, pub, err := kr.Sign("local", msg)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// clients with a certificate from another CA are rejected
+	writeConfig("other-client")
+	kr, err = New("cosmos", BackendRemote, dir, strings.NewReader(""))
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.Error(t, err)
+
+	// clients must be configured with a certificate
+	_, err = NewRemote(RemoteConfig{
+		Address:    address,
+		CACert:     filepath.Join(dir, "ca.pem"),
+		ServerName: "localhost",
+	})
+	require.Error(t, err)
+	_, err = NewRemote(RemoteConfig{
+		Address:    address,
+		CACert:     filepath.Join(dir, "ca.pem"),
+		ClientCert: filepath.Join(dir, "client.pem"),
+		ServerName: "localhost",
+	})
+	require.Error(t, err)
+
+	// signing services must be configured with the CA of their clients
+	_, err = NewSignerServerTLSConfig(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server-key.pem"), "")
+	require.Error(t, err)
+}
+
+func TestRemoteKeyringConfig(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	// the configuration file is required
+	_, err := New("cosmos", BackendRemote, dir, strings.NewReader(""))
+	require.Error(t, err)
+
+	// the address is required
+	_, err = NewRemote(RemoteConfig{Insecure: true})
+	require.Error(t, err)
+
+	// certificates must exist
+	_, err = NewRemote(RemoteConfig{Address: "localhost:26660", CACert: filepath.Join(dir, "missing.pem")})
+	require.Error(t, err)
+}
+
+// writeCert writes a PEM encoded certificate and key for localhost signed by
+// the provided CA to dir. A self-signed CA is created when parent is nil.
+func writeCert(
+	t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	} else {
+		template.DNSNames = []string{"localhost"}
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(
+		filepath.Join(dir, name+".pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600,
+	))
+	require.NoError(t, ioutil.WriteFile(
+		filepath.Join(dir, name+"-key.pem"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600,
+	))
+
+	_, err = tls.LoadX509KeyPair(filepath.Join(dir, name+".pem"), filepath.Join(dir, name+"-key.pem"))
+	require.NoError(t, err)
+
+	return cert, key
+}
@@ -0,0 +1,120 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/keyring"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ SignerServer = signerServer{}
+
+// signerServer implements the Signer gRPC service on top of a Keyring. It only
+// ever sends public keys and signatures, private keys never leave the keyring.
+type signerServer struct {
+	kr Keyring
+}
+
+// NewSignerServer returns a signing service backed by the provided keyring to
+// be used by the remote keyring backend.
+func NewSignerServer(kr Keyring) SignerServer {
+	return signerServer{kr: kr}
+}
+
+// NewSignerServerTLSConfig returns the mutual TLS configuration of a signing
+// service serving the provided certificate. Clients must present a certificate
+// signed by the CA of clientCAFile.
+func NewSignerServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if clientCAFile == "" {
+		return nil, fmt.Errorf("the CA certificate of the clients is required")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	pool, err := loadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// List implements SignerServer.List
+func (s signerServer) List(_ context.Context, _ *ListRequest) (*ListResponse, error) {
+	infos, err := s.kr.List()
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	keys := make([]*KeyInfo, len(infos))
+	for i, info := range infos {
+		keys[i] = keyInfoFromInfo(info)
+	}
+
+	return &ListResponse{Keys: keys}, nil
+}
+
+// Key implements SignerServer.Key
+func (s signerServer) Key(_ context.Context, req *KeyRequest) (*KeyResponse, error) {
+	info, err := s.kr.Key(req.Name)
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return &KeyResponse{Key: keyInfoFromInfo(info)}, nil
+}
+
+// KeyByAddress implements SignerServer.KeyByAddress
+func (s signerServer) KeyByAddress(_ context.Context, req *KeyByAddressRequest) (*KeyResponse, error) {
+	info, err := s.kr.KeyByAddress(sdk.AccAddress(req.Address))
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return &KeyResponse{Key: keyInfoFromInfo(info)}, nil
+}
+
+// Sign implements SignerServer.Sign
+func (s signerServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	sig, pub, err := s.kr.Sign(req.Name, req.Msg)
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return &SignResponse{Signature: sig, PubKey: pub.Bytes()}, nil
+}
+
+// SignByAddress implements SignerServer.SignByAddress
+func (s signerServer) SignByAddress(_ context.Context, req *SignByAddressRequest) (*SignResponse, error) {
+	sig, pub, err := s.kr.SignByAddress(sdk.AccAddress(req.Address), req.Msg)
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return &SignResponse{Signature: sig, PubKey: pub.Bytes()}, nil
+}
+
+// toRemoteError converts keyring errors to gRPC status errors so that missing
+// keys can be told apart by the remote keyring backend.
+func toRemoteError(err error) error {
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) || errors.Is(err, keyring.ErrKeyNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return status.Error(codes.Unknown, err.Error())
+}
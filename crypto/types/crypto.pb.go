@@ -34,6 +34,7 @@ type PublicKey struct {
 	//	*PublicKey_Sr25519
 	//	*PublicKey_Multisig
 	//	*PublicKey_Secp256R1
+	//	*PublicKey_MultisigWeighted
 	//	*PublicKey_AnyPubkey
 	Sum isPublicKey_Sum `protobuf_oneof:"sum"`
 }
@@ -92,16 +93,20 @@ type PublicKey_Multisig struct {
 type PublicKey_Secp256R1 struct {
 	Secp256R1 []byte `protobuf:"bytes,5,opt,name=secp256r1,proto3,oneof" json:"secp256r1,omitempty"`
 }
+type PublicKey_MultisigWeighted struct {
+	MultisigWeighted *PubKeyMultisigWeighted `protobuf:"bytes,6,opt,name=multisig_weighted,json=multisigWeighted,proto3,oneof" json:"multisig_weighted,omitempty"`
+}
 type PublicKey_AnyPubkey struct {
 	AnyPubkey *types.Any `protobuf:"bytes,15,opt,name=any_pubkey,json=anyPubkey,proto3,oneof" json:"any_pubkey,omitempty"`
 }
 
-func (*PublicKey_Secp256K1) isPublicKey_Sum() {}
-func (*PublicKey_Ed25519) isPublicKey_Sum()   {}
-func (*PublicKey_Sr25519) isPublicKey_Sum()   {}
-func (*PublicKey_Multisig) isPublicKey_Sum()  {}
-func (*PublicKey_Secp256R1) isPublicKey_Sum() {}
-func (*PublicKey_AnyPubkey) isPublicKey_Sum() {}
+func (*PublicKey_Secp256K1) isPublicKey_Sum()        {}
+func (*PublicKey_Ed25519) isPublicKey_Sum()          {}
+func (*PublicKey_Sr25519) isPublicKey_Sum()          {}
+func (*PublicKey_Multisig) isPublicKey_Sum()         {}
+func (*PublicKey_Secp256R1) isPublicKey_Sum()        {}
+func (*PublicKey_MultisigWeighted) isPublicKey_Sum() {}
+func (*PublicKey_AnyPubkey) isPublicKey_Sum()        {}
 
 func (m *PublicKey) GetSum() isPublicKey_Sum {
 	if m != nil {
@@ -145,6 +150,13 @@ func (m *PublicKey) GetSecp256R1() []byte {
 	return nil
 }
 
+func (m *PublicKey) GetMultisigWeighted() *PubKeyMultisigWeighted {
+	if x, ok := m.GetSum().(*PublicKey_MultisigWeighted); ok {
+		return x.MultisigWeighted
+	}
+	return nil
+}
+
 func (m *PublicKey) GetAnyPubkey() *types.Any {
 	if x, ok := m.GetSum().(*PublicKey_AnyPubkey); ok {
 		return x.AnyPubkey
@@ -160,6 +172,7 @@ func (*PublicKey) XXX_OneofWrappers() []interface{} {
 		(*PublicKey_Sr25519)(nil),
 		(*PublicKey_Multisig)(nil),
 		(*PublicKey_Secp256R1)(nil),
+		(*PublicKey_MultisigWeighted)(nil),
 		(*PublicKey_AnyPubkey)(nil),
 	}
 }
@@ -218,6 +231,68 @@ func (m *PubKeyMultisigThreshold) GetPubKeys() []*PublicKey {
 	return nil
 }
 
+// PubKeyMultisigWeighted specifies a public key type which nests multiple public
+// keys with a weight each and a threshold the weights of the signers must reach
+type PubKeyMultisigWeighted struct {
+	Threshold uint32       `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty" yaml:"threshold"`
+	PubKeys   []*PublicKey `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" yaml:"pubkeys"`
+	Weights   []uint32     `protobuf:"varint,3,rep,packed,name=weights,proto3" json:"weights,omitempty" yaml:"weights"`
+}
+
+func (m *PubKeyMultisigWeighted) Reset()         { *m = PubKeyMultisigWeighted{} }
+func (m *PubKeyMultisigWeighted) String() string { return proto.CompactTextString(m) }
+func (*PubKeyMultisigWeighted) ProtoMessage()    {}
+func (*PubKeyMultisigWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fa415c569c5d31a, []int{2}
+}
+func (m *PubKeyMultisigWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyMultisigWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyMultisigWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyMultisigWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyMultisigWeighted.Merge(m, src)
+}
+func (m *PubKeyMultisigWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyMultisigWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyMultisigWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyMultisigWeighted proto.InternalMessageInfo
+
+func (m *PubKeyMultisigWeighted) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *PubKeyMultisigWeighted) GetPubKeys() []*PublicKey {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func (m *PubKeyMultisigWeighted) GetWeights() []uint32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
 // MultiSignature wraps the signatures from a PubKeyMultisigThreshold.
 // See cosmos_sdk.tx.v1.ModeInfo.Multi for how to specify which signers signed
 // and with which modes
@@ -230,7 +305,7 @@ func (m *MultiSignature) Reset()         { *m = MultiSignature{} }
 func (m *MultiSignature) String() string { return proto.CompactTextString(m) }
 func (*MultiSignature) ProtoMessage()    {}
 func (*MultiSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fa415c569c5d31a, []int{2}
+	return fileDescriptor_5fa415c569c5d31a, []int{3}
 }
 func (m *MultiSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,7 +353,7 @@ type CompactBitArray struct {
 func (m *CompactBitArray) Reset()      { *m = CompactBitArray{} }
 func (*CompactBitArray) ProtoMessage() {}
 func (*CompactBitArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fa415c569c5d31a, []int{3}
+	return fileDescriptor_5fa415c569c5d31a, []int{4}
 }
 func (m *CompactBitArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,6 +399,7 @@ func (m *CompactBitArray) GetElems() []byte {
 func init() {
 	proto.RegisterType((*PublicKey)(nil), "cosmos.crypto.PublicKey")
 	proto.RegisterType((*PubKeyMultisigThreshold)(nil), "cosmos.crypto.PubKeyMultisigThreshold")
+	proto.RegisterType((*PubKeyMultisigWeighted)(nil), "cosmos.crypto.PubKeyMultisigWeighted")
 	proto.RegisterType((*MultiSignature)(nil), "cosmos.crypto.MultiSignature")
 	proto.RegisterType((*CompactBitArray)(nil), "cosmos.crypto.CompactBitArray")
 }
@@ -331,39 +407,43 @@ func init() {
 func init() { proto.RegisterFile("cosmos/crypto/crypto.proto", fileDescriptor_5fa415c569c5d31a) }
 
 var fileDescriptor_5fa415c569c5d31a = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x76, 0x65, 0xab, 0xbb, 0xad, 0x60, 0x55, 0x22, 0xab, 0x44, 0x52, 0xe5, 0x80,
-	0x0a, 0x12, 0xa9, 0x5a, 0xd4, 0x21, 0x7a, 0x5b, 0xc6, 0x61, 0x52, 0x85, 0x54, 0x65, 0x1c, 0x10,
-	0x12, 0xaa, 0x92, 0xd4, 0xa4, 0x51, 0x93, 0x38, 0xb2, 0x1d, 0x09, 0x3f, 0x01, 0x57, 0x8e, 0x1c,
-	0xb7, 0x3b, 0x0f, 0xc2, 0xb1, 0x47, 0x4e, 0x15, 0x4a, 0xdf, 0x60, 0x4f, 0x80, 0x6a, 0x27, 0xdb,
-	0x00, 0x71, 0x72, 0xbe, 0xff, 0xef, 0x73, 0xfc, 0xff, 0x7f, 0x36, 0xec, 0x06, 0x84, 0x25, 0x84,
-	0x0d, 0x02, 0x2a, 0x32, 0x4e, 0xca, 0xc5, 0xce, 0x28, 0xe1, 0x04, 0x1d, 0x29, 0x66, 0x2b, 0xb1,
-	0xdb, 0x09, 0x49, 0x48, 0x24, 0x19, 0xec, 0xbe, 0x54, 0x53, 0xf7, 0x24, 0x24, 0x24, 0x8c, 0xf1,
-	0x40, 0x56, 0x7e, 0xfe, 0x69, 0xe0, 0xa5, 0x42, 0x21, 0xeb, 0x4b, 0x0d, 0x36, 0x67, 0xb9, 0x1f,
-	0x47, 0xc1, 0x14, 0x0b, 0x64, 0xc0, 0x26, 0xc3, 0x41, 0x36, 0x1a, 0x9f, 0xae, 0x86, 0x3a, 0xe8,
-	0x81, 0xfe, 0xe1, 0x85, 0xe6, 0xde, 0x49, 0xa8, 0x0b, 0xf7, 0xf1, 0x62, 0x34, 0x1e, 0x0f, 0x5f,
-	0xeb, 0xb5, 0x92, 0x56, 0xc2, 0x8e, 0x31, 0xaa, 0x58, 0xbd, 0x62, 0xa5, 0x80, 0xde, 0xc0, 0x83,
-	0x24, 0x8f, 0x79, 0xc4, 0xa2, 0x50, 0xdf, 0xeb, 0x81, 0x7e, 0x6b, 0xf4, 0xd4, 0xfe, 0xc3, 0xb8,
-	0x3d, 0xcb, 0xfd, 0x29, 0x16, 0x6f, 0xcb, 0xa6, 0x77, 0x4b, 0x8a, 0xd9, 0x92, 0xc4, 0x8b, 0x0b,
-	0xcd, 0xbd, 0xdd, 0x79, 0xcf, 0x1d, 0x1d, 0xea, 0x8d, 0xbf, 0xdc, 0xd1, 0x21, 0x1a, 0x43, 0xe8,
-	0xa5, 0x62, 0x9e, 0xe5, 0xfe, 0x0a, 0x0b, 0xbd, 0x2d, 0xcf, 0xe9, 0xd8, 0x2a, 0xbb, 0x5d, 0x65,
-	0xb7, 0xcf, 0x52, 0xb1, 0xdb, 0xe6, 0xa5, 0x62, 0x26, 0x1b, 0x9d, 0x06, 0xac, 0xb3, 0x3c, 0xb1,
-	0xbe, 0x03, 0xf8, 0xf8, 0x3f, 0x2e, 0xd0, 0x2b, 0xd8, 0xe4, 0x55, 0x21, 0xe7, 0x72, 0xe4, 0x9c,
-	0x14, 0x1b, 0x13, 0x4c, 0x6f, 0x36, 0xe6, 0x43, 0xe1, 0x25, 0xf1, 0xc4, 0xba, 0xe5, 0x96, 0x7b,
-	0xd7, 0x8b, 0xde, 0xc3, 0x56, 0x26, 0xa7, 0x3b, 0x5f, 0x61, 0xc1, 0xf4, 0x5a, 0xaf, 0xde, 0x6f,
-	0x8d, 0xf4, 0x7f, 0xb3, 0xab, 0xf9, 0x3b, 0x4f, 0x8a, 0x8d, 0xb9, 0xaf, 0x4c, 0xb0, 0x9b, 0x8d,
-	0x79, 0xac, 0x7e, 0xad, 0x02, 0x31, 0xcb, 0x85, 0x59, 0xd5, 0xc9, 0xac, 0x53, 0x78, 0x2c, 0x7d,
-	0x5e, 0x46, 0x61, 0xea, 0xf1, 0x9c, 0x62, 0x64, 0x40, 0xc8, 0xaa, 0x82, 0xe9, 0xa0, 0x57, 0xef,
-	0x1f, 0xba, 0xf7, 0x94, 0xc9, 0xde, 0xfa, 0xda, 0x04, 0xd6, 0x47, 0xd8, 0x3e, 0x27, 0x49, 0xe6,
-	0x05, 0xdc, 0x89, 0xf8, 0x19, 0xa5, 0x9e, 0x40, 0xcf, 0xe1, 0x23, 0xfc, 0x99, 0x53, 0x6f, 0xee,
-	0x47, 0x9c, 0xcd, 0x19, 0x27, 0x14, 0x97, 0x29, 0xdd, 0xb6, 0x04, 0x4e, 0xc4, 0xd9, 0xa5, 0x94,
-	0x51, 0x07, 0x36, 0x70, 0x8c, 0x13, 0xa6, 0xee, 0xdf, 0x55, 0xc5, 0xe4, 0xe0, 0xdb, 0x95, 0xa9,
-	0x5d, 0x5d, 0x9b, 0x9a, 0x73, 0xfe, 0xa3, 0x30, 0xc0, 0xba, 0x30, 0xc0, 0xaf, 0xc2, 0x00, 0x5f,
-	0xb7, 0x86, 0xb6, 0xde, 0x1a, 0xda, 0xcf, 0xad, 0xa1, 0x7d, 0x78, 0x16, 0x46, 0x7c, 0x99, 0xfb,
-	0x76, 0x40, 0x92, 0x41, 0xf5, 0xa0, 0xe5, 0xf2, 0x82, 0x2d, 0x56, 0xd5, 0xdb, 0xe6, 0x22, 0xc3,
-	0xcc, 0x7f, 0x20, 0x2f, 0xeb, 0xe5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xa2, 0x1f, 0xee,
-	0xf9, 0x02, 0x00, 0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x41, 0x6f, 0xd3, 0x3e,
+	0x1c, 0x4d, 0x96, 0x75, 0x5d, 0xdd, 0x75, 0xdd, 0xac, 0xea, 0xff, 0xcf, 0x2a, 0x91, 0x54, 0x91,
+	0x40, 0x05, 0x41, 0xaa, 0x16, 0x75, 0x88, 0xde, 0x96, 0x71, 0xa8, 0x54, 0x21, 0x55, 0xd9, 0x24,
+	0x10, 0x12, 0xaa, 0x92, 0xd4, 0xa4, 0x51, 0x93, 0x3a, 0x8a, 0x1d, 0x81, 0xbf, 0x05, 0x47, 0x8e,
+	0xdb, 0x9d, 0x0f, 0xc2, 0xb1, 0x07, 0x0e, 0x5c, 0xa8, 0x50, 0xfb, 0x0d, 0xf6, 0x09, 0x50, 0xed,
+	0x64, 0x1d, 0x1b, 0x70, 0xe5, 0xe4, 0xfe, 0xde, 0x7b, 0xb6, 0xdf, 0xef, 0xfd, 0xea, 0x80, 0xba,
+	0x87, 0x49, 0x84, 0x49, 0xcb, 0x4b, 0x58, 0x4c, 0x71, 0xb6, 0x98, 0x71, 0x82, 0x29, 0x86, 0x15,
+	0xc1, 0x99, 0x02, 0xac, 0xd7, 0x7c, 0xec, 0x63, 0xce, 0xb4, 0xd6, 0xbf, 0x84, 0xa8, 0x7e, 0xe4,
+	0x63, 0xec, 0x87, 0xa8, 0xc5, 0x2b, 0x37, 0x7d, 0xd7, 0x72, 0x66, 0x4c, 0x50, 0xc6, 0xf7, 0x2d,
+	0x50, 0x1a, 0xa6, 0x6e, 0x18, 0x78, 0x03, 0xc4, 0xa0, 0x06, 0x4a, 0x04, 0x79, 0x71, 0xa7, 0x7b,
+	0x3c, 0x6d, 0xab, 0x72, 0x43, 0x6e, 0xee, 0xf5, 0x25, 0x7b, 0x03, 0xc1, 0x3a, 0x28, 0xa2, 0x71,
+	0xa7, 0xdb, 0x6d, 0x3f, 0x57, 0xb7, 0x32, 0x36, 0x07, 0xd6, 0x1c, 0x49, 0x04, 0xa7, 0xe4, 0x5c,
+	0x06, 0xc0, 0x17, 0x60, 0x37, 0x4a, 0x43, 0x1a, 0x90, 0xc0, 0x57, 0xb7, 0x1b, 0x72, 0xb3, 0xdc,
+	0x79, 0x60, 0xfe, 0x62, 0xdc, 0x1c, 0xa6, 0xee, 0x00, 0xb1, 0x97, 0x99, 0xe8, 0x7c, 0x92, 0x20,
+	0x32, 0xc1, 0xe1, 0xb8, 0x2f, 0xd9, 0xd7, 0x3b, 0x6f, 0xb8, 0x4b, 0xda, 0x6a, 0xe1, 0x96, 0xbb,
+	0xa4, 0x0d, 0xcf, 0xc1, 0x61, 0xae, 0x1d, 0xbd, 0x47, 0x81, 0x3f, 0xa1, 0x68, 0xac, 0xee, 0xf0,
+	0xeb, 0xee, 0xff, 0xf5, 0xba, 0x57, 0x99, 0xb8, 0x2f, 0xd9, 0x07, 0xd1, 0x2d, 0x0c, 0x76, 0x01,
+	0x70, 0x66, 0x6c, 0x14, 0xa7, 0xee, 0x14, 0x31, 0xb5, 0xca, 0x8f, 0xab, 0x99, 0x22, 0x51, 0x33,
+	0x4f, 0xd4, 0x3c, 0x99, 0xb1, 0xb5, 0x19, 0x67, 0xc6, 0x86, 0x5c, 0x68, 0x15, 0x80, 0x42, 0xd2,
+	0xc8, 0xf8, 0x2c, 0x83, 0xff, 0xff, 0xd0, 0x1b, 0x7c, 0x06, 0x4a, 0x34, 0x2f, 0x78, 0xda, 0x15,
+	0xeb, 0x68, 0xb9, 0xd0, 0xe5, 0xc1, 0xd5, 0x42, 0x3f, 0x60, 0x4e, 0x14, 0xf6, 0x8c, 0x6b, 0xde,
+	0xb0, 0x37, 0x5a, 0xf8, 0x1a, 0x94, 0x63, 0x3e, 0xb3, 0xd1, 0x14, 0x31, 0xa2, 0x6e, 0x35, 0x94,
+	0x66, 0xb9, 0xa3, 0xde, 0x6d, 0x51, 0x4c, 0xd5, 0xba, 0xb7, 0x5c, 0xe8, 0x45, 0x61, 0x82, 0x5c,
+	0x2d, 0xf4, 0x7d, 0x71, 0xb4, 0x68, 0x88, 0x18, 0x36, 0x88, 0x73, 0x25, 0x31, 0xbe, 0xca, 0xe0,
+	0xbf, 0xdf, 0x67, 0x03, 0x3b, 0x77, 0xdd, 0xd6, 0xfe, 0x99, 0x51, 0xf8, 0x18, 0x14, 0xc5, 0x88,
+	0x89, 0xaa, 0x34, 0x94, 0x66, 0xc5, 0x82, 0x9b, 0x0d, 0x19, 0x61, 0xd8, 0xb9, 0xc4, 0x38, 0x06,
+	0xfb, 0xbc, 0x9f, 0xb3, 0xc0, 0x9f, 0x39, 0x34, 0x4d, 0x10, 0xd4, 0x00, 0x20, 0x79, 0x41, 0x54,
+	0xb9, 0xa1, 0x34, 0xf7, 0xec, 0x1b, 0x48, 0x6f, 0x7b, 0x7e, 0xa9, 0xcb, 0xc6, 0x5b, 0x50, 0x3d,
+	0xc5, 0x51, 0xec, 0x78, 0xd4, 0x0a, 0xe8, 0x49, 0x92, 0x38, 0x0c, 0x3e, 0x02, 0x87, 0xe8, 0x03,
+	0x4d, 0x9c, 0x91, 0x1b, 0x50, 0x32, 0x22, 0x14, 0x27, 0x28, 0x8b, 0xc3, 0xae, 0x72, 0xc2, 0x0a,
+	0x28, 0x39, 0xe3, 0x30, 0xac, 0x81, 0x02, 0x0a, 0x51, 0x44, 0xc4, 0x63, 0xb1, 0x45, 0xd1, 0xdb,
+	0xfd, 0x74, 0xa1, 0x4b, 0x17, 0x97, 0xba, 0x64, 0x9d, 0x7e, 0x59, 0x6a, 0xf2, 0x7c, 0xa9, 0xc9,
+	0x3f, 0x96, 0x9a, 0xfc, 0x71, 0xa5, 0x49, 0xf3, 0x95, 0x26, 0x7d, 0x5b, 0x69, 0xd2, 0x9b, 0x87,
+	0x7e, 0x40, 0x27, 0xa9, 0x6b, 0x7a, 0x38, 0x6a, 0xe5, 0xaf, 0x9f, 0x2f, 0x4f, 0xc8, 0x78, 0x9a,
+	0x7f, 0x08, 0x28, 0x8b, 0x11, 0x71, 0x77, 0xf8, 0x7f, 0xf0, 0xe9, 0xcf, 0x01, 0x00, 0xbd, 0x57,
+	0x3c, 0x2b, 0x26, 0x04, 0x00, 0x00,
 }
 
 func (m *PublicKey) Marshal() (dAtA []byte, err error) {
@@ -483,6 +563,27 @@ func (m *PublicKey_Secp256R1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *PublicKey_MultisigWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublicKey_MultisigWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MultisigWeighted != nil {
+		{
+			size, err := m.MultisigWeighted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrypto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *PublicKey_AnyPubkey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -546,6 +647,66 @@ func (m *PubKeyMultisigThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyMultisigWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyMultisigWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyMultisigWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		dAtA5 := make([]byte, len(m.Weights)*10)
+		var j4 int
+		for _, num := range m.Weights {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintCrypto(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrypto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintCrypto(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MultiSignature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -700,6 +861,18 @@ func (m *PublicKey_Secp256R1) Size() (n int) {
 	}
 	return n
 }
+func (m *PublicKey_MultisigWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigWeighted != nil {
+		l = m.MultisigWeighted.Size()
+		n += 1 + l + sovCrypto(uint64(l))
+	}
+	return n
+}
 func (m *PublicKey_AnyPubkey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -730,6 +903,31 @@ func (m *PubKeyMultisigThreshold) Size() (n int) {
 	return n
 }
 
+func (m *PubKeyMultisigWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovCrypto(uint64(m.Threshold))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, e := range m.PubKeys {
+			l = e.Size()
+			n += 1 + l + sovCrypto(uint64(l))
+		}
+	}
+	if len(m.Weights) > 0 {
+		l = 0
+		for _, e := range m.Weights {
+			l += sovCrypto(uint64(e))
+		}
+		n += 1 + sovCrypto(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *MultiSignature) Size() (n int) {
 	if m == nil {
 		return 0
@@ -950,6 +1148,41 @@ func (m *PublicKey) Unmarshal(dAtA []byte) error {
 			copy(v, dAtA[iNdEx:postIndex])
 			m.Sum = &PublicKey_Secp256R1{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigWeighted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrypto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PubKeyMultisigWeighted{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &PublicKey_MultisigWeighted{v}
+			iNdEx = postIndex
 		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AnyPubkey", wireType)
@@ -1115,6 +1348,188 @@ func (m *PubKeyMultisigThreshold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PubKeyMultisigWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrypto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyMultisigWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyMultisigWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrypto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrypto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, &PublicKey{})
+			if err := m.PubKeys[len(m.PubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCrypto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCrypto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCrypto
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCrypto
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCrypto
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrypto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MultiSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
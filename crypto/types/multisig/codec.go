@@ -7,12 +7,15 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/sr25519"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types/secp256r1"
 )
 
 // TODO: Figure out API for others to either add their own pubkey types, or
 // to make verify / marshal accept a Cdc.
 const (
-	PubKeyAminoRoute = "tendermint/PubKeyMultisigThreshold"
+	PubKeyAminoRoute         = "tendermint/PubKeyMultisigThreshold"
+	PubKeyWeightedAminoRoute = "cosmos-sdk/PubKeyMultisigWeighted"
 )
 
 var Cdc = amino.NewCodec()
@@ -21,10 +24,14 @@ func init() {
 	Cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
 	Cdc.RegisterConcrete(PubKeyMultisigThreshold{},
 		PubKeyAminoRoute, nil)
+	Cdc.RegisterConcrete(PubKeyMultisigWeighted{},
+		PubKeyWeightedAminoRoute, nil)
 	Cdc.RegisterConcrete(ed25519.PubKeyEd25519{},
 		ed25519.PubKeyAminoName, nil)
 	Cdc.RegisterConcrete(sr25519.PubKeySr25519{},
 		sr25519.PubKeyAminoName, nil)
 	Cdc.RegisterConcrete(secp256k1.PubKeySecp256k1{},
 		secp256k1.PubKeyAminoName, nil)
+	Cdc.RegisterConcrete(secp256r1.PubKeySecp256r1{},
+		secp256r1.PubKeyAminoName, nil)
 }
@@ -88,3 +88,84 @@ func AddSignatureFromPubKey(mSig *signing.MultiSignatureData, sig signing.Signat
 func AddSignatureV2(mSig *signing.MultiSignatureData, sig signing.SignatureV2, keys []crypto.PubKey) error {
 	return AddSignatureFromPubKey(mSig, sig.Data, sig.PubKey, keys)
 }
+
+// verifyMultisignature verifies the signatures of sig against the members of a
+// multisig public key and returns the indices of the members that signed. All
+// the signatures must be valid, not only the ones required to meet a threshold.
+func verifyMultisignature(pubKeys []crypto.PubKey, getSignBytes GetSignBytesFunc, sig *signing.MultiSignatureData) ([]int, error) {
+	bitarray := sig.BitArray
+	size := bitarray.Size()
+	// ensure bit array is the correct size
+	if len(pubKeys) != size {
+		return nil, fmt.Errorf("bit array size is incorrect %d", size)
+	}
+	// ensure there is exactly one signature per bit set
+	if len(sig.Signatures) != bitarray.NumTrueBitsBefore(size) {
+		return nil, fmt.Errorf("signature size is incorrect %d", len(sig.Signatures))
+	}
+
+	signers := make([]int, 0, len(sig.Signatures))
+	for i := 0; i < size; i++ {
+		if !bitarray.GetIndex(i) {
+			continue
+		}
+
+		switch si := sig.Signatures[len(signers)].(type) {
+		case *signing.SingleSignatureData:
+			msg, err := getSignBytes(si.SignMode)
+			if err != nil {
+				return nil, err
+			}
+			if !pubKeys[i].VerifyBytes(msg, si.Signature) {
+				return nil, fmt.Errorf("unable to verify signature of index %d", i)
+			}
+		case *signing.MultiSignatureData:
+			nestedMultisigPk, ok := pubKeys[i].(PubKey)
+			if !ok {
+				return nil, fmt.Errorf("unable to parse pubkey of index %d", i)
+			}
+			if err := nestedMultisigPk.VerifyMultisignature(getSignBytes, si); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("improper signature data type for index %d", i)
+		}
+
+		signers = append(signers, i)
+	}
+
+	return signers, nil
+}
+
+// verifyAminoMultisignature is the equivalent of verifyMultisignature for amino
+// encoded AminoMultisignatures. Signatures of nested multisig public keys are
+// amino encoded AminoMultisignatures as well.
+func verifyAminoMultisignature(pubKeys []crypto.PubKey, msg []byte, marshalledSig []byte) ([]int, bool) {
+	var sig AminoMultisignature
+	if err := Cdc.UnmarshalBinaryBare(marshalledSig, &sig); err != nil {
+		return nil, false
+	}
+
+	size := sig.BitArray.Size()
+	// ensure bit array is the correct size
+	if len(pubKeys) != size {
+		return nil, false
+	}
+	// ensure there is exactly one signature per bit set
+	if len(sig.Sigs) != sig.BitArray.NumTrueBitsBefore(size) {
+		return nil, false
+	}
+
+	signers := make([]int, 0, len(sig.Sigs))
+	for i := 0; i < size; i++ {
+		if !sig.BitArray.GetIndex(i) {
+			continue
+		}
+		if !pubKeys[i].VerifyBytes(msg, sig.Sigs[len(signers)]) {
+			return nil, false
+		}
+		signers = append(signers, i)
+	}
+
+	return signers, true
+}
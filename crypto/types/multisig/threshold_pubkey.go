@@ -43,81 +43,19 @@ func NewPubKeyMultisigThreshold(k int, pubkeys []crypto.PubKey) PubKey {
 // NOTE: VerifyMultisignature should preferred to VerifyBytes which only works
 // with amino multisignatures.
 func (pk PubKeyMultisigThreshold) VerifyBytes(msg []byte, marshalledSig []byte) bool {
-	var sig AminoMultisignature
-	err := Cdc.UnmarshalBinaryBare(marshalledSig, &sig)
-	if err != nil {
-		return false
-	}
-	size := sig.BitArray.Size()
-	// ensure bit array is the correct size
-	if len(pk.PubKeys) != size {
-		return false
-	}
-	// ensure size of signature list
-	if len(sig.Sigs) < int(pk.K) || len(sig.Sigs) > size {
-		return false
-	}
-	// ensure at least k signatures are set
-	if sig.BitArray.NumTrueBitsBefore(size) < int(pk.K) {
-		return false
-	}
-	// index in the list of signatures which we are concerned with.
-	sigIndex := 0
-	for i := 0; i < size; i++ {
-		if sig.BitArray.GetIndex(i) {
-			if !pk.PubKeys[i].VerifyBytes(msg, sig.Sigs[sigIndex]) {
-				return false
-			}
-			sigIndex++
-		}
-	}
-	return true
+	signers, ok := verifyAminoMultisignature(pk.PubKeys, msg, marshalledSig)
+	return ok && len(signers) >= int(pk.K)
 }
 
 // VerifyMultisignature implements the PubKey.VerifyMultisignature method
 func (pk PubKeyMultisigThreshold) VerifyMultisignature(getSignBytes GetSignBytesFunc, sig *signing.MultiSignatureData) error {
-	bitarray := sig.BitArray
-	sigs := sig.Signatures
-	size := bitarray.Size()
-	// ensure bit array is the correct size
-	if len(pk.PubKeys) != size {
-		return fmt.Errorf("bit array size is incorrect %d", len(pk.PubKeys))
-	}
-	// ensure size of signature list
-	if len(sigs) < int(pk.K) || len(sigs) > size {
-		return fmt.Errorf("signature size is incorrect %d", len(sigs))
+	signers, err := verifyMultisignature(pk.PubKeys, getSignBytes, sig)
+	if err != nil {
+		return err
 	}
 	// ensure at least k signatures are set
-	if bitarray.NumTrueBitsBefore(size) < int(pk.K) {
-		return fmt.Errorf("minimum number of signatures not set, have %d, expected %d", bitarray.NumTrueBitsBefore(size), int(pk.K))
-	}
-	// index in the list of signatures which we are concerned with.
-	sigIndex := 0
-	for i := 0; i < size; i++ {
-		if bitarray.GetIndex(i) {
-			si := sig.Signatures[sigIndex]
-			switch si := si.(type) {
-			case *signing.SingleSignatureData:
-				msg, err := getSignBytes(si.SignMode)
-				if err != nil {
-					return err
-				}
-				if !pk.PubKeys[i].VerifyBytes(msg, si.Signature) {
-					return err
-				}
-			case *signing.MultiSignatureData:
-				nestedMultisigPk, ok := pk.PubKeys[i].(PubKey)
-				if !ok {
-					return fmt.Errorf("unable to parse pubkey of index %d", i)
-				}
-				if err := nestedMultisigPk.VerifyMultisignature(getSignBytes, si); err != nil {
-					return err
-				}
-			default:
-				return fmt.Errorf("improper signature data type for index %d", sigIndex)
-			}
-			sigIndex++
-		}
+	if len(signers) < int(pk.K) {
+		return fmt.Errorf("minimum number of signatures not set, have %d, expected %d", len(signers), int(pk.K))
 	}
 	return nil
 }
@@ -193,7 +193,7 @@ func TestMultiSigMigration(t *testing.T) {
 	err := multisig.AddSignatureFromPubKey(multisignature, sigs[0], pkSet[0], pkSet)
 
 	// create a StdSignature for msg, and convert it to sigV2
-	sig := authtypes.StdSignature{PubKey: pkSet[1].Bytes(), Signature: msg}
+	sig := authtypes.StdSignature{PubKey: pkSet[1].Bytes(), Signature: sigs[1].(*signing.SingleSignatureData).Signature}
 	sigV2, err := authtypes.StdSignatureToSignatureV2(cdc, sig)
 	require.NoError(t, multisig.AddSignatureV2(multisignature, sigV2, pkSet))
 
@@ -203,6 +203,67 @@ func TestMultiSigMigration(t *testing.T) {
 	require.NoError(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
 }
 
+func TestMultisigInvalidSignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pkSet, sigs := generatePubKeysAndSignatures(3, msg)
+	multisigKey := multisig.NewPubKeyMultisigThreshold(1, pkSet)
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	// an invalid signature fails the verification even if the threshold is
+	// met by the other signatures
+	multisignature := multisig.NewMultisig(3)
+	multisig.AddSignature(multisignature, sigs[0], 0)
+	multisig.AddSignature(multisignature, sigs[0], 2)
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	// signatures must match the position of their key
+	multisignature = multisig.NewMultisig(3)
+	multisig.AddSignature(multisignature, sigs[0], 1)
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+}
+
+func TestNestedMultisigAmino(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	cdc := codec.New()
+	nestedPks, nestedSigs := generatePubKeysAndSignatures(3, msg)
+	pks, sigs := generatePubKeysAndSignatures(2, msg)
+	for _, sig := range append(nestedSigs, sigs...) {
+		sig.(*signing.SingleSignatureData).SignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	}
+
+	// 2 of 3 board members, where the first member is itself a 2 of 3 multisig
+	nestedKey := multisig.NewPubKeyMultisigThreshold(2, nestedPks)
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{nestedKey, pks[0], pks[1]})
+
+	nestedSig := multisig.NewMultisig(3)
+	multisig.AddSignature(nestedSig, nestedSigs[0], 0)
+	multisig.AddSignature(nestedSig, nestedSigs[2], 2)
+	multisignature := multisig.NewMultisig(3)
+	multisig.AddSignature(multisignature, nestedSig, 0)
+	multisig.AddSignature(multisignature, sigs[1], 2)
+
+	aminoSig, err := authtypes.SignatureDataToAminoSignature(cdc, multisignature)
+	require.NoError(t, err)
+	require.True(t, multisigKey.VerifyBytes(msg, aminoSig))
+	require.False(t, multisigKey.VerifyBytes([]byte{5, 6, 7, 8}, aminoSig))
+
+	// amino signatures convert back to the same signature data
+	sigV2, err := authtypes.StdSignatureToSignatureV2(cdc, authtypes.StdSignature{PubKey: multisigKey.Bytes(), Signature: aminoSig})
+	require.NoError(t, err)
+	require.Equal(t, multisignature, sigV2.Data)
+
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+	require.NoError(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	// the nested multisig doesn't meet its own threshold
+	nestedSig = multisig.NewMultisig(3)
+	multisig.AddSignature(nestedSig, nestedSigs[0], 0)
+	multisignature = multisig.NewMultisig(3)
+	multisig.AddSignature(multisignature, nestedSig, 0)
+	multisig.AddSignature(multisignature, sigs[1], 2)
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+}
+
 func TestAddSignatureFromPubKeyNilCheck(t *testing.T) {
 	pkSet, sigs := generatePubKeysAndSignatures(5, []byte{1, 2, 3, 4})
 	multisignature := multisig.NewMultisig(5)
@@ -0,0 +1,119 @@
+package multisig
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// PubKeyMultisigWeighted implements a weighted threshold multisig. Each key has
+// a weight, a multisignature is valid when the sum of the weights of its
+// signers reaches the threshold.
+type PubKeyMultisigWeighted struct {
+	Threshold uint            `json:"threshold"`
+	PubKeys   []crypto.PubKey `json:"pubkeys"`
+	Weights   []uint          `json:"weights"`
+}
+
+var _ PubKey = PubKeyMultisigWeighted{}
+
+// NewPubKeyMultisigWeighted returns a new PubKeyMultisigWeighted.
+// Panics if 0 >= threshold, if the number of weights and pubkeys differ, if a
+// weight is 0 or if the sum of the weights is lower than the threshold.
+func NewPubKeyMultisigWeighted(threshold uint, pubkeys []crypto.PubKey, weights []uint) PubKey {
+	if threshold == 0 {
+		panic("weighted multisignature: threshold <= 0")
+	}
+	if len(pubkeys) != len(weights) {
+		panic("weighted multisignature: len(pubkeys) != len(weights)")
+	}
+
+	var total uint
+	for i, pubkey := range pubkeys {
+		if pubkey == nil {
+			panic("nil pubkey")
+		}
+		if weights[i] == 0 {
+			panic("weighted multisignature: weight <= 0")
+		}
+		total += weights[i]
+	}
+	if total < threshold {
+		panic("weighted multisignature: sum(weights) < threshold")
+	}
+
+	return PubKeyMultisigWeighted{threshold, pubkeys, weights}
+}
+
+// VerifyBytes expects sig to be an amino encoded version of a MultiSignature.
+// Returns true iff the sum of the weights of the signers reaches the threshold
+// and all signatures are valid.
+//
+// NOTE: VerifyMultisignature should preferred to VerifyBytes which only works
+// with amino multisignatures.
+func (pk PubKeyMultisigWeighted) VerifyBytes(msg []byte, marshalledSig []byte) bool {
+	signers, ok := verifyAminoMultisignature(pk.PubKeys, msg, marshalledSig)
+	return ok && pk.weight(signers) >= pk.Threshold
+}
+
+// VerifyMultisignature implements the PubKey.VerifyMultisignature method
+func (pk PubKeyMultisigWeighted) VerifyMultisignature(getSignBytes GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	signers, err := verifyMultisignature(pk.PubKeys, getSignBytes, sig)
+	if err != nil {
+		return err
+	}
+	// ensure the signers meet the threshold
+	if weight := pk.weight(signers); weight < pk.Threshold {
+		return fmt.Errorf("minimum weight of signatures not met, have %d, expected %d", weight, pk.Threshold)
+	}
+	return nil
+}
+
+// weight returns the sum of the weights of the keys at the given indices. It
+// returns 0 for malformed keys with a different number of weights and pubkeys.
+func (pk PubKeyMultisigWeighted) weight(indices []int) uint {
+	var weight uint
+	if len(pk.Weights) != len(pk.PubKeys) {
+		return weight
+	}
+	for _, i := range indices {
+		weight += pk.Weights[i]
+	}
+	return weight
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method
+func (pk PubKeyMultisigWeighted) GetPubKeys() []crypto.PubKey {
+	return pk.PubKeys
+}
+
+// Bytes returns the amino encoded version of the PubKeyMultisigWeighted
+func (pk PubKeyMultisigWeighted) Bytes() []byte {
+	return Cdc.MustMarshalBinaryBare(pk)
+}
+
+// Address returns tmhash(PubKeyMultisigWeighted.Bytes())
+func (pk PubKeyMultisigWeighted) Address() crypto.Address {
+	return crypto.AddressHash(pk.Bytes())
+}
+
+// Equals returns true iff pk and other both have the same threshold, and all
+// constituent keys and their weights are the same, and in the same order.
+func (pk PubKeyMultisigWeighted) Equals(other crypto.PubKey) bool {
+	otherKey, sameType := other.(PubKeyMultisigWeighted)
+	if !sameType {
+		return false
+	}
+	if pk.Threshold != otherKey.Threshold || len(pk.PubKeys) != len(otherKey.PubKeys) ||
+		len(pk.Weights) != len(otherKey.Weights) {
+		return false
+	}
+	for i := 0; i < len(pk.PubKeys); i++ {
+		if !pk.PubKeys[i].Equals(otherKey.PubKeys[i]) || pk.Weights[i] != otherKey.Weights[i] {
+			return false
+		}
+	}
+	return true
+}
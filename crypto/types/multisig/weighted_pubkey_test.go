@@ -0,0 +1,116 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestWeightedMultisig(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pkSet, sigs := generatePubKeysAndSignatures(3, msg)
+	multisigKey := multisig.NewPubKeyMultisigWeighted(3, pkSet, []uint{2, 1, 1})
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	cases := []struct {
+		name           string
+		signingIndices []int
+		expectPass     bool
+	}{
+		{"no signatures", []int{}, false},
+		{"weight 1", []int{1}, false},
+		{"weight 2", []int{0}, false},
+		{"weight 2 from two signers", []int{1, 2}, false},
+		{"weight 3", []int{0, 2}, true},
+		{"weight 4", []int{0, 1, 2}, true},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			multisignature := multisig.NewMultisig(len(pkSet))
+			for _, i := range tc.signingIndices {
+				require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[i], pkSet[i], pkSet))
+			}
+
+			err := multisigKey.VerifyMultisignature(signBytesFn, multisignature)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNestedWeightedMultisig(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	cdc := codec.New()
+	nestedPks, nestedSigs := generatePubKeysAndSignatures(3, msg)
+	pks, sigs := generatePubKeysAndSignatures(2, msg)
+	for _, sig := range append(nestedSigs, sigs...) {
+		sig.(*signing.SingleSignatureData).SignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	}
+
+	// the approval of the nested multisig weighs as much as the other two keys
+	nestedKey := multisig.NewPubKeyMultisigThreshold(2, nestedPks)
+	multisigKey := multisig.NewPubKeyMultisigWeighted(2, []crypto.PubKey{nestedKey, pks[0], pks[1]}, []uint{2, 1, 1})
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	nestedSig := multisig.NewMultisig(3)
+	multisig.AddSignature(nestedSig, nestedSigs[1], 1)
+	multisig.AddSignature(nestedSig, nestedSigs[2], 2)
+	multisignature := multisig.NewMultisig(3)
+	multisig.AddSignature(multisignature, nestedSig, 0)
+	require.NoError(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	aminoSig, err := authtypes.SignatureDataToAminoSignature(cdc, multisignature)
+	require.NoError(t, err)
+	require.True(t, multisigKey.VerifyBytes(msg, aminoSig))
+
+	// a single member of the nested multisig doesn't meet its threshold
+	nestedSig = multisig.NewMultisig(3)
+	multisig.AddSignature(nestedSig, nestedSigs[1], 1)
+	multisignature = multisig.NewMultisig(3)
+	multisig.AddSignature(multisignature, nestedSig, 0)
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	aminoSig, err = authtypes.SignatureDataToAminoSignature(cdc, multisignature)
+	require.NoError(t, err)
+	require.False(t, multisigKey.VerifyBytes(msg, aminoSig))
+
+	// so doesn't a single key of weight 1
+	multisignature = multisig.NewMultisig(3)
+	multisig.AddSignature(multisignature, sigs[0], 1)
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+}
+
+func TestWeightedMultisigPubKey(t *testing.T) {
+	pkSet, _ := generatePubKeysAndSignatures(3, []byte{1, 2, 3, 4})
+	multisigKey := multisig.NewPubKeyMultisigWeighted(3, pkSet, []uint{2, 1, 1})
+
+	// amino encoding
+	var pubKey crypto.PubKey
+	require.NoError(t, multisig.Cdc.UnmarshalBinaryBare(multisigKey.Bytes(), &pubKey))
+	require.Equal(t, multisigKey, pubKey)
+	require.Len(t, multisigKey.Address().Bytes(), 20)
+
+	// weights and threshold are part of the key
+	require.True(t, multisigKey.Equals(multisig.NewPubKeyMultisigWeighted(3, pkSet, []uint{2, 1, 1})))
+	require.False(t, multisigKey.Equals(multisig.NewPubKeyMultisigWeighted(3, pkSet, []uint{1, 1, 2})))
+	require.False(t, multisigKey.Equals(multisig.NewPubKeyMultisigWeighted(2, pkSet, []uint{2, 1, 1})))
+	require.False(t, multisigKey.Equals(multisig.NewPubKeyMultisigThreshold(3, pkSet)))
+	require.NotEqual(t, multisigKey.Address(), multisig.NewPubKeyMultisigThreshold(3, pkSet).Address())
+
+	// invalid keys
+	require.Panics(t, func() { multisig.NewPubKeyMultisigWeighted(0, pkSet, []uint{2, 1, 1}) })
+	require.Panics(t, func() { multisig.NewPubKeyMultisigWeighted(3, pkSet, []uint{2, 1}) })
+	require.Panics(t, func() { multisig.NewPubKeyMultisigWeighted(3, pkSet, []uint{2, 1, 0}) })
+	require.Panics(t, func() { multisig.NewPubKeyMultisigWeighted(5, pkSet, []uint{2, 1, 1}) })
+	require.Panics(t, func() { multisig.NewPubKeyMultisigWeighted(1, []crypto.PubKey{nil}, []uint{1}) })
+}
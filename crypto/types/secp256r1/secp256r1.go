@@ -0,0 +1,247 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeySecp256r1"
+	PubKeyAminoName  = "cosmos-sdk/PubKeySecp256r1"
+
+	// PrivKeySize is the size of a private key, in bytes
+	PrivKeySize = 32
+	// PubKeySize is the size of a compressed public key, in bytes
+	PubKeySize = 33
+	// SignatureSize is the size of a signature, in bytes. Signatures are the
+	// concatenation of the big-endian r and s values.
+	SignatureSize = 64
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(PubKeySecp256r1{}, PubKeyAminoName, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{}, PrivKeyAminoName, nil)
+}
+
+var (
+	_ crypto.PrivKey = PrivKeySecp256r1{}
+	_ crypto.PubKey  = PubKeySecp256r1{}
+)
+
+// curve is the NIST P-256 curve, also known as secp256r1
+var curve = elliptic.P256()
+
+// halfOrder is used to reject signatures with a high s value, which would
+// otherwise make signatures malleable
+var halfOrder = new(big.Int).Rsh(curve.Params().N, 1)
+
+//-------------------------------------
+
+// PrivKeySecp256r1 implements crypto.PrivKey. It holds the big-endian secret
+// scalar of a P-256 key.
+type PrivKeySecp256r1 [PrivKeySize]byte
+
+// Bytes returns the amino encoding of the private key
+func (privKey PrivKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign creates an ECDSA signature of the SHA-256 digest of msg. The returned
+// signature is in the [R || S] format with a low S value.
+func (privKey PrivKeySecp256r1) Sign(msg []byte) ([]byte, error) {
+	digest := sha256.Sum256(msg)
+
+	r, s, err := ecdsa.Sign(rand.Reader, privKey.toECDSA(), digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(curve.Params().N, s)
+	}
+
+	sig := make([]byte, SignatureSize)
+	copy(sig[32-len(r.Bytes()):32], r.Bytes())
+	copy(sig[64-len(s.Bytes()):], s.Bytes())
+
+	return sig, nil
+}
+
+// PubKey returns the compressed public key of the private key
+func (privKey PrivKeySecp256r1) PubKey() crypto.PubKey {
+	priv := privKey.toECDSA()
+	return compressPubKey(priv.X, priv.Y)
+}
+
+// Equals compares two private keys in constant time
+func (privKey PrivKeySecp256r1) Equals(other crypto.PrivKey) bool {
+	if otherSecp, ok := other.(PrivKeySecp256r1); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherSecp[:]) == 1
+	}
+
+	return false
+}
+
+func (privKey PrivKeySecp256r1) toECDSA() *ecdsa.PrivateKey {
+	priv := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(privKey[:])}
+	priv.Curve = curve
+	priv.X, priv.Y = curve.ScalarBaseMult(privKey[:])
+	return priv
+}
+
+// GenPrivKey generates a new private key using the crypto/rand randomness source
+func GenPrivKey() PrivKeySecp256r1 {
+	return genPrivKey(rand.Reader)
+}
+
+func genPrivKey(rand io.Reader) PrivKeySecp256r1 {
+	priv, err := ecdsa.GenerateKey(curve, rand)
+	if err != nil {
+		panic(err)
+	}
+
+	var privKey PrivKeySecp256r1
+	d := priv.D.Bytes()
+	copy(privKey[PrivKeySize-len(d):], d)
+
+	return privKey
+}
+
+// GenPrivKeyFromSecret deterministically derives a private key from the given
+// secret. The secret is reduced into the [1, N-1] range of valid scalars, N
+// being the order of the curve. The secret should be uniformly random and at
+// least 32 bytes long, such as the output of a key derivation function.
+func GenPrivKeyFromSecret(secret []byte) PrivKeySecp256r1 {
+	n := new(big.Int).Sub(curve.Params().N, big.NewInt(1))
+
+	d := new(big.Int).SetBytes(secret)
+	d.Mod(d, n)
+	d.Add(d, big.NewInt(1))
+
+	var privKey PrivKeySecp256r1
+	bz := d.Bytes()
+	copy(privKey[PrivKeySize-len(bz):], bz)
+
+	return privKey
+}
+
+//-------------------------------------
+
+// PubKeySecp256r1 implements crypto.PubKey. It holds a compressed P-256 point:
+// a 0x02 or 0x03 prefix, depending on the parity of Y, followed by the
+// big-endian X coordinate.
+type PubKeySecp256r1 [PubKeySize]byte
+
+// Address returns the truncated SHA-256 hash of the compressed public key
+func (pubKey PubKeySecp256r1) Address() crypto.Address {
+	return crypto.Address(tmhash.SumTruncated(pubKey[:]))
+}
+
+// Bytes returns the amino encoding of the public key
+func (pubKey PubKeySecp256r1) Bytes() []byte {
+	bz, err := cdc.MarshalBinaryBare(pubKey)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifyBytes verifies an ECDSA signature of the SHA-256 digest of msg in the
+// [R || S] format. Signatures with a high S value are rejected.
+func (pubKey PubKeySecp256r1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	x, y, err := decompressPubKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(halfOrder) > 0 {
+		return false
+	}
+
+	digest := sha256.Sum256(msg)
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, digest[:], r, s)
+}
+
+func (pubKey PubKeySecp256r1) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey[:])
+}
+
+// Equals compares two public keys
+func (pubKey PubKeySecp256r1) Equals(other crypto.PubKey) bool {
+	if otherSecp, ok := other.(PubKeySecp256r1); ok {
+		return bytes.Equal(pubKey[:], otherSecp[:])
+	}
+
+	return false
+}
+
+// compressPubKey returns the compressed form of the point (x, y)
+func compressPubKey(x, y *big.Int) PubKeySecp256r1 {
+	var pubKey PubKeySecp256r1
+	pubKey[0] = 0x02 + byte(y.Bit(0))
+
+	bz := x.Bytes()
+	copy(pubKey[PubKeySize-len(bz):], bz)
+
+	return pubKey
+}
+
+// decompressPubKey returns the point of a compressed public key, solving
+// y² = x³ - 3x + b for y.
+func decompressPubKey(pubKey PubKeySecp256r1) (*big.Int, *big.Int, error) {
+	if pubKey[0] != 0x02 && pubKey[0] != 0x03 {
+		return nil, nil, fmt.Errorf("invalid secp256r1 public key prefix %X", pubKey[0])
+	}
+
+	params := curve.Params()
+	x := new(big.Int).SetBytes(pubKey[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, nil, fmt.Errorf("invalid secp256r1 public key")
+	}
+
+	// x³ - 3x + b mod p
+	y2 := new(big.Int).Mul(x, x)
+	y2.Mul(y2, x)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	y2.Sub(y2, threeX)
+	y2.Add(y2, params.B)
+	y2.Mod(y2, params.P)
+
+	y := new(big.Int).ModSqrt(y2, params.P)
+	if y == nil {
+		return nil, nil, fmt.Errorf("invalid secp256r1 public key")
+	}
+
+	if y.Bit(0) != uint(pubKey[0]&1) {
+		y.Sub(params.P, y)
+	}
+
+	if !curve.IsOnCurve(x, y) {
+		return nil, nil, fmt.Errorf("invalid secp256r1 public key")
+	}
+
+	return x, y, nil
+}
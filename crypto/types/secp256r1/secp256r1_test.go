@@ -0,0 +1,90 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := []byte("hello world")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SignatureSize)
+
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+	require.False(t, pubKey.VerifyBytes([]byte("other message"), sig))
+	require.False(t, pubKey.VerifyBytes(msg, sig[:SignatureSize-1]))
+	require.False(t, GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+	// the high S counterpart of a valid signature is rejected
+	s := new(big.Int).SetBytes(sig[32:])
+	s.Sub(curve.Params().N, s)
+	highS := make([]byte, SignatureSize)
+	copy(highS, sig[:32])
+	copy(highS[64-len(s.Bytes()):], s.Bytes())
+	require.False(t, pubKey.VerifyBytes(msg, highS))
+
+	// signatures verify with the standard library
+	x, y, err := decompressPubKey(pubKey.(PubKeySecp256r1))
+	require.NoError(t, err)
+	digest := sha256.Sum256(msg)
+	require.True(t, ecdsa.Verify(
+		&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, digest[:],
+		new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:]),
+	))
+}
+
+func TestPubKeyCompression(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		priv := GenPrivKey().toECDSA()
+		pubKey := compressPubKey(priv.X, priv.Y)
+
+		x, y, err := decompressPubKey(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, priv.X, x)
+		require.Equal(t, priv.Y, y)
+	}
+
+	var invalid PubKeySecp256r1
+	_, _, err := decompressPubKey(invalid)
+	require.Error(t, err)
+	require.False(t, invalid.VerifyBytes([]byte("msg"), make([]byte, SignatureSize)))
+}
+
+func TestAminoEncoding(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	var decodedPriv crypto.PrivKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(privKey.Bytes(), &decodedPriv))
+	require.True(t, privKey.Equals(decodedPriv))
+
+	var decodedPub crypto.PubKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(pubKey.Bytes(), &decodedPub))
+	require.True(t, pubKey.Equals(decodedPub))
+	require.Equal(t, pubKey.Address(), decodedPub.Address())
+	require.Len(t, pubKey.Address(), crypto.AddressSize)
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secret := []byte("a secret of at least thirty two bytes")
+	privKey := GenPrivKeyFromSecret(secret)
+	require.Equal(t, privKey, GenPrivKeyFromSecret(secret))
+	require.NotEqual(t, privKey, GenPrivKeyFromSecret([]byte("another secret")))
+
+	// secrets out of the range of valid scalars yield valid keys
+	for _, secret := range [][]byte{nil, curve.Params().N.Bytes(), make([]byte, 32)} {
+		privKey := GenPrivKeyFromSecret(secret)
+		sig, err := privKey.Sign([]byte("msg"))
+		require.NoError(t, err)
+		require.True(t, privKey.PubKey().VerifyBytes([]byte("msg"), sig))
+	}
+}
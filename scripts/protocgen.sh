@@ -10,6 +10,15 @@ for dir in $proto_dirs; do
   --gocosmos_out=plugins=interfacetype+grpc,\
 Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:. \
   $(find "${dir}" -maxdepth 1 -name '*.proto')
+
+  # generate the grpc-gateway REST handlers of the services with HTTP options
+  if grep -q "google.api.http" $(find "${dir}" -maxdepth 1 -name '*.proto'); then
+    protoc \
+    -I "proto" \
+    -I "third_party/proto" \
+    --grpc-gateway_out=allow_colon_final_segments=true:. \
+    $(find "${dir}" -maxdepth 1 -name '*.proto')
+  fi
 done
 
 # generate codec/testdata proto code
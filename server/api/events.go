@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+const (
+	// maxEventsReplayBlocks bounds how many blocks behind the latest block the
+	// events of a subscription can be replayed from.
+	maxEventsReplayBlocks = 100
+
+	// eventsWriteTimeout is the time allowed to write an event to the peer.
+	eventsWriteTimeout = 10 * time.Second
+)
+
+// RegisterEventsRoute registers the websocket route streaming the events
+// matching the query parameter as JSON messages, with their transactions
+// decoded with txGenerator. The events since the from_height query parameter,
+// if set, are streamed first. from_height can be at most 100 blocks behind the
+// latest block. Errors interrupting the subscription are sent as error
+// responses while it is renewed.
+//
+// Only same origin requests are accepted unless CORS is enabled, and at most
+// APIConfig.MaxEventSubscriptions subscriptions are served at the same time.
+func (s *Server) RegisterEventsRoute(txGenerator client.TxGenerator) {
+	clientCtx := s.ClientCtx.WithTxGenerator(txGenerator)
+	s.Router.HandleFunc("/events/subscribe", s.eventsHandlerFn(clientCtx)).Methods("GET")
+}
+
+func (s *Server) eventsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		maxSubscriptions := s.config.MaxEventSubscriptions
+		if maxSubscriptions == 0 {
+			maxSubscriptions = config.DefaultMaxEventSubscriptions
+		}
+
+		subscriptions := atomic.AddInt32(&s.eventSubscriptions, 1)
+		defer atomic.AddInt32(&s.eventSubscriptions, -1)
+
+		if subscriptions > int32(maxSubscriptions) {
+			rest.WriteErrorResponse(w, http.StatusServiceUnavailable,
+				fmt.Sprintf("maximum number of event subscriptions reached: %d", maxSubscriptions))
+			return
+		}
+
+		var fromHeight uint64
+		if v := r.FormValue("from_height"); v != "" {
+			var ok bool
+			if fromHeight, ok = rest.ParseUint64OrReturnBadRequest(w, v); !ok {
+				return
+			}
+		}
+
+		if fromHeight > 0 {
+			latestHeight, err := rpc.GetChainHeight(clientCtx)
+			if rest.CheckInternalServerError(w, err) {
+				return
+			}
+
+			if minHeight := latestHeight - maxEventsReplayBlocks; int64(fromHeight) < minHeight {
+				rest.WriteErrorResponse(w, http.StatusBadRequest,
+					fmt.Sprintf("from_height must be at least %d, events are replayed from at most %d blocks behind the latest block",
+						minHeight, maxEventsReplayBlocks))
+				return
+			}
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		events, errs, err := clientCtx.SubscribeEvents(ctx, r.FormValue("query"), int64(fromHeight))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		upgrader := websocket.Upgrader{}
+		if s.config.EnableUnsafeCORS {
+			upgrader.CheckOrigin = func(*http.Request) bool { return true }
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader replied with an error
+			return
+		}
+		defer conn.Close()
+
+		// lift the read timeout of the API server from the subscription, each
+		// write has its own deadline instead of the server's write timeout
+		if err := conn.SetReadDeadline(time.Time{}); err != nil {
+			return
+		}
+
+		// the subscription ends when the peer closes the connection
+		go func() {
+			defer cancel()
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			var msg interface{}
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				msg = event
+
+			case err, ok := <-errs:
+				if !ok {
+					return
+				}
+				msg = rest.NewErrorResponse(0, err.Error())
+			}
+
+			if err := conn.SetWriteDeadline(time.Now().Add(eventsWriteTimeout)); err != nil {
+				return
+			}
+			if err := conn.WriteJSON(msg); err != nil {
+				return
+			}
+		}
+	}
+}
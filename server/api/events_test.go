@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+)
+
+type statusNode struct {
+	rpcclient.Client
+
+	height int64
+}
+
+func (n statusNode) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: n.height}}, nil
+}
+
+func (n statusNode) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	return nil, errors.New("no events")
+}
+
+// newWebsocketRequest returns a websocket handshake request from origin.
+func newWebsocketRequest(url, origin string) *http.Request {
+	r := httptest.NewRequest("GET", url, nil)
+	r.Header.Set("Connection", "upgrade")
+	r.Header.Set("Upgrade", "websocket")
+	r.Header.Set("Sec-Websocket-Version", "13")
+	r.Header.Set("Sec-Websocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	r.Header.Set("Origin", origin)
+
+	return r
+}
+
+func TestEventsHandlerFromHeight(t *testing.T) {
+	clientCtx := client.Context{}.
+		WithClient(statusNode{height: 1000}).
+		WithTxGenerator(simappparams.MakeEncodingConfig().TxGenerator)
+	handler := (&Server{}).eventsHandlerFn(clientCtx)
+
+	testCases := map[string]string{
+		"invalid height":    "/events/subscribe?from_height=abc",
+		"101 blocks behind": "/events/subscribe?from_height=899",
+		"999 blocks behind": "/events/subscribe?from_height=1",
+	}
+
+	for name, url := range testCases {
+		url := url
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			handler(w, httptest.NewRequest("GET", url, nil))
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+
+	// the replay is accepted up to 100 blocks behind the latest block, the
+	// recorder then fails the websocket upgrade
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest("GET", "/events/subscribe?from_height=900", nil))
+	require.NotContains(t, w.Body.String(), "from_height")
+}
+
+func TestEventsHandlerLimits(t *testing.T) {
+	clientCtx := client.Context{}.
+		WithClient(statusNode{height: 1000}).
+		WithTxGenerator(simappparams.MakeEncodingConfig().TxGenerator)
+
+	// cross origin requests are rejected unless CORS is enabled, the recorder
+	// fails the websocket upgrade of accepted requests
+	s := &Server{}
+	w := httptest.NewRecorder()
+	s.eventsHandlerFn(clientCtx)(w, newWebsocketRequest("http://example.com/events/subscribe", "http://other.com"))
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	s.config.EnableUnsafeCORS = true
+	w = httptest.NewRecorder()
+	s.eventsHandlerFn(clientCtx)(w, newWebsocketRequest("http://example.com/events/subscribe", "http://other.com"))
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+
+	// subscriptions above the limit are rejected
+	s.config.MaxEventSubscriptions = 2
+	s.eventSubscriptions = 2
+	w = httptest.NewRecorder()
+	s.eventsHandlerFn(clientCtx)(w, newWebsocketRequest("http://example.com/events/subscribe", "http://example.com"))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, int32(2), s.eventSubscriptions)
+}
@@ -0,0 +1,13 @@
+package api
+
+import (
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// RegisterFeeEstimationRoute registers the route estimating gas prices from the
+// fees paid by the transactions of recent blocks, served by the EstimateFees
+// method of the gRPC tx Service through grpc-gateway. The number of sampled
+// blocks is set by the blocks query parameter.
+func (s *Server) RegisterFeeEstimationRoute(txServer txtypes.ServiceServer) {
+	s.Router.Handle("/fees/estimate", newTxServiceGateway(txServer)).Methods("GET")
+}
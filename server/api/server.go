@@ -30,6 +30,10 @@ type Server struct {
 	logger   log.Logger
 	metrics  *telemetry.Metrics
 	listener net.Listener
+	config   config.APIConfig
+
+	// eventSubscriptions is the number of event subscriptions being served
+	eventSubscriptions int32
 }
 
 func New(clientCtx client.Context, logger log.Logger) *Server {
@@ -45,6 +49,8 @@ func New(clientCtx client.Context, logger log.Logger) *Server {
 // and are delegated to the Tendermint JSON RPC server. The process is
 // non-blocking, so an external signal handler must be used.
 func (s *Server) Start(cfg config.Config) error {
+	s.config = cfg.API
+
 	if cfg.API.Swagger {
 		s.registerSwaggerUI()
 	}
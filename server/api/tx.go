@@ -0,0 +1,106 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// RegisterTxServiceRoutes registers the REST routes of the gRPC tx Service
+// through grpc-gateway, as declared by the google.api.http options of
+// proto/cosmos/tx/service.proto, with protobuf JSON requests and responses:
+//
+// POST /cosmos/tx/simulate: Simulate
+// GET  /cosmos/tx/txs/{hash}: GetTx
+// POST /cosmos/tx/txs: BroadcastTx
+// GET  /cosmos/tx/txs?events={event}&pagination.offset=&pagination.limit=&order_by=: GetTxsEvent
+// GET  /cosmos/tx/fees/estimate?blocks=: EstimateFees
+func (s *Server) RegisterTxServiceRoutes(txServer txtypes.ServiceServer) {
+	s.Router.PathPrefix("/cosmos/tx/").Handler(newTxServiceGateway(txServer))
+}
+
+// newTxServiceGateway returns a grpc-gateway mux serving the tx Service.
+// Errors are written as REST error responses, with the HTTP status matching
+// the gRPC status code.
+func newTxServiceGateway(txServer txtypes.ServiceServer) *runtime.ServeMux {
+	gwMux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, gatewayMarshaler{}),
+		runtime.WithProtoErrorHandler(writeGatewayError),
+	)
+
+	// registering a server never fails
+	_ = txtypes.RegisterServiceHandlerServer(context.Background(), gwMux, txServer)
+
+	return gwMux
+}
+
+func writeGatewayError(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	rest.WriteErrorResponse(w, runtime.HTTPStatusFromCode(status.Code(err)), status.Convert(err).Message())
+}
+
+// gatewayMarshaler implements the grpc-gateway runtime.Marshaler with the
+// protobuf JSON encoding of gogoproto messages, which the default gateway
+// marshaler doesn't support.
+type gatewayMarshaler struct{}
+
+var _ runtime.Marshaler = gatewayMarshaler{}
+
+// Marshal implements runtime.Marshaler
+func (gatewayMarshaler) Marshal(v interface{}) ([]byte, error) {
+	if msg, ok := v.(proto.Message); ok {
+		return codec.ProtoMarshalJSON(msg)
+	}
+
+	return json.Marshal(v)
+}
+
+// Unmarshal implements runtime.Marshaler
+func (gatewayMarshaler) Unmarshal(data []byte, v interface{}) error {
+	if msg, ok := v.(proto.Message); ok {
+		return jsonpb.Unmarshal(bytes.NewReader(data), msg)
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// NewDecoder implements runtime.Marshaler
+func (m gatewayMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v interface{}) error {
+		bz, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+
+		return m.Unmarshal(bz, v)
+	})
+}
+
+// NewEncoder implements runtime.Marshaler
+func (m gatewayMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v interface{}) error {
+		bz, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(bz)
+		return err
+	})
+}
+
+// ContentType implements runtime.Marshaler
+func (gatewayMarshaler) ContentType() string {
+	return "application/json"
+}
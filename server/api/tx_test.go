@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// recordingTxServer records the requests of the tx Service.
+type recordingTxServer struct {
+	txtypes.ServiceServer
+
+	getTxsEvent  *txtypes.GetTxsEventRequest
+	broadcastTx  *txtypes.BroadcastTxRequest
+	estimateFees *txtypes.EstimateFeesRequest
+}
+
+func (s *recordingTxServer) GetTx(_ context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	return nil, status.Errorf(codes.NotFound, "tx %s not found", req.Hash)
+}
+
+func (s *recordingTxServer) BroadcastTx(_ context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	s.broadcastTx = req
+	return &txtypes.BroadcastTxResponse{TxResponse: &txtypes.TxResponse{Txhash: "ABCD"}}, nil
+}
+
+func (s *recordingTxServer) GetTxsEvent(_ context.Context, req *txtypes.GetTxsEventRequest) (*txtypes.GetTxsEventResponse, error) {
+	s.getTxsEvent = req
+	return &txtypes.GetTxsEventResponse{Pagination: &query.PageResponse{Total: 5}}, nil
+}
+
+func (s *recordingTxServer) EstimateFees(_ context.Context, req *txtypes.EstimateFeesRequest) (*txtypes.EstimateFeesResponse, error) {
+	s.estimateFees = req
+	return &txtypes.EstimateFeesResponse{Height: 10, Blocks: int64(req.Blocks)}, nil
+}
+
+func TestTxServiceRoutes(t *testing.T) {
+	txServer := &recordingTxServer{}
+	s := &Server{Router: mux.NewRouter()}
+	s.RegisterTxServiceRoutes(txServer)
+
+	w := httptest.NewRecorder()
+	s.Router.ServeHTTP(w, httptest.NewRequest("GET",
+		"/cosmos/tx/txs?events=message.action%3Dsend&events=tx.height%3D5&pagination.offset=4&pagination.limit=2&order_by=ORDER_BY_DESC", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, []string{"message.action=send", "tx.height=5"}, txServer.getTxsEvent.Events)
+	require.Equal(t, uint64(4), txServer.getTxsEvent.Pagination.Offset)
+	require.Equal(t, uint64(2), txServer.getTxsEvent.Pagination.Limit)
+	require.Equal(t, txtypes.OrderByDesc, txServer.getTxsEvent.OrderBy)
+	require.Contains(t, w.Body.String(), `"total":"5"`)
+
+	w = httptest.NewRecorder()
+	s.Router.ServeHTTP(w, httptest.NewRequest("POST", "/cosmos/tx/txs",
+		strings.NewReader(`{"tx_bytes":"AQID","mode":"BROADCAST_MODE_BLOCK"}`)))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, []byte{1, 2, 3}, txServer.broadcastTx.TxBytes)
+	require.Equal(t, txtypes.BroadcastModeBlock, txServer.broadcastTx.Mode)
+	require.Contains(t, w.Body.String(), `"txhash":"ABCD"`)
+
+	// gRPC errors are written with the matching HTTP status
+	w = httptest.NewRecorder()
+	s.Router.ServeHTTP(w, httptest.NewRequest("GET", "/cosmos/tx/txs/ABCD", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Contains(t, w.Body.String(), "tx ABCD not found")
+
+	w = httptest.NewRecorder()
+	s.Router.ServeHTTP(w, httptest.NewRequest("POST", "/cosmos/tx/txs", strings.NewReader("not json")))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestFeeEstimationRoute(t *testing.T) {
+	txServer := &recordingTxServer{}
+	s := &Server{Router: mux.NewRouter()}
+	s.RegisterFeeEstimationRoute(txServer)
+
+	w := httptest.NewRecorder()
+	s.Router.ServeHTTP(w, httptest.NewRequest("GET", "/fees/estimate?blocks=3", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, uint64(3), txServer.estimateFees.Blocks)
+	require.Contains(t, w.Body.String(), `"blocks":"3"`)
+
+	w = httptest.NewRecorder()
+	s.Router.ServeHTTP(w, httptest.NewRequest("GET", "/fees/estimate?blocks=x", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
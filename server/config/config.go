@@ -13,6 +13,10 @@ import (
 
 const (
 	defaultMinGasPrices = ""
+
+	// DefaultMaxEventSubscriptions is the default maximum number of concurrent
+	// websocket event subscriptions of the API server.
+	DefaultMaxEventSubscriptions = 100
 )
 
 // BaseConfig defines the server's basic configuration
@@ -70,11 +74,24 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// MaxEventSubscriptions defines the maximum number of concurrent websocket
+	// event subscriptions, DefaultMaxEventSubscriptions if zero
+	MaxEventSubscriptions uint `mapstructure:"max-event-subscriptions"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
 }
 
+// GRPCConfig defines the gRPC server configuration.
+type GRPCConfig struct {
+	// Enable defines if the gRPC server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the gRPC server to listen on
+	Address string `mapstructure:"address"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -82,6 +99,7 @@ type Config struct {
 	// Telemetry defines the application telemetry configuration
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
+	GRPC      GRPCConfig       `mapstructure:"grpc"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -127,12 +145,17 @@ func DefaultConfig() *Config {
 			GlobalLabels: [][]string{},
 		},
 		API: APIConfig{
-			Enable:             false,
-			Swagger:            false,
-			Address:            "tcp://0.0.0.0:1317",
-			MaxOpenConnections: 1000,
-			RPCReadTimeout:     10,
-			RPCMaxBodyBytes:    1000000,
+			Enable:                false,
+			Swagger:               false,
+			Address:               "tcp://0.0.0.0:1317",
+			MaxOpenConnections:    1000,
+			RPCReadTimeout:        10,
+			RPCMaxBodyBytes:       1000000,
+			MaxEventSubscriptions: DefaultMaxEventSubscriptions,
+		},
+		GRPC: GRPCConfig{
+			Enable:  false,
+			Address: "0.0.0.0:9090",
 		},
 	}
 }
@@ -169,13 +192,18 @@ func GetConfig() Config {
 			GlobalLabels:            globalLabels,
 		},
 		API: APIConfig{
-			Enable:             viper.GetBool("api.enable"),
-			Address:            viper.GetString("api.address"),
-			MaxOpenConnections: viper.GetUint("api.max-open-connections"),
-			RPCReadTimeout:     viper.GetUint("api.rpc-read-timeout"),
-			RPCWriteTimeout:    viper.GetUint("api.rpc-write-timeout"),
-			RPCMaxBodyBytes:    viper.GetUint("api.rpc-max-body-bytes"),
-			EnableUnsafeCORS:   viper.GetBool("api.enabled-unsafe-cors"),
+			Enable:                viper.GetBool("api.enable"),
+			Address:               viper.GetString("api.address"),
+			MaxOpenConnections:    viper.GetUint("api.max-open-connections"),
+			RPCReadTimeout:        viper.GetUint("api.rpc-read-timeout"),
+			RPCWriteTimeout:       viper.GetUint("api.rpc-write-timeout"),
+			RPCMaxBodyBytes:       viper.GetUint("api.rpc-max-body-bytes"),
+			EnableUnsafeCORS:      viper.GetBool("api.enabled-unsafe-cors"),
+			MaxEventSubscriptions: viper.GetUint("api.max-event-subscriptions"),
+		},
+		GRPC: GRPCConfig{
+			Enable:  viper.GetBool("grpc.enable"),
+			Address: viper.GetString("grpc.address"),
 		},
 	}
 }
@@ -111,6 +111,21 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
+
+# MaxEventSubscriptions defines the maximum number of concurrent websocket event subscriptions
+max-event-subscriptions = {{ .API.MaxEventSubscriptions }}
+
+###############################################################################
+###                           gRPC Configuration                            ###
+###############################################################################
+
+[grpc]
+
+# Enable defines if the gRPC server should be enabled.
+enable = {{ .GRPC.Enable }}
+
+# Address defines the gRPC server to listen on
+address = "{{ .GRPC.Address }}"
 `
 
 var configTemplate *template.Template
@@ -6,11 +6,13 @@ import (
 	"os"
 	"path/filepath"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,6 +25,10 @@ type (
 		abci.Application
 
 		RegisterAPIRoutes(*api.Server)
+
+		// RegisterGRPCServer registers the gRPC services of the application,
+		// serving requests through the provided client context.
+		RegisterGRPCServer(client.Context, gogogrpc.Server)
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// StartGRPCServer starts a gRPC server listening on address and serving the
+// gRPC services registered by the app. The process is non-blocking, the
+// returned server must be stopped by the caller.
+func StartGRPCServer(clientCtx client.Context, app Application, address string) (*grpc.Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcSrv := grpc.NewServer(
+		grpc.UnaryInterceptor(recoveryUnaryInterceptor),
+		grpc.StreamInterceptor(recoveryStreamInterceptor),
+	)
+	app.RegisterGRPCServer(clientCtx, grpcSrv)
+
+	go func() {
+		// Serve only returns once the server is stopped or the listener fails
+		_ = grpcSrv.Serve(listener)
+	}()
+
+	return grpcSrv, nil
+}
+
+// recoveryUnaryInterceptor returns an Internal error instead of crashing the
+// node when a unary gRPC handler panics.
+func recoveryUnaryInterceptor(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (res interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic in %s: %v", info.FullMethod, r)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// recoveryStreamInterceptor returns an Internal error instead of crashing the
+// node when a streaming gRPC handler panics.
+func recoveryStreamInterceptor(
+	srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic in %s: %v", info.FullMethod, r)
+		}
+	}()
+
+	return handler(srv, stream)
+}
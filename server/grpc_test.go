@@ -0,0 +1,25 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/cosmos.tx.Service/GetTx"}
+
+	res, err := recoveryUnaryInterceptor(context.Background(), "req", info,
+		func(_ context.Context, req interface{}) (interface{}, error) { return req, nil })
+	require.NoError(t, err)
+	require.Equal(t, "req", res)
+
+	_, err = recoveryUnaryInterceptor(context.Background(), "req", info,
+		func(context.Context, interface{}) (interface{}, error) { panic("boom") })
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.Contains(t, err.Error(), "panic in /cosmos.tx.Service/GetTx: boom")
+}
@@ -18,6 +18,7 @@ import (
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/rpc/client/local"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -200,10 +201,13 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 		return err
 	}
 
-	var apiSrv *api.Server
+	var (
+		apiSrv  *api.Server
+		grpcSrv *grpc.Server
+	)
 
 	config := config.GetConfig()
-	if config.API.Enable {
+	if config.API.Enable || config.GRPC.Enable {
 		genDoc, err := genDocProvider()
 		if err != nil {
 			return err
@@ -219,21 +223,30 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 			WithClient(local.New(tmNode)).
 			WithTrustNode(true)
 
-		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
-		app.RegisterAPIRoutes(apiSrv)
+		if config.GRPC.Enable {
+			grpcSrv, err = StartGRPCServer(clientCtx, app, config.GRPC.Address)
+			if err != nil {
+				return err
+			}
+		}
 
-		errCh := make(chan error)
+		if config.API.Enable {
+			apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
+			app.RegisterAPIRoutes(apiSrv)
 
-		go func() {
-			if err := apiSrv.Start(config); err != nil {
-				errCh <- err
-			}
-		}()
+			errCh := make(chan error)
 
-		select {
-		case err := <-errCh:
-			return err
-		case <-time.After(5 * time.Second): // assume server started successfully
+			go func() {
+				if err := apiSrv.Start(config); err != nil {
+					errCh <- err
+				}
+			}()
+
+			select {
+			case err := <-errCh:
+				return err
+			case <-time.After(5 * time.Second): // assume server started successfully
+			}
 		}
 	}
 
@@ -270,6 +283,10 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 			_ = apiSrv.Close()
 		}
 
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+
 		ctx.Logger.Info("exiting...")
 	})
 
@@ -118,9 +118,11 @@ func txCmd() *cobra.Command {
 		authcmd.GetSignCommand(initClientCtx),
 		authcmd.GetSignBatchCommand(encodingConfig.Amino),
 		authcmd.GetMultiSignCommand(initClientCtx),
+		authcmd.GetMultisigCommand(initClientCtx),
 		authcmd.GetValidateSignaturesCommand(initClientCtx),
 		flags.LineBreak,
 		authcmd.GetBroadcastCommand(initClientCtx),
+		authcmd.GetServiceCommand(initClientCtx),
 		authcmd.GetEncodeCommand(initClientCtx),
 		authcmd.GetDecodeCommand(initClientCtx),
 		flags.LineBreak,
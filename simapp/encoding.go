@@ -5,9 +5,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/std"
 )
 
-// MakeEncodingConfig creates an EncodingConfig for an amino based test configuration.
-//
-// TODO: this file should add a "+build test_amino" flag for #6190 and a proto.go file with a protobuf configuration
+// MakeEncodingConfig creates an EncodingConfig for testing. It uses protobuf
+// transactions by default and amino StdTx's when built with the test_amino tag.
 func MakeEncodingConfig() params.EncodingConfig {
 	encodingConfig := params.MakeEncodingConfig()
 	std.RegisterCodec(encodingConfig.Amino)
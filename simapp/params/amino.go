@@ -1,3 +1,5 @@
+// +build test_amino
+
 package params
 
 import (
@@ -7,8 +9,6 @@ import (
 )
 
 // MakeEncodingConfig creates an EncodingConfig for an amino based test configuration.
-//
-// TODO: this file should add a "+build test_amino" flag for #6190 and a proto.go file with a protobuf configuration
 func MakeEncodingConfig() EncodingConfig {
 	cdc := codec.New()
 	interfaceRegistry := types.NewInterfaceRegistry()
@@ -0,0 +1,25 @@
+// +build !test_amino
+
+package params
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// MakeEncodingConfig creates an EncodingConfig for a protobuf based test configuration.
+func MakeEncodingConfig() EncodingConfig {
+	cdc := codec.New()
+	interfaceRegistry := types.NewInterfaceRegistry()
+	marshaler := codec.NewHybridCodec(cdc, interfaceRegistry)
+	txGen := tx.NewTxGenerator(interfaceRegistry, std.DefaultPublicKeyCodec{}, tx.DefaultSignModeHandler())
+
+	return EncodingConfig{
+		InterfaceRegistry: interfaceRegistry,
+		Marshaler:         marshaler,
+		TxGenerator:       txGen,
+		Amino:             cdc,
+	}
+}
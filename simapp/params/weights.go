@@ -8,6 +8,8 @@ const (
 	DefaultWeightMsgWithdrawDelegationReward    int = 50
 	DefaultWeightMsgWithdrawValidatorCommission int = 50
 	DefaultWeightMsgFundCommunityPool           int = 50
+	DefaultWeightMsgSetAutoCompound             int = 50
+	DefaultWeightMsgWithdrawTokenizeShareReward int = 50
 	DefaultWeightMsgDeposit                     int = 100
 	DefaultWeightMsgVote                        int = 67
 	DefaultWeightMsgUnjail                      int = 100
@@ -16,6 +18,10 @@ const (
 	DefaultWeightMsgDelegate                    int = 100
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgRotateConsPubKey            int = 5
+	DefaultWeightMsgCancelUnbondingDelegation   int = 50
+	DefaultWeightMsgTokenizeShares              int = 50
+	DefaultWeightMsgRedeemTokensForShares       int = 50
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
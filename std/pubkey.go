@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/secp256r1"
 
 	"github.com/tendermint/tendermint/crypto"
 	ed255192 "github.com/tendermint/tendermint/crypto/ed25519"
@@ -45,6 +46,14 @@ func (cdc DefaultPublicKeyCodec) Decode(key *types.PublicKey) (crypto.PubKey, er
 		var res sr25519.PubKeySr25519
 		copy(res[:], key.Sr25519)
 		return res, nil
+	case *types.PublicKey_Secp256R1:
+		n := len(key.Secp256R1)
+		if n != secp256r1.PubKeySize {
+			return nil, fmt.Errorf("wrong length %d for secp256r1 public key", n)
+		}
+		var res secp256r1.PubKeySecp256r1
+		copy(res[:], key.Secp256R1)
+		return res, nil
 	case *types.PublicKey_Multisig:
 		pubKeys := key.Multisig.PubKeys
 		resKeys := make([]crypto.PubKey, len(pubKeys))
@@ -56,6 +65,27 @@ func (cdc DefaultPublicKeyCodec) Decode(key *types.PublicKey) (crypto.PubKey, er
 			resKeys[i] = dk
 		}
 		return multisig.NewPubKeyMultisigThreshold(int(key.Multisig.K), resKeys), nil
+	case *types.PublicKey_MultisigWeighted:
+		pubKeys := key.MultisigWeighted.PubKeys
+		weights := key.MultisigWeighted.Weights
+		if len(pubKeys) != len(weights) {
+			return nil, fmt.Errorf("%d weights for %d public keys in weighted multisig public key", len(weights), len(pubKeys))
+		}
+		resKeys := make([]crypto.PubKey, len(pubKeys))
+		resWeights := make([]uint, len(weights))
+		for i, k := range pubKeys {
+			dk, err := cdc.Decode(k)
+			if err != nil {
+				return nil, err
+			}
+			resKeys[i] = dk
+			resWeights[i] = uint(weights[i])
+		}
+		return multisig.PubKeyMultisigWeighted{
+			Threshold: uint(key.MultisigWeighted.Threshold),
+			PubKeys:   resKeys,
+			Weights:   resWeights,
+		}, nil
 	default:
 		return nil, fmt.Errorf("can't decode PubKey of type %T. Use a custom PublicKeyCodec instead", key)
 	}
@@ -70,6 +100,8 @@ func (cdc DefaultPublicKeyCodec) Encode(key crypto.PubKey) (*types.PublicKey, er
 		return &types.PublicKey{Sum: &types.PublicKey_Ed25519{Ed25519: key[:]}}, nil
 	case sr25519.PubKeySr25519:
 		return &types.PublicKey{Sum: &types.PublicKey_Sr25519{Sr25519: key[:]}}, nil
+	case secp256r1.PubKeySecp256r1:
+		return &types.PublicKey{Sum: &types.PublicKey_Secp256R1{Secp256R1: key[:]}}, nil
 	case multisig.PubKeyMultisigThreshold:
 		pubKeys := key.PubKeys
 		resKeys := make([]*types.PublicKey, len(pubKeys))
@@ -84,6 +116,25 @@ func (cdc DefaultPublicKeyCodec) Encode(key crypto.PubKey) (*types.PublicKey, er
 			K:       uint32(key.K),
 			PubKeys: resKeys,
 		}}}, nil
+	case multisig.PubKeyMultisigWeighted:
+		pubKeys := key.PubKeys
+		resKeys := make([]*types.PublicKey, len(pubKeys))
+		for i, k := range pubKeys {
+			dk, err := cdc.Encode(k)
+			if err != nil {
+				return nil, err
+			}
+			resKeys[i] = dk
+		}
+		weights := make([]uint32, len(key.Weights))
+		for i, w := range key.Weights {
+			weights[i] = uint32(w)
+		}
+		return &types.PublicKey{Sum: &types.PublicKey_MultisigWeighted{MultisigWeighted: &types.PubKeyMultisigWeighted{
+			Threshold: uint32(key.Threshold),
+			PubKeys:   resKeys,
+			Weights:   weights,
+		}}}, nil
 	default:
 		return nil, fmt.Errorf("can't encode PubKey of type %T. Use a custom PublicKeyCodec instead", key)
 	}
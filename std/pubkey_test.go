@@ -12,6 +12,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/sr25519"
 
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/secp256r1"
 )
 
 func roundTripTest(t *testing.T, pubKey crypto.PubKey) {
@@ -34,8 +35,21 @@ func TestDefaultPublicKeyCodec(t *testing.T) {
 	pubKeySr25519 := sr25519.GenPrivKey().PubKey()
 	roundTripTest(t, pubKeySr25519)
 
+	pubKeySecp256r1 := secp256r1.GenPrivKey().PubKey()
+	roundTripTest(t, pubKeySecp256r1)
+
 	pubKeyMultisig := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{
-		pubKeySecp256k1, pubKeyEd25519, pubKeySr25519,
+		pubKeySecp256k1, pubKeyEd25519, pubKeySr25519, pubKeySecp256r1,
 	})
 	roundTripTest(t, pubKeyMultisig)
+
+	pubKeyMultisigWeighted := multisig.NewPubKeyMultisigWeighted(3, []crypto.PubKey{
+		pubKeyMultisig, pubKeySecp256k1, pubKeySecp256r1,
+	}, []uint{2, 1, 1})
+	roundTripTest(t, pubKeyMultisigWeighted)
+
+	pubKeyMultisigNested := multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{
+		pubKeyMultisigWeighted, pubKeyEd25519,
+	})
+	roundTripTest(t, pubKeyMultisigNested)
 }
@@ -17,7 +17,9 @@ func KVStoreReversePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 }
 
 // DiffKVStores compares two KVstores and returns all the key/value pairs
-// that differ from one another. It also skips value comparison for a set of provided prefixes.
+// that differ from one another. The key/value pairs under a set of provided
+// prefixes are skipped on both stores, so that they may hold a different number
+// of entries under these prefixes.
 func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []tmkv.Pair) {
 	iterA := a.Iterator(nil, nil)
 
@@ -28,6 +30,9 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []t
 	defer iterB.Close()
 
 	for {
+		skipPrefixes(iterA, prefixesToSkip)
+		skipPrefixes(iterB, prefixesToSkip)
+
 		if !iterA.Valid() && !iterB.Valid() {
 			return kvAs, kvBs
 		}
@@ -45,19 +50,27 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []t
 			iterB.Next()
 		}
 
-		compareValue := true
+		if !bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value) {
+			kvAs = append(kvAs, kvA)
+			kvBs = append(kvBs, kvB)
+		}
+	}
+}
 
-		for _, prefix := range prefixesToSkip {
-			// Skip value comparison if we matched a prefix
-			if bytes.HasPrefix(kvA.Key, prefix) || bytes.HasPrefix(kvB.Key, prefix) {
-				compareValue = false
+// skipPrefixes advances the iterator past the keys matching any of the given
+// prefixes.
+func skipPrefixes(iter Iterator, prefixes [][]byte) {
+	for ; iter.Valid(); iter.Next() {
+		skip := false
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(iter.Key(), prefix) {
+				skip = true
 				break
 			}
 		}
 
-		if compareValue && (!bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value)) {
-			kvAs = append(kvAs, kvA)
-			kvBs = append(kvBs, kvB)
+		if !skip {
+			return
 		}
 	}
 }
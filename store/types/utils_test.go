@@ -68,6 +68,15 @@ func TestDiffKVStores(t *testing.T) {
 	kvAs, kvBs = types.DiffKVStores(store1, store2, [][]byte{prefix})
 	require.Equal(t, 0, len(kvAs))
 	require.Equal(t, len(kvAs), len(kvBs))
+
+	// A different number of keys under a skipped prefix does not shift the
+	// comparison of the following keys.
+	store1.Set(append(prefix, k2...), v1)
+	store1.Set([]byte("z"), v1)
+	store2.Set([]byte("z"), v1)
+	kvAs, kvBs = types.DiffKVStores(store1, store2, [][]byte{prefix})
+	require.Equal(t, 0, len(kvAs))
+	require.Equal(t, len(kvAs), len(kvBs))
 }
 
 func TestPrefixEndBytes(t *testing.T) {
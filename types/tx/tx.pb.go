@@ -93,6 +93,75 @@ func (m *Tx) GetSignatures() [][]byte {
 	return nil
 }
 
+// TxRaw is a variant of Tx that pins the signer's exact binary representation
+// of body and auth_info. It is wire compatible with Tx and is what is actually
+// broadcast, so that the bytes covered by the signatures are the bytes that get
+// decoded by the chain
+type TxRaw struct {
+	// body_bytes is a protobuf serialization of a TxBody that matches the
+	// representation in SignDocRaw
+	BodyBytes []byte `protobuf:"bytes,1,opt,name=body_bytes,json=bodyBytes,proto3" json:"body_bytes,omitempty"`
+	// auth_info_bytes is a protobuf serialization of an AuthInfo that matches the
+	// representation in SignDocRaw
+	AuthInfoBytes []byte `protobuf:"bytes,2,opt,name=auth_info_bytes,json=authInfoBytes,proto3" json:"auth_info_bytes,omitempty"`
+	// signatures are the raw binary signatures of signers specified by body and auth_info
+	Signatures [][]byte `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *TxRaw) Reset()         { *m = TxRaw{} }
+func (m *TxRaw) String() string { return proto.CompactTextString(m) }
+func (*TxRaw) ProtoMessage()    {}
+func (*TxRaw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{1}
+}
+func (m *TxRaw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxRaw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxRaw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxRaw.Merge(m, src)
+}
+func (m *TxRaw) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxRaw) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxRaw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxRaw proto.InternalMessageInfo
+
+func (m *TxRaw) GetBodyBytes() []byte {
+	if m != nil {
+		return m.BodyBytes
+	}
+	return nil
+}
+
+func (m *TxRaw) GetAuthInfoBytes() []byte {
+	if m != nil {
+		return m.AuthInfoBytes
+	}
+	return nil
+}
+
+func (m *TxRaw) GetSignatures() [][]byte {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
 // SignDoc is the standard type used for signing transaction in SIGN_MODE_DIRECT
 type SignDoc struct {
 	// body is the TxBody from Tx
@@ -114,7 +183,7 @@ func (m *SignDoc) Reset()         { *m = SignDoc{} }
 func (m *SignDoc) String() string { return proto.CompactTextString(m) }
 func (*SignDoc) ProtoMessage()    {}
 func (*SignDoc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b35c9d5d6b7bce8, []int{1}
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{2}
 }
 func (m *SignDoc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,6 +247,92 @@ func (m *SignDoc) GetAccountSequence() uint64 {
 	return 0
 }
 
+// SignDocRaw is a variant of SignDoc that pins the signer's exact binary
+// representation of body and auth_info. It is wire compatible with SignDoc and
+// its serialization is the sign bytes for SIGN_MODE_DIRECT
+type SignDocRaw struct {
+	// body_bytes is a protobuf serialization of a TxBody that matches the
+	// representation in TxRaw
+	BodyBytes []byte `protobuf:"bytes,1,opt,name=body_bytes,json=bodyBytes,proto3" json:"body_bytes,omitempty"`
+	// auth_info_bytes is a protobuf serialization of an AuthInfo that matches the
+	// representation in TxRaw
+	AuthInfoBytes []byte `protobuf:"bytes,2,opt,name=auth_info_bytes,json=authInfoBytes,proto3" json:"auth_info_bytes,omitempty"`
+	// chain_id is the unique identifier of the chain this transaction targets
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// account_number is the account number of the account in state
+	AccountNumber uint64 `protobuf:"varint,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// account_sequence is the sequence of the account in state
+	AccountSequence uint64 `protobuf:"varint,5,opt,name=account_sequence,json=accountSequence,proto3" json:"account_sequence,omitempty"`
+}
+
+func (m *SignDocRaw) Reset()         { *m = SignDocRaw{} }
+func (m *SignDocRaw) String() string { return proto.CompactTextString(m) }
+func (*SignDocRaw) ProtoMessage()    {}
+func (*SignDocRaw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{3}
+}
+func (m *SignDocRaw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignDocRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignDocRaw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignDocRaw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignDocRaw.Merge(m, src)
+}
+func (m *SignDocRaw) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignDocRaw) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignDocRaw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignDocRaw proto.InternalMessageInfo
+
+func (m *SignDocRaw) GetBodyBytes() []byte {
+	if m != nil {
+		return m.BodyBytes
+	}
+	return nil
+}
+
+func (m *SignDocRaw) GetAuthInfoBytes() []byte {
+	if m != nil {
+		return m.AuthInfoBytes
+	}
+	return nil
+}
+
+func (m *SignDocRaw) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *SignDocRaw) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *SignDocRaw) GetAccountSequence() uint64 {
+	if m != nil {
+		return m.AccountSequence
+	}
+	return 0
+}
+
 // TxBody is the body of a transaction that all signers sign over
 type TxBody struct {
 	// messages are the processable content of the transaction
@@ -201,7 +356,7 @@ func (m *TxBody) Reset()         { *m = TxBody{} }
 func (m *TxBody) String() string { return proto.CompactTextString(m) }
 func (*TxBody) ProtoMessage()    {}
 func (*TxBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b35c9d5d6b7bce8, []int{2}
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{4}
 }
 func (m *TxBody) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +435,7 @@ func (m *AuthInfo) Reset()         { *m = AuthInfo{} }
 func (m *AuthInfo) String() string { return proto.CompactTextString(m) }
 func (*AuthInfo) ProtoMessage()    {}
 func (*AuthInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b35c9d5d6b7bce8, []int{3}
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{5}
 }
 func (m *AuthInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +492,7 @@ func (m *SignerInfo) Reset()         { *m = SignerInfo{} }
 func (m *SignerInfo) String() string { return proto.CompactTextString(m) }
 func (*SignerInfo) ProtoMessage()    {}
 func (*SignerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b35c9d5d6b7bce8, []int{4}
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{6}
 }
 func (m *SignerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +550,7 @@ func (m *ModeInfo) Reset()         { *m = ModeInfo{} }
 func (m *ModeInfo) String() string { return proto.CompactTextString(m) }
 func (*ModeInfo) ProtoMessage()    {}
 func (*ModeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b35c9d5d6b7bce8, []int{5}
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{7}
 }
 func (m *ModeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +635,7 @@ func (m *ModeInfo_Single) Reset()         { *m = ModeInfo_Single{} }
 func (m *ModeInfo_Single) String() string { return proto.CompactTextString(m) }
 func (*ModeInfo_Single) ProtoMessage()    {}
 func (*ModeInfo_Single) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b35c9d5d6b7bce8, []int{5, 0}
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{7, 0}
 }
 func (m *ModeInfo_Single) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -529,7 +684,7 @@ func (m *ModeInfo_Multi) Reset()         { *m = ModeInfo_Multi{} }
 func (m *ModeInfo_Multi) String() string { return proto.CompactTextString(m) }
 func (*ModeInfo_Multi) ProtoMessage()    {}
 func (*ModeInfo_Multi) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b35c9d5d6b7bce8, []int{5, 1}
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{7, 1}
 }
 func (m *ModeInfo_Multi) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +742,7 @@ func (m *Fee) Reset()         { *m = Fee{} }
 func (m *Fee) String() string { return proto.CompactTextString(m) }
 func (*Fee) ProtoMessage()    {}
 func (*Fee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b35c9d5d6b7bce8, []int{6}
+	return fileDescriptor_9b35c9d5d6b7bce8, []int{8}
 }
 func (m *Fee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +787,9 @@ func (m *Fee) GetGasLimit() uint64 {
 
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.Tx")
+	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.TxRaw")
 	proto.RegisterType((*SignDoc)(nil), "cosmos.tx.SignDoc")
+	proto.RegisterType((*SignDocRaw)(nil), "cosmos.tx.SignDocRaw")
 	proto.RegisterType((*TxBody)(nil), "cosmos.tx.TxBody")
 	proto.RegisterType((*AuthInfo)(nil), "cosmos.tx.AuthInfo")
 	proto.RegisterType((*SignerInfo)(nil), "cosmos.tx.SignerInfo")
@@ -645,54 +802,57 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/tx.proto", fileDescriptor_9b35c9d5d6b7bce8) }
 
 var fileDescriptor_9b35c9d5d6b7bce8 = []byte{
-	// 749 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xdb, 0x38,
-	0x10, 0xb6, 0x2c, 0xdb, 0xb1, 0x27, 0xff, 0x4c, 0x16, 0x50, 0x1c, 0x40, 0x31, 0x0c, 0x04, 0xf0,
-	0x1e, 0x56, 0xca, 0x3a, 0x7b, 0xd8, 0xdd, 0xcb, 0x22, 0xce, 0x6e, 0x90, 0x60, 0x9b, 0x16, 0xa0,
-	0x83, 0x1e, 0x72, 0x11, 0x64, 0x99, 0x96, 0x89, 0x58, 0xa4, 0x2b, 0x52, 0xa8, 0x5d, 0xa0, 0xef,
-	0xd0, 0x4b, 0x5f, 0xa2, 0x87, 0x3e, 0x47, 0x6e, 0xcd, 0xb1, 0xa7, 0xb6, 0x48, 0x1e, 0xa4, 0x85,
-	0x28, 0xca, 0x71, 0x0b, 0x37, 0x3d, 0xf5, 0xa4, 0xe1, 0x37, 0xdf, 0x37, 0x33, 0x9c, 0x19, 0x11,
-	0x50, 0xc0, 0x45, 0xc4, 0x85, 0x2b, 0x27, 0xae, 0x9c, 0x38, 0xe3, 0x98, 0x4b, 0x8e, 0x6a, 0x19,
-	0xe6, 0xc8, 0x49, 0x7d, 0x3b, 0xe4, 0x21, 0x57, 0xa8, 0x9b, 0x5a, 0x19, 0xa1, 0x5e, 0xd7, 0xa2,
-	0x20, 0x9e, 0x8e, 0x25, 0xd7, 0x1f, 0xed, 0xdb, 0xca, 0x7d, 0x59, 0x8c, 0x0c, 0xdc, 0xbb, 0xcf,
-	0x22, 0x68, 0xc8, 0x28, 0x0b, 0xf3, 0xaf, 0x26, 0xec, 0x84, 0x9c, 0x87, 0x23, 0xe2, 0xaa, 0x53,
-	0x2f, 0x19, 0xb8, 0x3e, 0x9b, 0x66, 0xae, 0xe6, 0x4b, 0x28, 0x5e, 0x4c, 0xd0, 0x3e, 0x94, 0x7a,
-	0xbc, 0x3f, 0xb5, 0x8c, 0x86, 0xd1, 0x5a, 0x6e, 0x6f, 0x3a, 0xb3, 0x12, 0x9d, 0x8b, 0x49, 0x87,
-	0xf7, 0xa7, 0x58, 0xb9, 0xd1, 0x01, 0xd4, 0xfc, 0x44, 0x0e, 0x3d, 0xca, 0x06, 0xdc, 0x2a, 0x2a,
-	0xee, 0xd6, 0x1c, 0xf7, 0x28, 0x91, 0xc3, 0x33, 0x36, 0xe0, 0xb8, 0xea, 0x6b, 0x0b, 0xd9, 0x00,
-	0x69, 0x29, 0xbe, 0x4c, 0x62, 0x22, 0x2c, 0xb3, 0x61, 0xb6, 0x56, 0xf0, 0x1c, 0xd2, 0x7c, 0x67,
-	0xc0, 0x52, 0x97, 0x86, 0xec, 0x5f, 0x1e, 0xfc, 0xbc, 0x22, 0x76, 0xa0, 0x1a, 0x0c, 0x7d, 0xca,
-	0x3c, 0xda, 0xb7, 0xcc, 0x86, 0xd1, 0xaa, 0xe1, 0x25, 0x75, 0x3e, 0xeb, 0xa3, 0x7d, 0x58, 0xf3,
-	0x83, 0x80, 0x27, 0x4c, 0x7a, 0x2c, 0x89, 0x7a, 0x24, 0xb6, 0x4a, 0x0d, 0xa3, 0x55, 0xc2, 0xab,
-	0x1a, 0x7d, 0xac, 0x40, 0xf4, 0x2b, 0x6c, 0xe4, 0x34, 0x41, 0x9e, 0x25, 0x84, 0x05, 0xc4, 0x2a,
-	0x2b, 0xe2, 0xba, 0xc6, 0xbb, 0x1a, 0x6e, 0xbe, 0x2e, 0x42, 0x25, 0xab, 0x17, 0x1d, 0x40, 0x35,
-	0x22, 0x42, 0xf8, 0x21, 0x11, 0x96, 0xd1, 0x30, 0x5b, 0xcb, 0xed, 0x6d, 0x27, 0x9b, 0x84, 0x93,
-	0x4f, 0xc2, 0x39, 0x62, 0x53, 0x3c, 0x63, 0x21, 0x04, 0xa5, 0x88, 0x44, 0xd9, 0xb5, 0x6a, 0x58,
-	0xd9, 0x69, 0x89, 0x92, 0x46, 0x84, 0x27, 0xd2, 0x1b, 0x12, 0x1a, 0x0e, 0xa5, 0xba, 0x83, 0x89,
-	0x57, 0x35, 0x7a, 0xaa, 0x40, 0xd4, 0x81, 0x4d, 0x32, 0x91, 0x84, 0x09, 0xca, 0x99, 0xc7, 0xc7,
-	0x92, 0x72, 0x26, 0xac, 0xcf, 0x4b, 0x0f, 0xa4, 0xdd, 0x98, 0xf1, 0x9f, 0x64, 0x74, 0x74, 0x09,
-	0x36, 0xe3, 0xcc, 0x0b, 0x62, 0x2a, 0x69, 0xe0, 0x8f, 0xbc, 0x05, 0x01, 0xd7, 0x1f, 0x08, 0xb8,
-	0xcb, 0x38, 0x3b, 0xd6, 0xda, 0xff, 0xbe, 0x89, 0xdd, 0x1c, 0x40, 0x35, 0x1f, 0x0d, 0xfa, 0x13,
-	0x56, 0xd2, 0x1d, 0x20, 0xb1, 0x1a, 0x62, 0xde, 0x9c, 0x5f, 0xe6, 0xa6, 0xd8, 0x55, 0x6e, 0x35,
-	0xc7, 0x65, 0x31, 0xb3, 0x05, 0x6a, 0x80, 0x39, 0x20, 0x44, 0x8f, 0x7d, 0x6d, 0x4e, 0x70, 0x42,
-	0x08, 0x4e, 0x5d, 0x4d, 0x01, 0x70, 0x2f, 0x46, 0x87, 0x00, 0xe3, 0xa4, 0x37, 0xa2, 0x81, 0x77,
-	0x45, 0xf2, 0xcd, 0x5a, 0x5c, 0x7c, 0x2d, 0xe3, 0xfd, 0x4f, 0xd4, 0x86, 0x45, 0xbc, 0x4f, 0xbe,
-	0xb7, 0x61, 0xe7, 0xbc, 0x4f, 0xb2, 0x0d, 0x8b, 0xb4, 0xd5, 0x7c, 0x5b, 0x84, 0x6a, 0x0e, 0xa3,
-	0x3f, 0xa0, 0x22, 0x28, 0x0b, 0x47, 0x44, 0xe7, 0xab, 0x2f, 0xd0, 0x3a, 0x5d, 0xc5, 0x38, 0x2d,
-	0x60, 0xcd, 0x45, 0xbf, 0x43, 0x39, 0x4a, 0x46, 0x92, 0xea, 0x84, 0x3b, 0x8b, 0x44, 0xe7, 0x29,
-	0xe1, 0xb4, 0x80, 0x33, 0x66, 0xfd, 0x2f, 0xa8, 0x64, 0x61, 0x90, 0x0b, 0xa5, 0xb4, 0x16, 0x95,
-	0x70, 0xad, 0xbd, 0x3b, 0xa7, 0xcd, 0x1f, 0x82, 0xb4, 0x27, 0x69, 0x1c, 0xac, 0x88, 0xf5, 0xe7,
-	0x50, 0x56, 0xc1, 0xd0, 0xdf, 0x50, 0xed, 0x51, 0xe9, 0xc7, 0xb1, 0x9f, 0xb7, 0xc7, 0xce, 0xd5,
-	0xfa, 0xe1, 0x39, 0xe6, 0xd1, 0xd8, 0x0f, 0x64, 0x87, 0xca, 0xa3, 0x94, 0x85, 0x67, 0x7c, 0xd4,
-	0x06, 0x98, 0xf5, 0x49, 0x58, 0x45, 0x35, 0xc4, 0x85, 0x8d, 0xaa, 0xe5, 0x8d, 0x12, 0x9d, 0x32,
-	0x98, 0x22, 0x89, 0x9a, 0x2f, 0xc0, 0x3c, 0x21, 0x04, 0x3d, 0x85, 0x8a, 0x1f, 0xa5, 0xbf, 0x8f,
-	0x5e, 0x81, 0x95, 0x5c, 0x7d, 0xcc, 0x29, 0xeb, 0x1c, 0x5c, 0x7f, 0xd8, 0x2b, 0xbc, 0xf9, 0xb8,
-	0xd7, 0x0a, 0xa9, 0x1c, 0x26, 0x3d, 0x27, 0xe0, 0x91, 0xfb, 0xd5, 0xfb, 0xf7, 0x9b, 0xe8, 0x5f,
-	0xb9, 0x72, 0x3a, 0x26, 0x99, 0x40, 0x60, 0x1d, 0x0d, 0xed, 0x42, 0x2d, 0xf4, 0x85, 0x37, 0xa2,
-	0x11, 0x95, 0xaa, 0xa1, 0x25, 0x5c, 0x0d, 0x7d, 0xf1, 0x28, 0x3d, 0x77, 0xfe, 0xb9, 0xbe, 0xb5,
-	0x8d, 0x9b, 0x5b, 0xdb, 0xf8, 0x74, 0x6b, 0x1b, 0xaf, 0xee, 0xec, 0xc2, 0xcd, 0x9d, 0x5d, 0x78,
-	0x7f, 0x67, 0x17, 0x2e, 0xf7, 0x7f, 0x9c, 0xc8, 0x95, 0x93, 0x5e, 0x45, 0x2d, 0xce, 0xe1, 0x97,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0xf8, 0x98, 0x8a, 0xde, 0x05, 0x00, 0x00,
+	// 798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0xe3, 0x24, 0x1b, 0xbf, 0xfd, 0xd7, 0x4e, 0x8b, 0x94, 0xcd, 0x0a, 0x6f, 0x14, 0x69,
+	0x51, 0x38, 0x60, 0x2f, 0x5b, 0x0e, 0xc0, 0x05, 0x6d, 0x16, 0xaa, 0xad, 0xa0, 0x20, 0x4d, 0x56,
+	0x1c, 0x7a, 0xb1, 0x6c, 0x67, 0xe2, 0x8c, 0x1a, 0xcf, 0x04, 0xcf, 0x58, 0x75, 0x90, 0xf8, 0x0e,
+	0x5c, 0xf8, 0x12, 0x1c, 0xf8, 0x0c, 0x1c, 0x7b, 0xa3, 0x47, 0x4e, 0x80, 0x76, 0x3f, 0x08, 0x68,
+	0xfe, 0x38, 0x04, 0x94, 0xb6, 0x17, 0xe8, 0xc9, 0x6f, 0x7e, 0xef, 0xf7, 0xfe, 0xcc, 0xf3, 0xef,
+	0xd9, 0x80, 0x52, 0x2e, 0x72, 0x2e, 0x42, 0x59, 0x85, 0xb2, 0x0a, 0x96, 0x05, 0x97, 0x1c, 0x79,
+	0x06, 0x0b, 0x64, 0xd5, 0xbf, 0x9f, 0xf1, 0x8c, 0x6b, 0x34, 0x54, 0x96, 0x21, 0xf4, 0xfb, 0x36,
+	0x28, 0x2d, 0x56, 0x4b, 0xc9, 0xed, 0xc3, 0xfa, 0xee, 0xd5, 0x3e, 0x93, 0xc3, 0x80, 0x27, 0x7f,
+	0x57, 0x11, 0x34, 0x63, 0x94, 0x65, 0xf5, 0xd3, 0x12, 0x8e, 0x32, 0xce, 0xb3, 0x05, 0x09, 0xf5,
+	0x29, 0x29, 0x67, 0x61, 0xcc, 0x56, 0xc6, 0x35, 0xfc, 0x0e, 0x9a, 0xd7, 0x15, 0x3a, 0x85, 0x56,
+	0xc2, 0xa7, 0xab, 0x9e, 0x33, 0x70, 0x46, 0xbb, 0xe7, 0x77, 0x83, 0x75, 0x8b, 0xc1, 0x75, 0x35,
+	0xe6, 0xd3, 0x15, 0xd6, 0x6e, 0x74, 0x06, 0x5e, 0x5c, 0xca, 0x79, 0x44, 0xd9, 0x8c, 0xf7, 0x9a,
+	0x9a, 0x7b, 0x6f, 0x83, 0x7b, 0x51, 0xca, 0xf9, 0x23, 0x36, 0xe3, 0xb8, 0x1b, 0x5b, 0x0b, 0xf9,
+	0x00, 0xaa, 0x95, 0x58, 0x96, 0x05, 0x11, 0x3d, 0x77, 0xe0, 0x8e, 0xf6, 0xf0, 0x06, 0x32, 0x64,
+	0xd0, 0xbe, 0xae, 0x70, 0xfc, 0x0c, 0xbd, 0x0d, 0xa0, 0x4a, 0x44, 0xc9, 0x4a, 0x12, 0xa1, 0xfb,
+	0xd8, 0xc3, 0x9e, 0x42, 0xc6, 0x0a, 0x40, 0xef, 0xc0, 0xe1, 0xba, 0xb2, 0xe5, 0x34, 0x35, 0x67,
+	0xbf, 0x2e, 0x65, 0x78, 0xaf, 0xab, 0xf7, 0x8b, 0x03, 0x3b, 0x13, 0x9a, 0xb1, 0x4f, 0x79, 0xfa,
+	0xff, 0x5d, 0xfa, 0x08, 0xba, 0xe9, 0x3c, 0xa6, 0x2c, 0xa2, 0xd3, 0x9e, 0x3b, 0x70, 0x46, 0x1e,
+	0xde, 0xd1, 0xe7, 0x47, 0x53, 0x74, 0x0a, 0x07, 0x71, 0x9a, 0xf2, 0x92, 0xc9, 0x88, 0x95, 0x79,
+	0x42, 0x8a, 0x5e, 0x6b, 0xe0, 0x8c, 0x5a, 0x78, 0xdf, 0xa2, 0x5f, 0x6a, 0x10, 0xbd, 0x0b, 0x77,
+	0x6a, 0x9a, 0x20, 0xdf, 0x94, 0x84, 0xa5, 0xa4, 0xd7, 0xd6, 0xc4, 0x43, 0x8b, 0x4f, 0x2c, 0x3c,
+	0xfc, 0xd9, 0x01, 0xb0, 0x37, 0xfa, 0x0f, 0xe7, 0xf8, 0x46, 0xaf, 0xf0, 0x43, 0x13, 0x3a, 0x66,
+	0xe4, 0xe8, 0x0c, 0xba, 0x39, 0x11, 0x22, 0xce, 0x74, 0xf3, 0xee, 0x68, 0xf7, 0xfc, 0x7e, 0x60,
+	0xc4, 0x1b, 0xd4, 0xe2, 0x0d, 0x2e, 0xd8, 0x0a, 0xaf, 0x59, 0x08, 0x41, 0x2b, 0x27, 0xb9, 0x79,
+	0x33, 0x1e, 0xd6, 0xb6, 0x6a, 0x51, 0xd2, 0x9c, 0xf0, 0x52, 0x46, 0x73, 0x42, 0xb3, 0xb9, 0xd4,
+	0x77, 0x70, 0xf1, 0xbe, 0x45, 0xaf, 0x34, 0x88, 0xc6, 0x70, 0x97, 0x54, 0x92, 0x30, 0x41, 0x39,
+	0x8b, 0xf8, 0x52, 0x52, 0xce, 0x44, 0xef, 0xcf, 0x9d, 0x57, 0x94, 0xbd, 0xb3, 0xe6, 0x7f, 0x65,
+	0xe8, 0xe8, 0x09, 0xf8, 0x8c, 0xb3, 0x28, 0x2d, 0xa8, 0xa4, 0x69, 0xbc, 0x88, 0xb6, 0x24, 0x3c,
+	0x7c, 0x45, 0xc2, 0x63, 0xc6, 0xd9, 0xa5, 0x8d, 0xfd, 0xec, 0x5f, 0xb9, 0x87, 0x33, 0xe8, 0xd6,
+	0xea, 0x42, 0x1f, 0xc2, 0x9e, 0x92, 0x31, 0x29, 0xf4, 0xab, 0xab, 0x87, 0xf3, 0xd6, 0x86, 0x10,
+	0x27, 0xda, 0xad, 0xa5, 0xb8, 0x2b, 0xd6, 0xb6, 0x40, 0x03, 0x70, 0x67, 0x84, 0x58, 0xe5, 0x1e,
+	0x6c, 0x04, 0x3c, 0x24, 0x04, 0x2b, 0xd7, 0x50, 0x18, 0x05, 0x99, 0x00, 0xf4, 0x00, 0x60, 0x59,
+	0x26, 0x0b, 0x9a, 0x46, 0x4f, 0x49, 0xbd, 0x1c, 0xdb, 0x9b, 0xf7, 0x0c, 0xef, 0x73, 0xa2, 0x97,
+	0x24, 0xe7, 0x53, 0xf2, 0xb2, 0x25, 0x79, 0xcc, 0xa7, 0xc4, 0x2c, 0x49, 0x6e, 0xad, 0xe1, 0x4f,
+	0x4d, 0xe8, 0xd6, 0x30, 0xfa, 0x00, 0x3a, 0x82, 0xb2, 0x6c, 0x41, 0x6c, 0xbd, 0xfe, 0x96, 0xd8,
+	0x60, 0xa2, 0x19, 0x57, 0x0d, 0x6c, 0xb9, 0xe8, 0x7d, 0x68, 0xe7, 0xe5, 0x42, 0x52, 0x5b, 0xf0,
+	0x68, 0x5b, 0xd0, 0x63, 0x45, 0xb8, 0x6a, 0x60, 0xc3, 0xec, 0x7f, 0x04, 0x1d, 0x93, 0x06, 0x85,
+	0xd0, 0x52, 0xbd, 0xe8, 0x82, 0x07, 0xe7, 0xc7, 0x1b, 0xb1, 0xf5, 0xb7, 0x53, 0xcd, 0x44, 0xe5,
+	0xc1, 0x9a, 0xd8, 0x7f, 0x06, 0x6d, 0x9d, 0x0c, 0x7d, 0x0c, 0xdd, 0x84, 0xca, 0xb8, 0x28, 0xe2,
+	0x7a, 0x3c, 0x7e, 0x1d, 0x6d, 0xbf, 0xd5, 0x97, 0x3c, 0x5f, 0xc6, 0xa9, 0x1c, 0x53, 0x79, 0xa1,
+	0x58, 0x78, 0xcd, 0x47, 0xe7, 0x00, 0xeb, 0x39, 0xa9, 0xd5, 0x73, 0x5f, 0x36, 0x28, 0xaf, 0x1e,
+	0x94, 0x18, 0xb7, 0xc1, 0x15, 0x65, 0x3e, 0xfc, 0x16, 0xdc, 0x87, 0x84, 0xa0, 0xaf, 0xa1, 0x13,
+	0xe7, 0x6a, 0x7d, 0xac, 0x04, 0xf6, 0xea, 0xe8, 0x4b, 0x4e, 0xd9, 0xf8, 0xec, 0xf9, 0x6f, 0x27,
+	0x8d, 0x1f, 0x7f, 0x3f, 0x19, 0x65, 0x54, 0xce, 0xcb, 0x24, 0x48, 0x79, 0x1e, 0xfe, 0xe3, 0x97,
+	0xf1, 0x9e, 0x98, 0x3e, 0x0d, 0xe5, 0x6a, 0x49, 0x4c, 0x80, 0xc0, 0x36, 0x1b, 0x3a, 0x06, 0x2f,
+	0x8b, 0x45, 0xb4, 0xa0, 0x39, 0x95, 0x7a, 0xa0, 0x2d, 0xdc, 0xcd, 0x62, 0xf1, 0x85, 0x3a, 0x8f,
+	0x3f, 0x79, 0x7e, 0xe3, 0x3b, 0x2f, 0x6e, 0x7c, 0xe7, 0x8f, 0x1b, 0xdf, 0xf9, 0xfe, 0xd6, 0x6f,
+	0xbc, 0xb8, 0xf5, 0x1b, 0xbf, 0xde, 0xfa, 0x8d, 0x27, 0xa7, 0xaf, 0x2f, 0x14, 0xca, 0x2a, 0xe9,
+	0x68, 0xe1, 0x3c, 0xf8, 0x6b, 0x00, 0xa7, 0xbe, 0x6e, 0x7b, 0x11, 0x07, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -751,6 +911,52 @@ func (m *Tx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxRaw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRaw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AuthInfoBytes) > 0 {
+		i -= len(m.AuthInfoBytes)
+		copy(dAtA[i:], m.AuthInfoBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuthInfoBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BodyBytes) > 0 {
+		i -= len(m.BodyBytes)
+		copy(dAtA[i:], m.BodyBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BodyBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SignDoc) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -815,6 +1021,60 @@ func (m *SignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignDocRaw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignDocRaw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignDocRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountSequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuthInfoBytes) > 0 {
+		i -= len(m.AuthInfoBytes)
+		copy(dAtA[i:], m.AuthInfoBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuthInfoBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BodyBytes) > 0 {
+		i -= len(m.BodyBytes)
+		copy(dAtA[i:], m.BodyBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BodyBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxBody) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1219,6 +1479,29 @@ func (m *Tx) Size() (n int) {
 	return n
 }
 
+func (m *TxRaw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BodyBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuthInfoBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, b := range m.Signatures {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SignDoc) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1246,6 +1529,33 @@ func (m *SignDoc) Size() (n int) {
 	return n
 }
 
+func (m *SignDocRaw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BodyBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuthInfoBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovTx(uint64(m.AccountNumber))
+	}
+	if m.AccountSequence != 0 {
+		n += 1 + sovTx(uint64(m.AccountSequence))
+	}
+	return n
+}
+
 func (m *TxBody) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1564,7 +1874,7 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignDoc) Unmarshal(dAtA []byte) error {
+func (m *TxRaw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1587,17 +1897,17 @@ func (m *SignDoc) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignDoc: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxRaw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxRaw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1607,33 +1917,31 @@ func (m *SignDoc) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Body == nil {
-				m.Body = &TxBody{}
-			}
-			if err := m.Body.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BodyBytes = append(m.BodyBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.BodyBytes == nil {
+				m.BodyBytes = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfoBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1643,26 +1951,372 @@ func (m *SignDoc) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthInfo == nil {
-				m.AuthInfo = &AuthInfo{}
+			m.AuthInfoBytes = append(m.AuthInfoBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthInfoBytes == nil {
+				m.AuthInfoBytes = []byte{}
 			}
-			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
+			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Body == nil {
+				m.Body = &TxBody{}
+			}
+			if err := m.Body.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthInfo == nil {
+				m.AuthInfo = &AuthInfo{}
+			}
+			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountSequence", wireType)
+			}
+			m.AccountSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignDocRaw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignDocRaw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignDocRaw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyBytes = append(m.BodyBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.BodyBytes == nil {
+				m.BodyBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfoBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthInfoBytes = append(m.AuthInfoBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthInfoBytes == nil {
+				m.AuthInfoBytes = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
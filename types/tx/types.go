@@ -0,0 +1,88 @@
+package tx
+
+import (
+	"fmt"
+
+	golangproto "github.com/golang/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_, _ codectypes.UnpackInterfacesMessage = &Tx{}, &TxBody{}
+	_, _ codectypes.UnpackInterfacesMessage = &TxResponse{}, &GetTxResponse{}
+	_, _ codectypes.UnpackInterfacesMessage = &BroadcastTxResponse{}, &GetTxsEventResponse{}
+)
+
+func init() {
+	// grpc-gateway looks enums up in the golang/protobuf registry to parse their
+	// names in query parameters, gogoproto only registers them in its own.
+	golangproto.RegisterEnum("cosmos.tx.OrderBy", OrderBy_name, OrderBy_value)
+	golangproto.RegisterEnum("cosmos.tx.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *Tx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Body != nil {
+		return m.Body.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method.
+// It rejects empty message Anys, which would otherwise unpack to a nil Msg.
+func (m *TxBody) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i, any := range m.Messages {
+		if any == nil || any.TypeUrl == "" {
+			return fmt.Errorf("empty message at index %d", i)
+		}
+
+		var msg sdk.Msg
+		err := unpacker.UnpackAny(any, &msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *TxResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Tx != nil {
+		return m.Tx.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *GetTxResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.TxResponse != nil {
+		return m.TxResponse.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *BroadcastTxResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.TxResponse != nil {
+		return m.TxResponse.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *GetTxsEventResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, txResponse := range m.TxResponses {
+		if err := txResponse.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
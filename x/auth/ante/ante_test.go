@@ -15,10 +15,14 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/secp256r1"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // run the tx through the anteHandler and ensure its valid
@@ -645,6 +649,8 @@ func TestCountSubkeys(t *testing.T) {
 	multiLevelSubKey2 := multisig.NewPubKeyMultisigThreshold(4, genPubKeys(5))
 	multiLevelMultiKey := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{
 		multiLevelSubKey1, multiLevelSubKey2, secp256k1.GenPrivKey().PubKey()})
+	weightedMultiKey := multisig.NewPubKeyMultisigWeighted(3, []crypto.PubKey{
+		multiLevelSubKey1, secp256k1.GenPrivKey().PubKey()}, []uint{2, 1})
 	type args struct {
 		pub crypto.PubKey
 	}
@@ -656,6 +662,7 @@ func TestCountSubkeys(t *testing.T) {
 		{"single key", args{singleKey}, 1},
 		{"single level multikey", args{singleLevelMultiKey}, 5},
 		{"multi level multikey", args{multiLevelMultiKey}, 11},
+		{"weighted multikey", args{weightedMultiKey}, 6},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -797,9 +804,9 @@ func TestAnteHandlerReCheck(t *testing.T) {
 		name   string
 		params types.Params
 	}{
-		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000)},
+		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1)},
+		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1)},
+		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000, types.DefaultSigVerifyCostSecp256r1)},
 	}
 	for _, tc := range testCases {
 		// set testcase parameters
@@ -831,3 +838,137 @@ func TestAnteHandlerReCheck(t *testing.T) {
 	_, err = antehandler(ctx, tx, false)
 	require.NotNil(t, err, "antehandler on recheck did not fail once feePayer no longer has sufficient funds")
 }
+
+func TestAnteHandlerTxGenerator(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1).WithChainID("test-chain")
+	txGen := simapp.MakeEncodingConfig().TxGenerator
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, txGen.SignModeHandler())
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetAccountNumber(0))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins()))
+
+	newSignedTx := func(seq uint64) sdk.Tx {
+		txBuilder := txGen.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))))
+		fee := types.NewTestStdFee()
+		txBuilder.SetFeeAmount(fee.Amount)
+		txBuilder.SetGasLimit(fee.Gas)
+
+		sigData := &signing.SingleSignatureData{SignMode: txGen.SignModeHandler().DefaultMode()}
+		require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: priv1.PubKey(), Data: sigData}))
+
+		signBytes, err := txGen.SignModeHandler().GetSignBytes(sigData.SignMode, authsigning.SignerData{
+			ChainID:         ctx.ChainID(),
+			AccountNumber:   0,
+			AccountSequence: seq,
+		}, txBuilder.GetTx())
+		require.NoError(t, err)
+
+		sigData.Signature, err = priv1.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: priv1.PubKey(), Data: sigData}))
+
+		// the ante handler must accept the transaction as it is decoded by the app
+		txBytes, err := txGen.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		tx, err := txGen.TxDecoder()(txBytes)
+		require.NoError(t, err)
+
+		return tx
+	}
+
+	tx := newSignedTx(0)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	acc1 = app.AccountKeeper.GetAccount(ctx, addr1)
+	require.Equal(t, priv1.PubKey(), acc1.GetPubKey())
+	require.Equal(t, uint64(1), acc1.GetSequence())
+
+	// replaying the transaction fails signature verification
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdkerrors.ErrUnauthorized)
+
+	checkValidTx(t, anteHandler, ctx, newSignedTx(1), false)
+
+	// an empty signature is accepted in simulation
+	txBuilder := txGen.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))))
+	txBuilder.SetGasLimit(types.NewTestStdFee().Gas)
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{}))
+	txBytes, err := txGen.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	tx, err = txGen.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	checkValidTx(t, anteHandler, ctx.WithTxBytes(txBytes), tx, true)
+}
+
+func TestAnteHandlerSecp256r1(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1).WithChainID("test-chain")
+	txGen := simapp.MakeEncodingConfig().TxGenerator
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, txGen.SignModeHandler())
+
+	// keys and addresses
+	priv1 := secp256r1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2 := secp256r1.GenPrivKey()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetAccountNumber(0))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins()))
+
+	newSignedTx := func(priv crypto.PrivKey, seq uint64) sdk.Tx {
+		txBuilder := txGen.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))))
+		fee := types.NewTestStdFee()
+		txBuilder.SetFeeAmount(fee.Amount)
+		txBuilder.SetGasLimit(fee.Gas)
+
+		sigData := &signing.SingleSignatureData{SignMode: txGen.SignModeHandler().DefaultMode()}
+		require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: priv.PubKey(), Data: sigData}))
+
+		signBytes, err := txGen.SignModeHandler().GetSignBytes(sigData.SignMode, authsigning.SignerData{
+			ChainID:         ctx.ChainID(),
+			AccountNumber:   0,
+			AccountSequence: seq,
+		}, txBuilder.GetTx())
+		require.NoError(t, err)
+
+		sigData.Signature, err = priv.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: priv.PubKey(), Data: sigData}))
+
+		txBytes, err := txGen.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		tx, err := txGen.TxDecoder()(txBytes)
+		require.NoError(t, err)
+
+		return tx
+	}
+
+	// a key that does not match the account address is rejected
+	checkInvalidTx(t, anteHandler, ctx, newSignedTx(priv2, 0), false, sdkerrors.ErrInvalidPubKey)
+
+	// the secp256r1 signature verification cost is consumed
+	newCtx, err := anteHandler(ctx, newSignedTx(priv1, 0), false)
+	require.NoError(t, err)
+	require.True(t, newCtx.GasMeter().GasConsumed() >= types.DefaultSigVerifyCostSecp256r1)
+
+	acc1 = app.AccountKeeper.GetAccount(ctx, addr1)
+	require.Equal(t, priv1.PubKey(), acc1.GetPubKey())
+	require.Equal(t, uint64(1), acc1.GetSequence())
+
+	checkValidTx(t, anteHandler, ctx, newSignedTx(priv1, 1), false)
+}
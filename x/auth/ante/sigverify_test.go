@@ -14,6 +14,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -76,6 +77,19 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		require.NoError(t, err)
 	}
 
+	// a weighted multisig whose first member is the threshold multisig above
+	pkSet2, sigSet2 := generatePubKeysAndSignatures(2, msg, false)
+	multisigKey2 := multisig.NewPubKeyMultisigWeighted(3, []crypto.PubKey{multisigKey1, pkSet2[0], pkSet2[1]}, []uint{2, 1, 1})
+	multisignature2 := multisig.NewMultisig(3)
+	multisig.AddSignature(multisignature2, multisignature1, 0)
+	multisig.AddSignature(multisignature2, &signing.SingleSignatureData{Signature: sigSet2[1]}, 2)
+	expectedCost2 := expectedCost1 + expectedGasCostByKeys(pkSet2[1:])
+
+	// a multisignature with more signatures than bits set
+	multisignature3 := multisig.NewMultisig(3)
+	multisig.AddSignature(multisignature3, &signing.SingleSignatureData{Signature: sigSet2[0]}, 1)
+	multisignature3.Signatures = append(multisignature3.Signatures, multisignature3.Signatures[0])
+
 	type args struct {
 		meter  sdk.GasMeter
 		sig    signing.SignatureData
@@ -90,7 +104,10 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256r1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
+		{"Nested weighted multisig", args{sdk.NewInfiniteGasMeter(), multisignature2, multisigKey2, params}, expectedCost2, false},
+		{"Malformed multisig", args{sdk.NewInfiniteGasMeter(), multisignature3, multisigKey2, params}, 0, true},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
@@ -27,8 +27,9 @@ func TestGetBroadcastCommand_OfflineFlag(t *testing.T) {
 }
 
 func TestGetBroadcastCommand_WithoutOfflineFlag(t *testing.T) {
+	txGen := simappparams.MakeEncodingConfig().TxGenerator
 	clientCtx := client.Context{}
-	clientCtx = clientCtx.WithTxGenerator(simappparams.MakeEncodingConfig().TxGenerator)
+	clientCtx = clientCtx.WithTxGenerator(txGen)
 	cmd := GetBroadcastCommand(clientCtx)
 
 	viper.Set(flags.FlagOffline, false)
@@ -37,9 +38,12 @@ func TestGetBroadcastCommand_WithoutOfflineFlag(t *testing.T) {
 	t.Cleanup(cleanFunc)
 
 	// Create new file with tx
-	txContents := []byte("{\"type\":\"cosmos-sdk/StdTx\",\"value\":{\"msg\":[{\"type\":\"cosmos-sdk/MsgSend\",\"value\":{\"from_address\":\"cosmos1cxlt8kznps92fwu3j6npahx4mjfutydyene2qw\",\"to_address\":\"cosmos1wc8mpr8m3sy3ap3j7fsgqfzx36um05pystems4\",\"amount\":[{\"denom\":\"stake\",\"amount\":\"10000\"}]}}],\"fee\":{\"amount\":[],\"gas\":\"200000\"},\"signatures\":null,\"memo\":\"\"}}")
+	builder := txGen.NewTxBuilder()
+	builder.SetGasLimit(200000)
+	txContents, err := txGen.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
 	txFileName := filepath.Join(testDir, "tx.json")
-	err := ioutil.WriteFile(txFileName, txContents, 0644)
+	err = ioutil.WriteFile(txFileName, txContents, 0644)
 	require.NoError(t, err)
 
 	err = cmd.RunE(cmd, []string{txFileName})
@@ -442,3 +442,78 @@ func TestCLIMultisign(t *testing.T) {
 	// Cleanup testing directories
 	f.Cleanup()
 }
+
+func TestCLIMultisigSession(t *testing.T) {
+	t.Parallel()
+	f := cli.InitFixtures(t)
+
+	// start simd server with minimum fees
+	proc := f.SDStart()
+	t.Cleanup(func() { proc.Stop(false) })
+
+	fooBarBazAddr := f.KeyAddress(cli.KeyFooBarBaz)
+	bazAddr := f.KeyAddress(cli.KeyBaz)
+
+	// Send some tokens from one account to the other
+	success, _, _ := bankcli.TxSend(f, cli.KeyFoo, fooBarBazAddr, sdk.NewInt64Coin(cli.Denom, 10), "-y")
+	require.True(t, success)
+	tests.WaitForNextNBlocksTM(1, f.Port)
+
+	// Test generate sendTx with multisig
+	success, stdout, stderr := bankcli.TxSend(f, fooBarBazAddr.String(), bazAddr, sdk.NewInt64Coin(cli.Denom, 10), "--generate-only")
+	require.True(t, success)
+	require.Empty(t, stderr)
+
+	// Write the output to disk
+	unsignedTxFile, cleanup := tests.WriteToNewTempFile(t, stdout)
+	t.Cleanup(cleanup)
+
+	// Create the session
+	success, stdout, _ = testutil.TxMultisigInit(f, unsignedTxFile.Name(), cli.KeyFooBarBaz)
+	require.True(t, success)
+
+	sessionFile, cleanup := tests.WriteToNewTempFile(t, stdout)
+	t.Cleanup(cleanup)
+
+	// Sign with foo's key, twice
+	success, _, _ = testutil.TxMultisigSign(f, cli.KeyFoo, sessionFile.Name())
+	require.True(t, success)
+	success, _, _ = testutil.TxMultisigSign(f, cli.KeyFoo, sessionFile.Name())
+	require.False(t, success)
+
+	// The threshold isn't met yet
+	success, stdout, _ = testutil.TxMultisigStatus(f, sessionFile.Name(), "--output=json")
+	require.True(t, success)
+	require.Contains(t, stdout, `"complete":false`)
+
+	success, _, _ = testutil.TxMultisigFinalize(f, sessionFile.Name())
+	require.False(t, success)
+
+	// Sign with bar's key, offline
+	success, _, _ = testutil.TxMultisigSign(f, cli.KeyBar, sessionFile.Name(), "--offline")
+	require.True(t, success)
+
+	success, stdout, _ = testutil.TxMultisigStatus(f, sessionFile.Name(), "--output=json")
+	require.True(t, success)
+	require.Contains(t, stdout, `"complete":true`)
+
+	// Assemble the signed transaction and validate the multisignature
+	success, stdout, _ = testutil.TxMultisigFinalize(f, sessionFile.Name())
+	require.True(t, success)
+
+	signedTxFile, cleanup := tests.WriteToNewTempFile(t, stdout)
+	t.Cleanup(cleanup)
+
+	success, _, _ = testutil.TxValidateSignatures(f, signedTxFile.Name())
+	require.True(t, success)
+
+	// Broadcast the transaction
+	success, _, _ = testutil.TxMultisigFinalize(f, sessionFile.Name(), "--broadcast")
+	require.True(t, success)
+	tests.WaitForNextNBlocksTM(1, f.Port)
+
+	require.True(t, bankcli.QueryBalances(f, fooBarBazAddr).AmountOf(cli.Denom).IsZero())
+
+	// Cleanup testing directories
+	f.Cleanup()
+}
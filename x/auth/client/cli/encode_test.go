@@ -27,9 +27,11 @@ func TestGetCommandEncode(t *testing.T) {
 	txGen := encodingConfig.TxGenerator
 
 	// Build a test transaction
-	fee := authtypes.NewStdFee(50000, sdk.Coins{sdk.NewInt64Coin("atom", 150)})
-	stdTx := authtypes.NewStdTx([]sdk.Msg{}, fee, []authtypes.StdSignature{}, "foomemo")
-	JSONEncoded, err := txGen.TxJSONEncoder()(stdTx)
+	builder := txGen.NewTxBuilder()
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	builder.SetMemo("foomemo")
+	JSONEncoded, err := txGen.TxJSONEncoder()(builder.GetTx())
 	require.NoError(t, err)
 
 	txFile, cleanup := tests.WriteToNewTempFile(t, string(JSONEncoded))
@@ -56,11 +58,13 @@ func TestGetCommandDecode(t *testing.T) {
 	clientCtx = clientCtx.WithTxGenerator(txGen)
 
 	// Build a test transaction
-	fee := authtypes.NewStdFee(50000, sdk.Coins{sdk.NewInt64Coin("atom", 150)})
-	stdTx := authtypes.NewStdTx([]sdk.Msg{}, fee, []authtypes.StdSignature{}, "foomemo")
+	builder := txGen.NewTxBuilder()
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	builder.SetMemo("foomemo")
 
 	// Encode transaction
-	txBytes, err := clientCtx.TxGenerator.TxEncoder()(stdTx)
+	txBytes, err := clientCtx.TxGenerator.TxEncoder()(builder.GetTx())
 	require.NoError(t, err)
 
 	// Convert the transaction into base64 encoded string
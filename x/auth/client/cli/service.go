@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagMaxMsgs       = "max-msgs"
+	flagBatchInterval = "batch-interval"
+	flagMaxRetries    = "max-retries"
+	flagRetryInterval = "retry-interval"
+)
+
+// GetServiceCommand returns the tx service command.
+func GetServiceCommand(clientCtx client.Context) *cobra.Command {
+	defaultConfig := tx.DefaultServiceConfig()
+
+	cmd := &cobra.Command{
+		Use:   "service [file]",
+		Short: "Sign and broadcast the messages of transactions read line by line",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Read newline-delimited transactions created with the --generate-only flag
+from [file] and sign and broadcast their messages with the key provided with --from,
+until the end of the input. If you supply a dash (-) argument in place of an input
+filename, the command reads from standard input.
+
+The messages are queued and batched in transactions of at most --max-msgs messages,
+their memo and fees are replaced by the ones set by the flags. The sequence of the
+account is tracked across transactions, it is queried again if a transaction is
+rejected. Transactions rejected because of a wrong sequence or a full mempool are
+broadcast again up to --max-retries times.
+
+The response of each transaction is printed once per read transaction, in order.
+
+Example:
+$ <bot> | %s tx service - --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithOutput(cmd.OutOrStdout())
+
+			if clientCtx.Offline {
+				return errors.New("cannot broadcast tx during offline mode")
+			}
+			if clientCtx.GenerateOnly {
+				return fmt.Errorf("--%s is not supported by the tx service", flags.FlagGenerateOnly)
+			}
+
+			var in io.Reader = cmd.InOrStdin()
+			if args[0] != "-" {
+				fp, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer fp.Close()
+
+				in = fp
+			}
+
+			config := tx.DefaultServiceConfig()
+			config.MaxMsgs, _ = cmd.Flags().GetInt(flagMaxMsgs)
+			config.BatchInterval, _ = cmd.Flags().GetDuration(flagBatchInterval)
+			config.MaxRetries, _ = cmd.Flags().GetInt(flagMaxRetries)
+			config.RetryInterval, _ = cmd.Flags().GetDuration(flagRetryInterval)
+
+			service := tx.NewService(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()), config)
+			if err := service.Sync(); err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stopped := make(chan error, 1)
+			go func() { stopped <- service.Run(ctx) }()
+
+			// print the results in the order the transactions were read
+			results := make(chan (<-chan tx.ServiceResult), config.MaxMsgs)
+			printed := make(chan struct{})
+			go func() {
+				defer close(printed)
+				for result := range results {
+					res := <-result
+					if res.Err != nil {
+						cmd.PrintErrf("%s\n", res.Err)
+						continue
+					}
+					if err := clientCtx.PrintOutput(res.Response); err != nil {
+						cmd.PrintErrf("%s\n", err)
+					}
+				}
+			}()
+
+			scanner := bufio.NewScanner(in)
+			for scanner.Scan() {
+				if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+					continue
+				}
+
+				stdTx, err := clientCtx.TxGenerator.TxJSONDecoder()(scanner.Bytes())
+				if err != nil {
+					cmd.PrintErrf("%s\n", err)
+					continue
+				}
+
+				msgs := stdTx.GetMsgs()
+				if err := validateServiceMsgs(clientCtx, msgs); err != nil {
+					cmd.PrintErrf("%s\n", err)
+					continue
+				}
+
+				results <- service.Queue(msgs...)
+			}
+
+			close(results)
+			<-printed
+			cancel()
+			<-stopped
+
+			return scanner.Err()
+		},
+	}
+
+	cmd.Flags().Int(flagMaxMsgs, defaultConfig.MaxMsgs, "Maximum number of messages per transaction")
+	cmd.Flags().Duration(flagBatchInterval, defaultConfig.BatchInterval, "Maximum time messages wait for more messages before being broadcast")
+	cmd.Flags().Int(flagMaxRetries, defaultConfig.MaxRetries, "Maximum number of times a transaction is broadcast again")
+	cmd.Flags().Duration(flagRetryInterval, defaultConfig.RetryInterval, "Time waited before broadcasting a transaction again")
+	cmd = flags.PostCommands(cmd)[0]
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// validateServiceMsgs ensures the messages are valid and only signed by the
+// from account.
+func validateServiceMsgs(clientCtx client.Context, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return errors.New("transaction has no messages")
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(clientCtx.GetFromAddress()) {
+			return fmt.Errorf("message %s must only be signed by %s", msg.Type(), clientCtx.GetFromAddress())
+		}
+	}
+
+	return nil
+}
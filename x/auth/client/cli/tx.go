@@ -18,6 +18,7 @@ func GetTxCmd(clientCtx client.Context) *cobra.Command {
 	}
 	txCmd.AddCommand(
 		GetMultiSignCommand(clientCtx),
+		GetMultisigCommand(clientCtx),
 		GetSignCommand(clientCtx),
 		GetValidateSignaturesCommand(clientCtx),
 		GetSignBatchCommand(clientCtx.Codec),
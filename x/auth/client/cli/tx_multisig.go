@@ -0,0 +1,306 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const flagBroadcast = "broadcast"
+
+// GetMultisigCommand returns the multisig session commands.
+func GetMultisigCommand(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Collect the signatures of a multisig account in a session file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Collect the signatures of the members of a multisig account in a session file.
+
+A session holds a transaction generated with the --generate-only flag, the multisig
+public key and the signatures collected so far. The account number and sequence are
+fixed when the session is created, members can therefore sign offline.
+
+Example:
+$ %s tx multisig init transaction.json k1k2k3 --output-document session.json
+$ %s tx multisig sign session.json --from k1
+$ %s tx multisig sign session.json --from k2
+$ %s tx multisig status session.json
+$ %s tx multisig finalize session.json --broadcast
+`,
+				version.ClientName, version.ClientName, version.ClientName, version.ClientName, version.ClientName,
+			),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetMultisigInitCommand(clientCtx),
+		GetMultisigSignCommand(clientCtx),
+		GetMultisigStatusCommand(clientCtx),
+		GetMultisigFinalizeCommand(clientCtx),
+	)
+
+	return cmd
+}
+
+// GetMultisigInitCommand returns the command creating a multisig session.
+func GetMultisigInitCommand(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init [file] [name]",
+		Short: "Create a multisig session for a transaction generated offline",
+		Long: `Create a session collecting signatures on behalf of the multisig key [name]
+for the transaction read from [file]. The multisig key must be the only signer
+of the transaction.
+
+The --offline flag makes sure that the client will not reach out to an external node.
+Thus account number or sequence number lookups will not be performed and it is
+required to set such parameters manually.
+`,
+		PreRun: preSignCmd,
+		RunE:   makeMultisigInitCmd(clientCtx),
+		Args:   cobra.ExactArgs(2),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The session will be written to the given file instead of STDOUT")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func makeMultisigInitCmd(clientCtx client.Context) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, txBldr, tx, err := readTxAndInitContexts(clientCtx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		multisigInfo, err := txBldr.Keybase().Key(args[1])
+		if err != nil {
+			return err
+		}
+		if multisigInfo.GetType() != keyring.TypeMulti {
+			return fmt.Errorf("%q must be of type %s: %s", args[1], keyring.TypeMulti, multisigInfo.GetType())
+		}
+
+		multisigPub, ok := multisigInfo.GetPubKey().(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("%q is not a multisig public key: %T", args[1], multisigInfo.GetPubKey())
+		}
+
+		if !clientCtx.Offline {
+			accnum, seq, err := types.NewAccountRetriever(authclient.Codec).GetAccountNumberSequence(clientCtx, multisigInfo.GetAddress())
+			if err != nil {
+				return err
+			}
+
+			txBldr = txBldr.WithAccountNumber(accnum).WithSequence(seq)
+		}
+
+		session, err := authclient.NewMultisigSession(
+			clientCtx.TxGenerator, txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(), multisigPub, tx,
+		)
+		if err != nil {
+			return err
+		}
+
+		outputDoc := viper.GetString(flags.FlagOutputDocument)
+		if outputDoc != "" {
+			return authclient.WriteMultisigSession(clientCtx.Codec, outputDoc, session)
+		}
+
+		json, err := clientCtx.Codec.MarshalJSONIndent(session, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("%s\n", json)
+		return nil
+	}
+}
+
+// GetMultisigSignCommand returns the command adding a signature to a multisig session.
+func GetMultisigSignCommand(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [session-file]",
+		Short: "Sign the transaction of a multisig session",
+		Long: `Sign the transaction of the multisig session read from [session-file] with the
+key provided with --from and append the signature to the session. The key must be a
+member of the multisig account. The session file is updated in place unless
+--output-document is provided.
+`,
+		RunE: makeMultisigSignCmd(clientCtx),
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The session will be written to the given file instead of [session-file]")
+	cmd = flags.PostCommands(cmd)[0]
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func makeMultisigSignCmd(clientCtx client.Context) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		inBuf := bufio.NewReader(cmd.InOrStdin())
+		clientCtx = clientCtx.InitWithInput(inBuf)
+
+		session, err := authclient.ReadMultisigSession(clientCtx.Codec, args[0])
+		if err != nil {
+			return err
+		}
+
+		signBytes, err := session.SignBytes(clientCtx.TxGenerator)
+		if err != nil {
+			return err
+		}
+
+		txBldr := types.NewTxBuilderFromCLI(inBuf)
+		sig, pubKey, err := txBldr.Keybase().Sign(clientCtx.GetFromName(), signBytes)
+		if err != nil {
+			return err
+		}
+
+		stdSig := types.StdSignature{PubKey: pubKey.Bytes(), Signature: sig} //nolint:staticcheck
+		if err := session.AddSignature(clientCtx.TxGenerator, stdSig); err != nil {
+			return err
+		}
+
+		outputDoc := viper.GetString(flags.FlagOutputDocument)
+		if outputDoc == "" {
+			outputDoc = args[0]
+		}
+
+		if err := authclient.WriteMultisigSession(clientCtx.Codec, outputDoc, session); err != nil {
+			return err
+		}
+
+		status, err := session.Status(clientCtx.TxGenerator)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintErrf("Signature of %s added, %d signatures collected, complete: %t\n",
+			sdk.AccAddress(pubKey.Address()), status.Signatures, status.Complete)
+		return nil
+	}
+}
+
+// GetMultisigStatusCommand returns the command showing the members of the
+// multisig account that signed the transaction of a session.
+func GetMultisigStatusCommand(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session-file]",
+		Short: "Show which members of the multisig account signed the transaction of a session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.Init().WithOutput(cmd.OutOrStdout())
+
+			session, err := authclient.ReadMultisigSession(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			status, err := session.Status(clientCtx.TxGenerator)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(status)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// GetMultisigFinalizeCommand returns the command assembling the signatures of a
+// multisig session into a signed transaction.
+func GetMultisigFinalizeCommand(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session-file]",
+		Short: "Assemble the signatures of a multisig session into a signed transaction",
+		Long: `Assemble the signatures collected by the multisig session read from [session-file]
+into a multisig signature and print the signed transaction. The threshold of the
+multisig account must be met.
+
+If the --broadcast flag is set, the signed transaction is broadcast instead.
+`,
+		RunE: makeMultisigFinalizeCmd(clientCtx),
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().Bool(flagBroadcast, false, "Broadcast the signed transaction")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func makeMultisigFinalizeCmd(clientCtx client.Context) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx = clientCtx.Init()
+
+		session, err := authclient.ReadMultisigSession(clientCtx.Codec, args[0])
+		if err != nil {
+			return err
+		}
+
+		tx, err := session.Finalize(clientCtx.TxGenerator)
+		if err != nil {
+			return err
+		}
+
+		if viper.GetBool(flagBroadcast) {
+			if clientCtx.Offline {
+				return errors.New("cannot broadcast tx during offline mode")
+			}
+
+			txBytes, err := clientCtx.TxGenerator.TxEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		}
+
+		json, err := clientCtx.TxGenerator.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		if viper.GetString(flags.FlagOutputDocument) == "" {
+			cmd.Printf("%s\n", json)
+			return nil
+		}
+
+		fp, err := os.OpenFile(
+			viper.GetString(flags.FlagOutputDocument), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644,
+		)
+		if err != nil {
+			return err
+		}
+		defer fp.Close()
+
+		fmt.Fprintf(fp, "%s\n", json)
+
+		return nil
+	}
+}
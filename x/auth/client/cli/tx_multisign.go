@@ -58,14 +58,19 @@ recommended to set such parameters manually.
 
 func makeMultiSignCmd(clientCtx client.Context) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) (err error) {
-		clientCtx = clientCtx.Init()
-		cdc := clientCtx.Codec
 		tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
-		stdTx := tx.(types.StdTx)
 		if err != nil {
 			return
 		}
 
+		stdTx, err := toStdTx(tx)
+		if err != nil {
+			return
+		}
+
+		clientCtx = clientCtx.Init()
+		cdc := clientCtx.Codec
+
 		inBuf := bufio.NewReader(cmd.InOrStdin())
 		kb, err := keyring.New(sdk.KeyringServiceName(),
 			viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), inBuf)
@@ -81,8 +86,12 @@ func makeMultiSignCmd(clientCtx client.Context) func(cmd *cobra.Command, args []
 			return fmt.Errorf("%q must be of type %s: %s", args[1], keyring.TypeMulti, multisigInfo.GetType())
 		}
 
-		multisigPub := multisigInfo.GetPubKey().(multisig.PubKeyMultisigThreshold)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		multisigPub, ok := multisigInfo.GetPubKey().(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("%q is not a multisig public key: %T", args[1], multisigInfo.GetPubKey())
+		}
+
+		multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 		txBldr := types.NewTxBuilderFromCLI(inBuf)
 
 		if !clientCtx.Offline {
@@ -112,10 +121,10 @@ func makeMultiSignCmd(clientCtx client.Context) func(cmd *cobra.Command, args []
 
 			sigV2, err := types.StdSignatureToSignatureV2(cdc, stdSig)
 			if err != nil {
-				return nil
+				return err
 			}
 
-			if err := multisig.AddSignatureV2(multisigSig, sigV2, multisigPub.PubKeys); err != nil {
+			if err := multisig.AddSignatureV2(multisigSig, sigV2, multisigPub.GetPubKeys()); err != nil {
 				return err
 			}
 		}
@@ -201,11 +201,10 @@ func preSignCmd(cmd *cobra.Command, _ []string) {
 
 func makeSignCmd(clientCtx client.Context) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		clientCtx, txBldr, tx, err := readStdTxAndInitContexts(clientCtx, cmd, args[0])
+		clientCtx, txBldr, stdTx, err := readStdTxAndInitContexts(clientCtx, cmd, args[0])
 		if err != nil {
 			return err
 		}
-		stdTx := tx.(types.StdTx)
 
 		// if --signature-only is on, then override --append
 		var newTx types.StdTx
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestOfflineSigningCommandsRejectProtoTx(t *testing.T) {
+	txGen := simappparams.MakeEncodingConfig().TxGenerator
+	clientCtx := client.Context{}.WithTxGenerator(txGen)
+
+	testDir, cleanFunc := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanFunc)
+
+	builder := txGen.NewTxBuilder()
+	builder.SetGasLimit(200000)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	txContents, err := txGen.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	txFileName := filepath.Join(testDir, "tx.json")
+	require.NoError(t, ioutil.WriteFile(txFileName, txContents, 0644))
+
+	testCases := map[string]struct {
+		runE func() error
+	}{
+		"sign": {func() error {
+			cmd := GetSignCommand(clientCtx)
+			return cmd.RunE(cmd, []string{txFileName})
+		}},
+		"multisign": {func() error {
+			cmd := GetMultiSignCommand(clientCtx)
+			return cmd.RunE(cmd, []string{txFileName, "multi", "sig.json"})
+		}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.runE()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "only amino transactions can be signed offline")
+		})
+	}
+}
+
+func TestToStdTx(t *testing.T) {
+	stdTx := authtypes.NewStdTx(nil, authtypes.NewTestStdFee(), nil, "memo")
+
+	res, err := toStdTx(stdTx)
+	require.NoError(t, err)
+	require.Equal(t, stdTx, res)
+
+	_, err = toStdTx(simappparams.MakeEncodingConfig().TxGenerator.NewTxBuilder().GetTx())
+	require.Error(t, err)
+}
@@ -6,12 +6,15 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -37,13 +40,17 @@ transaction will be not be performed as that will require RPC communication with
 
 func makeValidateSignaturesCmd(clientCtx client.Context) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		clientCtx, txBldr, tx, err := readStdTxAndInitContexts(clientCtx, cmd, args[0])
+		clientCtx, txBldr, tx, err := readTxAndInitContexts(clientCtx, cmd, args[0])
 		if err != nil {
 			return err
 		}
-		stdTx := tx.(types.StdTx)
 
-		if !printAndValidateSigs(cmd, clientCtx, txBldr.ChainID(), stdTx, clientCtx.Offline) {
+		sigTx, ok := tx.(authsigning.SigVerifiableTx)
+		if !ok {
+			return fmt.Errorf("expected SigVerifiableTx, got %T", tx)
+		}
+
+		if !printAndValidateSigs(cmd, clientCtx, txBldr.ChainID(), sigTx, clientCtx.Offline) {
 			return fmt.Errorf("signatures validation failed")
 		}
 
@@ -55,17 +62,22 @@ func makeValidateSignaturesCmd(clientCtx client.Context) func(cmd *cobra.Command
 // expected signers. In addition, if offline has not been supplied, the signature is
 // verified over the transaction sign bytes. Returns false if the validation fails.
 func printAndValidateSigs(
-	cmd *cobra.Command, clientCtx client.Context, chainID string, stdTx types.StdTx, offline bool,
+	cmd *cobra.Command, clientCtx client.Context, chainID string, tx authsigning.SigVerifiableTx, offline bool,
 ) bool {
 	cmd.Println("Signers:")
-	signers := stdTx.GetSigners()
+	signers := tx.GetSigners()
 
 	for i, signer := range signers {
 		cmd.Printf("  %v: %v\n", i, signer.String())
 	}
 
 	success := true
-	sigs := stdTx.Signatures
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		cmd.Printf("failed to get signatures: %s\n", err)
+		return false
+	}
+
 	cmd.Println("")
 	cmd.Println("Signatures:")
 
@@ -74,10 +86,16 @@ func printAndValidateSigs(
 	}
 
 	for i, sig := range sigs {
+		if sig.PubKey == nil {
+			cmd.Printf("  %d: ERROR: signature has no public key\n", i)
+			success = false
+			continue
+		}
+
 		var (
 			multiSigHeader string
 			multiSigMsg    string
-			sigAddr        = sdk.AccAddress(sig.GetPubKey().Address())
+			sigAddr        = sdk.AccAddress(sig.PubKey.Address())
 			sigSanity      = "OK"
 		)
 
@@ -95,34 +113,29 @@ func printAndValidateSigs(
 				return false
 			}
 
-			sigBytes := types.StdSignBytes(
-				chainID, acc.GetAccountNumber(), acc.GetSequence(),
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
-			)
+			signerData := authsigning.SignerData{
+				ChainID:         chainID,
+				AccountNumber:   acc.GetAccountNumber(),
+				AccountSequence: acc.GetSequence(),
+			}
 
-			if ok := sig.GetPubKey().VerifyBytes(sigBytes, sig.Signature); !ok {
+			err = authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, clientCtx.TxGenerator.SignModeHandler(), tx)
+			if err != nil {
 				sigSanity = "ERROR: signature invalid"
 				success = false
 			}
 		}
 
-		multiPK, ok := sig.GetPubKey().(multisig.PubKeyMultisigThreshold)
-		if ok {
-			var multiSig multisig.AminoMultisignature
-			clientCtx.Codec.MustUnmarshalBinaryBare(sig.Signature, &multiSig)
+		if multiSig, ok := sig.Data.(*signing.MultiSignatureData); ok {
+			switch multiPK := sig.PubKey.(type) {
+			case multisig.PubKeyMultisigThreshold:
+				multiSigHeader = fmt.Sprintf(" [multisig threshold: %d/%d]", multiPK.K, len(multiPK.PubKeys))
+				multiSigMsg = multiSigString(multiSig, multiPK.PubKeys, nil)
 
-			var b strings.Builder
-			b.WriteString("\n  MultiSig Signatures:\n")
-
-			for i := 0; i < multiSig.BitArray.Size(); i++ {
-				if multiSig.BitArray.GetIndex(i) {
-					addr := sdk.AccAddress(multiPK.PubKeys[i].Address().Bytes())
-					b.WriteString(fmt.Sprintf("    %d: %s (weight: %d)\n", i, addr, 1))
-				}
+			case multisig.PubKeyMultisigWeighted:
+				multiSigHeader = fmt.Sprintf(" [multisig weighted threshold: %d]", multiPK.Threshold)
+				multiSigMsg = multiSigString(multiSig, multiPK.PubKeys, multiPK.Weights)
 			}
-
-			multiSigHeader = fmt.Sprintf(" [multisig threshold: %d/%d]", multiPK.K, len(multiPK.PubKeys))
-			multiSigMsg = b.String()
 		}
 
 		cmd.Printf("  %d: %s\t\t\t[%s]%s%s\n", i, sigAddr.String(), sigSanity, multiSigHeader, multiSigMsg)
@@ -133,17 +146,71 @@ func printAndValidateSigs(
 	return success
 }
 
+// multiSigString lists the signers of a multisignature. Signers have a weight
+// of 1 unless weights are provided.
+func multiSigString(multiSig *signing.MultiSignatureData, pubKeys []crypto.PubKey, weights []uint) string {
+	var b strings.Builder
+	b.WriteString("\n  MultiSig Signatures:\n")
+
+	for i := 0; i < multiSig.BitArray.Size() && i < len(pubKeys); i++ {
+		if multiSig.BitArray.GetIndex(i) {
+			weight := uint(1)
+			if i < len(weights) {
+				weight = weights[i]
+			}
+
+			addr := sdk.AccAddress(pubKeys[i].Address().Bytes())
+			b.WriteString(fmt.Sprintf("    %d: %s (weight: %d)\n", i, addr, weight))
+		}
+	}
+
+	return b.String()
+}
+
 func readStdTxAndInitContexts(clientCtx client.Context, cmd *cobra.Command, filename string) (
-	client.Context, types.TxBuilder, sdk.Tx, error,
+	client.Context, types.TxBuilder, types.StdTx, error,
 ) {
-	stdTx, err := authclient.ReadTxFromFile(clientCtx, filename)
+	tx, err := authclient.ReadTxFromFile(clientCtx, filename)
 	if err != nil {
 		return client.Context{}, types.TxBuilder{}, types.StdTx{}, err
 	}
 
+	stdTx, err := toStdTx(tx)
+	if err != nil {
+		return client.Context{}, types.TxBuilder{}, types.StdTx{}, err
+	}
+
+	clientCtx, txBldr := initContexts(clientCtx, cmd)
+	return clientCtx, txBldr, stdTx, nil
+}
+
+func readTxAndInitContexts(clientCtx client.Context, cmd *cobra.Command, filename string) (
+	client.Context, types.TxBuilder, sdk.Tx, error,
+) {
+	tx, err := authclient.ReadTxFromFile(clientCtx, filename)
+	if err != nil {
+		return client.Context{}, types.TxBuilder{}, nil, err
+	}
+
+	clientCtx, txBldr := initContexts(clientCtx, cmd)
+	return clientCtx, txBldr, tx, nil
+}
+
+func initContexts(clientCtx client.Context, cmd *cobra.Command) (client.Context, types.TxBuilder) {
 	inBuf := bufio.NewReader(cmd.InOrStdin())
 	clientCtx = clientCtx.InitWithInput(inBuf)
 	txBldr := types.NewTxBuilderFromCLI(inBuf)
 
-	return clientCtx, txBldr, stdTx, nil
+	return clientCtx, txBldr
+}
+
+// toStdTx returns tx as a StdTx, the only transaction type the offline signing
+// commands support.
+func toStdTx(tx sdk.Tx) (types.StdTx, error) {
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return types.StdTx{}, fmt.Errorf("expected %T, got %T: only amino transactions can be signed offline", types.StdTx{}, tx)
+	}
+
+	return stdTx, nil
 }
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGetValidateSignaturesCommand_ProtoTx(t *testing.T) {
+	encodingConfig := simappparams.MakeEncodingConfig()
+	sdk.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	txGen := encodingConfig.TxGenerator
+	clientCtx := client.Context{}.WithTxGenerator(txGen)
+
+	viper.Set(flags.FlagOffline, true)
+	t.Cleanup(func() { viper.Set(flags.FlagOffline, false) })
+
+	testDir, cleanFunc := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanFunc)
+
+	priv := secp256k1.GenPrivKey()
+	fromAddr := sdk.AccAddress(priv.PubKey().Address())
+	toAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	writeTx := func(name string, from sdk.AccAddress) string {
+		builder := txGen.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+		builder.SetGasLimit(200000)
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			PubKey: priv.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("sig")},
+		}))
+
+		txContents, err := txGen.TxJSONEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		txFileName := filepath.Join(testDir, name)
+		require.NoError(t, ioutil.WriteFile(txFileName, txContents, 0644))
+		return txFileName
+	}
+
+	// the signature matches its signer
+	cmd := GetValidateSignaturesCommand(clientCtx)
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	require.NoError(t, cmd.RunE(cmd, []string{writeTx("tx.json", fromAddr)}))
+	require.Contains(t, out.String(), fromAddr.String())
+	require.Contains(t, out.String(), "[OK]")
+
+	// the signature doesn't match its signer
+	cmd = GetValidateSignaturesCommand(clientCtx)
+	out.Reset()
+	cmd.SetOut(out)
+	require.Error(t, cmd.RunE(cmd, []string{writeTx("other_tx.json", toAddr)}))
+	require.Contains(t, out.String(), "ERROR: signature does not match its respective signer")
+}
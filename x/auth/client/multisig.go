@@ -0,0 +1,320 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MultisigSession collects the signatures of the members of a multisig account
+// over a transaction generated offline. The chain ID, account number and sequence
+// are fixed when the session is created so that all members sign the same bytes.
+//
+// The transaction is stored in the JSON encoding of the TxGenerator it was created
+// with, members sign it in SIGN_MODE_LEGACY_AMINO_JSON as their sign bytes must not
+// depend on the signatures collected so far.
+type MultisigSession struct {
+	ChainID       string                   `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64                   `json:"account_number" yaml:"account_number"`
+	Sequence      uint64                   `json:"sequence" yaml:"sequence"`
+	PubKey        crypto.PubKey            `json:"pub_key" yaml:"pub_key"`
+	Tx            json.RawMessage          `json:"tx" yaml:"tx"`
+	Signatures    []authtypes.StdSignature `json:"signatures" yaml:"signatures"` //nolint:staticcheck
+}
+
+// MultisigSessionStatus reports which members of a multisig account signed
+// the transaction of a session.
+type MultisigSessionStatus struct {
+	Address    sdk.AccAddress   `json:"address" yaml:"address"`
+	Signatures int              `json:"signatures" yaml:"signatures"`
+	Complete   bool             `json:"complete" yaml:"complete"`
+	Signers    []MultisigSigner `json:"signers" yaml:"signers"`
+}
+
+// MultisigSigner is a member of a multisig account and whether it signed.
+type MultisigSigner struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Signed  bool           `json:"signed" yaml:"signed"`
+}
+
+// NewMultisigSession returns a session collecting signatures on behalf of the
+// multisig account of pubKey. The multisig account must be the only signer of
+// the transaction, as the session discards the signatures already attached to
+// it and Finalize only sets the multisig signature.
+func NewMultisigSession(
+	txGen client.TxGenerator, chainID string, accnum, sequence uint64, pubKey multisig.PubKey, tx sdk.Tx,
+) (MultisigSession, error) {
+	if chainID == "" {
+		return MultisigSession{}, fmt.Errorf("chain ID required but not specified")
+	}
+
+	if err := validateMultisigSigner(pubKey, tx); err != nil {
+		return MultisigSession{}, err
+	}
+
+	txBuilder, err := newTxBuilderFromTx(txGen, tx)
+	if err != nil {
+		return MultisigSession{}, err
+	}
+
+	bz, err := txGen.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return MultisigSession{}, err
+	}
+
+	return MultisigSession{
+		ChainID:       chainID,
+		AccountNumber: accnum,
+		Sequence:      sequence,
+		PubKey:        pubKey,
+		Tx:            bz,
+		Signatures:    []authtypes.StdSignature{}, //nolint:staticcheck
+	}, nil
+}
+
+// GetTx decodes the transaction of the session.
+func (s MultisigSession) GetTx(txGen client.TxGenerator) (sdk.Tx, error) {
+	return txGen.TxJSONDecoder()(s.Tx)
+}
+
+// SignBytes returns the bytes members of the multisig account must sign.
+func (s MultisigSession) SignBytes(txGen client.TxGenerator) ([]byte, error) {
+	tx, err := s.GetTx(txGen)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.signBytes(txGen, tx, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+}
+
+func (s MultisigSession) signBytes(txGen client.TxGenerator, tx sdk.Tx, mode signing.SignMode) ([]byte, error) {
+	signerData := authsigning.SignerData{
+		ChainID:         s.ChainID,
+		AccountNumber:   s.AccountNumber,
+		AccountSequence: s.Sequence,
+	}
+
+	return txGen.SignModeHandler().GetSignBytes(mode, signerData, tx)
+}
+
+// AddSignature validates and adds the signature of a member of the multisig
+// account to the session. A member can only sign once.
+func (s *MultisigSession) AddSignature(txGen client.TxGenerator, sig authtypes.StdSignature) error { //nolint:staticcheck
+	pubKey := sig.GetPubKey()
+	if pubKey == nil {
+		return fmt.Errorf("signature has no public key")
+	}
+
+	multisigPub, err := s.multisigPubKey()
+	if err != nil {
+		return err
+	}
+
+	if !isMultisigMember(pubKey, multisigPub) {
+		return fmt.Errorf("%s is not a member of the multisig account %s",
+			sdk.AccAddress(pubKey.Address()), sdk.AccAddress(s.PubKey.Address()))
+	}
+
+	if s.HasSigned(pubKey) {
+		return fmt.Errorf("%s already signed", sdk.AccAddress(pubKey.Address()))
+	}
+
+	signBytes, err := s.SignBytes(txGen)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifyBytes(signBytes, sig.Signature) {
+		return fmt.Errorf("couldn't verify signature of %s", sdk.AccAddress(pubKey.Address()))
+	}
+
+	s.Signatures = append(s.Signatures, sig)
+
+	return nil
+}
+
+// HasSigned returns whether pubKey signed the transaction of the session.
+func (s MultisigSession) HasSigned(pubKey crypto.PubKey) bool {
+	for _, sig := range s.Signatures {
+		if pubKey.Equals(sig.GetPubKey()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Status returns the signing status of each member of the multisig account.
+// The session is complete once the collected signatures satisfy the multisig
+// public key.
+func (s MultisigSession) Status(txGen client.TxGenerator) (MultisigSessionStatus, error) {
+	multisigPub, err := s.multisigPubKey()
+	if err != nil {
+		return MultisigSessionStatus{}, err
+	}
+
+	pubKeys := multisigPub.GetPubKeys()
+	signers := make([]MultisigSigner, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		signers[i] = MultisigSigner{
+			Address: sdk.AccAddress(pubKey.Address()),
+			Signed:  s.HasSigned(pubKey),
+		}
+	}
+
+	_, err = s.Finalize(txGen)
+
+	return MultisigSessionStatus{
+		Address:    sdk.AccAddress(s.PubKey.Address()),
+		Signatures: len(s.Signatures),
+		Complete:   err == nil,
+		Signers:    signers,
+	}, nil
+}
+
+// Finalize assembles the signatures collected by the session into a multisig
+// signature and returns the signed transaction. It fails if the signatures don't
+// satisfy the multisig public key or if the multisig account isn't the only
+// signer of the transaction.
+func (s MultisigSession) Finalize(txGen client.TxGenerator) (sdk.Tx, error) {
+	multisigPub, err := s.multisigPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.GetTx(txGen)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateMultisigSigner(multisigPub, tx); err != nil {
+		return nil, err
+	}
+
+	multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
+	for _, sig := range s.Signatures {
+		sigV2, err := authtypes.StdSignatureToSignatureV2(legacy.Cdc, sig)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := multisig.AddSignatureV2(multisigSig, sigV2, multisigPub.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	getSignBytes := func(mode signing.SignMode) ([]byte, error) {
+		return s.signBytes(txGen, tx, mode)
+	}
+	if err := multisigPub.VerifyMultisignature(getSignBytes, multisigSig); err != nil {
+		return nil, fmt.Errorf("not enough signatures: %w", err)
+	}
+
+	txBuilder, err := newTxBuilderFromTx(txGen, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := signing.SignatureV2{PubKey: s.PubKey, Data: multisigSig}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx(), nil
+}
+
+// multisigPubKey returns the public key of the session as a multisig public key.
+func (s MultisigSession) multisigPubKey() (multisig.PubKey, error) {
+	multisigPub, ok := s.PubKey.(multisig.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a multisig public key, got %T", s.PubKey)
+	}
+
+	return multisigPub, nil
+}
+
+// validateMultisigSigner checks that the multisig account of pubKey is the only
+// signer of tx.
+func validateMultisigSigner(pubKey multisig.PubKey, tx sdk.Tx) error {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return fmt.Errorf("expected SigVerifiableTx, got %T", tx)
+	}
+
+	addr := sdk.AccAddress(pubKey.Address())
+	signers := sigTx.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(addr) {
+		return fmt.Errorf("%s: the multisig account %s must be the only signer of the transaction, got %v",
+			sdkerrors.ErrorInvalidSigner, addr, signers)
+	}
+
+	return nil
+}
+
+// ReadMultisigSession reads and decodes a multisig session from the given filename.
+func ReadMultisigSession(cdc *codec.Codec, filename string) (session MultisigSession, err error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+
+	err = cdc.UnmarshalJSON(bz, &session)
+	return
+}
+
+// WriteMultisigSession encodes and writes a multisig session to the given filename.
+func WriteMultisigSession(cdc *codec.Codec, filename string, session MultisigSession) error {
+	bz, err := cdc.MarshalJSONIndent(session, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, append(bz, '\n'), 0644)
+}
+
+// newTxBuilderFromTx returns a TxBuilder holding the messages, fee and memo of tx
+// without its signatures.
+func newTxBuilderFromTx(txGen client.TxGenerator, tx sdk.Tx) (client.TxBuilder, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, fmt.Errorf("expected FeeTx, got %T", tx)
+	}
+
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return nil, fmt.Errorf("expected TxWithMemo, got %T", tx)
+	}
+
+	txBuilder := txGen.NewTxBuilder()
+	if err := txBuilder.SetMsgs(tx.GetMsgs()...); err != nil {
+		return nil, err
+	}
+
+	txBuilder.SetFeeAmount(feeTx.GetFee())
+	txBuilder.SetGasLimit(feeTx.GetGas())
+	txBuilder.SetMemo(memoTx.GetMemo())
+
+	return txBuilder, nil
+}
+
+func isMultisigMember(pubKey crypto.PubKey, multisigPub multisig.PubKey) bool {
+	for _, member := range multisigPub.GetPubKeys() {
+		if pubKey.Equals(member) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMultisigSession(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	cryptocodec.RegisterCrypto(cdc)
+	banktypes.RegisterCodec(cdc)
+
+	encodingConfig := simappparams.MakeEncodingConfig()
+	sdk.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	testCases := map[string]client.TxGenerator{
+		"amino":    authtypes.StdTxGenerator{Cdc: cdc},
+		"protobuf": encodingConfig.TxGenerator,
+	}
+
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	for name, txGen := range testCases {
+		txGen, filename := txGen, filepath.Join(dir, name+".json")
+		t.Run(name, func(t *testing.T) {
+			testMultisigSession(t, cdc, txGen, filename)
+		})
+	}
+}
+
+func testMultisigSession(t *testing.T, cdc *codec.Codec, txGen client.TxGenerator, filename string) {
+	privKeys := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := make([]crypto.PubKey, len(privKeys))
+	for i, privKey := range privKeys {
+		pubKeys[i] = privKey.PubKey()
+	}
+	multisigPub := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	newTx := func(msg sdk.Msg) sdk.Tx {
+		txBuilder := txGen.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 150)))
+		txBuilder.SetGasLimit(100000)
+		txBuilder.SetMemo("memo")
+		return txBuilder.GetTx()
+	}
+
+	msg := banktypes.NewMsgSend(multisigAddr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	tx := newTx(msg)
+
+	// the multisig account must be a signer of the transaction
+	_, err := NewMultisigSession(txGen, "test-chain", 1, 2, multisigPub, newTx(banktypes.NewMsgSend(addr, multisigAddr, msg.Amount)))
+	require.Error(t, err)
+	_, err = NewMultisigSession(txGen, "", 1, 2, multisigPub, tx)
+	require.Error(t, err)
+
+	// the multisig account must be the only signer of the transaction
+	txBuilder := txGen.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg, banktypes.NewMsgSend(addr, multisigAddr, msg.Amount)))
+	_, err = NewMultisigSession(txGen, "test-chain", 1, 2, multisigPub, txBuilder.GetTx())
+	require.Error(t, err)
+
+	session, err := NewMultisigSession(txGen, "test-chain", 1, 2, multisigPub, tx)
+	require.NoError(t, err)
+
+	signBytes, err := session.SignBytes(txGen)
+	require.NoError(t, err)
+
+	sign := func(privKey crypto.PrivKey, signBytes []byte) authtypes.StdSignature { //nolint:staticcheck
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		return authtypes.StdSignature{PubKey: privKey.PubKey().Bytes(), Signature: sig} //nolint:staticcheck
+	}
+
+	// signatures of non members and over other bytes are rejected
+	require.Error(t, session.AddSignature(txGen, sign(secp256k1.GenPrivKey(), signBytes)))
+	require.Error(t, session.AddSignature(txGen, sign(privKeys[0], []byte("other bytes"))))
+
+	require.NoError(t, session.AddSignature(txGen, sign(privKeys[2], signBytes)))
+	require.Error(t, session.AddSignature(txGen, sign(privKeys[2], signBytes)))
+
+	// the threshold isn't met yet
+	status, err := session.Status(txGen)
+	require.NoError(t, err)
+	require.Equal(t, multisigAddr, status.Address)
+	require.Equal(t, 1, status.Signatures)
+	require.False(t, status.Complete)
+	require.Len(t, status.Signers, 3)
+	require.False(t, status.Signers[0].Signed)
+	require.True(t, status.Signers[2].Signed)
+
+	_, err = session.Finalize(txGen)
+	require.Error(t, err)
+
+	// the session survives a round trip through a file
+	require.NoError(t, WriteMultisigSession(cdc, filename, session))
+	session, err = ReadMultisigSession(cdc, filename)
+	require.NoError(t, err)
+	require.Equal(t, multisigPub, session.PubKey)
+	bz, err := session.SignBytes(txGen)
+	require.NoError(t, err)
+	require.Equal(t, signBytes, bz)
+
+	require.NoError(t, session.AddSignature(txGen, sign(privKeys[0], signBytes)))
+	status, err = session.Status(txGen)
+	require.NoError(t, err)
+	require.True(t, status.Complete)
+
+	signedTx, err := session.Finalize(txGen)
+	require.NoError(t, err)
+	require.Equal(t, tx.GetMsgs(), signedTx.GetMsgs())
+
+	sigTx, ok := signedTx.(authsigning.SigVerifiableTx)
+	require.True(t, ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, multisigPub, sigs[0].PubKey)
+
+	getSignBytes := func(mode signing.SignMode) ([]byte, error) {
+		signerData := authsigning.SignerData{ChainID: "test-chain", AccountNumber: 1, AccountSequence: 2}
+		return txGen.SignModeHandler().GetSignBytes(mode, signerData, signedTx)
+	}
+	multisigSig, ok := sigs[0].Data.(*signing.MultiSignatureData)
+	require.True(t, ok)
+	require.NoError(t, multisigPub.VerifyMultisignature(getSignBytes, multisigSig))
+
+	// a session whose transaction gained another signer can't be finalized
+	session.Tx, err = txGen.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	_, err = session.Finalize(txGen)
+	require.Contains(t, err.Error(), "must be the only signer")
+}
+
+func TestMultisigSessionWeighted(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	cryptocodec.RegisterCrypto(cdc)
+	banktypes.RegisterCodec(cdc)
+	txGen := authtypes.StdTxGenerator{Cdc: cdc}
+
+	privKeys := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := make([]crypto.PubKey, len(privKeys))
+	for i, privKey := range privKeys {
+		pubKeys[i] = privKey.PubKey()
+	}
+	multisigPub := multisig.NewPubKeyMultisigWeighted(3, pubKeys, []uint{2, 1, 1})
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	msg := banktypes.NewMsgSend(multisigAddr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	tx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewTestStdFee(), nil, "memo")
+
+	session, err := NewMultisigSession(txGen, "test-chain", 1, 2, multisigPub, tx)
+	require.NoError(t, err)
+	signBytes, err := session.SignBytes(txGen)
+	require.NoError(t, err)
+
+	addSignature := func(privKey crypto.PrivKey) {
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		stdSig := authtypes.StdSignature{PubKey: privKey.PubKey().Bytes(), Signature: sig} //nolint:staticcheck
+		require.NoError(t, session.AddSignature(txGen, stdSig))
+	}
+
+	// two members of weight 1 don't meet the threshold
+	addSignature(privKeys[1])
+	addSignature(privKeys[2])
+	status, err := session.Status(txGen)
+	require.NoError(t, err)
+	require.False(t, status.Complete)
+
+	addSignature(privKeys[0])
+	status, err = session.Status(txGen)
+	require.NoError(t, err)
+	require.True(t, status.Complete)
+
+	signedTx, err := session.Finalize(txGen)
+	require.NoError(t, err)
+	stdTx, ok := signedTx.(authtypes.StdTx)
+	require.True(t, ok)
+	require.True(t, multisigPub.VerifyBytes(signBytes, stdTx.Signatures[0].Signature))
+
+	// sessions require a multisig public key
+	session.PubKey = pubKeys[0]
+	_, err = session.Status(txGen)
+	require.Error(t, err)
+}
@@ -45,6 +45,30 @@ func TxMultisign(f *cli.Fixtures, fileName, name string, signaturesFiles []strin
 	return cli.ExecuteWriteRetStdStreams(f.T, cli.AddFlags(cmd, flags))
 }
 
+// TxMultisigInit is simcli tx multisig init
+func TxMultisigInit(f *cli.Fixtures, fileName, name string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx multisig init --keyring-backend=test %v %s %s", f.SimcliBinary, f.Flags(), fileName, name)
+	return cli.ExecuteWriteRetStdStreams(f.T, cli.AddFlags(cmd, flags))
+}
+
+// TxMultisigSign is simcli tx multisig sign
+func TxMultisigSign(f *cli.Fixtures, signer, sessionFile string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx multisig sign --keyring-backend=test --from=%s %v %s", f.SimcliBinary, signer, f.Flags(), sessionFile)
+	return cli.ExecuteWriteRetStdStreams(f.T, cli.AddFlags(cmd, flags), clientkeys.DefaultKeyPass)
+}
+
+// TxMultisigStatus is simcli tx multisig status
+func TxMultisigStatus(f *cli.Fixtures, sessionFile string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx multisig status %v %s", f.SimcliBinary, f.Flags(), sessionFile)
+	return cli.ExecuteWriteRetStdStreams(f.T, cli.AddFlags(cmd, flags))
+}
+
+// TxMultisigFinalize is simcli tx multisig finalize
+func TxMultisigFinalize(f *cli.Fixtures, sessionFile string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx multisig finalize %v %s", f.SimcliBinary, f.Flags(), sessionFile)
+	return cli.ExecuteWriteRetStdStreams(f.T, cli.AddFlags(cmd, flags))
+}
+
 func TxSignBatch(f *cli.Fixtures, signer, fileName string, flags ...string) (bool, string, string) {
 	cmd := fmt.Sprintf("%s tx sign-batch %v --keyring-backend=test --from=%s %v", f.SimcliBinary, f.Flags(), signer, fileName)
 
@@ -11,9 +11,12 @@ import (
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -77,6 +80,11 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, clientCtx client.Cont
 		return nil
 	}
 
+	txBldr, err = PrepareGasPrices(txBldr, clientCtx)
+	if err != nil {
+		return err
+	}
+
 	if !clientCtx.SkipConfirm {
 		stdSignMsg, err := txBldr.BuildSignMsg(msgs)
 		if err != nil {
@@ -344,6 +352,35 @@ func PrepareTxBuilder(txBldr authtypes.TxBuilder, clientCtx client.Context) (aut
 	return txBldr, nil
 }
 
+// PrepareGasPrices estimates the gas prices of a TxBuilder from the transactions
+// of recent blocks when they are set to auto, targeting the inclusion speed of
+// the --fee-speed flag.
+func PrepareGasPrices(txBldr authtypes.TxBuilder, clientCtx client.Context) (authtypes.TxBuilder, error) {
+	if !txBldr.GasPricesAuto() {
+		return txBldr, nil
+	}
+
+	if clientCtx.Offline {
+		return txBldr, errors.New("cannot estimate gas prices in offline mode")
+	}
+
+	if !txBldr.Fees().IsZero() {
+		return txBldr, errors.New("cannot provide both fees and gas prices")
+	}
+
+	estimate, err := clienttx.EstimateFees(clientCtx, clienttx.DefaultFeeEstimationBlocks)
+	if err != nil {
+		return txBldr, err
+	}
+
+	gasPrice, err := estimate.GasPrice(clienttx.FeeSpeed(viper.GetString(flags.FlagFeeSpeed)))
+	if err != nil {
+		return txBldr, err
+	}
+
+	return txBldr.WithGasPrices(sdk.NewDecCoins(gasPrice).String()), nil
+}
+
 func buildUnsignedStdTxOffline(txBldr authtypes.TxBuilder, clientCtx client.Context, msgs []sdk.Msg) (stdTx authtypes.StdTx, err error) {
 	if txBldr.SimulateAndExecute() {
 		if clientCtx.Offline {
@@ -358,6 +395,11 @@ func buildUnsignedStdTxOffline(txBldr authtypes.TxBuilder, clientCtx client.Cont
 		_, _ = fmt.Fprintf(os.Stderr, "estimated gas = %v\n", txBldr.Gas())
 	}
 
+	txBldr, err = PrepareGasPrices(txBldr, clientCtx)
+	if err != nil {
+		return stdTx, err
+	}
+
 	stdSignMsg, err := txBldr.BuildSignMsg(msgs)
 	if err != nil {
 		return stdTx, err
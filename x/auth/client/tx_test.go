@@ -131,11 +131,13 @@ func TestReadStdTxFromFile(t *testing.T) {
 	clientCtx = clientCtx.WithTxGenerator(txGen)
 
 	// Build a test transaction
-	fee := authtypes.NewStdFee(50000, sdk.Coins{sdk.NewInt64Coin("atom", 150)})
-	stdTx := authtypes.NewStdTx([]sdk.Msg{}, fee, []authtypes.StdSignature{}, "foomemo")
+	builder := txGen.NewTxBuilder()
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	builder.SetMemo("foomemo")
 
 	// Write it to the file
-	encodedTx, err := txGen.TxJSONEncoder()(stdTx)
+	encodedTx, err := txGen.TxJSONEncoder()(builder.GetTx())
 	require.NoError(t, err)
 	jsonTxFile := writeToNewTempFile(t, string(encodedTx))
 	defer os.Remove(jsonTxFile.Name())
@@ -143,7 +145,7 @@ func TestReadStdTxFromFile(t *testing.T) {
 	// Read it back
 	decodedTx, err := ReadTxFromFile(clientCtx, jsonTxFile.Name())
 	require.NoError(t, err)
-	require.Equal(t, decodedTx.(authtypes.StdTx).Memo, "foomemo")
+	require.Equal(t, decodedTx.(sdk.TxWithMemo).GetMemo(), "foomemo")
 }
 
 func TestBatchScanner_Scan(t *testing.T) {
@@ -247,6 +249,24 @@ func TestPrepareTxBuilder(t *testing.T) {
 	require.Equal(t, accSeq, bldr.Sequence())
 }
 
+func TestPrepareGasPrices(t *testing.T) {
+	bldr := authtypes.NewTxBuilder(
+		authtypes.DefaultTxEncoder(makeCodec()), 1, 1, 200000, 1.1, false, "test-chain", "", nil, nil,
+	)
+
+	// gas prices that aren't set to auto are left untouched
+	prepared, err := PrepareGasPrices(bldr.WithGasPrices("0.025stake"), client.Context{})
+	require.NoError(t, err)
+	require.False(t, prepared.GasPricesAuto())
+	require.Equal(t, bldr.WithGasPrices("0.025stake").GasPrices(), prepared.GasPrices())
+
+	// auto gas prices can't be estimated offline or along with fees
+	_, err = PrepareGasPrices(bldr.WithGasPrices("auto"), client.Context{Offline: true})
+	require.EqualError(t, err, "cannot estimate gas prices in offline mode")
+	_, err = PrepareGasPrices(bldr.WithFees("1stake").WithGasPrices("auto"), client.Context{})
+	require.EqualError(t, err, "cannot provide both fees and gas prices")
+}
+
 func writeToNewTempFile(t *testing.T, data string) *os.File {
 	fp, err := ioutil.TempFile(os.TempDir(), "client_tx_test")
 	require.NoError(t, err)
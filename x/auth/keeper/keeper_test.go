@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
@@ -95,6 +96,22 @@ func TestGetSetParams(t *testing.T) {
 	require.Equal(t, params, actualParams)
 }
 
+func TestMigrateParams(t *testing.T) {
+	app, ctx := createTestApp(true)
+	params := types.DefaultParams()
+	params.MaxMemoCharacters = 512
+	app.AccountKeeper.SetParams(ctx, params)
+
+	// remove the secp256r1 verification cost, as on a chain upgrading from a
+	// version without it
+	store := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	store.Delete(append([]byte(types.ModuleName+"/"), types.KeySigVerifyCostSecp256r1...))
+	require.Panics(t, func() { app.AccountKeeper.GetParams(ctx) })
+
+	app.AccountKeeper.MigrateParams(ctx)
+	require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
+}
+
 func TestSupply_ValidatePermissions(t *testing.T) {
 	app, _ := createTestApp(true)
 
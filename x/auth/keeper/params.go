@@ -15,3 +15,13 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// MigrateParams sets the SigVerifyCostSecp256r1 parameter to its default value
+// if it is missing from the param space, as on chains upgrading from a version
+// without secp256r1 keys. GetParams panics until it is set, so it must be
+// called by the upgrade handler of such chains.
+func (ak AccountKeeper) MigrateParams(ctx sdk.Context) {
+	if !ak.paramSubspace.Has(ctx, types.KeySigVerifyCostSecp256r1) {
+		ak.paramSubspace.Set(ctx, types.KeySigVerifyCostSecp256r1, types.DefaultSigVerifyCostSecp256r1)
+	}
+}
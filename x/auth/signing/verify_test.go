@@ -66,6 +66,7 @@ func TestVerifySignature(t *testing.T) {
 	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pkSet)
 	multisignature := multisig.NewMultisig(2)
 	msgs = []sdk.Msg{types.NewTestMsg(addr, addr1)}
+	stdTx = types.NewStdTx(msgs, fee, nil, memo)
 	multiSignBytes := types.StdSignBytes(signerData.ChainID, signerData.AccountNumber, signerData.AccountSequence,
 		fee, msgs, memo)
 
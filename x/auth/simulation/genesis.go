@@ -21,6 +21,7 @@ const (
 	TxSizeCostPerByte      = "tx_size_cost_per_byte"
 	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
 	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
+	SigVerifyCostSECP256R1 = "sig_verify_cost_secp256r1"
 )
 
 // GenMaxMemoChars randomized MaxMemoChars
@@ -51,6 +52,11 @@ func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 500, 1000))
 }
 
+// GenSigVerifyCostSECP256R1 randomized SigVerifyCostSECP256R1
+func GenSigVerifyCostSECP256R1(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 500, 1000))
+}
+
 // RandomizedGenState generates a random GenesisState for auth
 func RandomizedGenState(simState *module.SimulationState) {
 	var maxMemoChars uint64
@@ -83,8 +89,14 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { sigVerifyCostSECP256K1 = GenSigVerifyCostSECP256K1(r) },
 	)
 
+	var sigVerifyCostSECP256R1 uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SigVerifyCostSECP256R1, &sigVerifyCostSECP256R1, simState.Rand,
+		func(r *rand.Rand) { sigVerifyCostSECP256R1 = GenSigVerifyCostSECP256R1(r) },
+	)
+
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
-		sigVerifyCostED25519, sigVerifyCostSECP256K1)
+		sigVerifyCostED25519, sigVerifyCostSECP256K1, sigVerifyCostSECP256R1)
 	genesisAccs := RandomGenesisAccounts(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
@@ -0,0 +1,377 @@
+package tx
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// wrapper wraps a protobuf tx.Tx and implements the sdk.Tx interfaces used by
+// the ante handler. It retains the serialized TxBody and AuthInfo so that the
+// bytes signed over in SIGN_MODE_DIRECT are exactly the bytes that are
+// broadcast and decoded.
+type wrapper struct {
+	tx *tx.Tx
+
+	// bodyBz is the protobuf encoding of tx.Body. It is set when decoding a
+	// transaction and lazily computed otherwise.
+	bodyBz []byte
+
+	// authInfoBz is the protobuf encoding of tx.AuthInfo. It is set when
+	// decoding a transaction and lazily computed otherwise.
+	authInfoBz []byte
+
+	// pubKeys are the decoded public keys of tx.AuthInfo.SignerInfos
+	pubKeys []crypto.PubKey
+}
+
+// ProtoTxProvider is implemented by transactions wrapping a protobuf tx.Tx.
+type ProtoTxProvider interface {
+	GetProtoTx() *tx.Tx
+}
+
+var (
+	_ authsigning.SigFeeMemoTx = &wrapper{}
+	_ ProtoTxProvider          = &wrapper{}
+	_ client.TxBuilder         = &builder{}
+)
+
+// GetProtoTx implements ProtoTxProvider.GetProtoTx
+func (w *wrapper) GetProtoTx() *tx.Tx {
+	return w.tx
+}
+
+// GetMsgs implements sdk.Tx.GetMsgs
+func (w *wrapper) GetMsgs() []sdk.Msg {
+	anys := w.tx.Body.Messages
+	res := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			// messages which were not unpacked make the tx invalid, returning
+			// no messages lets the tx be rejected instead of panicking
+			return nil
+		}
+		res[i] = msg
+	}
+	return res
+}
+
+// ValidateBasic implements sdk.Tx.ValidateBasic
+func (w *wrapper) ValidateBasic() error {
+	sigs := w.tx.Signatures
+
+	if w.GetGas() > authtypes.MaxGasWanted {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid gas supplied; %d > %d", w.GetGas(), authtypes.MaxGasWanted,
+		)
+	}
+	if w.GetFee().IsAnyNegative() {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee,
+			"invalid fee provided: %s", w.GetFee(),
+		)
+	}
+	if len(sigs) == 0 {
+		return sdkerrors.ErrNoSignatures
+	}
+	if len(sigs) != len(w.GetSigners()) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"wrong number of signers; expected %d, got %d", len(w.GetSigners()), len(sigs),
+		)
+	}
+	if len(sigs) != len(w.tx.AuthInfo.SignerInfos) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"wrong number of signer infos; expected %d, got %d", len(sigs), len(w.tx.AuthInfo.SignerInfos),
+		)
+	}
+
+	return nil
+}
+
+// GetSigners returns the addresses that must sign the transaction in the
+// order they first appear in the messages. Duplicate addresses are omitted.
+func (w *wrapper) GetSigners() []sdk.AccAddress {
+	var signers []sdk.AccAddress
+	seen := map[string]bool{}
+
+	for _, msg := range w.GetMsgs() {
+		for _, addr := range msg.GetSigners() {
+			if !seen[addr.String()] {
+				signers = append(signers, addr)
+				seen[addr.String()] = true
+			}
+		}
+	}
+
+	return signers
+}
+
+// GetPubKeys implements SigVerifiableTx.GetPubKeys. A nil entry is returned
+// for each signer that did not include a public key.
+func (w *wrapper) GetPubKeys() []crypto.PubKey {
+	pks := make([]crypto.PubKey, len(w.tx.AuthInfo.SignerInfos))
+	copy(pks, w.pubKeys)
+	return pks
+}
+
+// GetSignatures implements SigVerifiableTx.GetSignatures
+func (w *wrapper) GetSignatures() [][]byte {
+	return w.tx.Signatures
+}
+
+// GetSignaturesV2 implements SigVerifiableTx.GetSignaturesV2
+func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	signerInfos := w.tx.AuthInfo.SignerInfos
+	if len(signerInfos) != len(w.tx.Signatures) {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"wrong number of signatures; expected %d, got %d", len(signerInfos), len(w.tx.Signatures),
+		)
+	}
+
+	pubKeys := w.GetPubKeys()
+	res := make([]signing.SignatureV2, len(signerInfos))
+
+	for i, si := range signerInfos {
+		// a missing mode info denotes an empty signature used in simulation
+		if si.ModeInfo == nil {
+			res[i] = signing.SignatureV2{PubKey: pubKeys[i]}
+			continue
+		}
+
+		data, err := ModeInfoAndSigToSignatureData(si.ModeInfo, w.tx.Signatures[i])
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to convert signature %d to V2", i)
+		}
+
+		res[i] = signing.SignatureV2{
+			PubKey: pubKeys[i],
+			Data:   data,
+		}
+	}
+
+	return res, nil
+}
+
+// GetMemo implements sdk.TxWithMemo.GetMemo
+func (w *wrapper) GetMemo() string {
+	return w.tx.Body.Memo
+}
+
+// GetGas implements sdk.FeeTx.GetGas
+func (w *wrapper) GetGas() uint64 {
+	return w.tx.AuthInfo.GetFee().GetGasLimit()
+}
+
+// GetFee implements sdk.FeeTx.GetFee
+func (w *wrapper) GetFee() sdk.Coins {
+	return w.tx.AuthInfo.GetFee().GetAmount()
+}
+
+// FeePayer implements sdk.FeeTx.FeePayer. The first signer pays the fee and
+// an empty address is returned when the transaction has no signers.
+func (w *wrapper) FeePayer() sdk.AccAddress {
+	signers := w.GetSigners()
+	if signers != nil {
+		return signers[0]
+	}
+	return sdk.AccAddress{}
+}
+
+// getBodyBytes returns the protobuf encoding of the TxBody
+func (w *wrapper) getBodyBytes() []byte {
+	if w.bodyBz == nil {
+		// a TxBody contains only generated types and never fails to marshal
+		bz, err := proto.Marshal(w.tx.Body)
+		if err != nil {
+			panic(err)
+		}
+		w.bodyBz = bz
+	}
+	return w.bodyBz
+}
+
+// getAuthInfoBytes returns the protobuf encoding of the AuthInfo
+func (w *wrapper) getAuthInfoBytes() []byte {
+	if w.authInfoBz == nil {
+		// an AuthInfo contains only generated types and never fails to marshal
+		bz, err := proto.Marshal(w.tx.AuthInfo)
+		if err != nil {
+			panic(err)
+		}
+		w.authInfoBz = bz
+	}
+	return w.authInfoBz
+}
+
+// builder implements client.TxBuilder for protobuf transactions. Setters
+// replace the TxBody and AuthInfo instead of mutating them so that the
+// transactions previously returned by GetTx are left untouched.
+type builder struct {
+	tx          *tx.Tx
+	pubKeys     []crypto.PubKey
+	pubkeyCodec cryptotypes.PublicKeyCodec
+}
+
+func newBuilder(pubkeyCodec cryptotypes.PublicKeyCodec) *builder {
+	return &builder{
+		tx: &tx.Tx{
+			Body: &tx.TxBody{},
+			AuthInfo: &tx.AuthInfo{
+				Fee: &tx.Fee{},
+			},
+		},
+		pubkeyCodec: pubkeyCodec,
+	}
+}
+
+// GetTx implements TxBuilder.GetTx
+func (b *builder) GetTx() sdk.Tx {
+	theTx := *b.tx
+	return &wrapper{
+		tx:      &theTx,
+		pubKeys: b.pubKeys,
+	}
+}
+
+// SetMsgs implements TxBuilder.SetMsgs
+func (b *builder) SetMsgs(msgs ...sdk.Msg) error {
+	anys := make([]*codectypes.Any, len(msgs))
+
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+
+		anys[i] = any
+	}
+
+	body := *b.tx.Body
+	body.Messages = anys
+	b.tx.Body = &body
+
+	return nil
+}
+
+// SetMemo implements TxBuilder.SetMemo
+func (b *builder) SetMemo(memo string) {
+	body := *b.tx.Body
+	body.Memo = memo
+	b.tx.Body = &body
+}
+
+// SetFeeAmount implements TxBuilder.SetFeeAmount
+func (b *builder) SetFeeAmount(amount sdk.Coins) {
+	fee := *b.tx.AuthInfo.Fee
+	fee.Amount = amount
+	b.setFee(&fee)
+}
+
+// SetGasLimit implements TxBuilder.SetGasLimit
+func (b *builder) SetGasLimit(limit uint64) {
+	fee := *b.tx.AuthInfo.Fee
+	fee.GasLimit = limit
+	b.setFee(&fee)
+}
+
+func (b *builder) setFee(fee *tx.Fee) {
+	authInfo := *b.tx.AuthInfo
+	authInfo.Fee = fee
+	b.tx.AuthInfo = &authInfo
+}
+
+// SetSignatures implements TxBuilder.SetSignatures. A signature without data
+// results in an empty signature and no mode info, which is how transactions
+// are simulated.
+func (b *builder) SetSignatures(signatures ...signing.SignatureV2) error {
+	n := len(signatures)
+	signerInfos := make([]*tx.SignerInfo, n)
+	rawSigs := make([][]byte, n)
+	pubKeys := make([]crypto.PubKey, n)
+
+	for i, sig := range signatures {
+		pubKey, err := encodePubKey(b.pubkeyCodec, sig.PubKey)
+		if err != nil {
+			return err
+		}
+
+		var modeInfo *tx.ModeInfo
+		if sig.Data != nil {
+			modeInfo, rawSigs[i], err = SignatureDataToModeInfoAndSig(sig.Data)
+			if err != nil {
+				return err
+			}
+		}
+
+		signerInfos[i] = &tx.SignerInfo{
+			PublicKey: pubKey,
+			ModeInfo:  modeInfo,
+		}
+		pubKeys[i] = sig.PubKey
+	}
+
+	authInfo := *b.tx.AuthInfo
+	authInfo.SignerInfos = signerInfos
+	b.tx.AuthInfo = &authInfo
+	b.tx.Signatures = rawSigs
+	b.pubKeys = pubKeys
+
+	return nil
+}
+
+// encodePubKey packs a crypto.PubKey as an Any holding a protobuf PublicKey.
+// The Any is built without a cached value so that it is identical to the one
+// produced when decoding a transaction.
+func encodePubKey(pubkeyCodec cryptotypes.PublicKeyCodec, pubKey crypto.PubKey) (*codectypes.Any, error) {
+	if pubKey == nil {
+		return nil, nil
+	}
+
+	pk, err := pubkeyCodec.Encode(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := proto.Marshal(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &codectypes.Any{
+		TypeUrl: "/" + proto.MessageName(pk),
+		Value:   bz,
+	}, nil
+}
+
+// decodePubKey decodes a crypto.PubKey from an Any holding a protobuf
+// PublicKey. A nil Any yields a nil public key.
+func decodePubKey(pubkeyCodec cryptotypes.PublicKeyCodec, any *codectypes.Any) (crypto.PubKey, error) {
+	if any == nil {
+		return nil, nil
+	}
+
+	var pk cryptotypes.PublicKey
+	if typeURL := "/" + proto.MessageName(&pk); any.TypeUrl != typeURL {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expected %s, got %s", typeURL, any.TypeUrl)
+	}
+
+	if err := proto.Unmarshal(any.Value, &pk); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	return pubkeyCodec.Decode(&pk)
+}
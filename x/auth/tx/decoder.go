@@ -0,0 +1,100 @@
+package tx
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultTxDecoder returns a default protobuf TxDecoder using the provided AnyUnpacker
+// and PublicKeyCodec. The body and auth info bytes are kept as they were
+// received so that signatures are verified against the exact bytes signed.
+func DefaultTxDecoder(anyUnpacker codectypes.AnyUnpacker, keyCodec cryptotypes.PublicKeyCodec) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		var raw txtypes.TxRaw
+		if err := proto.Unmarshal(txBytes, &raw); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		var body txtypes.TxBody
+		if err := proto.Unmarshal(raw.BodyBytes, &body); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		var authInfo txtypes.AuthInfo
+		if err := proto.Unmarshal(raw.AuthInfoBytes, &authInfo); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		theTx := &txtypes.Tx{
+			Body:       &body,
+			AuthInfo:   &authInfo,
+			Signatures: raw.Signatures,
+		}
+
+		w, err := newWrapper(theTx, anyUnpacker, keyCodec)
+		if err != nil {
+			return nil, err
+		}
+
+		w.bodyBz = raw.BodyBytes
+		w.authInfoBz = raw.AuthInfoBytes
+
+		return w, nil
+	}
+}
+
+// DefaultJSONTxDecoder returns a default protobuf JSON TxDecoder using the provided
+// AnyUnpacker and PublicKeyCodec
+func DefaultJSONTxDecoder(anyUnpacker codectypes.AnyUnpacker, keyCodec cryptotypes.PublicKeyCodec) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		var theTx txtypes.Tx
+		if err := jsonpb.Unmarshal(strings.NewReader(string(txBytes)), &theTx); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		if theTx.Body == nil {
+			theTx.Body = &txtypes.TxBody{}
+		}
+		if theTx.AuthInfo == nil {
+			theTx.AuthInfo = &txtypes.AuthInfo{}
+		}
+
+		return newWrapper(&theTx, anyUnpacker, keyCodec)
+	}
+}
+
+// newWrapper unpacks the messages and decodes the public keys of a decoded
+// transaction and wraps it.
+func newWrapper(theTx *txtypes.Tx, anyUnpacker codectypes.AnyUnpacker, keyCodec cryptotypes.PublicKeyCodec) (*wrapper, error) {
+	if err := theTx.UnpackInterfaces(anyUnpacker); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	var pubKeys []crypto.PubKey
+	if n := len(theTx.AuthInfo.SignerInfos); n > 0 {
+		pubKeys = make([]crypto.PubKey, n)
+
+		for i, si := range theTx.AuthInfo.SignerInfos {
+			pk, err := decodePubKey(keyCodec, si.PublicKey)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+			}
+
+			pubKeys[i] = pk
+		}
+	}
+
+	return &wrapper{
+		tx:      theTx,
+		pubKeys: pubKeys,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestDecoderRejectsEmptyMessages(t *testing.T) {
+	registry := makeTestInterfaceRegistry()
+	decoder := tx.DefaultTxDecoder(registry, std.DefaultPublicKeyCodec{})
+	jsonDecoder := tx.DefaultJSONTxDecoder(registry, std.DefaultPublicKeyCodec{})
+
+	body := &txtypes.TxBody{Messages: []*codectypes.Any{{}}}
+	bodyBz, err := body.Marshal()
+	require.NoError(t, err)
+	authInfoBz, err := (&txtypes.AuthInfo{}).Marshal()
+	require.NoError(t, err)
+	txBz, err := (&txtypes.TxRaw{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz}).Marshal()
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() {
+		_, err = decoder(txBz)
+	})
+	require.True(t, sdkerrors.ErrTxDecode.Is(err), err)
+
+	require.NotPanics(t, func() {
+		_, err = jsonDecoder([]byte(`{"body":{"messages":[{}]}}`))
+	})
+	require.True(t, sdkerrors.ErrTxDecode.Is(err), err)
+}
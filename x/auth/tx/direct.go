@@ -0,0 +1,55 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// signModeDirectHandler defines the SIGN_MODE_DIRECT SignModeHandler
+type signModeDirectHandler struct{}
+
+var _ signing.SignModeHandler = signModeDirectHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeDirectHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_DIRECT
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeDirectHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (signModeDirectHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_DIRECT {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_DIRECT, mode)
+	}
+
+	w, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	return DirectSignBytes(w.getBodyBytes(), w.getAuthInfoBytes(), data.ChainID, data.AccountNumber, data.AccountSequence)
+}
+
+// DirectSignBytes returns the SIGN_MODE_DIRECT sign bytes for the provided TxBody bytes, AuthInfo bytes, chain ID,
+// account number and sequence. These are the bytes of the serialized SignDoc.
+func DirectSignBytes(bodyBz, authInfoBz []byte, chainID string, accnum, sequence uint64) ([]byte, error) {
+	signDoc := txtypes.SignDocRaw{
+		BodyBytes:       bodyBz,
+		AuthInfoBytes:   authInfoBz,
+		ChainId:         chainID,
+		AccountNumber:   accnum,
+		AccountSequence: sequence,
+	}
+
+	return proto.Marshal(&signDoc)
+}
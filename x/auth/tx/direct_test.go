@@ -0,0 +1,87 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDirectModeHandler(t *testing.T) {
+	txGen := tx.NewTxGenerator(makeTestInterfaceRegistry(), std.DefaultPublicKeyCodec{}, tx.DefaultSignModeHandler())
+	handler := txGen.SignModeHandler()
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, handler.DefaultMode())
+
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	msg := banktypes.NewMsgSend(addr, sdk.AccAddress([]byte("to_address__________")), sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+
+	txBuilder := txGen.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: priv.PubKey(), Data: sigData}))
+
+	signerData := authsigning.SignerData{
+		ChainID:         "test-chain",
+		AccountNumber:   1,
+		AccountSequence: 3,
+	}
+	signBytes, err := handler.GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	sigData.Signature, err = priv.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: priv.PubKey(), Data: sigData}))
+
+	// the signature must not change the bytes signed over
+	signedTx := txBuilder.GetTx()
+	signBytes2, err := handler.GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, signerData, signedTx)
+	require.NoError(t, err)
+	require.Equal(t, signBytes, signBytes2)
+
+	txBytes, err := txGen.TxEncoder()(signedTx)
+	require.NoError(t, err)
+
+	decoded, err := txGen.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, signedTx, decoded)
+
+	sigTx := decoded.(authsigning.SigFeeMemoTx)
+	require.NoError(t, sigTx.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, sigTx.GetSigners())
+	require.Equal(t, addr, sigTx.FeePayer())
+	require.Equal(t, "sometestmemo", sigTx.GetMemo())
+
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.NoError(t, authsigning.VerifySignature(sigs[0].PubKey, signerData, sigs[0].Data, handler, decoded))
+
+	// the signature is bound to the account sequence
+	signerData.AccountSequence++
+	require.Error(t, authsigning.VerifySignature(sigs[0].PubKey, signerData, sigs[0].Data, handler, decoded))
+
+	// the legacy amino JSON handler is supported as well
+	_, err = handler.GetSignBytes(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, decoded)
+	require.NoError(t, err)
+
+	// JSON round trip
+	jsonBz, err := txGen.TxJSONEncoder()(decoded)
+	require.NoError(t, err)
+	jsonTx, err := txGen.TxJSONDecoder()(jsonBz)
+	require.NoError(t, err)
+	require.Equal(t, decoded.GetMsgs(), jsonTx.GetMsgs())
+	require.Equal(t, sigTx.GetPubKeys(), jsonTx.(authsigning.SigFeeMemoTx).GetPubKeys())
+	require.Equal(t, sigTx.GetSignatures(), jsonTx.(authsigning.SigFeeMemoTx).GetSignatures())
+}
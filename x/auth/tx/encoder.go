@@ -0,0 +1,52 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultTxEncoder returns a default protobuf TxEncoder
+func DefaultTxEncoder() sdk.TxEncoder {
+	return func(tx sdk.Tx) ([]byte, error) {
+		w, ok := tx.(*wrapper)
+		if !ok {
+			return nil, fmt.Errorf("expected %T, got %T", &wrapper{}, tx)
+		}
+
+		raw := &txtypes.TxRaw{
+			BodyBytes:     w.getBodyBytes(),
+			AuthInfoBytes: w.getAuthInfoBytes(),
+			Signatures:    w.tx.Signatures,
+		}
+
+		return proto.Marshal(raw)
+	}
+}
+
+// DefaultJSONTxEncoder returns a default protobuf JSON TxEncoder
+func DefaultJSONTxEncoder() sdk.TxEncoder {
+	return func(tx sdk.Tx) ([]byte, error) {
+		w, ok := tx.(*wrapper)
+		if !ok {
+			return nil, fmt.Errorf("expected %T, got %T", &wrapper{}, tx)
+		}
+
+		// public keys are not unpacked as interfaces, so their JSON
+		// representation needs to be computed explicitly
+		packer := codectypes.ProtoJSONPacker{JSONPBMarshaler: &jsonpb.Marshaler{}}
+		for _, si := range w.tx.AuthInfo.SignerInfos {
+			if err := packer.UnpackAny(si.PublicKey, nil); err != nil {
+				return nil, err
+			}
+		}
+
+		return codec.ProtoMarshalJSON(w.tx)
+	}
+}
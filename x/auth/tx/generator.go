@@ -0,0 +1,65 @@
+package tx
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// generator is a client.TxGenerator for protobuf transactions
+type generator struct {
+	pubkeyCodec cryptotypes.PublicKeyCodec
+	handler     signing.SignModeHandler
+	decoder     sdk.TxDecoder
+	encoder     sdk.TxEncoder
+	jsonDecoder sdk.TxDecoder
+	jsonEncoder sdk.TxEncoder
+}
+
+var _ client.TxGenerator = generator{}
+
+// NewTxGenerator returns a new protobuf TxGenerator using the provided AnyUnpacker, PublicKeyCodec and
+// SignModeHandler. The AnyUnpacker (usually the app's InterfaceRegistry) must know every sdk.Msg
+// implementation that can be decoded.
+func NewTxGenerator(anyUnpacker codectypes.AnyUnpacker, pubkeyCodec cryptotypes.PublicKeyCodec, signModeHandler signing.SignModeHandler) client.TxGenerator {
+	return generator{
+		pubkeyCodec: pubkeyCodec,
+		handler:     signModeHandler,
+		decoder:     DefaultTxDecoder(anyUnpacker, pubkeyCodec),
+		encoder:     DefaultTxEncoder(),
+		jsonDecoder: DefaultJSONTxDecoder(anyUnpacker, pubkeyCodec),
+		jsonEncoder: DefaultJSONTxEncoder(),
+	}
+}
+
+// NewTxBuilder implements TxGenerator.NewTxBuilder
+func (g generator) NewTxBuilder() client.TxBuilder {
+	return newBuilder(g.pubkeyCodec)
+}
+
+// SignModeHandler implements TxGenerator.SignModeHandler
+func (g generator) SignModeHandler() signing.SignModeHandler {
+	return g.handler
+}
+
+// TxEncoder implements TxGenerator.TxEncoder
+func (g generator) TxEncoder() sdk.TxEncoder {
+	return g.encoder
+}
+
+// TxDecoder implements TxGenerator.TxDecoder
+func (g generator) TxDecoder() sdk.TxDecoder {
+	return g.decoder
+}
+
+// TxJSONEncoder implements TxGenerator.TxJSONEncoder
+func (g generator) TxJSONEncoder() sdk.TxEncoder {
+	return g.jsonEncoder
+}
+
+// TxJSONDecoder implements TxGenerator.TxJSONDecoder
+func (g generator) TxJSONDecoder() sdk.TxDecoder {
+	return g.jsonDecoder
+}
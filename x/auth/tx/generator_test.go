@@ -0,0 +1,26 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func makeTestInterfaceRegistry() codectypes.InterfaceRegistry {
+	registry := codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	return registry
+}
+
+func TestGenerator(t *testing.T) {
+	txGen := tx.NewTxGenerator(makeTestInterfaceRegistry(), std.DefaultPublicKeyCodec{}, tx.DefaultSignModeHandler())
+	suite.Run(t, testutil.NewTxGeneratorTestSuite(txGen))
+}
@@ -0,0 +1,19 @@
+package tx
+
+import (
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DefaultSignModeHandler returns the default protobuf SignModeHandler supporting
+// SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON.
+func DefaultSignModeHandler() signing.SignModeHandler {
+	return signing.NewSignModeHandlerMap(
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		[]signing.SignModeHandler{
+			signModeDirectHandler{},
+			types.LegacyAminoJSONHandler{},
+		},
+	)
+}
@@ -0,0 +1,345 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// maxTxsEventLimit is the maximum number of transactions Tendermint returns per
+// search page, it is also the default limit.
+const maxTxsEventLimit = 100
+
+// maxFeeEstimationBlocks bounds the number of blocks queried by a single fee
+// estimation request.
+const maxFeeEstimationBlocks = 100
+
+// txServer implements the gRPC tx Service on top of a Tendermint node.
+type txServer struct {
+	clientCtx client.Context
+}
+
+var _ txtypes.ServiceServer = txServer{}
+
+// NewTxServer returns a gRPC tx Service simulating, broadcasting and querying
+// transactions through the Tendermint node of clientCtx. Queried transactions
+// are decoded with the TxGenerator of clientCtx.
+func NewTxServer(clientCtx client.Context) txtypes.ServiceServer {
+	return txServer{clientCtx: clientCtx}
+}
+
+// Simulate implements ServiceServer.Simulate
+func (s txServer) Simulate(_ context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx bytes")
+	}
+
+	bz, _, err := s.clientCtx.QueryWithData("/app/simulate", req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var simRes sdk.SimulationResponse
+	if err := jsonpb.Unmarshal(strings.NewReader(string(bz)), &simRes); err != nil {
+		return nil, err
+	}
+
+	return &txtypes.SimulateResponse{GasInfo: &simRes.GasInfo, Result: simRes.Result}, nil
+}
+
+// GetTx implements ServiceServer.GetTx
+func (s txServer) GetTx(_ context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil || len(hash) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %q", req.Hash)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTx, err := node.Tx(hash, !s.clientCtx.TrustNode)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	txResponses, err := s.txResponses([]*ctypes.ResultTx{resTx})
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetTxResponse{TxResponse: txResponses[0]}, nil
+}
+
+// BroadcastTx implements ServiceServer.BroadcastTx
+func (s txServer) BroadcastTx(_ context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx bytes")
+	}
+
+	var mode string
+	switch req.Mode {
+	case txtypes.BroadcastModeSync, txtypes.BroadcastModeUnspecified:
+		mode = flags.BroadcastSync
+
+	case txtypes.BroadcastModeAsync:
+		mode = flags.BroadcastAsync
+
+	case txtypes.BroadcastModeBlock:
+		mode = flags.BroadcastBlock
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid broadcast mode %s", req.Mode)
+	}
+
+	res, err := s.clientCtx.WithBroadcastMode(mode).BroadcastTx(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.BroadcastTxResponse{TxResponse: newTxResponse(res)}, nil
+}
+
+// GetTxsEvent implements ServiceServer.GetTxsEvent. Tendermint paginates search
+// results by pages, so the offset must be a multiple of the limit, and keys are
+// not supported. The total number of matching transactions is always returned.
+func (s txServer) GetTxsEvent(_ context.Context, req *txtypes.GetTxsEventRequest) (*txtypes.GetTxsEventResponse, error) {
+	if req == nil || len(req.Events) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "must declare at least one event to search")
+	}
+
+	tmEvents := make([]string, len(req.Events))
+	for i, event := range req.Events {
+		tmEvent, err := tmEventCondition(event)
+		if err != nil {
+			return nil, err
+		}
+
+		tmEvents[i] = tmEvent
+	}
+
+	page, limit, err := txsEventPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var orderBy string
+	switch req.OrderBy {
+	case txtypes.OrderByUnspecified, txtypes.OrderByAsc:
+		orderBy = "asc"
+
+	case txtypes.OrderByDesc:
+		orderBy = "desc"
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order %s", req.OrderBy)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTxs, err := node.TxSearch(strings.Join(tmEvents, " AND "), !s.clientCtx.TrustNode, page, limit, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	txResponses, err := s.txResponses(resTxs.Txs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetTxsEventResponse{
+		TxResponses: txResponses,
+		Pagination:  &query.PageResponse{Total: uint64(resTxs.TotalCount)},
+	}, nil
+}
+
+// EstimateFees implements ServiceServer.EstimateFees
+func (s txServer) EstimateFees(_ context.Context, req *txtypes.EstimateFeesRequest) (*txtypes.EstimateFeesResponse, error) {
+	blocks := uint64(clienttx.DefaultFeeEstimationBlocks)
+	if req != nil && req.Blocks != 0 {
+		blocks = req.Blocks
+	}
+
+	if blocks > maxFeeEstimationBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "blocks must be between 1 and %d", maxFeeEstimationBlocks)
+	}
+
+	estimate, err := clienttx.EstimateFees(s.clientCtx, int64(blocks))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &txtypes.EstimateFeesResponse{
+		Height:    estimate.Height,
+		Blocks:    estimate.Blocks,
+		GasPrices: make([]txtypes.GasPriceEstimate, len(estimate.GasPrices)),
+	}
+	for i, gasPrice := range estimate.GasPrices {
+		res.GasPrices[i] = txtypes.GasPriceEstimate{
+			Denom:   gasPrice.Denom,
+			Txs:     uint64(gasPrice.Txs),
+			Slow:    gasPrice.Slow,
+			Average: gasPrice.Average,
+			Fast:    gasPrice.Fast,
+		}
+	}
+
+	return res, nil
+}
+
+// tmEventCondition converts an event formatted as
+// {eventType}.{eventAttribute}={value} to a condition of a Tendermint search
+// query. The query language has no escape sequences, hence keys and values with
+// characters that would alter the query are rejected.
+func tmEventCondition(event string) (string, error) {
+	tokens := strings.Split(event, "=")
+	if len(tokens) != 2 || tokens[0] == "" {
+		return "", status.Errorf(codes.InvalidArgument,
+			"invalid event; event %s should be of the format: {eventType}.{eventAttribute}={value}", event)
+	}
+
+	key, value := tokens[0], tokens[1]
+	if strings.ContainsAny(key, " \t\n\r\\()\"'<>") {
+		return "", status.Errorf(codes.InvalidArgument, "invalid event key %q", key)
+	}
+
+	if key == tmtypes.TxHeightKey {
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "invalid height %q", value)
+		}
+
+		return fmt.Sprintf("%s=%s", key, value), nil
+	}
+
+	if strings.ContainsAny(value, "'\"") {
+		return "", status.Errorf(codes.InvalidArgument, "invalid event value %q; quotes are not supported", value)
+	}
+
+	return fmt.Sprintf("%s='%s'", key, value), nil
+}
+
+// txsEventPage converts a PageRequest to a Tendermint search page and limit.
+func txsEventPage(req *query.PageRequest) (page, limit int, err error) {
+	if req == nil {
+		return 1, maxTxsEventLimit, nil
+	}
+
+	if len(req.Key) != 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "key based pagination is not supported, use offset and limit")
+	}
+
+	if req.Limit > maxTxsEventLimit {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "limit must not exceed %d", maxTxsEventLimit)
+	}
+
+	pageLimit := req.Limit
+	if pageLimit == 0 {
+		pageLimit = maxTxsEventLimit
+	}
+
+	if req.Offset%pageLimit != 0 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "offset must be a multiple of the limit %d", pageLimit)
+	}
+
+	return int(req.Offset/pageLimit) + 1, int(pageLimit), nil
+}
+
+// txResponses verifies the transaction results unless the node is trusted and
+// decodes their transactions with the TxGenerator.
+func (s txServer) txResponses(resTxs []*ctypes.ResultTx) ([]*txtypes.TxResponse, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	blockTimes := make(map[int64]string)
+	txResponses := make([]*txtypes.TxResponse, len(resTxs))
+
+	for i, resTx := range resTxs {
+		if err := s.validateTxResult(resTx); err != nil {
+			return nil, err
+		}
+
+		if _, ok := blockTimes[resTx.Height]; !ok {
+			height := resTx.Height
+			block, err := node.Block(&height)
+			if err != nil {
+				return nil, err
+			}
+
+			blockTimes[resTx.Height] = block.Block.Time.Format(time.RFC3339)
+		}
+
+		// transactions that can't be decoded are returned encoded only
+		tx, err := s.clientCtx.TxGenerator.TxDecoder()(resTx.Tx)
+		if err != nil {
+			tx = nil
+		}
+
+		txResponses[i] = newTxResponse(sdk.NewResponseResultTx(resTx, tx, blockTimes[resTx.Height]))
+		txResponses[i].TxBytes = resTx.Tx
+	}
+
+	return txResponses, nil
+}
+
+// validateTxResult verifies the inclusion proof of a transaction result
+// against the block header unless the node is trusted.
+func (s txServer) validateTxResult(resTx *ctypes.ResultTx) error {
+	if s.clientCtx.TrustNode {
+		return nil
+	}
+
+	check, err := s.clientCtx.Verify(resTx.Height)
+	if err != nil {
+		return err
+	}
+
+	return resTx.Proof.Validate(check.Header.DataHash)
+}
+
+// newTxResponse converts a TxResponse to its protobuf representation.
+func newTxResponse(res sdk.TxResponse) *txtypes.TxResponse {
+	txResponse := &txtypes.TxResponse{
+		Height:    res.Height,
+		Txhash:    res.TxHash,
+		Codespace: res.Codespace,
+		Code:      res.Code,
+		Data:      res.Data,
+		RawLog:    res.RawLog,
+		Info:      res.Info,
+		GasWanted: res.GasWanted,
+		GasUsed:   res.GasUsed,
+		Timestamp: res.Timestamp,
+	}
+
+	if tx, ok := res.Tx.(ProtoTxProvider); ok {
+		txResponse.Tx = tx.GetProtoTx()
+	}
+
+	return txResponse
+}
@@ -0,0 +1,238 @@
+package tx_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// txsNode serves committed transactions, all included at height 5.
+type txsNode struct {
+	rpcclient.Client
+
+	txs []tmtypes.Tx
+
+	query   string
+	page    int
+	perPage int
+	orderBy string
+}
+
+func (n *txsNode) resultTx(tx tmtypes.Tx) *ctypes.ResultTx {
+	return &ctypes.ResultTx{Hash: tx.Hash(), Height: 5, Tx: tx, TxResult: abci.ResponseDeliverTx{GasUsed: 1000}}
+}
+
+func (n *txsNode) Tx(hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	for _, tx := range n.txs {
+		if tmbytes.HexBytes(tx.Hash()).String() == tmbytes.HexBytes(hash).String() {
+			return n.resultTx(tx), nil
+		}
+	}
+
+	return nil, fmt.Errorf("tx (%X) not found", hash)
+}
+
+func (n *txsNode) TxSearch(query string, _ bool, page, perPage int, orderBy string) (*ctypes.ResultTxSearch, error) {
+	n.query, n.page, n.perPage, n.orderBy = query, page, perPage, orderBy
+
+	res := &ctypes.ResultTxSearch{TotalCount: 5}
+	for _, tx := range n.txs {
+		res.Txs = append(res.Txs, n.resultTx(tx))
+	}
+
+	return res, nil
+}
+
+func (n *txsNode) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: 5}}, nil
+}
+
+func (n *txsNode) Block(height *int64) (*ctypes.ResultBlock, error) {
+	block := &tmtypes.Block{}
+	block.Height = *height
+	block.Time = time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+	if *height == 5 {
+		block.Txs = n.txs
+	}
+
+	return &ctypes.ResultBlock{Block: block}, nil
+}
+
+func (n *txsNode) BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (n *txsNode) ABCIQueryWithOptions(path string, _ tmbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	if path != "/app/simulate" {
+		return nil, fmt.Errorf("unexpected query %s", path)
+	}
+
+	bz, err := codec.ProtoMarshalJSON(&sdk.SimulationResponse{
+		GasInfo: sdk.GasInfo{GasWanted: 2000, GasUsed: 1000},
+		Result:  &sdk.Result{Log: "simulated"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func newTxServer(t *testing.T) (txtypes.ServiceServer, *txsNode) {
+	txGen := tx.NewTxGenerator(makeTestInterfaceRegistry(), std.DefaultPublicKeyCodec{}, tx.DefaultSignModeHandler())
+
+	txBuilder := txGen.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))))
+	txBuilder.SetMemo("memo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	txBuilder.SetGasLimit(1000)
+	bz, err := txGen.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	node := &txsNode{txs: []tmtypes.Tx{bz, []byte("not a tx")}}
+	clientCtx := client.Context{}.WithClient(node).WithTxGenerator(txGen).WithTrustNode(true)
+
+	return tx.NewTxServer(clientCtx), node
+}
+
+func requireStatusCode(t *testing.T, code codes.Code, err error) {
+	require.Error(t, err)
+	require.Equal(t, code, status.Code(err), err.Error())
+}
+
+func TestTxServerGetTxsEvent(t *testing.T) {
+	txServer, node := newTxServer(t)
+
+	res, err := txServer.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+		Events:     []string{"message.action=send", "tx.height=5"},
+		Pagination: &query.PageRequest{Offset: 4, Limit: 2},
+		OrderBy:    txtypes.OrderByDesc,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "message.action='send' AND tx.height=5", node.query)
+	require.Equal(t, 3, node.page)
+	require.Equal(t, 2, node.perPage)
+	require.Equal(t, "desc", node.orderBy)
+
+	require.Equal(t, uint64(5), res.Pagination.Total)
+	require.Len(t, res.TxResponses, 2)
+	require.Equal(t, "memo", res.TxResponses[0].Tx.Body.Memo)
+	require.Len(t, res.TxResponses[0].Tx.Body.Messages, 1)
+	require.Equal(t, []byte(node.txs[0]), res.TxResponses[0].TxBytes)
+	require.Equal(t, int64(1000), res.TxResponses[0].GasUsed)
+	require.Equal(t, "2020-07-01T00:00:00Z", res.TxResponses[0].Timestamp)
+	// transactions that can't be decoded are only returned encoded
+	require.Nil(t, res.TxResponses[1].Tx)
+	require.Equal(t, []byte("not a tx"), res.TxResponses[1].TxBytes)
+
+	bz, err := codec.ProtoMarshalJSON(res)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"@type":"/cosmos.bank.MsgSend"`)
+
+	_, err = txServer.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{Events: []string{"message.action=send"}})
+	require.NoError(t, err)
+	require.Equal(t, 1, node.page)
+	require.Equal(t, 100, node.perPage)
+	require.Equal(t, "asc", node.orderBy)
+
+	for _, req := range []*txtypes.GetTxsEventRequest{
+		nil,
+		{},
+		{Events: []string{"message.action"}},
+		{Events: []string{"message.action=send=receive"}},
+		// conditions can't be injected in the search query
+		{Events: []string{"message.action=send' OR message.sender='addr"}},
+		{Events: []string{"message.action=\"send\""}},
+		{Events: []string{"message.action OR tx.height=5"}},
+		{Events: []string{"tx.height=5 OR message.action='send'"}},
+		{Events: []string{"tx.height=five"}},
+		{Events: []string{"message.action=send"}, Pagination: &query.PageRequest{Offset: 3, Limit: 2}},
+		{Events: []string{"message.action=send"}, Pagination: &query.PageRequest{Limit: 101}},
+		{Events: []string{"message.action=send"}, Pagination: &query.PageRequest{Key: []byte("key")}},
+		{Events: []string{"message.action=send"}, OrderBy: 3},
+	} {
+		_, err := txServer.GetTxsEvent(context.Background(), req)
+		requireStatusCode(t, codes.InvalidArgument, err)
+	}
+}
+
+func TestTxServerGetTx(t *testing.T) {
+	txServer, node := newTxServer(t)
+
+	res, err := txServer.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: fmt.Sprintf("%X", node.txs[0].Hash())})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%X", node.txs[0].Hash()), res.TxResponse.Txhash)
+	require.Equal(t, "memo", res.TxResponse.Tx.Body.Memo)
+
+	_, err = txServer.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: "not hex"})
+	requireStatusCode(t, codes.InvalidArgument, err)
+
+	_, err = txServer.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: "ABCD"})
+	requireStatusCode(t, codes.NotFound, err)
+}
+
+func TestTxServerBroadcastTx(t *testing.T) {
+	txServer, node := newTxServer(t)
+
+	res, err := txServer.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{TxBytes: node.txs[0]})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%X", node.txs[0].Hash()), res.TxResponse.Txhash)
+
+	_, err = txServer.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{})
+	requireStatusCode(t, codes.InvalidArgument, err)
+
+	_, err = txServer.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{TxBytes: node.txs[0], Mode: 4})
+	requireStatusCode(t, codes.InvalidArgument, err)
+}
+
+func TestTxServerSimulate(t *testing.T) {
+	txServer, node := newTxServer(t)
+
+	res, err := txServer.Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: node.txs[0]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), res.GasInfo.GasUsed)
+	require.Equal(t, "simulated", res.Result.Log)
+
+	_, err = txServer.Simulate(context.Background(), &txtypes.SimulateRequest{})
+	requireStatusCode(t, codes.InvalidArgument, err)
+}
+
+func TestTxServerEstimateFees(t *testing.T) {
+	txServer, _ := newTxServer(t)
+
+	res, err := txServer.EstimateFees(context.Background(), &txtypes.EstimateFeesRequest{Blocks: 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), res.Height)
+	require.Equal(t, int64(3), res.Blocks)
+	require.Len(t, res.GasPrices, 1)
+	require.Equal(t, "stake", res.GasPrices[0].Denom)
+	require.Equal(t, uint64(1), res.GasPrices[0].Txs)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), res.GasPrices[0].Average)
+
+	// all the blocks are sampled by default
+	res, err = txServer.EstimateFees(context.Background(), &txtypes.EstimateFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), res.Blocks)
+
+	_, err = txServer.EstimateFees(context.Background(), &txtypes.EstimateFeesRequest{Blocks: 101})
+	requireStatusCode(t, codes.InvalidArgument, err)
+}
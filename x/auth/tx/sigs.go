@@ -0,0 +1,99 @@
+package tx
+
+import (
+	"fmt"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignatureDataToModeInfoAndSig converts a SignatureData to a ModeInfo and raw bytes signature
+func SignatureDataToModeInfoAndSig(data signing.SignatureData) (*tx.ModeInfo, []byte, error) {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return &tx.ModeInfo{
+			Sum: &tx.ModeInfo_Single_{
+				Single: &tx.ModeInfo_Single{Mode: data.SignMode},
+			},
+		}, data.Signature, nil
+
+	case *signing.MultiSignatureData:
+		n := len(data.Signatures)
+		modeInfos := make([]*tx.ModeInfo, n)
+		sigs := make([][]byte, n)
+
+		for i, d := range data.Signatures {
+			var err error
+			modeInfos[i], sigs[i], err = SignatureDataToModeInfoAndSig(d)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		multiSig := cryptotypes.MultiSignature{
+			Signatures: sigs,
+		}
+		sig, err := multiSig.Marshal()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &tx.ModeInfo{
+			Sum: &tx.ModeInfo_Multi_{
+				Multi: &tx.ModeInfo_Multi{
+					Bitarray:  data.BitArray,
+					ModeInfos: modeInfos,
+				},
+			},
+		}, sig, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unexpected signature data type %T", data)
+	}
+}
+
+// ModeInfoAndSigToSignatureData converts a ModeInfo and raw bytes signature to a SignatureData or returns
+// an error
+func ModeInfoAndSigToSignatureData(modeInfo *tx.ModeInfo, sig []byte) (signing.SignatureData, error) {
+	switch modeInfo := modeInfo.GetSum().(type) {
+	case *tx.ModeInfo_Single_:
+		return &signing.SingleSignatureData{
+			SignMode:  modeInfo.Single.GetMode(),
+			Signature: sig,
+		}, nil
+
+	case *tx.ModeInfo_Multi_:
+		multi := modeInfo.Multi
+
+		var multiSig cryptotypes.MultiSignature
+		if err := multiSig.Unmarshal(sig); err != nil {
+			return nil, err
+		}
+
+		if len(multiSig.Signatures) != len(multi.GetModeInfos()) {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized,
+				"wrong number of multisig signatures; expected %d, got %d", len(multi.GetModeInfos()), len(multiSig.Signatures),
+			)
+		}
+
+		sigs := make([]signing.SignatureData, len(multiSig.Signatures))
+		for i, mi := range multi.GetModeInfos() {
+			var err error
+			sigs[i], err = ModeInfoAndSigToSignatureData(mi, multiSig.Signatures[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return &signing.MultiSignatureData{
+			BitArray:   multi.GetBitarray(),
+			Signatures: sigs,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected mode info type %T", modeInfo)
+	}
+}
@@ -0,0 +1,52 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestSignatureDataModeInfoRoundTrip(t *testing.T) {
+	bitArray := cryptotypes.NewCompactBitArray(3)
+	bitArray.SetIndex(0, true)
+	bitArray.SetIndex(2, true)
+
+	nestedBitArray := cryptotypes.NewCompactBitArray(2)
+	nestedBitArray.SetIndex(1, true)
+
+	data := &signing.MultiSignatureData{
+		BitArray: bitArray,
+		Signatures: []signing.SignatureData{
+			&signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+				Signature: []byte("signature1"),
+			},
+			&signing.MultiSignatureData{
+				BitArray: nestedBitArray,
+				Signatures: []signing.SignatureData{
+					&signing.SingleSignatureData{
+						SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+						Signature: []byte("signature2"),
+					},
+				},
+			},
+		},
+	}
+
+	modeInfo, sig, err := tx.SignatureDataToModeInfoAndSig(data)
+	require.NoError(t, err)
+	require.NotNil(t, modeInfo.GetMulti())
+
+	res, err := tx.ModeInfoAndSigToSignatureData(modeInfo, sig)
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+
+	// a signature count mismatching the mode infos is rejected
+	modeInfo.GetMulti().ModeInfos = modeInfo.GetMulti().ModeInfos[:1]
+	_, err = tx.ModeInfoAndSigToSignatureData(modeInfo, sig)
+	require.Error(t, err)
+}
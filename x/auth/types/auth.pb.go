@@ -113,6 +113,7 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	SigVerifyCostSecp256r1 uint64 `protobuf:"varint,6,opt,name=sig_verify_cost_secp256r1,json=sigVerifyCostSecp256r1,proto3" json:"sig_verify_cost_secp256r1,omitempty" yaml:"sig_verify_cost_secp256r1"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,6 +183,13 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetSigVerifyCostSecp256r1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256r1
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.ModuleAccount")
@@ -191,48 +199,49 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/auth.proto", fileDescriptor_ec2401f40a84da7e) }
 
 var fileDescriptor_ec2401f40a84da7e = []byte{
-	// 650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0x8d, 0x49, 0x7e, 0xfc, 0xd9, 0x00, 0x12, 0x26, 0x80, 0xc9, 0xaf, 0xf2, 0x46, 0x3e, 0x51,
-	0xa9, 0x09, 0x82, 0x8a, 0x4a, 0xe4, 0x50, 0x15, 0xd3, 0x56, 0x42, 0x14, 0x84, 0x16, 0xa9, 0xaa,
-	0x7a, 0xb1, 0xd6, 0xce, 0x36, 0x58, 0x64, 0xb3, 0xc6, 0xbb, 0xae, 0x62, 0x3e, 0x41, 0x8f, 0x3d,
-	0x55, 0x3d, 0xf2, 0x21, 0x7a, 0xeb, 0x17, 0xe8, 0x11, 0xf5, 0xd4, 0x93, 0x5b, 0x85, 0x4b, 0xd5,
-	0xa3, 0x8f, 0x3d, 0x55, 0xde, 0x35, 0xc1, 0x41, 0xb4, 0x97, 0x64, 0xe7, 0xcd, 0xbc, 0x37, 0xe3,
-	0x37, 0xda, 0x05, 0xcb, 0x1e, 0xe3, 0x94, 0xf1, 0x75, 0x1c, 0x89, 0x13, 0xf9, 0xd3, 0x0a, 0x42,
-	0x26, 0x98, 0x5e, 0x55, 0x78, 0x2b, 0x83, 0xea, 0xab, 0x2a, 0x70, 0x64, 0x6a, 0x3d, 0xcf, 0xc8,
-	0xa0, 0x5e, 0xeb, 0xb2, 0x2e, 0x53, 0x78, 0x76, 0x52, 0xa8, 0xf5, 0x61, 0x02, 0x54, 0x6d, 0xcc,
-	0xc9, 0x8e, 0xe7, 0xb1, 0xa8, 0x2f, 0xf4, 0x7d, 0x30, 0x85, 0x3b, 0x9d, 0x90, 0x70, 0x6e, 0x68,
-	0x0d, 0x6d, 0x6d, 0xd6, 0xde, 0xf8, 0x9d, 0xc0, 0x66, 0xd7, 0x17, 0x27, 0x91, 0xdb, 0xf2, 0x18,
-	0xcd, 0x35, 0xf3, 0xbf, 0x26, 0xef, 0x9c, 0xae, 0x8b, 0x38, 0x20, 0xbc, 0xb5, 0xe3, 0x79, 0x3b,
-	0x8a, 0x88, 0xae, 0x15, 0xf4, 0xe7, 0x60, 0x2a, 0x88, 0x5c, 0xe7, 0x94, 0xc4, 0xc6, 0x84, 0x14,
-	0x6b, 0xfe, 0x4a, 0x60, 0x2d, 0x88, 0xdc, 0x9e, 0xef, 0x65, 0xe8, 0x03, 0x46, 0x7d, 0x41, 0x68,
-	0x20, 0xe2, 0x34, 0x81, 0x0b, 0x31, 0xa6, 0xbd, 0xb6, 0x75, 0x93, 0xb5, 0xd0, 0x64, 0x10, 0xb9,
-	0xfb, 0x24, 0xd6, 0x9f, 0x80, 0x79, 0xac, 0xe6, 0x73, 0xfa, 0x11, 0x75, 0x49, 0x68, 0x94, 0x1b,
-	0xda, 0x5a, 0xc5, 0x5e, 0x4d, 0x13, 0xb8, 0xa4, 0x68, 0xe3, 0x79, 0x0b, 0xcd, 0xe5, 0xc0, 0xa1,
-	0x8c, 0xf5, 0x3a, 0x98, 0xe6, 0xe4, 0x2c, 0x22, 0x7d, 0x8f, 0x18, 0x95, 0x8c, 0x8b, 0x46, 0x71,
-	0xbb, 0xf6, 0xee, 0x02, 0x96, 0x3e, 0x5e, 0xc0, 0xd2, 0xd7, 0x4f, 0xcd, 0xe9, 0xdc, 0x87, 0x3d,
-	0xeb, 0xb3, 0x06, 0xe6, 0x0e, 0x58, 0x27, 0xea, 0x8d, 0xac, 0x79, 0x05, 0x66, 0x5d, 0xcc, 0x89,
-	0x93, 0x2b, 0x4b, 0x7f, 0xaa, 0x9b, 0x46, 0xab, 0xe0, 0x7f, 0xab, 0x60, 0xa5, 0xfd, 0xff, 0x65,
-	0x02, 0xb5, 0x34, 0x81, 0x8b, 0x6a, 0xc2, 0x22, 0xd7, 0x42, 0x55, 0xb7, 0x60, 0xba, 0x0e, 0x2a,
-	0x7d, 0x4c, 0x89, 0x34, 0x69, 0x06, 0xc9, 0xb3, 0xde, 0x00, 0xd5, 0x80, 0x84, 0xd4, 0xe7, 0xdc,
-	0x67, 0x7d, 0x6e, 0x94, 0x1b, 0xe5, 0xb5, 0x19, 0x54, 0x84, 0xda, 0xf5, 0xc2, 0xdc, 0xf3, 0x63,
-	0xa3, 0xee, 0x59, 0xdf, 0xcb, 0x60, 0xf2, 0x08, 0x87, 0x98, 0x72, 0xfd, 0x10, 0x2c, 0x52, 0x3c,
-	0x70, 0x28, 0xa1, 0xcc, 0xf1, 0x4e, 0x70, 0x88, 0x3d, 0x41, 0x42, 0xb5, 0xdd, 0x8a, 0x6d, 0xa6,
-	0x09, 0xac, 0xab, 0xf9, 0xee, 0x28, 0xb2, 0xd0, 0x02, 0xc5, 0x83, 0x03, 0x42, 0xd9, 0xee, 0x08,
-	0xd3, 0xb7, 0xc1, 0xac, 0x18, 0x38, 0xdc, 0xef, 0x3a, 0x3d, 0x9f, 0xfa, 0x42, 0x0e, 0x5d, 0xb1,
-	0x57, 0x6e, 0x3e, 0xb4, 0x98, 0xb5, 0x10, 0x10, 0x83, 0x63, 0xbf, 0xfb, 0x22, 0x0b, 0x74, 0x04,
-	0x96, 0x64, 0xf2, 0x9c, 0x38, 0x1e, 0xe3, 0xc2, 0x09, 0x48, 0xe8, 0xb8, 0xb1, 0x20, 0xf9, 0x3a,
-	0x1b, 0x69, 0x02, 0xef, 0x15, 0x34, 0x6e, 0x97, 0x59, 0x68, 0x21, 0x13, 0x3b, 0x27, 0xbb, 0x8c,
-	0x8b, 0x23, 0x12, 0xda, 0xb1, 0x20, 0xfa, 0x19, 0x58, 0xc9, 0xba, 0xbd, 0x25, 0xa1, 0xff, 0x26,
-	0x56, 0xf5, 0xa4, 0xb3, 0xb9, 0xb5, 0xb5, 0xb1, 0xad, 0x16, 0x6d, 0xb7, 0x87, 0x09, 0xac, 0x1d,
-	0xfb, 0xdd, 0x97, 0xb2, 0x22, 0xa3, 0x3e, 0x7b, 0x2a, 0xf3, 0x69, 0x02, 0x4d, 0xd5, 0xed, 0x2f,
-	0x02, 0x16, 0xaa, 0xf1, 0x31, 0x9e, 0x82, 0xf5, 0x18, 0xac, 0xde, 0x66, 0x70, 0xe2, 0x05, 0x9b,
-	0x5b, 0x8f, 0x4e, 0x37, 0x8c, 0xff, 0x64, 0xd3, 0xc7, 0xc3, 0x04, 0x2e, 0x8f, 0x35, 0x3d, 0xbe,
-	0xae, 0x48, 0x13, 0xd8, 0xb8, 0xbb, 0xed, 0x48, 0xc4, 0x42, 0xcb, 0xfc, 0x4e, 0x6e, 0x7b, 0x3a,
-	0xdb, 0xf7, 0xcf, 0x0b, 0xa8, 0xd9, 0xbb, 0x5f, 0x86, 0xa6, 0x76, 0x39, 0x34, 0xb5, 0x1f, 0x43,
-	0x53, 0x7b, 0x7f, 0x65, 0x96, 0x2e, 0xaf, 0xcc, 0xd2, 0xb7, 0x2b, 0xb3, 0xf4, 0xfa, 0xfe, 0x3f,
-	0x6f, 0xeb, 0x40, 0x3d, 0x20, 0xf2, 0xd2, 0xba, 0x93, 0xf2, 0x11, 0x78, 0xf8, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x10, 0x68, 0x06, 0x78, 0x5c, 0x04, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x31, 0x4f, 0xdb, 0x40,
+	0x14, 0x8e, 0x21, 0x0d, 0x70, 0x01, 0x24, 0x4c, 0x00, 0x93, 0x56, 0xbe, 0xc8, 0x13, 0x95, 0x9a,
+	0xa0, 0x50, 0x51, 0x89, 0x0c, 0x55, 0x31, 0x6d, 0x25, 0x44, 0x41, 0xc8, 0x48, 0x55, 0xd5, 0xc5,
+	0xb2, 0x9d, 0x6b, 0xb0, 0xc8, 0xe5, 0xcc, 0xdd, 0xb9, 0x8a, 0xf9, 0x05, 0x1d, 0x3b, 0x55, 0x1d,
+	0xf9, 0x11, 0xdd, 0xfa, 0x07, 0x3a, 0xa2, 0x4e, 0x55, 0x07, 0xab, 0x0a, 0x4b, 0xd5, 0xd1, 0x63,
+	0xa7, 0xca, 0x77, 0x26, 0x38, 0x08, 0xd2, 0xc5, 0xbe, 0xf7, 0xbd, 0xf7, 0x7d, 0xdf, 0xf3, 0x3b,
+	0xeb, 0x81, 0x65, 0x8f, 0x30, 0x4c, 0xd8, 0xba, 0x13, 0xf2, 0x63, 0xf1, 0x68, 0x04, 0x94, 0x70,
+	0xa2, 0x96, 0x25, 0xde, 0x48, 0xa1, 0xea, 0xaa, 0x0c, 0x6c, 0x91, 0x5a, 0xcf, 0x32, 0x22, 0xa8,
+	0x56, 0x3a, 0xa4, 0x43, 0x24, 0x9e, 0x9e, 0x24, 0x6a, 0x7c, 0x9a, 0x00, 0x65, 0xd3, 0x61, 0x68,
+	0xdb, 0xf3, 0x48, 0xd8, 0xe3, 0xea, 0x1e, 0x98, 0x72, 0xda, 0x6d, 0x8a, 0x18, 0xd3, 0x94, 0x9a,
+	0xb2, 0x36, 0x6b, 0x36, 0xff, 0xc6, 0xb0, 0xde, 0xf1, 0xf9, 0x71, 0xe8, 0x36, 0x3c, 0x82, 0x33,
+	0xcd, 0xec, 0x55, 0x67, 0xed, 0x93, 0x75, 0x1e, 0x05, 0x88, 0x35, 0xb6, 0x3d, 0x6f, 0x5b, 0x12,
+	0xad, 0x2b, 0x05, 0xf5, 0x25, 0x98, 0x0a, 0x42, 0xd7, 0x3e, 0x41, 0x91, 0x36, 0x21, 0xc4, 0xea,
+	0x7f, 0x62, 0x58, 0x09, 0x42, 0xb7, 0xeb, 0x7b, 0x29, 0xfa, 0x88, 0x60, 0x9f, 0x23, 0x1c, 0xf0,
+	0x28, 0x89, 0xe1, 0x42, 0xe4, 0xe0, 0x6e, 0xcb, 0xb8, 0xce, 0x1a, 0x56, 0x29, 0x08, 0xdd, 0x3d,
+	0x14, 0xa9, 0xcf, 0xc0, 0xbc, 0x23, 0xfb, 0xb3, 0x7b, 0x21, 0x76, 0x11, 0xd5, 0x26, 0x6b, 0xca,
+	0x5a, 0xd1, 0x5c, 0x4d, 0x62, 0xb8, 0x24, 0x69, 0xa3, 0x79, 0xc3, 0x9a, 0xcb, 0x80, 0x03, 0x11,
+	0xab, 0x55, 0x30, 0xcd, 0xd0, 0x69, 0x88, 0x7a, 0x1e, 0xd2, 0x8a, 0x29, 0xd7, 0x1a, 0xc6, 0xad,
+	0xca, 0x87, 0x73, 0x58, 0xf8, 0x7c, 0x0e, 0x0b, 0xdf, 0xbf, 0xd4, 0xa7, 0xb3, 0x39, 0xec, 0x1a,
+	0x5f, 0x15, 0x30, 0xb7, 0x4f, 0xda, 0x61, 0x77, 0x38, 0x9a, 0x37, 0x60, 0xd6, 0x75, 0x18, 0xb2,
+	0x33, 0x65, 0x31, 0x9f, 0xf2, 0x86, 0xd6, 0xc8, 0xcd, 0xbf, 0x91, 0x1b, 0xa5, 0x79, 0xff, 0x22,
+	0x86, 0x4a, 0x12, 0xc3, 0x45, 0xd9, 0x61, 0x9e, 0x6b, 0x58, 0x65, 0x37, 0x37, 0x74, 0x15, 0x14,
+	0x7b, 0x0e, 0x46, 0x62, 0x48, 0x33, 0x96, 0x38, 0xab, 0x35, 0x50, 0x0e, 0x10, 0xc5, 0x3e, 0x63,
+	0x3e, 0xe9, 0x31, 0x6d, 0xb2, 0x36, 0xb9, 0x36, 0x63, 0xe5, 0xa1, 0x56, 0x35, 0xd7, 0xf7, 0xfc,
+	0x48, 0xab, 0xbb, 0xc6, 0xcf, 0x22, 0x28, 0x1d, 0x3a, 0xd4, 0xc1, 0x4c, 0x3d, 0x00, 0x8b, 0xd8,
+	0xe9, 0xdb, 0x18, 0x61, 0x62, 0x7b, 0xc7, 0x0e, 0x75, 0x3c, 0x8e, 0xa8, 0xbc, 0xdd, 0xa2, 0xa9,
+	0x27, 0x31, 0xac, 0xca, 0xfe, 0x6e, 0x29, 0x32, 0xac, 0x05, 0xec, 0xf4, 0xf7, 0x11, 0x26, 0x3b,
+	0x43, 0x4c, 0xdd, 0x02, 0xb3, 0xbc, 0x6f, 0x33, 0xbf, 0x63, 0x77, 0x7d, 0xec, 0x73, 0xd1, 0x74,
+	0xd1, 0x5c, 0xb9, 0xfe, 0xd0, 0x7c, 0xd6, 0xb0, 0x00, 0xef, 0x1f, 0xf9, 0x9d, 0x57, 0x69, 0xa0,
+	0x5a, 0x60, 0x49, 0x24, 0xcf, 0x90, 0xed, 0x11, 0xc6, 0xed, 0x00, 0x51, 0xdb, 0x8d, 0x38, 0xca,
+	0xae, 0xb3, 0x96, 0xc4, 0xf0, 0x41, 0x4e, 0xe3, 0x66, 0x99, 0x61, 0x2d, 0xa4, 0x62, 0x67, 0x68,
+	0x87, 0x30, 0x7e, 0x88, 0xa8, 0x19, 0x71, 0xa4, 0x9e, 0x82, 0x95, 0xd4, 0xed, 0x3d, 0xa2, 0xfe,
+	0xbb, 0x48, 0xd6, 0xa3, 0xf6, 0xc6, 0xe6, 0x66, 0x73, 0x4b, 0x5e, 0xb4, 0xd9, 0x1a, 0xc4, 0xb0,
+	0x72, 0xe4, 0x77, 0x5e, 0x8b, 0x8a, 0x94, 0xfa, 0xe2, 0xb9, 0xc8, 0x27, 0x31, 0xd4, 0xa5, 0xdb,
+	0x1d, 0x02, 0x86, 0x55, 0x61, 0x23, 0x3c, 0x09, 0xab, 0x11, 0x58, 0xbd, 0xc9, 0x60, 0xc8, 0x0b,
+	0x36, 0x36, 0x9f, 0x9c, 0x34, 0xb5, 0x7b, 0xc2, 0xf4, 0xe9, 0x20, 0x86, 0xcb, 0x23, 0xa6, 0x47,
+	0x57, 0x15, 0x49, 0x0c, 0x6b, 0xb7, 0xdb, 0x0e, 0x45, 0x0c, 0x6b, 0x99, 0xdd, 0xca, 0x1d, 0x63,
+	0x4d, 0x9b, 0x5a, 0x69, 0xbc, 0x35, 0xfd, 0xbf, 0x35, 0xbd, 0xcb, 0x9a, 0x36, 0x5b, 0xd3, 0xe9,
+	0xaf, 0xf6, 0xfb, 0x1c, 0x2a, 0xe6, 0xce, 0xb7, 0x81, 0xae, 0x5c, 0x0c, 0x74, 0xe5, 0xd7, 0x40,
+	0x57, 0x3e, 0x5e, 0xea, 0x85, 0x8b, 0x4b, 0xbd, 0xf0, 0xe3, 0x52, 0x2f, 0xbc, 0x7d, 0x38, 0x76,
+	0x51, 0xf4, 0xe5, 0xee, 0x12, 0xfb, 0xc2, 0x2d, 0x89, 0xfd, 0xf3, 0xf8, 0xdf, 0x00, 0x1d, 0xbe,
+	0xf1, 0x78, 0xd7, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -269,6 +278,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.SigVerifyCostSecp256r1 != that1.SigVerifyCostSecp256r1 {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -389,6 +401,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigVerifyCostSecp256r1 != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256r1))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -495,6 +512,9 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256r1))
+	}
 	return n
 }
 
@@ -940,6 +960,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256r1", wireType)
+			}
+			m.SigVerifyCostSecp256r1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256r1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
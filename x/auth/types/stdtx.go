@@ -126,13 +126,13 @@ func (ss StdSignature) MarshalYAML() (interface{}, error) {
 
 // CountSubKeys counts the total number of keys for a multi-sig public key.
 func CountSubKeys(pub crypto.PubKey) int {
-	v, ok := pub.(multisig.PubKeyMultisigThreshold)
+	v, ok := pub.(multisig.PubKey)
 	if !ok {
 		return 1
 	}
 
 	numKeys := 0
-	for _, subkey := range v.PubKeys {
+	for _, subkey := range v.GetPubKeys() {
 		numKeys += CountSubKeys(subkey)
 	}
 
@@ -409,11 +409,15 @@ func pubKeySigToSigData(cdc *codec.Codec, key crypto.PubKey, sig []byte) (signin
 		return nil, err
 	}
 
-	sigs := multiSig.Sigs
-	sigDatas := make([]signing.SignatureData, len(sigs))
 	pubKeys := multiPK.GetPubKeys()
 	bitArray := multiSig.BitArray
-	n := multiSig.BitArray.Size()
+	n := bitArray.Size()
+	if n != len(pubKeys) || len(multiSig.Sigs) != bitArray.NumTrueBitsBefore(n) {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "malformed multisignature of %d signatures for %d keys", len(multiSig.Sigs), len(pubKeys),
+		)
+	}
+
 	signatures := multisig.NewMultisig(n)
 	sigIdx := 0
 	for i := 0; i < n; i++ {
@@ -423,8 +427,7 @@ func pubKeySigToSigData(cdc *codec.Codec, key crypto.PubKey, sig []byte) (signin
 				return nil, sdkerrors.Wrapf(err, "Unable to convert Signature to SigData %d", sigIdx)
 			}
 
-			sigDatas[sigIdx] = data
-			multisig.AddSignature(signatures, data, sigIdx)
+			multisig.AddSignature(signatures, data, i)
 			sigIdx++
 		}
 	}
@@ -27,6 +27,7 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	gasPricesAuto      bool
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -108,6 +109,10 @@ func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// GasPricesAuto returns the option to estimate the gas prices from the
+// transactions of recent blocks.
+func (bldr TxBuilder) GasPricesAuto() bool { return bldr.gasPricesAuto }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -137,8 +142,15 @@ func (bldr TxBuilder) WithFees(fees string) TxBuilder {
 	return bldr
 }
 
-// WithGasPrices returns a copy of the context with updated gas prices.
+// WithGasPrices returns a copy of the context with updated gas prices. Gas
+// prices set to auto must be estimated before the transaction is built.
 func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
+	bldr.gasPricesAuto = gasPrices == flags.GasPricesAuto
+	if bldr.gasPricesAuto {
+		bldr.gasPrices = nil
+		return bldr
+	}
+
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
 	if err != nil {
 		panic(err)
@@ -180,6 +192,10 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		return StdSignMsg{}, fmt.Errorf("chain ID required but not specified")
 	}
 
+	if bldr.gasPricesAuto {
+		return StdSignMsg{}, errors.New("gas prices set to auto must be estimated before building the transaction")
+	}
+
 	fees := bldr.fees
 	if !bldr.gasPrices.IsZero() {
 		if !fees.IsZero() {
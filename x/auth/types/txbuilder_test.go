@@ -146,3 +146,21 @@ func TestTxBuilderBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestTxBuilderGasPricesAuto(t *testing.T) {
+	bldr := NewTxBuilder(DefaultTxEncoder(codec.New()), 1, 1, 200000, 1.1, false, "test-chain", "", nil, nil)
+
+	bldr = bldr.WithGasPrices("auto")
+	require.True(t, bldr.GasPricesAuto())
+	require.True(t, bldr.GasPrices().IsZero())
+
+	// auto gas prices must be estimated before the transaction is built
+	_, err := bldr.BuildSignMsg([]sdk.Msg{sdk.NewTestMsg(addr)})
+	require.Error(t, err)
+
+	bldr = bldr.WithGasPrices("0.025stake")
+	require.False(t, bldr.GasPricesAuto())
+	stdSignMsg, err := bldr.BuildSignMsg([]sdk.Msg{sdk.NewTestMsg(addr)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5000)), stdSignMsg.Fee.Amount)
+}
@@ -17,6 +17,8 @@ var (
 	NewPeriodicVestingAccount      = types.NewPeriodicVestingAccount
 	NewDelayedVestingAccountRaw    = types.NewDelayedVestingAccountRaw
 	NewDelayedVestingAccount       = types.NewDelayedVestingAccount
+	NewClawbackVestingAccount      = types.NewClawbackVestingAccount
+	NewMsgClawback                 = types.NewMsgClawback
 )
 
 type (
@@ -24,6 +26,8 @@ type (
 	ContinuousVestingAccount = types.ContinuousVestingAccount
 	PeriodicVestingAccount   = types.PeriodicVestingAccount
 	DelayedVestingAccount    = types.DelayedVestingAccount
+	ClawbackVestingAccount   = types.ClawbackVestingAccount
+	MsgClawback              = types.MsgClawback
 	Period                   = types.Period
 	Periods                  = types.Periods
 )
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Transaction command flags
+const (
+	FlagDest = "dest"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/auth/vesting
+// transaction commands.
+func NewTxCmd(clientCtx client.Context) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(NewMsgClawbackCmd(clientCtx))
+
+	return txCmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Transfer unvested coins of a clawback vesting account back to the funder",
+		Long: `Transfer the unvested coins of a clawback vesting account back to its funder,
+or to the address given by --dest. Unvested coins which are delegated are
+undelegated and paid out once their unbonding period completes. The transaction
+must be signed by the account's funder.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destStr, _ := cmd.Flags().GetString(FlagDest); destStr != "" {
+				dest, err = sdk.AccAddressFromBech32(destStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address to receive the clawed back coins (defaults to the funder)")
+
+	return flags.PostCommands(cmd)[0]
+}
@@ -0,0 +1,176 @@
+package vesting
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// NewHandler returns a handler for x/auth/vesting message types.
+func NewHandler(ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgClawback:
+			return handleMsgClawback(ctx, ak, bk, sk, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+// handleMsgClawback removes the unvested coins of a ClawbackVestingAccount and
+// returns them to the funder (or the requested destination). Unvested coins
+// held in the account's balance are transferred immediately. Any remainder of
+// the bond denom is delegated and is undelegated into unbonding delegations
+// owned by the destination, which receives the coins once unbonding completes.
+//
+// Clawback is refused while the account has unbonding delegations, as their
+// coins are neither in its balance nor delegated.
+func handleMsgClawback(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, msg *types.MsgClawback,
+) (*sdk.Result, error) {
+	acc := ak.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrNotClawbackAccount, msg.Address.String())
+	}
+
+	if !va.FunderAddress.Equals(msg.FunderAddress) {
+		return nil, sdkerrors.Wrap(types.ErrNotFunder, msg.FunderAddress.String())
+	}
+
+	dest := msg.GetDestination()
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	if len(sk.GetUnbondingDelegations(ctx, va.Address, 1)) > 0 {
+		return nil, sdkerrors.Wrap(types.ErrUnbondingPending, msg.Address.String())
+	}
+
+	updated, clawback := va.ComputeClawback(ctx.BlockTime().Unix())
+
+	// take as much as possible from the account's balance first
+	balance := bk.GetAllBalances(ctx, va.Address)
+	fromBalance := sdk.NewCoins()
+
+	for _, coin := range clawback {
+		amt := sdk.MinInt(coin.Amount, balance.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			fromBalance = fromBalance.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	// the remainder of the bond denom can only be found in delegations, other
+	// denoms can't be delegated
+	bondDenom := sk.BondDenom(ctx)
+	for _, coin := range clawback {
+		if coin.Denom != bondDenom && fromBalance.AmountOf(coin.Denom).LT(coin.Amount) {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "%s%s of the unvested coins are missing from the balance",
+				coin.Amount.Sub(fromBalance.AmountOf(coin.Denom)), coin.Denom,
+			)
+		}
+	}
+
+	shortfall := clawback.AmountOf(bondDenom).Sub(fromBalance.AmountOf(bondDenom))
+	undelegated := sdk.NewCoins()
+
+	if shortfall.IsPositive() {
+		unbonded, err := undelegateTo(ctx, sk, va.Address, dest, shortfall)
+		if err != nil {
+			return nil, err
+		}
+
+		if unbonded.IsPositive() {
+			undelegated = undelegated.Add(sdk.NewCoin(bondDenom, unbonded))
+		}
+	}
+
+	// account what remains delegated against the truncated schedule
+	delegated := updated.DelegatedFree.Add(updated.DelegatedVesting...)
+	remaining := sdk.NewCoins()
+	for _, coin := range delegated {
+		amt := coin.Amount.Sub(undelegated.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	updated.SetDelegated(ctx.BlockTime(), remaining)
+
+	ak.SetAccount(ctx, &updated)
+
+	if !fromBalance.IsZero() {
+		if err := bk.SendCoins(ctx, va.Address, dest, fromBalance); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, dest.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fromBalance.Add(undelegated...).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// undelegateTo unbonds up to amount tokens from the delegations of delAddr,
+// crediting the unbonding delegations to dest. It returns the amount of tokens
+// actually unbonded, which may be less than requested if the delegations were
+// slashed.
+func undelegateTo(
+	ctx sdk.Context, sk types.StakingKeeper, delAddr, dest sdk.AccAddress, amount sdk.Int,
+) (sdk.Int, error) {
+	unbonded := sdk.ZeroInt()
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, delAddr, math.MaxUint16) {
+		remaining := amount.Sub(unbonded)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		validator, found := sk.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+
+		want := sdk.MinInt(remaining, validator.TokensFromShares(delegation.Shares).TruncateInt())
+		if !want.IsPositive() {
+			continue
+		}
+
+		shares, err := sk.ValidateUnbondAmount(ctx, delAddr, delegation.ValidatorAddress, want)
+		if err != nil {
+			return unbonded, err
+		}
+
+		_, amt, err := sk.UndelegateTo(ctx, delAddr, delegation.ValidatorAddress, dest, shares)
+		if err != nil {
+			return unbonded, err
+		}
+
+		unbonded = unbonded.Add(amt)
+	}
+
+	return unbonded, nil
+}
@@ -0,0 +1,147 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestHandleMsgClawback(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+	valAddr, funder, other := sdk.ValAddress(addrs[0]), addrs[1], addrs[2]
+
+	// create and bond a validator
+	sh := staking.NewHandler(app.StakingKeeper)
+	_, err := sh(ctx, stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewCoin(bondDenom, sdk.NewInt(100)),
+		stakingtypes.Description{}, stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// create a clawback vesting account funded with 100 tokens which vest in
+	// three periods and are locked up for 12 hours
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+	lockupPeriods := types.Periods{{Length: 12 * 60 * 60, Amount: origCoins}}
+	vestingPeriods := types.Periods{
+		{Length: 6 * 60 * 60, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 40))},
+		{Length: 6 * 60 * 60, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30))},
+		{Length: 12 * 60 * 60, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30))},
+	}
+	va := types.NewClawbackVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr), funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods,
+	)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, va))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, funder, addr, origCoins))
+
+	// delegate 60 of the vesting tokens
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(60), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	h := vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	ctx = ctx.WithBlockTime(now.Add(7 * time.Hour))
+
+	// only the funder may claw back
+	_, err = h(ctx, types.NewMsgClawback(other, addr, nil))
+	require.Error(t, err)
+
+	// only clawback vesting accounts can be clawed back
+	_, err = h(ctx, types.NewMsgClawback(funder, other, nil))
+	require.Error(t, err)
+
+	res, err := h(ctx, types.NewMsgClawback(funder, addr, nil))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the 40 undelegated tokens are returned immediately and the remaining 20
+	// unvested tokens are unbonding towards the funder
+	require.Equal(t, sdk.NewInt(940), app.BankKeeper.GetBalance(ctx, funder, bondDenom).Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr).IsZero())
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, funder, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, sdk.NewInt(20), ubd.Entries[0].Balance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(40), delegation.Shares)
+
+	// the vested tokens remain locked up and are accounted as delegated vesting
+	va = app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 40)), va.OriginalVesting)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 40)), va.DelegatedVesting)
+	require.True(t, va.DelegatedFree.IsZero())
+	require.NoError(t, va.Validate())
+}
+
+func TestHandleMsgClawbackRefused(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	valAddr, funder := sdk.ValAddress(addrs[0]), addrs[1]
+
+	sh := staking.NewHandler(app.StakingKeeper)
+	_, err := sh(ctx, stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewCoin(bondDenom, sdk.NewInt(100)),
+		stakingtypes.Description{}, stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	newAccount := func(origCoins sdk.Coins) sdk.AccAddress {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		periods := types.Periods{{Length: 24 * 60 * 60, Amount: origCoins}}
+		va := types.NewClawbackVestingAccount(
+			authtypes.NewBaseAccountWithAddress(addr), funder, origCoins, now.Unix(), periods, periods,
+		)
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, va))
+		return addr
+	}
+	h := vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	// unvested coins of other denoms missing from the balance can't be clawed
+	// back
+	addr := newAccount(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50), sdk.NewInt64Coin("foo", 10)))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, funder, addr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))))
+	_, err = h(ctx, types.NewMsgClawback(funder, addr, nil))
+	require.True(t, sdkerrors.ErrInsufficientFunds.Is(err), err)
+
+	// clawback is refused while coins are unbonding
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))
+	addr = newAccount(origCoins)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, funder, addr, origCoins))
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(50), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Undelegate(ctx, addr, valAddr, sdk.NewDec(20))
+	require.NoError(t, err)
+
+	_, err = h(ctx, types.NewMsgClawback(funder, addr, nil))
+	require.True(t, types.ErrUnbondingPending.Is(err), err)
+	require.Equal(t, sdk.NewInt(900), app.BankKeeper.GetBalance(ctx, funder, bondDenom).Amount)
+}
@@ -0,0 +1,116 @@
+package vesting
+
+import (
+	"encoding/json"
+
+	"github.com/gogo/protobuf/grpc"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic defines the basic application module used by the vesting
+// module. The module holds no state of its own; vesting accounts are stored by
+// x/auth.
+type AppModuleBasic struct{}
+
+// Name returns the vesting module's name.
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterCodec performs a no-op. The vesting types are registered on the
+// Amino codec by the std package, as vesting accounts must be known to every
+// application using x/auth.
+func (AppModuleBasic) RegisterCodec(_ *codec.Codec) {}
+
+// DefaultGenesis returns the vesting module's default genesis state, which is
+// empty.
+func (AppModuleBasic) DefaultGenesis(_ codec.JSONMarshaler) json.RawMessage {
+	return []byte("{}")
+}
+
+// ValidateGenesis performs a no-op as the vesting module has no genesis state.
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONMarshaler, _ json.RawMessage) error {
+	return nil
+}
+
+// RegisterRESTRoutes registers no REST routes for the vesting module.
+func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
+
+// GetTxCmd returns the root tx command for the vesting module.
+func (AppModuleBasic) GetTxCmd(clientCtx client.Context) *cobra.Command {
+	return cli.NewTxCmd(clientCtx)
+}
+
+// GetQueryCmd returns no root query command for the vesting module. Vesting
+// accounts can be queried through x/auth.
+func (AppModuleBasic) GetQueryCmd(_ client.Context) *cobra.Command { return nil }
+
+//____________________________________________________________________________
+
+// AppModule implements an application module for the vesting module.
+type AppModule struct {
+	AppModuleBasic
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		stakingKeeper:  sk,
+	}
+}
+
+// RegisterInvariants performs a no-op.
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the vesting module.
+func (am AppModule) Route() sdk.Route {
+	return sdk.NewRoute(types.RouterKey, NewHandler(am.accountKeeper, am.bankKeeper, am.stakingKeeper))
+}
+
+// QuerierRoute returns no querier route.
+func (AppModule) QuerierRoute() string { return "" }
+
+// NewQuerierHandler returns no sdk.Querier.
+func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+
+// RegisterQueryService performs a no-op.
+func (AppModule) RegisterQueryService(_ grpc.Server) {}
+
+// InitGenesis performs a no-op.
+func (AppModule) InitGenesis(_ sdk.Context, _ codec.JSONMarshaler, _ json.RawMessage) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the vesting module's empty exported genesis state.
+func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
+	return am.DefaultGenesis(cdc)
+}
+
+// BeginBlock performs a no-op.
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock performs a no-op.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/auth/vesting module sentinel errors
+var (
+	ErrNotClawbackAccount = sdkerrors.Register(ModuleName, 2, "account is not a clawback vesting account")
+	ErrNotFunder          = sdkerrors.Register(ModuleName, 3, "clawback can only be requested by the original funder")
+	ErrUnbondingPending   = sdkerrors.Register(ModuleName, 4, "clawback is not allowed while unbonding delegations are pending")
+)
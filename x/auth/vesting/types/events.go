@@ -0,0 +1,12 @@
+package types
+
+// vesting module event types
+const (
+	EventTypeClawback = "clawback"
+
+	AttributeKeyFunder      = "funder"
+	AttributeKeyAccount     = "account"
+	AttributeKeyDestination = "destination"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// vesting message types
+const (
+	TypeMsgClawback = "clawback"
+)
+
+var _ sdk.Msg = &MsgClawback{}
+
+// NewMsgClawback returns a reference to a new MsgClawback. An empty
+// destination address means the clawed back coins are returned to the funder.
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	return &MsgClawback{
+		FunderAddress: funder,
+		Address:       addr,
+		DestAddress:   dest,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if msg.FunderAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing funder address")
+	}
+
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
+
+// GetDestination returns the address the clawed back coins are sent to,
+// defaulting to the funder when no destination is set.
+func (msg MsgClawback) GetDestination() sdk.AccAddress {
+	if msg.DestAddress.Empty() {
+		return msg.FunderAddress
+	}
+
+	return msg.DestAddress
+}
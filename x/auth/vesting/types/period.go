@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
@@ -26,3 +28,76 @@ func (vp Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// TotalLength returns the summed length of all periods.
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+
+	return total
+}
+
+// TotalAmount returns the summed amount of all periods.
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount...)
+	}
+
+	return total
+}
+
+// ReadSchedule returns the total amount of coins released by the given
+// schedule of periods as of readTime. Periods are laid out back-to-back
+// starting at startTime.
+func ReadSchedule(startTime int64, periods Periods, readTime int64) sdk.Coins {
+	released := sdk.NewCoins()
+	if readTime <= startTime {
+		return released
+	}
+
+	// track the start time of the next period
+	periodStartTime := startTime
+	for _, period := range periods {
+		if readTime-periodStartTime < period.Length {
+			break
+		}
+
+		released = released.Add(period.Amount...)
+		periodStartTime += period.Length
+	}
+
+	return released
+}
+
+// capPeriods returns a copy of the given periods where the cumulative amount
+// released never exceeds the given cap. Periods which would release nothing are
+// still kept so that the schedule's overall length is preserved.
+func capPeriods(periods Periods, cap sdk.Coins) Periods {
+	capped := make(Periods, len(periods))
+	remaining := cap
+
+	for i, period := range periods {
+		amount := coinsMin(period.Amount, remaining)
+		remaining = remaining.Sub(amount)
+		capped[i] = Period{Length: period.Length, Amount: amount}
+	}
+
+	return capped
+}
+
+// coinsMin returns the per-denomination minimum of the two coin sets. A
+// denomination absent from either set is absent from the result.
+func coinsMin(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coin := range a {
+		amt := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			min = min.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	return min
+}
@@ -238,54 +238,172 @@ func (m *PeriodicVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PeriodicVestingAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It provides
+// an account that can hold contributions subject to "lockup" (like a
+// PeriodicVestingAccount), or vesting which is subject to clawback
+// of unvested tokens, or a combination (tokens vest, but are still locked).
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	FunderAddress       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"funder_address,omitempty" yaml:"funder_address"`
+	StartTime           int64                                         `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	LockupPeriods       []Period                                      `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3" json:"lockup_periods" yaml:"lockup_periods"`
+	VestingPeriods      []Period                                      `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
+func (*ClawbackVestingAccount) ProtoMessage() {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae36726ee12abd18, []int{5}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
+// MsgClawback defines a message that removes unvested tokens from a
+// ClawbackVestingAccount and returns them to the funder (or to the optional
+// destination address).
+type MsgClawback struct {
+	FunderAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"funder_address,omitempty" yaml:"funder_address"`
+	Address       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	DestAddress   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"dest_address,omitempty" yaml:"dest_address"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae36726ee12abd18, []int{6}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+func (m *MsgClawback) GetFunderAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return nil
+}
+
+func (m *MsgClawback) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *MsgClawback) GetDestAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DestAddress
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.ContinuousVestingAccount")
 	proto.RegisterType((*DelayedVestingAccount)(nil), "cosmos.vesting.DelayedVestingAccount")
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.PeriodicVestingAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.ClawbackVestingAccount")
+	proto.RegisterType((*MsgClawback)(nil), "cosmos.vesting.MsgClawback")
 }
 
 func init() { proto.RegisterFile("cosmos/vesting/vesting.proto", fileDescriptor_ae36726ee12abd18) }
 
 var fileDescriptor_ae36726ee12abd18 = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xb1, 0x6e, 0x13, 0x31,
-	0x18, 0xc7, 0xcf, 0x49, 0x08, 0xc5, 0x29, 0x49, 0xeb, 0x36, 0xe1, 0x54, 0xa1, 0xbb, 0xe8, 0xa6,
-	0x2c, 0x5c, 0xa0, 0x30, 0x65, 0xeb, 0x15, 0x21, 0xa1, 0x32, 0xa0, 0x13, 0xea, 0xd0, 0x25, 0x72,
-	0x72, 0xe6, 0x62, 0x91, 0x3b, 0x47, 0x67, 0x07, 0x91, 0x81, 0x4e, 0x48, 0x30, 0xb2, 0x20, 0x31,
-	0x56, 0x8c, 0x3c, 0x00, 0xcf, 0xd0, 0x31, 0x23, 0x53, 0x40, 0xc9, 0x1b, 0xe4, 0x09, 0x50, 0x6c,
-	0x5f, 0x42, 0xae, 0x11, 0x52, 0x19, 0x10, 0x4b, 0x72, 0xfe, 0xec, 0xef, 0xff, 0xfd, 0xfc, 0xf9,
-	0x6f, 0xc3, 0xbb, 0x5d, 0xc6, 0x23, 0xc6, 0x9b, 0xaf, 0x09, 0x17, 0x34, 0x0e, 0xd3, 0x7f, 0x77,
-	0x90, 0x30, 0xc1, 0x50, 0x59, 0xcd, 0xba, 0x3a, 0x7a, 0xb0, 0x1f, 0xb2, 0x90, 0xc9, 0xa9, 0xe6,
-	0xe2, 0x4b, 0xad, 0x3a, 0xd8, 0xd3, 0x1a, 0x7a, 0xb1, 0x0a, 0xd6, 0x74, 0x10, 0x0f, 0x45, 0x4f,
-	0xfe, 0xa8, 0xb8, 0xf3, 0xa5, 0x00, 0x91, 0x87, 0x39, 0x39, 0x55, 0x92, 0x47, 0xdd, 0x2e, 0x1b,
-	0xc6, 0x02, 0x1d, 0xc1, 0xed, 0x0e, 0xe6, 0xa4, 0x8d, 0xd5, 0xd8, 0x04, 0x75, 0xd0, 0x28, 0x1d,
-	0x9a, 0xae, 0xd6, 0x94, 0x02, 0x8b, 0x34, 0xbd, 0xde, 0x2b, 0x8c, 0x27, 0x36, 0xf0, 0x4b, 0x9d,
-	0x55, 0x08, 0xbd, 0x03, 0x70, 0x87, 0x25, 0x34, 0xa4, 0x31, 0xee, 0xb7, 0x35, 0xb1, 0x99, 0xab,
-	0xe7, 0x1b, 0xa5, 0xc3, 0xed, 0x54, 0xe7, 0x98, 0xd1, 0xd8, 0x3b, 0xb9, 0x9c, 0xd8, 0xc6, 0x7c,
-	0x62, 0xdf, 0x19, 0xe1, 0xa8, 0xdf, 0x72, 0xb2, 0x39, 0xce, 0xd7, 0x1f, 0x76, 0x23, 0xa4, 0xa2,
-	0x37, 0xec, 0xb8, 0x5d, 0x16, 0x35, 0xd7, 0x76, 0x77, 0x8f, 0x07, 0xaf, 0x9a, 0x62, 0x34, 0x20,
-	0x4a, 0x8b, 0xfb, 0x95, 0x34, 0x5d, 0x6f, 0x08, 0x9d, 0xc3, 0x72, 0x40, 0xfa, 0x24, 0xc4, 0x82,
-	0x04, 0xed, 0x97, 0x09, 0x21, 0x66, 0x7e, 0x03, 0xc3, 0x53, 0xcd, 0x50, 0x55, 0x0c, 0xeb, 0x19,
-	0xd7, 0x23, 0xb8, 0xbd, 0x4c, 0x7e, 0x92, 0x10, 0x82, 0xde, 0x03, 0xb8, 0xbb, 0x92, 0x4b, 0xfb,
-	0x50, 0xd8, 0xc0, 0xf0, 0x4c, 0x33, 0x98, 0x59, 0x86, 0xbf, 0x6a, 0xc4, 0xce, 0x32, 0x3f, 0xed,
-	0x84, 0x0b, 0xb7, 0x48, 0x1c, 0xb4, 0x05, 0x8d, 0x88, 0x79, 0xa3, 0x0e, 0x1a, 0x79, 0x6f, 0x6f,
-	0x3e, 0xb1, 0x2b, 0xaa, 0x5a, 0x3a, 0xe3, 0xf8, 0x37, 0x49, 0x1c, 0xbc, 0xa0, 0x11, 0x69, 0x6d,
-	0x7d, 0xb8, 0xb0, 0x8d, 0xcf, 0x17, 0xb6, 0xe1, 0x7c, 0x03, 0xd0, 0x3c, 0x66, 0xb1, 0xa0, 0xf1,
-	0x90, 0x0d, 0x79, 0xc6, 0x2a, 0x67, 0x70, 0x5f, 0x5a, 0x45, 0x53, 0x66, 0x2c, 0xe3, 0xb8, 0xeb,
-	0x9e, 0x75, 0xaf, 0x9a, 0x4d, 0x9b, 0x07, 0x75, 0xae, 0xda, 0xf0, 0x11, 0x84, 0x5c, 0xe0, 0x44,
-	0x28, 0xe8, 0x9c, 0x84, 0xae, 0xce, 0x27, 0xf6, 0xae, 0x82, 0x5e, 0xcd, 0x39, 0xfe, 0x2d, 0x39,
-	0xc8, 0x80, 0xbf, 0x85, 0xd5, 0xc7, 0xa4, 0x8f, 0x47, 0xcb, 0x26, 0xfc, 0x03, 0xe8, 0xdf, 0xca,
-	0x9f, 0xc3, 0xe2, 0x73, 0x92, 0x50, 0x16, 0xa0, 0x1a, 0x2c, 0xf6, 0x49, 0x1c, 0x8a, 0x9e, 0xac,
-	0x90, 0xf7, 0xf5, 0x08, 0x9d, 0xc2, 0x22, 0x8e, 0x64, 0xe5, 0x4d, 0x37, 0xe3, 0xfe, 0xc2, 0x11,
-	0xd7, 0x3a, 0x75, 0xad, 0xd6, 0x2a, 0xc8, 0xfa, 0x9f, 0x72, 0xb0, 0xa6, 0x00, 0x68, 0xf7, 0x7f,
-	0x3f, 0x35, 0xd4, 0x86, 0x95, 0x14, 0x66, 0x20, 0x99, 0xb9, 0xbe, 0xa9, 0xb5, 0x2c, 0x8c, 0xda,
-	0x92, 0x67, 0xe9, 0xfb, 0x52, 0x53, 0xb2, 0x99, 0x64, 0xc7, 0x2f, 0xeb, 0x88, 0x5a, 0xce, 0x57,
-	0xe7, 0xe2, 0x9d, 0x5c, 0x4e, 0x2d, 0x30, 0x9e, 0x5a, 0xe0, 0xe7, 0xd4, 0x02, 0x1f, 0x67, 0x96,
-	0x31, 0x9e, 0x59, 0xc6, 0xf7, 0x99, 0x65, 0x9c, 0x3d, 0xf8, 0x63, 0xa7, 0xdf, 0xa8, 0xe7, 0x33,
-	0x7d, 0x9c, 0x65, 0xe3, 0x3b, 0x45, 0xf9, 0x90, 0x3e, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xb4,
-	0x69, 0xaa, 0x1c, 0xbb, 0x05, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x24, 0x4d, 0xcb, 0x25, 0x4d, 0x5b, 0xb7, 0x0d, 0x56, 0x05, 0x76, 0xe5, 0x29,
+	0x4b, 0x1d, 0x5a, 0x98, 0xba, 0xc5, 0x45, 0x08, 0x28, 0x48, 0xc8, 0x42, 0x1d, 0x2a, 0xa4, 0xe8,
+	0x62, 0x5f, 0x5d, 0x2b, 0x8e, 0x2f, 0xf8, 0xce, 0x40, 0x07, 0x3a, 0x21, 0xc1, 0xc8, 0x82, 0xc4,
+	0x58, 0x31, 0xf2, 0x01, 0x18, 0xf8, 0x04, 0x1d, 0x3b, 0x32, 0x19, 0xd4, 0x4a, 0x7c, 0x80, 0x8c,
+	0x4c, 0x28, 0x77, 0xe7, 0xa4, 0x76, 0xa3, 0xaa, 0x05, 0x15, 0xb1, 0x24, 0xb9, 0x77, 0xf7, 0xfe,
+	0xef, 0xe7, 0xfb, 0xbf, 0x73, 0x0e, 0xdc, 0xb0, 0x31, 0xe9, 0x62, 0xd2, 0x78, 0x81, 0x08, 0xf5,
+	0x02, 0x37, 0xf9, 0x36, 0x7a, 0x21, 0xa6, 0x58, 0xae, 0xf2, 0x59, 0x43, 0x44, 0x97, 0x16, 0x5c,
+	0xec, 0x62, 0x36, 0xd5, 0x18, 0xfc, 0xe2, 0xab, 0x96, 0xe6, 0x85, 0x86, 0x58, 0xcc, 0x83, 0x35,
+	0x11, 0x84, 0x11, 0xdd, 0x65, 0x1f, 0x3c, 0xae, 0x7f, 0x2a, 0x02, 0xd9, 0x84, 0x04, 0x6d, 0x71,
+	0xc9, 0xa6, 0x6d, 0xe3, 0x28, 0xa0, 0x72, 0x13, 0x54, 0xda, 0x90, 0xa0, 0x16, 0xe4, 0x63, 0x45,
+	0x5a, 0x96, 0xea, 0xe5, 0x35, 0xc5, 0x10, 0x9a, 0x4c, 0x60, 0x90, 0x26, 0xd6, 0x9b, 0xc5, 0xa3,
+	0x58, 0x93, 0xac, 0x72, 0x7b, 0x14, 0x92, 0xdf, 0x48, 0x60, 0x16, 0x87, 0x9e, 0xeb, 0x05, 0xd0,
+	0x6f, 0x09, 0x62, 0x25, 0xbf, 0x5c, 0xa8, 0x97, 0xd7, 0x2a, 0x89, 0xce, 0x06, 0xf6, 0x02, 0x73,
+	0xf3, 0x30, 0xd6, 0x72, 0xfd, 0x58, 0xbb, 0xbe, 0x07, 0xbb, 0xfe, 0xba, 0x9e, 0xcd, 0xd1, 0x3f,
+	0x7f, 0xd7, 0xea, 0xae, 0x47, 0x77, 0xa3, 0xb6, 0x61, 0xe3, 0x6e, 0x23, 0xf5, 0x74, 0x2b, 0xc4,
+	0xe9, 0x34, 0xe8, 0x5e, 0x0f, 0x71, 0x2d, 0x62, 0xcd, 0x24, 0xe9, 0xe2, 0x81, 0xe4, 0x7d, 0x50,
+	0x75, 0x90, 0x8f, 0x5c, 0x48, 0x91, 0xd3, 0xda, 0x09, 0x11, 0x52, 0x0a, 0x63, 0x18, 0x1e, 0x08,
+	0x86, 0x45, 0xce, 0x90, 0xce, 0xb8, 0x1c, 0xc1, 0xf4, 0x30, 0xf9, 0x5e, 0x88, 0x90, 0xfc, 0x56,
+	0x02, 0x73, 0x23, 0xb9, 0x64, 0x1f, 0x8a, 0x63, 0x18, 0x1e, 0x09, 0x06, 0x25, 0xcb, 0xf0, 0x47,
+	0x1b, 0x31, 0x3b, 0xcc, 0x4f, 0x76, 0xc2, 0x00, 0x53, 0x28, 0x70, 0x5a, 0xd4, 0xeb, 0x22, 0x65,
+	0x62, 0x59, 0xaa, 0x17, 0xcc, 0xf9, 0x7e, 0xac, 0xcd, 0xf0, 0x6a, 0xc9, 0x8c, 0x6e, 0x4d, 0xa2,
+	0xc0, 0x79, 0xea, 0x75, 0xd1, 0xfa, 0xd4, 0xbb, 0x03, 0x2d, 0xf7, 0xf1, 0x40, 0xcb, 0xe9, 0x5f,
+	0x24, 0xa0, 0x6c, 0xe0, 0x80, 0x7a, 0x41, 0x84, 0x23, 0x92, 0x69, 0x95, 0x6d, 0xb0, 0xc0, 0x5a,
+	0x45, 0x50, 0x66, 0x5a, 0x46, 0x37, 0xd2, 0x3d, 0x6b, 0x9c, 0x6d, 0x36, 0xd1, 0x3c, 0x72, 0xfb,
+	0x6c, 0x1b, 0xde, 0x01, 0x80, 0x50, 0x18, 0x52, 0x0e, 0x9d, 0x67, 0xd0, 0x8b, 0xfd, 0x58, 0x9b,
+	0xe3, 0xd0, 0xa3, 0x39, 0xdd, 0xba, 0xc6, 0x06, 0x19, 0xf0, 0xd7, 0x60, 0xf1, 0x2e, 0xf2, 0xe1,
+	0x1e, 0x72, 0x32, 0xc2, 0x57, 0x08, 0x7d, 0xaa, 0xfc, 0x3e, 0x28, 0x3d, 0x41, 0xa1, 0x87, 0x1d,
+	0xb9, 0x06, 0x4a, 0x3e, 0x0a, 0x5c, 0xba, 0xcb, 0x2a, 0x14, 0x2c, 0x31, 0x92, 0xb7, 0x40, 0x09,
+	0x76, 0x59, 0xe5, 0x71, 0x27, 0xe3, 0xd6, 0xa0, 0x23, 0x2e, 0xe5, 0xba, 0x50, 0x5b, 0x2f, 0xb2,
+	0xfa, 0x1f, 0xf2, 0xa0, 0xc6, 0x01, 0x3c, 0xfb, 0x7f, 0x77, 0x4d, 0x6e, 0x81, 0x99, 0x04, 0xa6,
+	0xc7, 0x98, 0x89, 0x38, 0xa9, 0xb5, 0x2c, 0x0c, 0x7f, 0x24, 0x53, 0x15, 0xe7, 0xa5, 0xc6, 0x65,
+	0x33, 0xc9, 0xba, 0x55, 0x15, 0x11, 0xbe, 0x9c, 0x9c, 0xf2, 0xe5, 0x67, 0x01, 0xd4, 0x36, 0x7c,
+	0xf8, 0xb2, 0x0d, 0xed, 0xce, 0x3f, 0xdc, 0x97, 0xe7, 0xa0, 0xba, 0x13, 0x05, 0x0e, 0x0a, 0x5b,
+	0xd0, 0x71, 0x42, 0x44, 0x08, 0xdb, 0x9b, 0x8a, 0xf9, 0x70, 0xf4, 0xe2, 0x49, 0xcf, 0xeb, 0xbf,
+	0x62, 0x6d, 0xe5, 0x02, 0xde, 0x37, 0x6d, 0xbb, 0xc9, 0x33, 0xac, 0x69, 0xae, 0x20, 0x86, 0x19,
+	0x2b, 0x0a, 0x17, 0xb4, 0xe2, 0x19, 0xa8, 0xfa, 0xd8, 0xee, 0x44, 0xbd, 0xa1, 0x13, 0xc5, 0x73,
+	0x9d, 0xb8, 0x99, 0x7e, 0x7b, 0xa6, 0x73, 0x75, 0x6b, 0x9a, 0x07, 0x84, 0x0f, 0xe3, 0x8c, 0x9e,
+	0xb8, 0x22, 0xa3, 0xbf, 0xe6, 0x41, 0xf9, 0x31, 0x71, 0x13, 0xaf, 0xc7, 0x38, 0x20, 0x5d, 0xb5,
+	0x03, 0x9b, 0x60, 0x32, 0xed, 0xf6, 0xea, 0xe5, 0x25, 0x13, 0x05, 0xb9, 0x03, 0x2a, 0x0e, 0x22,
+	0x74, 0x48, 0x5f, 0x60, 0x8a, 0xf7, 0xfb, 0xb1, 0x36, 0x9f, 0xfc, 0x69, 0x10, 0xfa, 0x17, 0xec,
+	0xe5, 0x41, 0xbe, 0x18, 0x98, 0x9b, 0x87, 0xc7, 0xaa, 0x74, 0x74, 0xac, 0x4a, 0x3f, 0x8e, 0x55,
+	0xe9, 0xfd, 0x89, 0x9a, 0x3b, 0x3a, 0x51, 0x73, 0xdf, 0x4e, 0xd4, 0xdc, 0xf6, 0xea, 0xb9, 0xaa,
+	0xaf, 0xf8, 0x25, 0x23, 0xb9, 0xc2, 0xb0, 0x22, 0xed, 0x12, 0xbb, 0x6e, 0xdc, 0xfe, 0x3d, 0x00,
+	0x21, 0x62, 0x06, 0x9b, 0xe1, 0x08, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -541,6 +659,125 @@ func (m *PeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -655,6 +892,59 @@ func (m *PeriodicVestingAccount) Size() (n int) {
 	return n
 }
 
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1316,6 +1606,371 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = append(m.FunderAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FunderAddress == nil {
+				m.FunderAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = append(m.FunderAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FunderAddress == nil {
+				m.FunderAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = append(m.DestAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestAddress == nil {
+				m.DestAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 //-----------------------------------------------------------------------------
@@ -174,8 +175,10 @@ type vestingAccountYAML struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	LockupPeriods  Periods        `json:"lockup_periods,omitempty" yaml:"lockup_periods,omitempty"`
 }
 
 type vestingAccountJSON struct {
@@ -189,8 +192,10 @@ type vestingAccountJSON struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	LockupPeriods  Periods        `json:"lockup_periods,omitempty" yaml:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -712,3 +717,250 @@ func (dva *DelayedVestingAccount) UnmarshalJSON(bz []byte) error {
 
 	return nil
 }
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. Coins are
+// only spendable once they have both vested according to the vesting periods
+// and been released according to the lockup periods. Unvested coins may be
+// clawed back by the funder at any time.
+func NewClawbackVestingAccount(
+	baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins,
+	startTime int64, lockupPeriods, vestingPeriods Periods,
+) *ClawbackVestingAccount {
+	endTime := startTime + max64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength())
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  originalVesting,
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		EndTime:          endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder,
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetVestedCoins returns the total number of coins which have both vested and
+// been released from lockup.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return coinsMin(va.GetVestedOnly(blockTime), va.GetUnlockedOnly(blockTime))
+}
+
+// GetVestingCoins returns the total number of coins which are either still
+// vesting or still locked up.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// GetVestedOnly returns the amount of coins released by the vesting schedule
+// only, ignoring the lockup schedule. Only these coins are protected from
+// clawback.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.VestingPeriods, blockTime.Unix())
+}
+
+// GetUnlockedOnly returns the amount of coins released by the lockup schedule
+// only, ignoring the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.LockupPeriods, blockTime.Unix())
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked).
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// SetDelegated replaces the delegation bookkeeping of the account with the
+// given total of delegated coins. It is accounted as delegated vesting up to
+// the coins still vesting at blockTime, and as delegated free otherwise.
+func (va *ClawbackVestingAccount) SetDelegated(blockTime time.Time, delegated sdk.Coins) {
+	va.DelegatedVesting = coinsMin(delegated, va.GetVestingCoins(blockTime))
+	va.DelegatedFree = delegated.Sub(va.DelegatedVesting)
+}
+
+// GetStartTime returns the time when vesting and lockup start for a clawback
+// vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetFunder returns the address of the account allowed to claw back unvested
+// coins.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	return va.FunderAddress
+}
+
+// GetLockupPeriods returns the lockup periods of the clawback vesting account.
+func (va ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return va.LockupPeriods
+}
+
+// GetVestingPeriods returns the vesting periods of the clawback vesting account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// ComputeClawback returns a copy of the account with its vesting schedule
+// truncated at clawbackTime, along with the unvested coins which are removed
+// from the original vesting amount. Vesting periods ending after clawbackTime
+// are dropped, and the lockup schedule is capped at the remaining vested
+// amount.
+//
+// NOTE: The delegation bookkeeping of the returned account is left untouched;
+// it is the caller's responsibility to move the clawed back coins and update
+// the delegated vesting and delegated free amounts accordingly, see
+// SetDelegated.
+func (va ClawbackVestingAccount) ComputeClawback(clawbackTime int64) (ClawbackVestingAccount, sdk.Coins) {
+	vestedPeriods := Periods{}
+	periodEndTime := va.StartTime
+
+	for _, period := range va.VestingPeriods {
+		periodEndTime += period.Length
+		if periodEndTime > clawbackTime {
+			break
+		}
+
+		vestedPeriods = append(vestedPeriods, period)
+	}
+
+	vested := vestedPeriods.TotalAmount()
+	unvested := va.OriginalVesting.Sub(vested)
+
+	bva := *va.BaseVestingAccount
+	bva.OriginalVesting = vested
+
+	updated := va
+	updated.BaseVestingAccount = &bva
+	updated.VestingPeriods = vestedPeriods
+	updated.LockupPeriods = capPeriods(va.LockupPeriods, vested)
+	updated.EndTime = updated.StartTime + max64(Periods(updated.LockupPeriods).TotalLength(), vestedPeriods.TotalLength())
+
+	return updated, unvested
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.FunderAddress.Empty() {
+		return errors.New("funder address cannot be empty")
+	}
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	endTime := va.StartTime + max64(Periods(va.LockupPeriods).TotalLength(), Periods(va.VestingPeriods).TotalLength())
+	if endTime != va.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	alias := vestingAccountYAML{
+		Address:          va.Address,
+		AccountNumber:    va.AccountNumber,
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+
+	pk := va.GetPubKey()
+	if pk != nil {
+		pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
+
+// MarshalJSON returns the JSON representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalJSON() ([]byte, error) {
+	alias := vestingAccountJSON{
+		Address:          va.Address,
+		PubKey:           va.GetPubKey(),
+		AccountNumber:    va.AccountNumber,
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+
+	return legacy.Cdc.MarshalJSON(alias)
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into a ClawbackVestingAccount.
+func (va *ClawbackVestingAccount) UnmarshalJSON(bz []byte) error {
+	var alias vestingAccountJSON
+	if err := legacy.Cdc.UnmarshalJSON(bz, &alias); err != nil {
+		return err
+	}
+
+	va.BaseVestingAccount = &BaseVestingAccount{
+		BaseAccount:      authtypes.NewBaseAccount(alias.Address, alias.PubKey, alias.AccountNumber, alias.Sequence),
+		OriginalVesting:  alias.OriginalVesting,
+		DelegatedFree:    alias.DelegatedFree,
+		DelegatedVesting: alias.DelegatedVesting,
+		EndTime:          alias.EndTime,
+	}
+	va.FunderAddress = alias.FunderAddress
+	va.StartTime = alias.StartTime
+	va.LockupPeriods = alias.LockupPeriods
+	va.VestingPeriods = alias.VestingPeriods
+
+	return nil
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
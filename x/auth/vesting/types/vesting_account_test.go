@@ -578,6 +578,112 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, funder := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(18*time.Hour).Unix(), va.GetEndTime())
+	require.NoError(t, va.Validate())
+
+	// require no coins vested in the very beginning of the vesting schedule
+	require.True(t, va.GetVestedCoins(now).IsZero())
+	require.Equal(t, origCoins, va.LockedCoins(now))
+
+	// require no coins vested after the first vesting period while locked up
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedOnly(now.Add(6*time.Hour)))
+	require.True(t, va.GetVestedCoins(now.Add(6*time.Hour)).IsZero())
+
+	// require 50% of coins vested once the lockup ends
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.LockedCoins(now.Add(12*time.Hour)))
+
+	// require 100% of coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(18*time.Hour)))
+	require.True(t, va.LockedCoins(now.Add(18*time.Hour)).IsZero())
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}},
+	}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, funder := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// claw back right after the first vesting period
+	updated, clawback := va.ComputeClawback(now.Add(7 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)}, updated.OriginalVesting)
+	require.Len(t, updated.VestingPeriods, 1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)}, updated.LockupPeriods[0].Amount)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), updated.EndTime)
+	require.NoError(t, updated.Validate())
+
+	// the original account must be left untouched
+	require.Equal(t, origCoins, va.OriginalVesting)
+	require.Len(t, va.VestingPeriods, 3)
+
+	// the vested coins are still subject to lockup
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)}, updated.LockedCoins(now.Add(7*time.Hour)))
+	require.True(t, updated.LockedCoins(now.Add(12*time.Hour)).IsZero())
+
+	// nothing is clawed back once everything vested
+	updated, clawback = va.ComputeClawback(now.Add(24 * time.Hour).Unix())
+	require.True(t, clawback.IsZero())
+	require.Equal(t, origCoins, updated.OriginalVesting)
+}
+
+func TestTrackDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, funder := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	// require the ability to delegate all vesting coins
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods, periods)
+	va.TrackDelegation(now, origCoins, origCoins)
+	require.Equal(t, origCoins, va.DelegatedVesting)
+	require.True(t, va.DelegatedFree.Empty())
+
+	// require the ability to delegate all vested coins
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods, periods)
+	va.TrackDelegation(now.Add(24*time.Hour), origCoins, origCoins)
+	require.True(t, va.DelegatedVesting.Empty())
+	require.Equal(t, origCoins, va.DelegatedFree)
+
+	// require the ability to delegate half vested and half vesting coins
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods, periods)
+	va.TrackDelegation(now.Add(12*time.Hour), origCoins, origCoins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedFree)
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -626,6 +732,27 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(50), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting account without funder",
+			types.NewClawbackVestingAccount(baseAcc, nil, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
+		{
+			"invalid clawback vesting lockup amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
 		{
 			"invalid vesting period amounts",
 			types.NewPeriodicVestingAccountRaw(
@@ -788,3 +915,46 @@ func TestDelayedVestingAccountJSON(t *testing.T) {
 	require.NoError(t, json.Unmarshal(bz, &a))
 	require.Equal(t, acc.String(), a.String())
 }
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+	periods := types.Periods{types.Period{3600, coins}}
+
+	acc := types.NewClawbackVestingAccount(baseAcc, addr, coins, time.Now().Unix(), periods, periods)
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
+
+func TestClawbackVestingAccountJSON(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+	periods := types.Periods{types.Period{3600, coins}}
+
+	acc := types.NewClawbackVestingAccount(baseAcc, addr, coins, time.Now().Unix(), periods, periods)
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	bz1, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(bz1), string(bz))
+
+	var a types.ClawbackVestingAccount
+	require.NoError(t, json.Unmarshal(bz, &a))
+	require.Equal(t, acc.String(), a.String())
+}
@@ -14,6 +14,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -907,6 +908,61 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins() {
 	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addrModule).Empty())
 }
 
+func (suite *IntegrationTestSuite) TestDelegateCoins_TrackVestingDelegation() {
+	app, ctx := suite.app, suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
+	macc := app.AccountKeeper.NewAccountWithAddress(ctx, addrModule) // we don't need to define an actual module account bc we just need the address for testing
+	app.AccountKeeper.SetAccount(ctx, macc)
+
+	testCases := map[string]func(*authtypes.BaseAccount) vestexported.VestingAccount{
+		"continuous": func(bacc *authtypes.BaseAccount) vestexported.VestingAccount {
+			return vesting.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+		},
+		"delayed": func(bacc *authtypes.BaseAccount) vestexported.VestingAccount {
+			return vesting.NewDelayedVestingAccount(bacc, origCoins, endTime.Unix())
+		},
+		"periodic": func(bacc *authtypes.BaseAccount) vestexported.VestingAccount {
+			periods := vesting.Periods{
+				{Length: 12 * 60 * 60, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+				{Length: 12 * 60 * 60, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+			}
+			return vesting.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+		},
+		"clawback": func(bacc *authtypes.BaseAccount) vestexported.VestingAccount {
+			periods := vesting.Periods{{Length: 24 * 60 * 60, Amount: origCoins}}
+			return vesting.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now.Unix(), periods, periods)
+		},
+	}
+
+	for name, newVestingAccount := range testCases {
+		addr := sdk.AccAddress([]byte("addr_" + name))
+		app.AccountKeeper.SetAccount(ctx, newVestingAccount(authtypes.NewBaseAccountWithAddress(addr)))
+		suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, origCoins))
+
+		// the delegation tracking must be stored with the account
+		suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr, addrModule, delCoins), name)
+
+		vacc, ok := app.AccountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+		suite.Require().True(ok, name)
+		suite.Require().Equal(delCoins, vacc.GetDelegatedVesting(), name)
+		suite.Require().True(vacc.GetDelegatedFree().Empty(), name)
+
+		suite.Require().NoError(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr, delCoins), name)
+
+		vacc, ok = app.AccountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+		suite.Require().True(ok, name)
+		suite.Require().True(vacc.GetDelegatedVesting().Empty(), name)
+		suite.Require().True(vacc.GetDelegatedFree().Empty(), name)
+	}
+}
+
 func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
 	app, ctx := suite.app, suite.ctx
 
@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// GetQueryCmd returns the parent command for all x/capability CLI query
+// commands. The provided clientCtx should have, at a minimum, a verifier,
+// Tendermint RPC client, and marshaler set.
+func GetQueryCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the capability module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryCapabilities(clientCtx),
+		GetCmdQueryModuleCapabilities(clientCtx),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryCapabilities implements the command to query all the persisted
+// capabilities along with their owners.
+func GetCmdQueryCapabilities(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capabilities",
+		Short: "Query all the capabilities with their owners",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the persisted capabilities with their index and the names and
+modules owning them.
+
+Example:
+  $ %s query %s capabilities --page=1 --limit=50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Capabilities(context.Background(), &types.QueryCapabilitiesRequest{Req: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Capabilities)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of capabilities to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of capabilities to query for")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetCmdQueryModuleCapabilities implements the command to query the persisted
+// capabilities owned by a module.
+func GetCmdQueryModuleCapabilities(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-capabilities [module]",
+		Short: "Query the capabilities owned by a module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the persisted capabilities owned by a module, along with all their
+owners.
+
+Example:
+  $ %s query %s module-capabilities transfer
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleCapabilities(
+				context.Background(), &types.QueryModuleCapabilitiesRequest{Module: args[0], Req: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Capabilities)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of capabilities to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of capabilities to query for")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// readPageRequest builds an offset based page request from the page and limit
+// flags.
+func readPageRequest(cmd *cobra.Command) (*query.PageRequest, error) {
+	page, err := cmd.Flags().GetInt(flags.FlagPage)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := cmd.Flags().GetInt(flags.FlagLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if page < 1 || limit < 1 {
+		return nil, fmt.Errorf("page and limit must be positive, got %d and %d", page, limit)
+	}
+
+	return &query.PageRequest{Offset: uint64((page - 1) * limit), Limit: uint64(limit)}, nil
+}
@@ -23,18 +23,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	index := k.GetLatestIndex(ctx)
 	owners := []types.GenesisOwners{}
 
-	for i := uint64(1); i < index; i++ {
-		capabilityOwners, ok := k.GetOwners(ctx, i)
-		if !ok || len(capabilityOwners.Owners) == 0 {
-			continue
+	k.IterateOwners(ctx, func(i uint64, capabilityOwners types.CapabilityOwners) bool {
+		if len(capabilityOwners.Owners) == 0 {
+			return false
 		}
 
-		genOwner := types.GenesisOwners{
+		owners = append(owners, types.GenesisOwners{
 			Index:  i,
 			Owners: capabilityOwners,
-		}
-		owners = append(owners, genOwner)
-	}
+		})
+		return false
+	})
 
 	return types.GenesisState{
 		Index:  index,
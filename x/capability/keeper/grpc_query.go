@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Capabilities implements the Query/Capabilities gRPC method
+func (k Keeper) Capabilities(c context.Context, req *types.QueryCapabilitiesRequest) (*types.QueryCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	capabilities := []types.IdentifiedCapability{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	res, err := query.Paginate(prefixStore, req.Req, func(key []byte, value []byte) error {
+		var owners types.CapabilityOwners
+		if err := k.cdc.UnmarshalBinaryBare(value, &owners); err != nil {
+			return err
+		}
+
+		capabilities = append(capabilities, types.NewIdentifiedCapability(types.IndexFromKey(key), owners))
+		return nil
+	})
+
+	if err != nil {
+		return &types.QueryCapabilitiesResponse{}, err
+	}
+
+	return &types.QueryCapabilitiesResponse{Capabilities: capabilities, Res: res}, nil
+}
+
+// ModuleCapabilities implements the Query/ModuleCapabilities gRPC method
+func (k Keeper) ModuleCapabilities(c context.Context, req *types.QueryModuleCapabilitiesRequest) (*types.QueryModuleCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Module) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid module")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	capabilities := []types.IdentifiedCapability{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	res, err := query.FilteredPaginate(prefixStore, req.Req, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var owners types.CapabilityOwners
+		if err := k.cdc.UnmarshalBinaryBare(value, &owners); err != nil {
+			return false, err
+		}
+
+		if !owners.HasModule(req.Module) {
+			return false, nil
+		}
+
+		if accumulate {
+			capabilities = append(capabilities, types.NewIdentifiedCapability(types.IndexFromKey(key), owners))
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return &types.QueryModuleCapabilitiesResponse{}, err
+	}
+
+	return &types.QueryModuleCapabilitiesResponse{Capabilities: capabilities, Res: res}, nil
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryCapabilities() {
+	bankSK := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	stakingSK := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx)
+	types.RegisterQueryServer(queryHelper, *suite.keeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.ModuleCapabilities(gocontext.Background(), &types.QueryModuleCapabilitiesRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.Capabilities(gocontext.Background(), &types.QueryCapabilitiesRequest{})
+	suite.Require().NoError(err)
+	prevCount := len(res.Capabilities)
+
+	cap1, err := bankSK.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	cap2, err := bankSK.NewCapability(suite.ctx, "send")
+	suite.Require().NoError(err)
+	suite.Require().NoError(stakingSK.ClaimCapability(suite.ctx, cap2, "delegate"))
+
+	res, err = queryClient.Capabilities(gocontext.Background(), &types.QueryCapabilitiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Capabilities, prevCount+2)
+	suite.Require().Equal(types.IdentifiedCapability{
+		Index:  cap2.GetIndex(),
+		Owners: []types.Owner{types.NewOwner(banktypes.ModuleName, "send"), types.NewOwner(stakingtypes.ModuleName, "delegate")},
+	}, res.Capabilities[len(res.Capabilities)-1])
+
+	modRes, err := queryClient.ModuleCapabilities(
+		gocontext.Background(), &types.QueryModuleCapabilitiesRequest{Module: banktypes.ModuleName},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(modRes.Capabilities, 2)
+	suite.Require().Equal(cap1.GetIndex(), modRes.Capabilities[0].Index)
+	suite.Require().Equal(cap2.GetIndex(), modRes.Capabilities[1].Index)
+
+	modRes, err = queryClient.ModuleCapabilities(
+		gocontext.Background(), &types.QueryModuleCapabilitiesRequest{Module: stakingtypes.ModuleName},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(modRes.Capabilities, 1)
+	suite.Require().Equal(cap2.GetIndex(), modRes.Capabilities[0].Index)
+}
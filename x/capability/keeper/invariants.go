@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// RegisterInvariants registers the capability module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "memory-store", MemStoreInvariant(k))
+}
+
+// AllInvariants runs all invariants of the capability module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return MemStoreInvariant(k)(ctx)
+	}
+}
+
+// MemStoreInvariant checks that the in-memory capabilities, built by
+// InitializeAndSeal and maintained by the scoped keepers, match the persisted
+// capability owners: every persisted owner must have its in-memory capability
+// and forward and reverse mappings, and every in-memory mapping must belong to
+// a persisted owner.
+func MemStoreInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		memStore := ctx.KVStore(k.memKey)
+
+		k.IterateOwners(ctx, func(index uint64, owners types.CapabilityOwners) bool {
+			cap := k.capMap[index]
+			if cap == nil {
+				count++
+				msg += fmt.Sprintf("\tcapability %d has no in-memory reference\n", index)
+			}
+
+			for _, owner := range owners.Owners {
+				revIndex := memStore.Get(types.RevCapabilityKey(owner.Module, owner.Name))
+				if revIndex == nil || sdk.BigEndianToUint64(revIndex) != index {
+					count++
+					msg += fmt.Sprintf("\tcapability %d has no reverse mapping for owner %s\n", index, owner.Key())
+				}
+
+				if cap != nil && string(memStore.Get(types.FwdCapabilityKey(owner.Module, cap))) != owner.Name {
+					count++
+					msg += fmt.Sprintf("\tcapability %d has no forward mapping for owner %s\n", index, owner.Key())
+				}
+			}
+
+			return false
+		})
+
+		iterator := memStore.Iterator(nil, nil)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			// in-memory keys are either <module>/rev/<name> or <module>/fwd/<reference>
+			parts := strings.SplitN(string(iterator.Key()), "/", 3)
+			if len(parts) != 3 {
+				continue
+			}
+
+			var name string
+			switch parts[1] {
+			case "rev":
+				name = parts[2]
+			case "fwd":
+				name = string(iterator.Value())
+			default:
+				continue
+			}
+
+			owner := types.NewOwner(parts[0], name)
+			index := sdk.BigEndianToUint64(memStore.Get(types.RevCapabilityKey(owner.Module, owner.Name)))
+
+			owners, ok := k.GetOwners(ctx, index)
+			if _, found := owners.Get(owner); !ok || !found {
+				count++
+				msg += fmt.Sprintf("\tin-memory mapping %s has no persisted owner %s\n", iterator.Key(), owner.Key())
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "memory-store",
+			fmt.Sprintf("inconsistent in-memory capabilities found %d\n%s", count, msg),
+		), broken
+	}
+}
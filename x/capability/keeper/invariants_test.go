@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestMemStoreInvariant() {
+	bankSK := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	stakingSK := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	// load the capabilities persisted at genesis into the keeper
+	suite.keeper.InitializeAndSeal(suite.ctx)
+
+	invariant := keeper.MemStoreInvariant(*suite.keeper)
+	_, broken := invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	cap, err := bankSK.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(stakingSK.ClaimCapability(suite.ctx, cap, "delegate"))
+
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	suite.Require().NoError(stakingSK.ReleaseCapability(suite.ctx, cap))
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// a missing reverse mapping breaks the invariant
+	memStore := suite.ctx.KVStore(suite.app.GetMemKey(types.MemStoreKey))
+	cacheCtx, _ := suite.ctx.CacheContext()
+	cacheCtx.KVStore(suite.app.GetMemKey(types.MemStoreKey)).Delete(types.RevCapabilityKey(banktypes.ModuleName, "transfer"))
+
+	_, broken = invariant(cacheCtx)
+	suite.Require().True(broken)
+
+	// an in-memory mapping without persisted owner breaks the invariant
+	memStore.Set(types.RevCapabilityKey(stakingtypes.ModuleName, "delegate"), sdk.Uint64ToBigEndian(cap.GetIndex()))
+
+	_, broken = invariant(suite.ctx)
+	suite.Require().True(broken)
+}
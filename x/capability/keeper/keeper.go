@@ -155,6 +155,24 @@ func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners
 	return owners, true
 }
 
+// IterateOwners iterates through the persisted capability owners in ascending
+// capability index order.
+func (k Keeper) IterateOwners(ctx sdk.Context, cb func(index uint64, owners types.CapabilityOwners) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+	iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var owners types.CapabilityOwners
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &owners)
+
+		if cb(types.IndexFromKey(iterator.Key()), owners) {
+			break
+		}
+	}
+}
+
 // InitializeCapability takes in an index and an owners array. It creates the capability in memory
 // and sets the fwd and reverse keys for each owner in the memstore
 func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types.CapabilityOwners) {
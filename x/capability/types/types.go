@@ -85,3 +85,21 @@ func (co *CapabilityOwners) Get(owner Owner) (int, bool) {
 
 	return i, false
 }
+
+// HasModule returns true if the given module owns the capability under at least
+// one name.
+func (co CapabilityOwners) HasModule(module string) bool {
+	for _, owner := range co.Owners {
+		if owner.Module == module {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewIdentifiedCapability returns a new IdentifiedCapability for the given
+// capability index and owners.
+func NewIdentifiedCapability(index uint64, owners CapabilityOwners) IdentifiedCapability {
+	return IdentifiedCapability{Index: index, Owners: owners.Owners}
+}
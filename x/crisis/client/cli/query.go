@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+const flagRoute = "route"
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group crisis queries under a subcommand
+	crisisQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	crisisQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryInvariants(cdc),
+			GetCmdQueryBrokenInvariants(cdc),
+			GetCmdQueryVerifyInvariants(cdc),
+		)...,
+	)
+
+	return crisisQueryCmd
+}
+
+// GetCmdQueryInvariants implements the command to query the registered
+// invariants along with their policy.
+func GetCmdQueryInvariants(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "invariants",
+		Short: "Query the registered invariants and their policy",
+		Long: strings.TrimSpace(`Query the registered invariants along with the policy applied when they are broken
+(halt, log or disabled):
+
+$ <appcli> query crisis invariants
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariants)
+			res, _, err := clientCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var invariants []types.InvariantInfo
+			cdc.MustUnmarshalJSON(res, &invariants)
+			return clientCtx.PrintOutput(invariants)
+		},
+	}
+}
+
+// GetCmdQueryBrokenInvariants implements the command to query the recorded
+// occurrences of broken invariants.
+func GetCmdQueryBrokenInvariants(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broken-invariants",
+		Short: "Query the recorded occurrences of broken invariants",
+		Long: strings.TrimSpace(`Query the occurrences of broken invariants which did not halt the chain, optionally
+restricted to a single invariant:
+
+$ <appcli> query crisis broken-invariants --route=bank/total-supply
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			params := types.NewQueryBrokenInvariantsParams(
+				viper.GetString(flagRoute), viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit),
+			)
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBrokenInvariants)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var brokenInvariants []types.BrokenInvariant
+			cdc.MustUnmarshalJSON(res, &brokenInvariants)
+			return clientCtx.PrintOutput(brokenInvariants)
+		},
+	}
+
+	cmd.Flags().String(flagRoute, "", "(optional) filter by the full route of an invariant, e.g. bank/total-supply")
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of broken invariants to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of broken invariants to query for")
+
+	return cmd
+}
+
+// GetCmdQueryVerifyInvariants implements the command to verify invariants in a
+// query-only mode.
+func GetCmdQueryVerifyInvariants(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-invariants [route...]",
+		Short: "Verify invariants against the latest state without submitting a transaction",
+		Long: strings.TrimSpace(`Verify the given invariants, or all the registered invariants if none is given, against
+the latest state. No constant fee is charged and the chain is never halted, whatever the
+policy of the invariants is:
+
+$ <appcli> query crisis verify-invariants bank/total-supply staking/module-accounts
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryVerifyInvariantsParams(args))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryVerifyInvariants)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var results []types.InvariantResult
+			cdc.MustUnmarshalJSON(res, &results)
+			return clientCtx.PrintOutput(results)
+		},
+	}
+}
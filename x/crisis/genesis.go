@@ -9,10 +9,18 @@ import (
 // new crisis genesis
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
 	keeper.SetConstantFee(ctx, data.ConstantFee)
+	keeper.SetInvariantPolicies(ctx, data.InvariantPolicies)
+
+	for _, bi := range data.BrokenInvariants {
+		keeper.SetBrokenInvariant(ctx, bi)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	constantFee := keeper.GetConstantFee(ctx)
-	return types.NewGenesisState(constantFee)
+	invariantPolicies := keeper.GetInvariantPolicies(ctx)
+	brokenInvariants := keeper.GetBrokenInvariants(ctx, "")
+
+	return types.NewGenesisState(constantFee, invariantPolicies, brokenInvariants)
 }
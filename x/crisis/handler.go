@@ -31,25 +31,24 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg *types.MsgVerifyInvariant, k
 		return nil, err
 	}
 
-	// use a cached context to avoid gas costs during invariants
-	cacheCtx, _ := ctx.CacheContext()
-
-	found := false
-	msgFullRoute := msg.FullInvariantRoute()
-
-	var res string
-	var stop bool
-	for _, invarRoute := range k.Routes() {
-		if invarRoute.FullRoute() == msgFullRoute {
-			res, stop = invarRoute.Invar(cacheCtx)
-			found = true
+	invarRoute, found := k.GetRoute(msg.FullInvariantRoute())
+	if !found {
+		return nil, types.ErrUnknownInvariant
+	}
 
-			break
-		}
+	policy := k.GetInvariantPolicy(ctx, invarRoute.FullRoute())
+	if policy == types.PolicyDisabled {
+		return nil, sdkerrors.Wrap(types.ErrInvariantDisabled, invarRoute.FullRoute())
 	}
 
-	if !found {
-		return nil, types.ErrUnknownInvariant
+	// use a cached context to avoid gas costs during invariants
+	cacheCtx, _ := ctx.CacheContext()
+	res, stop := invarRoute.Invar(cacheCtx)
+
+	if stop && policy == types.PolicyLog {
+		// the invariant is not critical, record it instead of halting the chain
+		k.HandleBrokenInvariant(ctx, invarRoute.FullRoute(), res)
+		stop = false
 	}
 
 	if stop {
@@ -120,3 +120,31 @@ func TestHandleMsgVerifyInvariantWithInvariantBrokenAndNotEnoughPoolCoins(t *tes
 		res, _ = h(ctx, msg)
 	}, fmt.Sprintf("%v", res))
 }
+
+func TestHandleMsgVerifyInvariantWithPolicies(t *testing.T) {
+	app, ctx, addrs := createTestApp()
+	sender := addrs[0]
+	h := crisis.NewHandler(app.CrisisKeeper)
+
+	// a broken invariant with the log policy is recorded instead of halting
+	app.CrisisKeeper.SetInvariantPolicies(ctx, []types.InvariantPolicyEntry{
+		types.NewInvariantPolicyEntry(dummyRouteWhichFails.FullRoute(), types.PolicyLog),
+	})
+
+	res, err := h(ctx, types.NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichFails.Route))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t,
+		[]types.BrokenInvariant{types.NewBrokenInvariant(dummyRouteWhichFails.FullRoute(), ctx.BlockHeight(), "whoops")},
+		app.CrisisKeeper.GetBrokenInvariants(ctx, ""),
+	)
+
+	// a disabled invariant cannot be verified
+	app.CrisisKeeper.SetInvariantPolicies(ctx, []types.InvariantPolicyEntry{
+		types.NewInvariantPolicyEntry(dummyRouteWhichFails.FullRoute(), types.PolicyDisabled),
+	})
+
+	res, err = h(ctx, types.NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichFails.Route))
+	require.True(t, types.ErrInvariantDisabled.Is(err))
+	require.Nil(t, res)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// GetBrokenInvariant returns the record of a broken invariant.
+func (k Keeper) GetBrokenInvariant(ctx sdk.Context, route string) (types.BrokenInvariant, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetBrokenInvariantKey(route))
+	if bz == nil {
+		return types.BrokenInvariant{}, false
+	}
+
+	var bi types.BrokenInvariant
+	k.cdc.MustUnmarshalBinaryBare(bz, &bi)
+
+	return bi, true
+}
+
+// SetBrokenInvariant sets the record of a broken invariant.
+func (k Keeper) SetBrokenInvariant(ctx sdk.Context, bi types.BrokenInvariant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&bi)
+	store.Set(types.GetBrokenInvariantKey(bi.Route), bz)
+}
+
+// IterateBrokenInvariants iterates through the broken invariant records in
+// ascending route order.
+func (k Keeper) IterateBrokenInvariants(ctx sdk.Context, fn func(bi types.BrokenInvariant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BrokenInvariantKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bi types.BrokenInvariant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bi)
+
+		if fn(bi) {
+			break
+		}
+	}
+}
+
+// GetBrokenInvariants returns the broken invariant records. If a route is
+// given, only the record of the corresponding invariant is returned.
+func (k Keeper) GetBrokenInvariants(ctx sdk.Context, route string) []types.BrokenInvariant {
+	brokenInvariants := []types.BrokenInvariant{}
+	if route != "" {
+		if bi, found := k.GetBrokenInvariant(ctx, route); found {
+			brokenInvariants = append(brokenInvariants, bi)
+		}
+
+		return brokenInvariants
+	}
+
+	k.IterateBrokenInvariants(ctx, func(bi types.BrokenInvariant) (stop bool) {
+		brokenInvariants = append(brokenInvariants, bi)
+		return false
+	})
+
+	return brokenInvariants
+}
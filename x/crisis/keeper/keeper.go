@@ -6,6 +6,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -13,6 +14,8 @@ import (
 
 // Keeper - crisis keeper
 type Keeper struct {
+	cdc            codec.Marshaler
+	storeKey       sdk.StoreKey
 	routes         []types.InvarRoute
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
@@ -24,7 +27,7 @@ type Keeper struct {
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(
-	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
+	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
 	feeCollectorName string,
 ) Keeper {
 
@@ -34,6 +37,8 @@ func NewKeeper(
 	}
 
 	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
 		routes:           make([]types.InvarRoute, 0),
 		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
@@ -67,8 +72,10 @@ func (k Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
-// AssertInvariants asserts all registered invariants. If any invariant fails,
-// the method panics.
+// AssertInvariants asserts all registered invariants according to their
+// policy. If an invariant with the halt policy fails, the method panics.
+// Broken invariants with the log policy are recorded instead and disabled
+// invariants are skipped.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
@@ -76,7 +83,17 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	invarRoutes := k.Routes()
 
 	for _, ir := range invarRoutes {
+		policy := k.GetInvariantPolicy(ctx, ir.FullRoute())
+		if policy == types.PolicyDisabled {
+			continue
+		}
+
 		if res, stop := ir.Invar(ctx); stop {
+			if policy == types.PolicyLog {
+				k.HandleBrokenInvariant(ctx, ir.FullRoute(), res)
+				continue
+			}
+
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
 			panic(fmt.Errorf("invariant broken: %s\n"+
@@ -91,6 +108,42 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// HandleBrokenInvariant records the occurrence of a broken invariant which does
+// not halt the chain and emits the corresponding event. A single record is kept
+// per invariant, holding its first and latest occurrences along with their
+// count, so that an invariant broken at every check does not grow the state.
+func (k Keeper) HandleBrokenInvariant(ctx sdk.Context, route, msg string) {
+	k.Logger(ctx).Error("invariant broken", "route", route, "height", ctx.BlockHeight(), "msg", msg)
+
+	bi, found := k.GetBrokenInvariant(ctx, route)
+	if found {
+		bi = bi.Record(ctx.BlockHeight(), msg)
+	} else {
+		bi = types.NewBrokenInvariant(route, ctx.BlockHeight(), msg)
+	}
+
+	k.SetBrokenInvariant(ctx, bi)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInvariantBroken,
+			sdk.NewAttribute(types.AttributeKeyRoute, route),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+}
+
+// GetRoute returns the registered invariant route with the given full route.
+func (k Keeper) GetRoute(fullRoute string) (types.InvarRoute, bool) {
+	for _, ir := range k.routes {
+		if ir.FullRoute() == fullRoute {
+			return ir, true
+		}
+	}
+
+	return types.InvarRoute{}, false
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -43,3 +44,46 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestAssertInvariantsWithPolicies(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(false, abci.Header{Height: 10})
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "broken 1", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken 2", true })
+	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+
+	app.CrisisKeeper.SetInvariantPolicies(ctx, []types.InvariantPolicyEntry{
+		types.NewInvariantPolicyEntry("testModule/testRoute1", types.PolicyLog),
+		types.NewInvariantPolicyEntry("testModule/testRoute2", types.PolicyDisabled),
+	})
+	require.Equal(t, types.PolicyLog, app.CrisisKeeper.GetInvariantPolicy(ctx, "testModule/testRoute1"))
+	require.Equal(t, types.PolicyHalt, app.CrisisKeeper.GetInvariantPolicy(ctx, "bank/total-supply"))
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+
+	// only the invariant with the log policy is recorded
+	expected := []types.BrokenInvariant{types.NewBrokenInvariant("testModule/testRoute1", 10, "broken 1")}
+	require.Equal(t, expected, app.CrisisKeeper.GetBrokenInvariants(ctx, ""))
+	require.Equal(t, expected, app.CrisisKeeper.GetBrokenInvariants(ctx, "testModule/testRoute1"))
+	require.Empty(t, app.CrisisKeeper.GetBrokenInvariants(ctx, "testModule/testRoute2"))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeInvariantBroken, events[0].Type)
+
+	// later occurrences update the record of the invariant
+	ctx = ctx.WithBlockHeight(11)
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+	ctx = ctx.WithBlockHeight(12)
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+
+	bi, found := app.CrisisKeeper.GetBrokenInvariant(ctx, "testModule/testRoute1")
+	require.True(t, found)
+	require.Equal(t, int64(10), bi.FirstHeight)
+	require.Equal(t, int64(12), bi.LastHeight)
+	require.Equal(t, uint64(3), bi.Count)
+	require.Len(t, app.CrisisKeeper.GetBrokenInvariants(ctx, ""), 1)
+}
@@ -15,3 +15,26 @@ func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
 	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
 }
+
+// GetInvariantPolicies get's the invariant policies from the paramSpace
+func (k Keeper) GetInvariantPolicies(ctx sdk.Context) (policies []types.InvariantPolicyEntry) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyInvariantPolicies, &policies)
+	return
+}
+
+// SetInvariantPolicies set's the invariant policies in the paramSpace
+func (k Keeper) SetInvariantPolicies(ctx sdk.Context, policies []types.InvariantPolicyEntry) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyInvariantPolicies, policies)
+}
+
+// GetInvariantPolicy returns the policy of the invariant with the given full
+// route. Invariants without a policy halt the chain when broken.
+func (k Keeper) GetInvariantPolicy(ctx sdk.Context, route string) types.InvariantPolicy {
+	for _, entry := range k.GetInvariantPolicies(ctx) {
+		if entry.Route == route {
+			return entry.Policy
+		}
+	}
+
+	return types.PolicyHalt
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// NewQuerier creates a new querier for crisis clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryInvariants:
+			return queryInvariants(ctx, k)
+
+		case types.QueryBrokenInvariants:
+			return queryBrokenInvariants(ctx, req, k)
+
+		case types.QueryVerifyInvariants:
+			return queryVerifyInvariants(ctx, req, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func queryInvariants(ctx sdk.Context, k Keeper) ([]byte, error) {
+	invariants := make([]types.InvariantInfo, len(k.Routes()))
+	for i, ir := range k.Routes() {
+		invariants[i] = types.NewInvariantInfo(ir.FullRoute(), k.GetInvariantPolicy(ctx, ir.FullRoute()))
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, invariants)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryBrokenInvariants(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBrokenInvariantsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	brokenInvariants := k.GetBrokenInvariants(ctx, params.Route)
+
+	start, end := client.Paginate(len(brokenInvariants), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		brokenInvariants = []types.BrokenInvariant{}
+	} else {
+		brokenInvariants = brokenInvariants[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, brokenInvariants)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryVerifyInvariants runs the requested invariants without charging the
+// constant fee nor halting the chain, whatever their policy is. The invariants
+// share a gas meter limited to QueryVerifyInvariantsGasLimit.
+func queryVerifyInvariants(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryVerifyInvariantsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	invarRoutes := k.Routes()
+	if len(params.Routes) > 0 {
+		invarRoutes = make([]types.InvarRoute, len(params.Routes))
+		for i, route := range params.Routes {
+			ir, found := k.GetRoute(route)
+			if !found {
+				return nil, sdkerrors.Wrap(types.ErrUnknownInvariant, route)
+			}
+			invarRoutes[i] = ir
+		}
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(types.QueryVerifyInvariantsGasLimit))
+
+	results := make([]types.InvariantResult, len(invarRoutes))
+	for i, ir := range invarRoutes {
+		cacheCtx, _ := ctx.CacheContext()
+		msg, broken, err := verifyInvariant(cacheCtx, ir)
+		if err != nil {
+			return nil, err
+		}
+
+		results[i] = types.NewInvariantResult(ir.FullRoute(), k.GetInvariantPolicy(ctx, ir.FullRoute()), broken, msg)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, results)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// verifyInvariant runs an invariant, returning an error if it runs out of gas.
+func verifyInvariant(ctx sdk.Context, ir types.InvarRoute) (msg string, broken bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "verifying %s: %s", ir.FullRoute(), oog.Descriptor)
+		}
+	}()
+
+	msg, broken = ir.Invar(ctx)
+	return msg, broken, nil
+}
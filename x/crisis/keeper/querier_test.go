@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestQueries(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, abci.Header{Height: 7})
+	cdc := codec.New()
+
+	app.CrisisKeeper.RegisterRoute("testModule", "passes", func(sdk.Context) (string, bool) { return "", false })
+	app.CrisisKeeper.RegisterRoute("testModule", "fails", func(sdk.Context) (string, bool) { return "whoops", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "expensive", func(ctx sdk.Context) (string, bool) {
+		ctx.GasMeter().ConsumeGas(types.QueryVerifyInvariantsGasLimit+1, "expensive")
+		return "", false
+	})
+	app.CrisisKeeper.SetInvariantPolicies(ctx, []types.InvariantPolicyEntry{
+		types.NewInvariantPolicyEntry("testModule/fails", types.PolicyLog),
+	})
+	app.CrisisKeeper.HandleBrokenInvariant(ctx, "testModule/fails", "whoops")
+
+	querier := keeper.NewQuerier(app.CrisisKeeper)
+
+	// invariants
+	bz, err := querier(ctx, []string{types.QueryInvariants}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var invariants []types.InvariantInfo
+	require.NoError(t, cdc.UnmarshalJSON(bz, &invariants))
+	require.Len(t, invariants, len(app.CrisisKeeper.Routes()))
+	require.Contains(t, invariants, types.NewInvariantInfo("testModule/passes", types.PolicyHalt))
+	require.Contains(t, invariants, types.NewInvariantInfo("testModule/fails", types.PolicyLog))
+
+	// broken invariants
+	req := abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryBrokenInvariantsParams("testModule/fails", 1, 10))}
+	bz, err = querier(ctx, []string{types.QueryBrokenInvariants}, req)
+	require.NoError(t, err)
+
+	var brokenInvariants []types.BrokenInvariant
+	require.NoError(t, cdc.UnmarshalJSON(bz, &brokenInvariants))
+	require.Equal(t, []types.BrokenInvariant{types.NewBrokenInvariant("testModule/fails", 7, "whoops")}, brokenInvariants)
+
+	// verify a subset of the invariants
+	req = abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryVerifyInvariantsParams([]string{"testModule/passes", "testModule/fails"}))}
+	bz, err = querier(ctx, []string{types.QueryVerifyInvariants}, req)
+	require.NoError(t, err)
+
+	var results []types.InvariantResult
+	require.NoError(t, cdc.UnmarshalJSON(bz, &results))
+	require.Equal(t, []types.InvariantResult{
+		types.NewInvariantResult("testModule/passes", types.PolicyHalt, false, ""),
+		types.NewInvariantResult("testModule/fails", types.PolicyLog, true, "whoops"),
+	}, results)
+
+	// the query does not record broken invariants
+	require.Len(t, app.CrisisKeeper.GetBrokenInvariants(ctx, ""), 1)
+
+	req = abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryVerifyInvariantsParams([]string{"testModule/unknown"}))}
+	_, err = querier(ctx, []string{types.QueryVerifyInvariants}, req)
+	require.True(t, types.ErrUnknownInvariant.Is(err))
+
+	// the invariants verified by the query are limited in gas
+	req = abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryVerifyInvariantsParams([]string{"testModule/fails", "testModule/expensive"}))}
+	_, err = querier(ctx, []string{types.QueryVerifyInvariants}, req)
+	require.True(t, sdkerrors.ErrOutOfGas.Is(err), err)
+
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
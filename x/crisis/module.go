@@ -62,8 +62,10 @@ func (b AppModuleBasic) GetTxCmd(clientCtx client.Context) *cobra.Command {
 	return cli.NewTxCmd(clientCtx)
 }
 
-// GetQueryCmd returns no root query command for the crisis module.
-func (AppModuleBasic) GetQueryCmd(clientCtx client.Context) *cobra.Command { return nil }
+// GetQueryCmd returns the root query command for the crisis module.
+func (AppModuleBasic) GetQueryCmd(clientCtx client.Context) *cobra.Command {
+	return cli.GetQueryCmd(clientCtx.Codec)
+}
 
 //____________________________________________________________________________
 
@@ -98,11 +100,15 @@ func (am AppModule) Route() sdk.Route {
 	return sdk.NewRoute(RouterKey, NewHandler(*am.keeper))
 }
 
-// QuerierRoute returns no querier route.
-func (AppModule) QuerierRoute() string { return "" }
+// QuerierRoute returns the crisis module's querier route name.
+func (AppModule) QuerierRoute() string {
+	return types.QuerierRoute
+}
 
-// NewQuerierHandler returns no sdk.Querier.
-func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+// NewQuerierHandler returns the crisis module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return keeper.NewQuerier(*am.keeper)
+}
 
 func (am AppModule) RegisterQueryService(grpc.Server) {}
 
@@ -25,6 +25,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InvariantPolicy defines how a broken invariant is handled by the crisis
+// module.
+type InvariantPolicy int32
+
+const (
+	// INVARIANT_POLICY_HALT defines a policy halting the chain when the invariant is broken.
+	PolicyHalt InvariantPolicy = 0
+	// INVARIANT_POLICY_LOG defines a policy recording the broken invariant and emitting an event.
+	PolicyLog InvariantPolicy = 1
+	// INVARIANT_POLICY_DISABLED defines a policy never checking the invariant.
+	PolicyDisabled InvariantPolicy = 2
+)
+
+var InvariantPolicy_name = map[int32]string{
+	0: "INVARIANT_POLICY_HALT",
+	1: "INVARIANT_POLICY_LOG",
+	2: "INVARIANT_POLICY_DISABLED",
+}
+
+var InvariantPolicy_value = map[string]int32{
+	"INVARIANT_POLICY_HALT":     0,
+	"INVARIANT_POLICY_LOG":      1,
+	"INVARIANT_POLICY_DISABLED": 2,
+}
+
+func (InvariantPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cc68222b2e6ddda9, []int{0}
+}
+
 // MsgVerifyInvariant - message struct to verify a particular invariance
 type MsgVerifyInvariant struct {
 	Sender              github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
@@ -86,32 +115,183 @@ func (m *MsgVerifyInvariant) GetInvariantRoute() string {
 	return ""
 }
 
+// InvariantPolicyEntry defines the policy of a registered invariant, identified
+// by its full route.
+type InvariantPolicyEntry struct {
+	Route  string          `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Policy InvariantPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=cosmos.crisis.InvariantPolicy" json:"policy,omitempty"`
+}
+
+func (m *InvariantPolicyEntry) Reset()      { *m = InvariantPolicyEntry{} }
+func (*InvariantPolicyEntry) ProtoMessage() {}
+func (*InvariantPolicyEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc68222b2e6ddda9, []int{1}
+}
+func (m *InvariantPolicyEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantPolicyEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantPolicyEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantPolicyEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantPolicyEntry.Merge(m, src)
+}
+func (m *InvariantPolicyEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantPolicyEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantPolicyEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantPolicyEntry proto.InternalMessageInfo
+
+func (m *InvariantPolicyEntry) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantPolicyEntry) GetPolicy() InvariantPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return PolicyHalt
+}
+
+// BrokenInvariant defines the record of an invariant which was broken without
+// halting the chain. Only its first and latest occurrences are kept.
+type BrokenInvariant struct {
+	Route       string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	FirstHeight int64  `protobuf:"varint,2,opt,name=first_height,json=firstHeight,proto3" json:"first_height,omitempty" yaml:"first_height"`
+	LastHeight  int64  `protobuf:"varint,3,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty" yaml:"last_height"`
+	Count       uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// message returned by the invariant at its latest occurrence
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *BrokenInvariant) Reset()      { *m = BrokenInvariant{} }
+func (*BrokenInvariant) ProtoMessage() {}
+func (*BrokenInvariant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc68222b2e6ddda9, []int{2}
+}
+func (m *BrokenInvariant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BrokenInvariant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BrokenInvariant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BrokenInvariant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BrokenInvariant.Merge(m, src)
+}
+func (m *BrokenInvariant) XXX_Size() int {
+	return m.Size()
+}
+func (m *BrokenInvariant) XXX_DiscardUnknown() {
+	xxx_messageInfo_BrokenInvariant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BrokenInvariant proto.InternalMessageInfo
+
+func (m *BrokenInvariant) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *BrokenInvariant) GetFirstHeight() int64 {
+	if m != nil {
+		return m.FirstHeight
+	}
+	return 0
+}
+
+func (m *BrokenInvariant) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func (m *BrokenInvariant) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *BrokenInvariant) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.crisis.InvariantPolicy", InvariantPolicy_name, InvariantPolicy_value)
 	proto.RegisterType((*MsgVerifyInvariant)(nil), "cosmos.crisis.MsgVerifyInvariant")
+	proto.RegisterType((*InvariantPolicyEntry)(nil), "cosmos.crisis.InvariantPolicyEntry")
+	proto.RegisterType((*BrokenInvariant)(nil), "cosmos.crisis.BrokenInvariant")
 }
 
 func init() { proto.RegisterFile("cosmos/crisis/crisis.proto", fileDescriptor_cc68222b2e6ddda9) }
 
 var fileDescriptor_cc68222b2e6ddda9 = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2c, 0xce, 0x84, 0x51, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0xbc, 0x10, 0x39, 0x3d, 0x88, 0xa0, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x46, 0x1f,
-	0xc4, 0x82, 0x28, 0x52, 0x6a, 0x60, 0xe2, 0x12, 0xf2, 0x2d, 0x4e, 0x0f, 0x4b, 0x2d, 0xca, 0x4c,
-	0xab, 0xf4, 0xcc, 0x2b, 0x4b, 0x2c, 0xca, 0x4c, 0xcc, 0x2b, 0x11, 0xf2, 0xe4, 0x62, 0x2b, 0x4e,
-	0xcd, 0x4b, 0x49, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x71, 0x32, 0xfc, 0x75, 0x4f, 0x5e,
-	0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x66, 0x2d, 0x98, 0xd2,
-	0x2d, 0x4e, 0xc9, 0xd6, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x73, 0x4c, 0x4e, 0x76, 0x4c, 0x49,
-	0x29, 0x4a, 0x2d, 0x2e, 0x0e, 0x82, 0x1a, 0x20, 0x14, 0xc2, 0x25, 0x9a, 0x09, 0x33, 0x37, 0x3e,
-	0x37, 0x3f, 0xa5, 0x34, 0x27, 0x35, 0x3e, 0x2f, 0x31, 0x37, 0x55, 0x82, 0x49, 0x81, 0x51, 0x83,
-	0xd3, 0x49, 0xe1, 0xd3, 0x3d, 0x79, 0x99, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0xac, 0xca, 0x94,
-	0x82, 0x84, 0xe1, 0xe2, 0xbe, 0x60, 0x61, 0xbf, 0xc4, 0xdc, 0x54, 0x21, 0x67, 0x2e, 0x7e, 0x84,
-	0xf2, 0xa2, 0xfc, 0xd2, 0x92, 0x54, 0x09, 0x66, 0xb0, 0x79, 0x52, 0x9f, 0xee, 0xc9, 0x8b, 0xa1,
-	0x9b, 0x07, 0x56, 0xa0, 0x14, 0xc4, 0x07, 0x17, 0x09, 0x02, 0x09, 0x58, 0xb1, 0xbc, 0x58, 0x20,
-	0xcf, 0xe8, 0xe4, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xda, 0x78,
-	0x7d, 0x5c, 0x01, 0x0b, 0x75, 0xb0, 0xd7, 0x93, 0xd8, 0xc0, 0x01, 0x6a, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0xe1, 0xbb, 0x7c, 0x71, 0x93, 0x01, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xed, 0xe6, 0x07, 0xe4, 0x9a, 0x26, 0xd1, 0x35, 0x2d, 0xc6, 0x42, 0xb6, 0xe5, 0x85,
+	0x00, 0xaa, 0xa3, 0x82, 0x04, 0x52, 0x36, 0xbb, 0x89, 0x88, 0xa5, 0x34, 0xad, 0x4c, 0x54, 0x09,
+	0x96, 0xc8, 0xb1, 0xaf, 0x8e, 0x55, 0xdb, 0x17, 0xf9, 0x1c, 0x44, 0x36, 0x46, 0x94, 0x89, 0x91,
+	0x25, 0x52, 0x25, 0x2a, 0xc4, 0xc8, 0x9f, 0xc1, 0xd8, 0x09, 0x31, 0x45, 0x28, 0x59, 0x98, 0x33,
+	0x32, 0xa1, 0x9c, 0x9b, 0xa4, 0xa4, 0x15, 0x93, 0xfd, 0xbe, 0xef, 0xf3, 0xbe, 0xef, 0xde, 0xd3,
+	0x1d, 0xe0, 0x2d, 0x4c, 0x7c, 0x4c, 0xca, 0x56, 0xe8, 0x12, 0x77, 0xf1, 0x51, 0x7a, 0x21, 0x8e,
+	0x30, 0xdc, 0x8a, 0x73, 0x4a, 0x2c, 0xf2, 0x45, 0x07, 0x3b, 0x98, 0x66, 0xca, 0xf3, 0xbf, 0x18,
+	0x92, 0xdf, 0x6f, 0x00, 0x78, 0x48, 0x9c, 0x13, 0x14, 0xba, 0xa7, 0x03, 0x3d, 0x78, 0x6b, 0x86,
+	0xae, 0x19, 0x44, 0x50, 0x07, 0x69, 0x82, 0x02, 0x1b, 0x85, 0x1c, 0x2b, 0xb1, 0xa5, 0xac, 0xb6,
+	0xff, 0x67, 0x2c, 0xee, 0x39, 0x6e, 0xd4, 0xed, 0x77, 0x14, 0x0b, 0xfb, 0xe5, 0x45, 0x5b, 0xfa,
+	0xd9, 0x23, 0xf6, 0x59, 0x39, 0x1a, 0xf4, 0x10, 0x51, 0x54, 0xcb, 0x52, 0x6d, 0x3b, 0x44, 0x84,
+	0x18, 0x57, 0x06, 0xb0, 0x05, 0x76, 0xdc, 0x85, 0x6f, 0xdb, 0xc7, 0x76, 0xdf, 0x43, 0xed, 0xc0,
+	0xf4, 0x11, 0xb7, 0x21, 0xb1, 0xa5, 0x8c, 0x26, 0xcd, 0xc6, 0xe2, 0x83, 0x81, 0xe9, 0x7b, 0x15,
+	0xf9, 0x56, 0x4c, 0x36, 0xb6, 0x97, 0xfa, 0x21, 0x95, 0x9b, 0xa6, 0x8f, 0xe0, 0x01, 0xc8, 0xaf,
+	0xf0, 0x10, 0xf7, 0x23, 0xc4, 0x25, 0xa8, 0x1f, 0x3f, 0x1b, 0x8b, 0xbb, 0xeb, 0x7e, 0x14, 0x90,
+	0x8d, 0xdc, 0x52, 0x31, 0xe6, 0x42, 0x25, 0xf9, 0xfb, 0x5c, 0x64, 0xe5, 0x00, 0x14, 0x97, 0x83,
+	0x1f, 0x63, 0xcf, 0xb5, 0x06, 0xb5, 0x20, 0x0a, 0x07, 0xb0, 0x08, 0x52, 0xb1, 0xf1, 0x7c, 0x05,
+	0x19, 0x23, 0x0e, 0xe0, 0x73, 0x90, 0xee, 0x51, 0x88, 0x9e, 0x3f, 0xf7, 0x54, 0x50, 0xfe, 0x59,
+	0xb3, 0xb2, 0x66, 0x65, 0x5c, 0xd1, 0x95, 0xbb, 0x9f, 0xce, 0x45, 0x86, 0xf6, 0xfb, 0xc1, 0x82,
+	0xbc, 0x16, 0xe2, 0x33, 0x14, 0xac, 0xf6, 0x7d, 0x7b, 0xaf, 0x0a, 0xc8, 0x9e, 0xba, 0x21, 0x89,
+	0xda, 0x5d, 0xe4, 0x3a, 0xdd, 0x88, 0x76, 0x4c, 0x68, 0xf7, 0x66, 0x63, 0x71, 0x3b, 0x9e, 0xf0,
+	0x7a, 0x56, 0x36, 0x36, 0x69, 0x58, 0xa7, 0x11, 0x7c, 0x01, 0x36, 0x3d, 0x73, 0x55, 0x9a, 0xa0,
+	0xa5, 0xbb, 0xb3, 0xb1, 0x08, 0xe3, 0xd2, 0x6b, 0x49, 0xd9, 0x00, 0x9e, 0xb9, 0x2c, 0x2c, 0x82,
+	0x94, 0x85, 0xfb, 0x41, 0xc4, 0x25, 0x25, 0xb6, 0x94, 0x34, 0xe2, 0x00, 0x72, 0xe0, 0x8e, 0x8f,
+	0x08, 0x31, 0x1d, 0xc4, 0xa5, 0xe8, 0x11, 0x17, 0xe1, 0x6a, 0xb0, 0xc7, 0x5f, 0x58, 0x90, 0x5f,
+	0x1b, 0x1f, 0x3e, 0x02, 0x3b, 0x7a, 0xf3, 0x44, 0x35, 0x74, 0xb5, 0xd9, 0x6a, 0x1f, 0x1f, 0x35,
+	0xf4, 0x83, 0xd7, 0xed, 0xba, 0xda, 0x68, 0x15, 0x18, 0x3e, 0x37, 0x1c, 0x49, 0x20, 0xc6, 0xea,
+	0xa6, 0x17, 0xc1, 0x87, 0xa0, 0x78, 0x03, 0x6d, 0x1c, 0xbd, 0x2c, 0xb0, 0xfc, 0xd6, 0x70, 0x24,
+	0x65, 0x62, 0xb2, 0x81, 0x1d, 0xb8, 0x0f, 0xee, 0xdf, 0x00, 0xab, 0xfa, 0x2b, 0x55, 0x6b, 0xd4,
+	0xaa, 0x85, 0x0d, 0x1e, 0x0e, 0x47, 0x52, 0x2e, 0xa6, 0xab, 0x2e, 0x31, 0x3b, 0x1e, 0xb2, 0xf9,
+	0xec, 0x87, 0xcf, 0x02, 0xf3, 0xf5, 0x42, 0x60, 0xbe, 0x5d, 0x08, 0x8c, 0x56, 0xfb, 0x3e, 0x11,
+	0xd8, 0xcb, 0x89, 0xc0, 0xfe, 0x9a, 0x08, 0xec, 0xc7, 0xa9, 0xc0, 0x5c, 0x4e, 0x05, 0xe6, 0xe7,
+	0x54, 0x60, 0xde, 0x3c, 0xf9, 0xef, 0x1d, 0x7f, 0xb7, 0x78, 0x67, 0xf4, 0xb2, 0x77, 0xd2, 0xf4,
+	0x09, 0x3d, 0xfb, 0x3b, 0x00, 0xb2, 0x70, 0xc8, 0x98, 0x85, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgVerifyInvariant) Equal(that interface{}) bool {
@@ -144,6 +324,69 @@ func (this *MsgVerifyInvariant) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *InvariantPolicyEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InvariantPolicyEntry)
+	if !ok {
+		that2, ok := that.(InvariantPolicyEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Route != that1.Route {
+		return false
+	}
+	if this.Policy != that1.Policy {
+		return false
+	}
+	return true
+}
+func (this *BrokenInvariant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BrokenInvariant)
+	if !ok {
+		that2, ok := that.(BrokenInvariant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Route != that1.Route {
+		return false
+	}
+	if this.FirstHeight != that1.FirstHeight {
+		return false
+	}
+	if this.LastHeight != that1.LastHeight {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	return true
+}
 func (m *MsgVerifyInvariant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -188,6 +431,93 @@ func (m *MsgVerifyInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InvariantPolicyEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantPolicyEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantPolicyEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BrokenInvariant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BrokenInvariant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BrokenInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Count != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FirstHeight != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.FirstHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCrisis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCrisis(v)
 	base := offset
@@ -220,6 +550,48 @@ func (m *MsgVerifyInvariant) Size() (n int) {
 	return n
 }
 
+func (m *InvariantPolicyEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovCrisis(uint64(m.Policy))
+	}
+	return n
+}
+
+func (m *BrokenInvariant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	if m.FirstHeight != 0 {
+		n += 1 + sovCrisis(uint64(m.FirstHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovCrisis(uint64(m.LastHeight))
+	}
+	if m.Count != 0 {
+		n += 1 + sovCrisis(uint64(m.Count))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	return n
+}
+
 func sovCrisis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -377,6 +749,284 @@ func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InvariantPolicyEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantPolicyEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantPolicyEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= InvariantPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrisis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BrokenInvariant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BrokenInvariant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BrokenInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeight", wireType)
+			}
+			m.FirstHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrisis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCrisis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 
 // x/crisis module sentinel errors
 var (
-	ErrNoSender         = sdkerrors.Register(ModuleName, 2, "sender address is empty")
-	ErrUnknownInvariant = sdkerrors.Register(ModuleName, 3, "unknown invariant")
+	ErrNoSender          = sdkerrors.Register(ModuleName, 2, "sender address is empty")
+	ErrUnknownInvariant  = sdkerrors.Register(ModuleName, 3, "unknown invariant")
+	ErrInvariantDisabled = sdkerrors.Register(ModuleName, 4, "invariant is disabled")
 )
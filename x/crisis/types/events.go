@@ -2,8 +2,10 @@ package types
 
 // crisis module event types
 const (
-	EventTypeInvariant = "invariant"
+	EventTypeInvariant       = "invariant"
+	EventTypeInvariantBroken = "invariant_broken"
 
 	AttributeValueCrisis = ModuleName
 	AttributeKeyRoute    = "route"
+	AttributeKeyHeight   = "height"
 )
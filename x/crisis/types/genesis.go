@@ -8,20 +8,28 @@ import (
 
 // GenesisState - crisis genesis state
 type GenesisState struct {
-	ConstantFee sdk.Coin `json:"constant_fee" yaml:"constant_fee"`
+	ConstantFee       sdk.Coin               `json:"constant_fee" yaml:"constant_fee"`
+	InvariantPolicies []InvariantPolicyEntry `json:"invariant_policies" yaml:"invariant_policies"`
+	BrokenInvariants  []BrokenInvariant      `json:"broken_invariants" yaml:"broken_invariants"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(constantFee sdk.Coin) GenesisState {
+func NewGenesisState(
+	constantFee sdk.Coin, invariantPolicies []InvariantPolicyEntry, brokenInvariants []BrokenInvariant,
+) GenesisState {
 	return GenesisState{
-		ConstantFee: constantFee,
+		ConstantFee:       constantFee,
+		InvariantPolicies: invariantPolicies,
+		BrokenInvariants:  brokenInvariants,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		ConstantFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		ConstantFee:       sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		InvariantPolicies: []InvariantPolicyEntry{},
+		BrokenInvariants:  []BrokenInvariant{},
 	}
 }
 
@@ -30,5 +38,16 @@ func ValidateGenesis(data GenesisState) error {
 	if !data.ConstantFee.IsPositive() {
 		return fmt.Errorf("constant fee must be positive: %s", data.ConstantFee)
 	}
+
+	if err := validateInvariantPolicies(data.InvariantPolicies); err != nil {
+		return err
+	}
+
+	for _, bi := range data.BrokenInvariants {
+		if err := bi.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -3,4 +3,23 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// StoreKey is the store key string for crisis
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for crisis
+	QuerierRoute = ModuleName
 )
+
+// Keys for crisis store
+// Items are stored with the following key: values
+//
+// - 0x01<route_Bytes>: BrokenInvariant
+var (
+	BrokenInvariantKeyPrefix = []byte{0x01} // Prefix for broken invariant records
+)
+
+// GetBrokenInvariantKey returns the key of the record of a broken invariant.
+func GetBrokenInvariantKey(route string) []byte {
+	return append(BrokenInvariantKeyPrefix, []byte(route)...)
+}
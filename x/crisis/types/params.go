@@ -10,12 +10,15 @@ import (
 var (
 	// key for constant fee parameter
 	ParamStoreKeyConstantFee = []byte("ConstantFee")
+	// key for the invariant policies parameter
+	ParamStoreKeyInvariantPolicies = []byte("InvariantPolicies")
 )
 
 // type declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable(
 		paramtypes.NewParamSetPair(ParamStoreKeyConstantFee, sdk.Coin{}, validateConstantFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyInvariantPolicies, []InvariantPolicyEntry{}, validateInvariantPolicies),
 	)
 }
 
@@ -31,3 +34,24 @@ func validateConstantFee(i interface{}) error {
 
 	return nil
 }
+
+func validateInvariantPolicies(i interface{}) error {
+	v, ok := i.([]InvariantPolicyEntry)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, entry := range v {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+
+		if seen[entry.Route] {
+			return fmt.Errorf("duplicate policy for invariant %s", entry.Route)
+		}
+		seen[entry.Route] = true
+	}
+
+	return nil
+}
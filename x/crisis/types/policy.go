@@ -0,0 +1,173 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// InvariantPolicyFromString returns an InvariantPolicy from a string. It
+// returns an error if the string is invalid.
+func InvariantPolicyFromString(str string) (InvariantPolicy, error) {
+	switch str {
+	case "halt":
+		return PolicyHalt, nil
+
+	case "log":
+		return PolicyLog, nil
+
+	case "disabled":
+		return PolicyDisabled, nil
+
+	default:
+		return InvariantPolicy(0xff), fmt.Errorf("'%s' is not a valid invariant policy", str)
+	}
+}
+
+// ValidInvariantPolicy returns true if the invariant policy is valid and false
+// otherwise.
+func ValidInvariantPolicy(policy InvariantPolicy) bool {
+	return policy == PolicyHalt || policy == PolicyLog || policy == PolicyDisabled
+}
+
+// MarshalJSON marshals the policy to JSON using its string representation.
+func (p InvariantPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON decodes the policy from its JSON string representation.
+func (p *InvariantPolicy) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	policy, err := InvariantPolicyFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*p = policy
+	return nil
+}
+
+// MarshalYAML marshals the policy to YAML using its string representation.
+func (p InvariantPolicy) MarshalYAML() (interface{}, error) {
+	return p.String(), nil
+}
+
+// String implements the Stringer interface.
+func (p InvariantPolicy) String() string {
+	switch p {
+	case PolicyHalt:
+		return "halt"
+	case PolicyLog:
+		return "log"
+	case PolicyDisabled:
+		return "disabled"
+	default:
+		return ""
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+func (p InvariantPolicy) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(p.String()))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(p))))
+	}
+}
+
+// NewInvariantPolicyEntry creates a new InvariantPolicyEntry instance
+func NewInvariantPolicyEntry(route string, policy InvariantPolicy) InvariantPolicyEntry {
+	return InvariantPolicyEntry{
+		Route:  route,
+		Policy: policy,
+	}
+}
+
+// String implements the Stringer interface for InvariantPolicyEntry
+func (e InvariantPolicyEntry) String() string {
+	out, _ := yaml.Marshal(e)
+	return string(out)
+}
+
+// Validate performs a basic validation of the invariant policy entry.
+func (e InvariantPolicyEntry) Validate() error {
+	if err := validateFullRoute(e.Route); err != nil {
+		return err
+	}
+
+	if !ValidInvariantPolicy(e.Policy) {
+		return fmt.Errorf("invalid policy for invariant %s: %d", e.Route, e.Policy)
+	}
+
+	return nil
+}
+
+// NewBrokenInvariant creates a new BrokenInvariant instance recording the first
+// occurrence of a broken invariant.
+func NewBrokenInvariant(route string, height int64, message string) BrokenInvariant {
+	return BrokenInvariant{
+		Route:       route,
+		FirstHeight: height,
+		LastHeight:  height,
+		Count:       1,
+		Message:     message,
+	}
+}
+
+// Record returns the broken invariant updated with a new occurrence.
+func (bi BrokenInvariant) Record(height int64, message string) BrokenInvariant {
+	bi.LastHeight = height
+	bi.Count++
+	bi.Message = message
+
+	return bi
+}
+
+// String implements the Stringer interface for BrokenInvariant
+func (bi BrokenInvariant) String() string {
+	return fmt.Sprintf(`Broken Invariant:
+  Route:        %s
+  First Height: %d
+  Last Height:  %d
+  Count:        %d
+  Message:      %s`, bi.Route, bi.FirstHeight, bi.LastHeight, bi.Count, strings.TrimSpace(bi.Message))
+}
+
+// Validate performs a basic validation of the broken invariant record.
+func (bi BrokenInvariant) Validate() error {
+	if err := validateFullRoute(bi.Route); err != nil {
+		return err
+	}
+
+	if bi.FirstHeight < 0 {
+		return fmt.Errorf("negative height of broken invariant %s: %d", bi.Route, bi.FirstHeight)
+	}
+
+	if bi.LastHeight < bi.FirstHeight {
+		return fmt.Errorf("last height of broken invariant %s before its first height: %d < %d", bi.Route, bi.LastHeight, bi.FirstHeight)
+	}
+
+	if bi.Count == 0 {
+		return fmt.Errorf("broken invariant %s without occurrences", bi.Route)
+	}
+
+	return nil
+}
+
+// validateFullRoute checks that a route is a full invariant route, i.e.
+// made of a module name and an invariant route.
+func validateFullRoute(route string) error {
+	parts := strings.SplitN(route, "/", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return fmt.Errorf("invalid invariant route, expected <module>/<route>: %q", route)
+	}
+
+	return nil
+}
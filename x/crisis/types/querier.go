@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DONTCOVER
+
+// Query endpoints supported by the crisis querier
+const (
+	QueryInvariants       = "invariants"
+	QueryBrokenInvariants = "brokenInvariants"
+	QueryVerifyInvariants = "verifyInvariants"
+)
+
+// QueryVerifyInvariantsGasLimit is the gas limit of the 'custom/crisis/verifyInvariants'
+// query. The query is free, so the invariants it runs are limited to this
+// amount of gas in total.
+const QueryVerifyInvariantsGasLimit uint64 = 100000000
+
+// QueryBrokenInvariantsParams defines the params for the following queries:
+// - 'custom/crisis/brokenInvariants'
+type QueryBrokenInvariantsParams struct {
+	Route       string
+	Page, Limit int
+}
+
+// NewQueryBrokenInvariantsParams creates a new QueryBrokenInvariantsParams instance
+func NewQueryBrokenInvariantsParams(route string, page, limit int) QueryBrokenInvariantsParams {
+	return QueryBrokenInvariantsParams{route, page, limit}
+}
+
+// QueryVerifyInvariantsParams defines the params for the following queries:
+// - 'custom/crisis/verifyInvariants'
+//
+// If no route is given, all the registered invariants are verified.
+type QueryVerifyInvariantsParams struct {
+	Routes []string
+}
+
+// NewQueryVerifyInvariantsParams creates a new QueryVerifyInvariantsParams instance
+func NewQueryVerifyInvariantsParams(routes []string) QueryVerifyInvariantsParams {
+	return QueryVerifyInvariantsParams{routes}
+}
+
+// InvariantInfo defines a registered invariant along with its policy
+type InvariantInfo struct {
+	Route  string          `json:"route" yaml:"route"`
+	Policy InvariantPolicy `json:"policy" yaml:"policy"`
+}
+
+// NewInvariantInfo creates a new InvariantInfo instance
+func NewInvariantInfo(route string, policy InvariantPolicy) InvariantInfo {
+	return InvariantInfo{
+		Route:  route,
+		Policy: policy,
+	}
+}
+
+// String implements the stringer interface for InvariantInfo
+func (i InvariantInfo) String() string {
+	return fmt.Sprintf("%s: %s", i.Route, i.Policy)
+}
+
+// InvariantResult defines the result of the verification of an invariant
+type InvariantResult struct {
+	Route   string          `json:"route" yaml:"route"`
+	Policy  InvariantPolicy `json:"policy" yaml:"policy"`
+	Broken  bool            `json:"broken" yaml:"broken"`
+	Message string          `json:"message" yaml:"message"`
+}
+
+// NewInvariantResult creates a new InvariantResult instance
+func NewInvariantResult(route string, policy InvariantPolicy, broken bool, msg string) InvariantResult {
+	return InvariantResult{
+		Route:   route,
+		Policy:  policy,
+		Broken:  broken,
+		Message: msg,
+	}
+}
+
+// String implements the stringer interface for InvariantResult
+func (r InvariantResult) String() string {
+	status := "ok"
+	if r.Broken {
+		status = "broken"
+	}
+
+	out := fmt.Sprintf("%s (%s): %s", r.Route, r.Policy, status)
+	if r.Broken {
+		out = fmt.Sprintf("%s\n%s", out, strings.TrimSpace(r.Message))
+	}
+
+	return out
+}
@@ -33,4 +33,10 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// pay the community pool funding streams which are due
+	k.PayFundingStreams(ctx)
+
+	// compound the rewards of the delegators who opted in
+	k.ProcessAutoCompounding(ctx)
 }
@@ -32,7 +32,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorCommission(queryRoute, cdc),
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
+		GetCmdQueryAutoCompound(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryFundingStreams(queryRoute, cdc),
+		GetCmdQueryFundingStream(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -261,6 +264,47 @@ $ %s query distribution rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 	}
 }
 
+// GetCmdQueryAutoCompound implements the query auto-compound command.
+func GetCmdQueryAutoCompound(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether the staking rewards of a delegator are auto-compounded",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a delegator opted in to auto-compounding its staking rewards.
+
+Example:
+$ %s query distribution auto-compound cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAutoCompound)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var enabled bool
+			cdc.MustUnmarshalJSON(res, &enabled)
+			return clientCtx.PrintOutput(enabled)
+		},
+	}
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info
 func GetCmdQueryCommunityPool(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -290,3 +334,77 @@ $ %s query distribution community-pool
 		},
 	}
 }
+
+// GetCmdQueryFundingStreams returns the command for fetching all community pool
+// funding streams
+func GetCmdQueryFundingStreams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "funding-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all active community pool funding streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all active funding streams paying periodically from the community pool.
+
+Example:
+$ %s query distribution funding-streams
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFundingStreams)
+			res, _, err := clientCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var result []types.FundingStream
+			cdc.MustUnmarshalJSON(res, &result)
+			return clientCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryFundingStream returns the command for fetching a single community
+// pool funding stream
+func GetCmdQueryFundingStream(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "funding-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a community pool funding stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a funding stream paying periodically from the community pool by its ID.
+
+Example:
+$ %s query distribution funding-stream 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s is not a valid uint: %w", args[0], err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFundingStreamParams(streamID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFundingStream)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.FundingStream
+			cdc.MustUnmarshalJSON(res, &result)
+			return clientCtx.PrintOutput(result)
+		},
+	}
+}
@@ -3,7 +3,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -15,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/common"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -43,6 +46,8 @@ func NewTxCmd(clientCtx client.Context) *cobra.Command {
 		NewWithdrawRewardsCmd(clientCtx),
 		NewWithdrawAllRewardsCmd(clientCtx),
 		NewSetWithdrawAddrCmd(clientCtx),
+		NewSetAutoCompoundCmd(clientCtx),
+		NewWithdrawTokenizeShareRecordRewardCmd(clientCtx),
 		NewFundCommunityPoolCmd(clientCtx),
 	)...)
 
@@ -195,6 +200,72 @@ $ %s tx distribution set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75
 	return cmd
 }
 
+func NewSetAutoCompoundCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [true|false]",
+		Short: "opt in or out of auto-compounding the staking rewards of an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in or out of auto-compounding the staking rewards of a delegator address.
+While opted in, the rewards of all the delegations of the delegator are periodically
+withdrawn and delegated back to the same validators. Opting in requires the withdraw
+address to be the delegator address.
+
+Example:
+$ %s tx distribution set-auto-compound true --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
+
+			delAddr := clientCtx.GetFromAddress()
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("%s is not a valid bool: %w", args[0], err)
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+	return cmd
+}
+
+func NewWithdrawTokenizeShareRecordRewardCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Short: "withdraw the rewards of the tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards accrued by the delegations of all the tokenize share
+records owned by an address.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+	return cmd
+}
+
 func NewFundCommunityPoolCmd(clientCtx client.Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fund-community-pool [amount]",
@@ -290,3 +361,140 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitContinuousProposal implements the command to submit a
+// continuous-community-pool-spend proposal
+func GetCmdSubmitContinuousProposal(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-community-pool-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a continuous community pool spend proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a continuous community pool spend proposal along with an initial deposit.
+If the proposal passes, the recipient is paid the given amount from the community
+pool once every period, until the given number of payments has been made. The
+first payment is made one period after the proposal passes. The proposal details
+must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal continuous-community-pool-spend <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Monthly Community Grant",
+  "description": "Pay me some Atoms every month!",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "1000stake",
+  "period": "720h",
+  "payments": "12",
+  "deposit": "1000stake"
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
+
+			proposal, err := ParseContinuousCommunityPoolSpendProposalJSON(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoins(proposal.Amount)
+			if err != nil {
+				return err
+			}
+			period, err := time.ParseDuration(proposal.Period)
+			if err != nil {
+				return err
+			}
+			content := types.NewContinuousCommunityPoolSpendProposal(
+				proposal.Title, proposal.Description, proposal.Recipient, amount, period, proposal.Payments,
+			)
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelFundingProposal implements the command to submit a
+// cancel-community-pool-funding proposal
+func GetCmdSubmitCancelFundingProposal(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-community-pool-funding [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a community pool funding stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel a community pool funding stream along with an
+initial deposit. Payments already made by the stream are not reverted.
+
+Example:
+$ %s tx gov submit-proposal cancel-community-pool-funding 1 --title="Cancel grant" --description="..." --deposit=1000stake --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s is not a valid uint: %w", args[0], err)
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewCancelCommunityPoolFundingProposal(title, description, streamID)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
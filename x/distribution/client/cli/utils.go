@@ -16,6 +16,17 @@ type (
 		Amount      string         `json:"amount" yaml:"amount"`
 		Deposit     string         `json:"deposit" yaml:"deposit"`
 	}
+
+	// ContinuousCommunityPoolSpendProposalJSON defines a ContinuousCommunityPoolSpendProposal with a deposit
+	ContinuousCommunityPoolSpendProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount      string         `json:"amount" yaml:"amount"`
+		Period      string         `json:"period" yaml:"period"`
+		Payments    uint64         `json:"payments,string" yaml:"payments"`
+		Deposit     string         `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseCommunityPoolSpendProposalJSON reads and parses a CommunityPoolSpendProposalJSON from a file.
@@ -33,3 +44,22 @@ func ParseCommunityPoolSpendProposalJSON(cdc codec.JSONMarshaler, proposalFile s
 
 	return proposal, nil
 }
+
+// ParseContinuousCommunityPoolSpendProposalJSON reads and parses a
+// ContinuousCommunityPoolSpendProposalJSON from a file.
+func ParseContinuousCommunityPoolSpendProposalJSON(
+	cdc codec.JSONMarshaler, proposalFile string,
+) (ContinuousCommunityPoolSpendProposalJSON, error) {
+	proposal := ContinuousCommunityPoolSpendProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -9,4 +9,14 @@ import (
 // ProposalHandler is the community spend proposal handler.
 var (
 	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+
+	// ContinuousProposalHandler is the continuous community spend proposal handler.
+	ContinuousProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitContinuousProposal, rest.ContinuousProposalRESTHandler,
+	)
+
+	// CancelFundingProposalHandler is the cancel community pool funding proposal handler.
+	CancelFundingProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitCancelFundingProposal, rest.CancelFundingProposalRESTHandler,
+	)
 )
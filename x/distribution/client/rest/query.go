@@ -33,6 +33,12 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 		delegatorWithdrawalAddrHandlerFn(clientCtx),
 	).Methods("GET")
 
+	// Get whether the delegator's rewards are auto-compounded
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		delegatorAutoCompoundHandlerFn(clientCtx),
+	).Methods("GET")
+
 	// Validator distribution information
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
@@ -63,6 +69,18 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 		communityPoolHandler(clientCtx),
 	).Methods("GET")
 
+	// Get the community pool funding streams
+	r.HandleFunc(
+		"/distribution/funding_streams",
+		fundingStreamsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	// Get a community pool funding stream
+	r.HandleFunc(
+		"/distribution/funding_streams/{streamID}",
+		fundingStreamHandlerFn(clientCtx),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query the total rewards balance from all delegations
@@ -142,6 +160,30 @@ func delegatorWithdrawalAddrHandlerFn(clientCtx client.Context) http.HandlerFunc
 	}
 }
 
+// HTTP request handler to query whether a delegator's rewards are auto-compounded
+func delegatorAutoCompoundHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := clientCtx.JSONMarshaler.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAutoCompound), bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
 // ValidatorDistInfo defines the properties of
 // validator distribution information response.
 type ValidatorDistInfo struct {
@@ -307,3 +349,51 @@ func checkResponseQueryDelegationRewards(
 
 	return res, height, true
 }
+
+// HTTP request handler to query the community pool funding streams
+func fundingStreamsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFundingStreams)
+		res, height, err := clientCtx.QueryWithData(route, nil)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+// HTTP request handler to query a community pool funding stream
+func fundingStreamHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		streamID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["streamID"])
+		if !ok {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := clientCtx.JSONMarshaler.MarshalJSON(types.NewQueryFundingStreamParams(streamID))
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFundingStream)
+		res, height, err := clientCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
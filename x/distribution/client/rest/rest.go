@@ -59,3 +59,75 @@ func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// ContinuousProposalRESTHandler returns a ProposalRESTHandler that exposes the
+// continuous community pool spend REST handler with a given sub-route.
+func ContinuousProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "continuous_community_pool_spend",
+		Handler:  postContinuousProposalHandlerFn(clientCtx),
+	}
+}
+
+// CancelFundingProposalRESTHandler returns a ProposalRESTHandler that exposes
+// the cancel community pool funding REST handler with a given sub-route.
+func CancelFundingProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_community_pool_funding",
+		Handler:  postCancelFundingProposalHandlerFn(clientCtx),
+	}
+}
+
+func postContinuousProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ContinuousCommunityPoolSpendProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewContinuousCommunityPoolSpendProposal(
+			req.Title, req.Description, req.Recipient, req.Amount, req.Period, req.Payments,
+		)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postCancelFundingProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelCommunityPoolFundingProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelCommunityPoolFundingProposal(req.Title, req.Description, req.StreamID)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
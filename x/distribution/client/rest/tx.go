@@ -28,6 +28,11 @@ type (
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 		Amount  sdk.Coins    `json:"amount" yaml:"amount"`
 	}
+
+	setAutoCompoundReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Enabled bool         `json:"enabled" yaml:"enabled"`
+	}
 )
 
 func registerTxHandlers(clientCtx client.Context, r *mux.Router) {
@@ -49,6 +54,18 @@ func registerTxHandlers(clientCtx client.Context, r *mux.Router) {
 		newSetDelegatorWithdrawalAddrHandlerFn(clientCtx),
 	).Methods("POST")
 
+	// Opt in or out of rewards auto-compounding
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		newSetAutoCompoundHandlerFn(clientCtx),
+	).Methods("POST")
+
+	// Withdraw the rewards of the tokenize share records of an owner
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/tokenize_share_rewards",
+		newWithdrawTokenizeShareRecordRewardHandlerFn(clientCtx),
+	).Methods("POST")
+
 	// Withdraw validator rewards and commission
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
@@ -148,6 +165,60 @@ func newSetDelegatorWithdrawalAddrHandlerFn(clientCtx client.Context) http.Handl
 	}
 }
 
+func newSetAutoCompoundHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setAutoCompoundReq
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetAutoCompound(delAddr, req.Enabled)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func newWithdrawTokenizeShareRecordRewardHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawRewardsReq
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		ownerAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgWithdrawTokenizeShareRecordReward(ownerAddr)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
 func newWithdrawValidatorRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req withdrawRewardsReq
@@ -226,6 +297,18 @@ func registerTxRoutes(clientCtx client.Context, r *mux.Router, queryRoute string
 		setDelegatorWithdrawalAddrHandlerFn(clientCtx),
 	).Methods("POST")
 
+	// Opt in or out of rewards auto-compounding
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		setAutoCompoundHandlerFn(clientCtx),
+	).Methods("POST")
+
+	// Withdraw the rewards of the tokenize share records of an owner
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/tokenize_share_rewards",
+		withdrawTokenizeShareRecordRewardHandlerFn(clientCtx),
+	).Methods("POST")
+
 	// Withdraw validator rewards and commission
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
@@ -331,6 +414,64 @@ func setDelegatorWithdrawalAddrHandlerFn(clientCtx client.Context) http.HandlerF
 	}
 }
 
+// Opt in or out of rewards auto-compounding
+func setAutoCompoundHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setAutoCompoundReq
+
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetAutoCompound(delAddr, req.Enabled)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// Withdraw the rewards of the tokenize share records of an owner
+func withdrawTokenizeShareRecordRewardHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawRewardsReq
+
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		ownerAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgWithdrawTokenizeShareRecordReward(ownerAddr)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Withdraw validator rewards and commission
 func withdrawValidatorRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -38,6 +38,18 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, stream := range data.FundingStreams {
+		keeper.SetFundingStream(ctx, stream)
+	}
+	if data.NextFundingStreamID > 0 {
+		keeper.SetNextFundingStreamID(ctx, data.NextFundingStreamID)
+	}
+	for _, delAddr := range data.AutoCompoundDelegators {
+		keeper.SetAutoCompoundDelegator(ctx, delAddr)
+	}
+	if data.AutoCompoundCursor != nil {
+		keeper.SetAutoCompoundCursor(ctx, *data.AutoCompoundCursor)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -142,5 +154,22 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	streams := keeper.GetAllFundingStreams(ctx)
+	nextStreamID := keeper.GetNextFundingStreamID(ctx)
+
+	autoCompound := make([]sdk.AccAddress, 0)
+	keeper.IterateAutoCompoundDelegators(ctx, nil, func(delAddr sdk.AccAddress) (stop bool) {
+		autoCompound = append(autoCompound, delAddr)
+		return false
+	})
+
+	var autoCompoundCursor *types.AutoCompoundCursor
+	if cursor, found := keeper.GetAutoCompoundCursor(ctx); found {
+		autoCompoundCursor = &cursor
+	}
+
+	return types.NewGenesisState(
+		params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, streams, nextStreamID, autoCompound,
+		autoCompoundCursor,
+	)
 }
@@ -1,6 +1,8 @@
 package distribution
 
 import (
+	"strconv"
+
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -28,6 +30,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgFundCommunityPool:
 			return handleMsgFundCommunityPool(ctx, msg, k)
 
+		case *types.MsgSetAutoCompound:
+			return handleMsgSetAutoCompound(ctx, msg, k)
+
+		case *types.MsgWithdrawTokenizeShareRecordReward:
+			return handleMsgWithdrawTokenizeShareRecordReward(ctx, msg, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distribution message type: %T", msg)
 		}
@@ -123,12 +131,57 @@ func handleMsgFundCommunityPool(ctx sdk.Context, msg *types.MsgFundCommunityPool
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+func handleMsgSetAutoCompound(ctx sdk.Context, msg *types.MsgSetAutoCompound, k keeper.Keeper) (*sdk.Result, error) {
+	if err := k.SetAutoCompound(ctx, msg.DelegatorAddress, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgWithdrawTokenizeShareRecordReward(
+	ctx sdk.Context, msg *types.MsgWithdrawTokenizeShareRecordReward, k keeper.Keeper,
+) (*sdk.Result, error) {
+	if _, err := k.WithdrawAllTokenizeShareRecordReward(ctx, msg.OwnerAddress); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func NewCommunityPoolSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
 
+		case *types.ContinuousCommunityPoolSpendProposal:
+			return keeper.HandleContinuousCommunityPoolSpendProposal(ctx, k, c)
+
+		case *types.CancelCommunityPoolFundingProposal:
+			return keeper.HandleCancelCommunityPoolFundingProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distr proposal content type: %T", c)
 		}
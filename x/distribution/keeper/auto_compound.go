@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// GetAutoCompound returns true if the delegator opted in to auto-compounding.
+func (k Keeper) GetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundKey(delAddr))
+}
+
+// SetAutoCompound opts the delegator in or out of auto-compounding. Since the
+// compounded rewards are delegated from the delegator's account, opting in
+// requires the delegator to withdraw its rewards to its own address.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) error {
+	store := ctx.KVStore(k.storeKey)
+
+	if !enabled {
+		store.Delete(types.GetAutoCompoundKey(delAddr))
+		return nil
+	}
+
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	k.SetAutoCompoundDelegator(ctx, delAddr)
+	return nil
+}
+
+// SetAutoCompoundDelegator opts the delegator in to auto-compounding whatever
+// its withdraw address is, as a delegator stays opted in when changing its
+// withdraw address afterwards.
+func (k Keeper) SetAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundKey(delAddr), []byte{0x01})
+}
+
+// IterateAutoCompoundDelegators iterates over the delegators opted in to
+// auto-compounding, starting at the given address (inclusive). A nil start
+// iterates over all delegators.
+func (k Keeper) IterateAutoCompoundDelegators(
+	ctx sdk.Context, start sdk.AccAddress, handler func(delAddr sdk.AccAddress) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.AutoCompoundPrefix)
+	iter := store.Iterator(types.GetAutoCompoundKey(start), end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetAutoCompoundAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// GetAutoCompoundCursor returns the position of the auto-compounding pass in
+// progress, found is false if no pass is in progress.
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (cursor types.AutoCompoundCursor, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AutoCompoundCursorKey)
+	if bz == nil {
+		return cursor, false
+	}
+	cursor.DelegatorAddress, cursor.ValidatorAddress = types.GetAutoCompoundCursorAddresses(bz)
+	return cursor, true
+}
+
+// SetAutoCompoundCursor sets the next delegation to auto-compound in the
+// current pass.
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, cursor types.AutoCompoundCursor) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoCompoundCursorKey, types.GetAutoCompoundCursorValue(cursor.DelegatorAddress, cursor.ValidatorAddress))
+}
+
+// DeleteAutoCompoundCursor marks the current auto-compounding pass as done.
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundCursorKey)
+}
+
+// ProcessAutoCompounding compounds the rewards of the delegators opted in to
+// auto-compounding. A pass over all such delegators starts every
+// AutoCompoundInterval blocks and is spread over as many blocks as needed to
+// compound at most MaxAutoCompoundsPerBlock delegations per block. The pass
+// may stop in the middle of the delegations of a delegator, in which case the
+// next block resumes from the first delegation left.
+func (k Keeper) ProcessAutoCompounding(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoCompoundInterval == 0 || params.MaxAutoCompoundsPerBlock == 0 {
+		return
+	}
+
+	cursor, found := k.GetAutoCompoundCursor(ctx)
+	if !found && ctx.BlockHeight()%int64(params.AutoCompoundInterval) != 0 {
+		return
+	}
+
+	// Every delegator consumes at least one unit of the block budget, hence
+	// collecting one more delegator than the budget is enough to find where
+	// the next block should resume.
+	var delegators []sdk.AccAddress
+	k.IterateAutoCompoundDelegators(ctx, cursor.DelegatorAddress, func(delAddr sdk.AccAddress) (stop bool) {
+		delegators = append(delegators, delAddr)
+		return uint64(len(delegators)) > params.MaxAutoCompoundsPerBlock
+	})
+
+	budget := params.MaxAutoCompoundsPerBlock
+	for _, delAddr := range delegators {
+		if budget == 0 {
+			k.SetAutoCompoundCursor(ctx, types.AutoCompoundCursor{DelegatorAddress: delAddr})
+			return
+		}
+
+		// only the delegator of the cursor may have been partially compounded
+		var start sdk.ValAddress
+		if delAddr.Equals(cursor.DelegatorAddress) {
+			start = cursor.ValidatorAddress
+		}
+
+		compounded, next := k.compoundDelegatorRewards(ctx, delAddr, start, budget)
+		if next != nil {
+			k.SetAutoCompoundCursor(ctx, types.AutoCompoundCursor{DelegatorAddress: delAddr, ValidatorAddress: next})
+			return
+		}
+		budget -= compounded
+	}
+
+	k.DeleteAutoCompoundCursor(ctx)
+}
+
+// compoundDelegatorRewards withdraws the rewards of the delegations of the
+// delegator to the validators starting at the given address (inclusive), and
+// re-delegates the bond denom rewards to the same validators. At most budget
+// delegations are processed, it returns the number of units of the budget
+// consumed and the validator of the next delegation to process if the budget
+// did not allow to process them all.
+func (k Keeper) compoundDelegatorRewards(
+	ctx sdk.Context, delAddr sdk.AccAddress, start sdk.ValAddress, budget uint64,
+) (compounded uint64, next sdk.ValAddress) {
+	// the withdraw address may have been changed since opting in
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return 1, nil
+	}
+
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingexported.DelegationI) (stop bool) {
+		if bytes.Compare(del.GetValidatorAddr(), start) >= 0 {
+			valAddrs = append(valAddrs, del.GetValidatorAddr())
+		}
+		return false
+	})
+
+	if uint64(len(valAddrs)) > budget {
+		next = valAddrs[budget]
+		valAddrs = valAddrs[:budget]
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, valAddr := range valAddrs {
+		// compound each delegation atomically so that a failure leaves it untouched
+		cacheCtx, write := ctx.CacheContext()
+
+		rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to withdraw rewards of %s from %s: %s", delAddr, valAddr, err))
+			continue
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			continue
+		}
+
+		validator, found := k.stakingKeeper.GetValidator(cacheCtx, valAddr)
+		if !found {
+			continue
+		}
+
+		newShares, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, sdk.Unbonded, validator, true)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to compound rewards of %s to %s: %s", delAddr, valAddr, err))
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompound,
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+				sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+			),
+		)
+	}
+
+	// a delegator without delegations left still consumes one unit
+	if len(valAddrs) == 0 {
+		return 1, next
+	}
+
+	return uint64(len(valAddrs)), next
+}
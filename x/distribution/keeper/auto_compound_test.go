@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSetAutoCompound(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], true))
+	require.True(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], false))
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+
+	// opting in requires rewards to be withdrawn to the delegator itself
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[1]))
+	err := app.DistrKeeper.SetAutoCompound(ctx, addr[0], true)
+	require.True(t, types.ErrAutoCompoundWithdrawAddr.Is(err))
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+}
+
+func TestProcessAutoCompounding(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.TokensFromConsensusPower(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// compound every 10 blocks, at most one delegation per block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 10
+	params.MaxAutoCompoundsPerBlock = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// create validator with 50% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1, sdk.NewCoin(sdk.DefaultBondDenom, valTokens), stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+	_, err := sh(ctx, msg)
+	require.NoError(t, err)
+
+	// delegate from the two other accounts
+	delAddrs := []sdk.AccAddress{addr[1], addr[2]}
+	if bytes.Compare(delAddrs[0], delAddrs[1]) > 0 {
+		delAddrs[0], delAddrs[1] = delAddrs[1], delAddrs[0]
+	}
+	for _, delAddr := range delAddrs {
+		_, err = sh(ctx, stakingtypes.NewMsgDelegate(delAddr, valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+		require.NoError(t, err)
+		require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, delAddr, true))
+	}
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(30))})
+
+	shares := func(delAddr sdk.AccAddress) sdk.Dec {
+		del, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddrs[0])
+		require.True(t, found)
+		return del.Shares
+	}
+	initialShares := shares(delAddrs[0])
+
+	// no pass starts outside of the interval
+	ctx = ctx.WithBlockHeight(9)
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.Equal(t, initialShares, shares(delAddrs[0]))
+	_, found := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+
+	// the pass starts with the first delegator and resumes on the next block
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.True(t, shares(delAddrs[0]).GT(initialShares))
+	require.Equal(t, initialShares, shares(delAddrs[1]))
+	cursor, found := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+	require.Equal(t, types.AutoCompoundCursor{DelegatorAddress: delAddrs[1]}, cursor)
+
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.True(t, shares(delAddrs[1]).GT(initialShares))
+	_, found = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+
+	// the delegator's own balance is left untouched
+	require.Equal(t,
+		sdk.TokensFromConsensusPower(1000).Sub(valTokens),
+		app.BankKeeper.GetBalance(ctx, delAddrs[0], sdk.DefaultBondDenom).Amount,
+	)
+
+	// nothing happens until the next interval
+	compounded := shares(delAddrs[0])
+	ctx = ctx.WithBlockHeight(12)
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.Equal(t, compounded, shares(delAddrs[0]))
+}
+
+func TestProcessAutoCompoundingSplitsDelegator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 4, sdk.TokensFromConsensusPower(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr[:3])
+	delAddr := addr[3]
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// compound every 10 blocks, at most two delegations per block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 10
+	params.MaxAutoCompoundsPerBlock = 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// create three validators, in the order of their delegation keys, and
+	// delegate to each of them from a single delegator
+	sort.Slice(valAddrs, func(i, j int) bool { return bytes.Compare(valAddrs[i], valAddrs[j]) < 0 })
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	for i, valAddr := range valAddrs {
+		msg := stakingtypes.NewMsgCreateValidator(
+			valAddr, PKS[i], sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+			stakingtypes.Description{}, commission, sdk.OneInt(),
+		)
+		_, err := sh(ctx, msg)
+		require.NoError(t, err)
+
+		_, err = sh(ctx, stakingtypes.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+		require.NoError(t, err)
+	}
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, delAddr, true))
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	for _, valAddr := range valAddrs {
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(30))})
+	}
+
+	shares := func(valAddr sdk.ValAddress) sdk.Dec {
+		del, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.True(t, found)
+		return del.Shares
+	}
+	initialShares := shares(valAddrs[0])
+
+	// the budget only covers the first two delegations of the delegator
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.True(t, shares(valAddrs[0]).GT(initialShares))
+	require.True(t, shares(valAddrs[1]).GT(initialShares))
+	require.Equal(t, initialShares, shares(valAddrs[2]))
+
+	cursor, found := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+	require.Equal(t, types.AutoCompoundCursor{DelegatorAddress: delAddr, ValidatorAddress: valAddrs[2]}, cursor)
+
+	// the next block resumes with the last delegation and ends the pass
+	compounded := shares(valAddrs[0])
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.Equal(t, compounded, shares(valAddrs[0]))
+	require.True(t, shares(valAddrs[2]).GT(initialShares))
+
+	_, found = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+}
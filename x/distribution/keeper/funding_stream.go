@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetNextFundingStreamID returns the ID to assign to the next funding stream.
+func (k Keeper) GetNextFundingStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextFundingStreamIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextFundingStreamID sets the ID to assign to the next funding stream.
+func (k Keeper) SetNextFundingStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextFundingStreamIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetFundingStream returns the funding stream with the given ID.
+func (k Keeper) GetFundingStream(ctx sdk.Context, id uint64) (stream types.FundingStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFundingStreamKey(id))
+	if bz == nil {
+		return stream, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &stream)
+	return stream, true
+}
+
+// SetFundingStream stores a funding stream.
+func (k Keeper) SetFundingStream(ctx sdk.Context, stream types.FundingStream) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&stream)
+	store.Set(types.GetFundingStreamKey(stream.ID), bz)
+}
+
+// DeleteFundingStream removes a funding stream.
+func (k Keeper) DeleteFundingStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFundingStreamKey(id))
+}
+
+// IterateFundingStreams iterates over all funding streams in ascending ID
+// order.
+func (k Keeper) IterateFundingStreams(ctx sdk.Context, handler func(stream types.FundingStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FundingStreamPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.FundingStream
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// GetAllFundingStreams returns all funding streams.
+func (k Keeper) GetAllFundingStreams(ctx sdk.Context) []types.FundingStream {
+	streams := make([]types.FundingStream, 0)
+	k.IterateFundingStreams(ctx, func(stream types.FundingStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+
+	return streams
+}
+
+// PayFundingStreams makes the payment of every funding stream that is due at
+// the current block time. Streams are expected to be created by governance
+// only, hence there are few of them and they can be iterated every block. At
+// most one payment is made per stream and block. If the community pool cannot
+// cover a payment, the payment is retried on the next block.
+func (k Keeper) PayFundingStreams(ctx sdk.Context) {
+	var due []types.FundingStream
+	k.IterateFundingStreams(ctx, func(stream types.FundingStream) (stop bool) {
+		if stream.IsDue(ctx.BlockTime()) {
+			due = append(due, stream)
+		}
+		return false
+	})
+
+	for _, stream := range due {
+		if err := k.DistributeFromFeePool(ctx, stream.Amount, stream.Recipient); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to pay funding stream %d: %s", stream.ID, err))
+			continue
+		}
+
+		stream.PaymentsRemaining--
+		stream.NextPaymentTime = stream.NextPaymentTime.Add(stream.Period)
+
+		if stream.PaymentsRemaining == 0 {
+			k.DeleteFundingStream(ctx, stream.ID)
+		} else {
+			k.SetFundingStream(ctx, stream)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFundingPayment,
+				sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(stream.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Amount.String()),
+			),
+		)
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -23,3 +24,52 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.Commun
 	logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
 }
+
+// HandleContinuousCommunityPoolSpendProposal is a handler for executing a passed
+// continuous community spend proposal. It creates a funding stream whose first
+// payment is due one period after the proposal passes.
+func HandleContinuousCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.ContinuousCommunityPoolSpendProposal) error {
+	if k.blockedAddrs[p.Recipient.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", p.Recipient)
+	}
+
+	id := k.GetNextFundingStreamID(ctx)
+	stream := types.NewFundingStream(id, p.Recipient, p.Amount, p.Period, p.Payments, ctx.BlockTime())
+
+	k.SetFundingStream(ctx, stream)
+	k.SetNextFundingStreamID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundingStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, p.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, p.Amount.String()),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("created funding stream %d paying %s to recipient %s %d times", id, p.Amount, p.Recipient, p.Payments))
+	return nil
+}
+
+// HandleCancelCommunityPoolFundingProposal is a handler for executing a passed
+// proposal cancelling a funding stream. Payments already made are not reverted.
+func HandleCancelCommunityPoolFundingProposal(ctx sdk.Context, k Keeper, p *types.CancelCommunityPoolFundingProposal) error {
+	if _, found := k.GetFundingStream(ctx, p.StreamID); !found {
+		return sdkerrors.Wrapf(types.ErrNoFundingStream, "%d", p.StreamID)
+	}
+
+	k.DeleteFundingStream(ctx, p.StreamID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundingCancelled,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(p.StreamID, 10)),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled funding stream %d", p.StreamID))
+	return nil
+}
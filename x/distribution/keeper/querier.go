@@ -42,6 +42,15 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryFundingStreams:
+			return queryFundingStreams(ctx, path[1:], req, k)
+
+		case types.QueryFundingStream:
+			return queryFundingStream(ctx, path[1:], req, k)
+
+		case types.QueryAutoCompound:
+			return queryAutoCompound(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -254,3 +263,51 @@ func queryCommunityPool(ctx sdk.Context, _ []string, _ abci.RequestQuery, k Keep
 
 	return bz, nil
 }
+
+func queryFundingStreams(ctx sdk.Context, _ []string, _ abci.RequestQuery, k Keeper) ([]byte, error) {
+	streams := k.GetAllFundingStreams(ctx)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, streams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryFundingStream(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryFundingStreamParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	stream, found := k.GetFundingStream(ctx, params.StreamID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoFundingStream, "%d", params.StreamID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, stream)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryAutoCompound(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	enabled := k.GetAutoCompound(ctx, params.DelegatorAddress)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, enabled)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
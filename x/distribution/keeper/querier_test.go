@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -109,6 +110,34 @@ func getQueriedCommunityPool(t *testing.T, ctx sdk.Context, cdc *codec.Codec, qu
 	return
 }
 
+func getQueriedFundingStreams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (streams []types.FundingStream) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryFundingStreams}, ""),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{types.QueryFundingStreams}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &streams))
+
+	return
+}
+
+func getQueriedFundingStream(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, streamID uint64) (stream types.FundingStream, err error) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryFundingStream}, ""),
+		Data: cdc.MustMarshalJSON(types.NewQueryFundingStreamParams(streamID)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryFundingStream}, query)
+	if err != nil {
+		return stream, err
+	}
+	require.Nil(t, cdc.UnmarshalJSON(bz, &stream))
+
+	return
+}
+
 func TestQueries(t *testing.T) {
 	cdc := codec.New()
 	types.RegisterCodec(cdc)
@@ -202,3 +231,28 @@ func TestQueries(t *testing.T) {
 	communityPool := getQueriedCommunityPool(t, ctx, cdc, querier)
 	require.Nil(t, communityPool)
 }
+
+func TestQueryFundingStreams(t *testing.T) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(0, 0).UTC()})
+	querier := keeper.NewQuerier(app.DistrKeeper)
+
+	require.Empty(t, getQueriedFundingStreams(t, ctx, cdc, querier))
+
+	addr := sdk.AccAddress([]byte("funding_recipient___"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	stream := types.NewFundingStream(1, addr, amount, time.Hour, 3, ctx.BlockTime())
+	app.DistrKeeper.SetFundingStream(ctx, stream)
+
+	require.Equal(t, []types.FundingStream{stream}, getQueriedFundingStreams(t, ctx, cdc, querier))
+
+	queried, err := getQueriedFundingStream(t, ctx, cdc, querier, 1)
+	require.NoError(t, err)
+	require.Equal(t, stream, queried)
+
+	_, err = getQueriedFundingStream(t, ctx, cdc, querier, 2)
+	require.Error(t, err)
+}
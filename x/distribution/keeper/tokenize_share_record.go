@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+// held by a tokenize share record and sends them, along with the rewards
+// previously withdrawn to the record's module account, to the record owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, recordID uint64) (sdk.Coins, error) {
+	record, found := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return nil, stakingtypes.ErrTokenizeShareRecordNotExists
+	}
+
+	if k.blockedAddrs[record.Owner.String()] {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", record.Owner)
+	}
+
+	moduleAddr := record.GetModuleAddress()
+
+	if k.stakingKeeper.Delegation(ctx, moduleAddr, record.Validator) != nil {
+		if _, err := k.WithdrawDelegationRewards(ctx, moduleAddr, record.Validator); err != nil {
+			return nil, err
+		}
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, record.Owner, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordOwner, record.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(recordID, 10)),
+		),
+	)
+
+	return rewards, nil
+}
+
+// WithdrawAllTokenizeShareRecordReward withdraws the rewards of all the
+// tokenize share records of an owner.
+func (k Keeper) WithdrawAllTokenizeShareRecordReward(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	if len(records) == 0 {
+		return nil, stakingtypes.ErrTokenizeShareRecordNotExists
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range records {
+		rewards, err := k.WithdrawTokenizeShareRecordReward(ctx, record.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	return totalRewards, nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.TokensFromConsensusPower(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delAddr, owner := addr[1], addr[2]
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// create validator with no commission and a delegation of the same amount
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1, sdk.NewCoin(sdk.DefaultBondDenom, valTokens), stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+	_, err := sh(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = sh(ctx, stakingtypes.NewMsgDelegate(delAddr, valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+	require.NoError(t, err)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize the whole delegation on behalf of the owner
+	shareToken, record, err := app.StakingKeeper.TokenizeShares(
+		ctx, delAddr, valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens), owner,
+	)
+	require.NoError(t, err)
+
+	// allocate some rewards, half of them accrue to the record's delegation
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20))})
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	ownerBalance := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom).Amount
+
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, record.ID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))), rewards)
+	require.Equal(t, ownerBalance.Add(sdk.TokensFromConsensusPower(10)), app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom).Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// rewards pending when the record is removed are paid to the owner as well
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20))})
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddr, shareToken)
+	require.NoError(t, err)
+
+	_, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, record.ID)
+	require.False(t, found)
+	require.Equal(t, ownerBalance.Add(sdk.TokensFromConsensusPower(20)), app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom).Amount)
+
+	// the delegator's own rewards are unaffected
+	delRewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[0])
+	require.NoError(t, err)
+	require.True(t, delRewards.IsZero())
+
+	_, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, owner)
+	require.True(t, stakingtypes.ErrTokenizeShareRecordNotExists.Is(err))
+}
+
+func TestBeforeTokenizeShareRecordRemovedBlockedOwner(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.TokensFromConsensusPower(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// a record owned by an address which became blocked, e.g. after an upgrade
+	blocked := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	record := stakingtypes.NewTokenizeShareRecord(1, blocked, valAddrs[0])
+	app.StakingKeeper.SetTokenizeShareRecord(ctx, record)
+
+	// the removal fails with an error instead of a panic and keeps the record
+	err := app.StakingKeeper.DeleteTokenizeShareRecord(ctx, record)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	_, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, record.ID)
+	require.True(t, found)
+}
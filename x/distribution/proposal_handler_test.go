@@ -2,6 +2,7 @@ package distribution_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -71,3 +72,80 @@ func TestProposalHandlerFailed(t *testing.T) {
 	balances := app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.True(t, balances.IsZero())
 }
+
+func TestContinuousProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+
+	recipient := delAddr1
+	payment := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+
+	// fund the community pool with enough coins for two payments
+	pool := payment.Add(payment...)
+	macc := app.DistrKeeper.GetDistributionAccount(ctx)
+	balances := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, macc.GetAddress(), balances.Add(pool...)))
+	app.AccountKeeper.SetModuleAccount(ctx, macc)
+
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(pool...)
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	hdlr := distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)
+
+	// the same recipient is paid by two streams
+	tp := types.NewContinuousCommunityPoolSpendProposal("Test", "description", recipient, payment, time.Hour, 3)
+	require.NoError(t, hdlr(ctx, tp))
+	require.NoError(t, hdlr(ctx, tp))
+
+	stream, found := app.DistrKeeper.GetFundingStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(3), stream.PaymentsRemaining)
+	require.Equal(t, now.Add(time.Hour), stream.NextPaymentTime)
+	require.Len(t, app.DistrKeeper.GetAllFundingStreams(ctx), 2)
+
+	// nothing is paid before the first period elapses
+	app.DistrKeeper.PayFundingStreams(ctx.WithBlockTime(now.Add(time.Minute)))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+
+	// cancel the second stream
+	require.NoError(t, hdlr(ctx, types.NewCancelCommunityPoolFundingProposal("Test", "description", 2)))
+	require.Error(t, hdlr(ctx, types.NewCancelCommunityPoolFundingProposal("Test", "description", 2)))
+
+	// the first payment is made after one period
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	app.DistrKeeper.PayFundingStreams(ctx)
+	require.Equal(t, payment, app.BankKeeper.GetAllBalances(ctx, recipient))
+
+	stream, found = app.DistrKeeper.GetFundingStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(2), stream.PaymentsRemaining)
+	require.Equal(t, now.Add(2*time.Hour), stream.NextPaymentTime)
+
+	// the second payment is made after another period
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	app.DistrKeeper.PayFundingStreams(ctx)
+	require.Equal(t, pool, app.BankKeeper.GetAllBalances(ctx, recipient))
+
+	// the community pool is empty so the last payment stays pending
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+	app.DistrKeeper.PayFundingStreams(ctx)
+	require.Equal(t, pool, app.BankKeeper.GetAllBalances(ctx, recipient))
+
+	stream, found = app.DistrKeeper.GetFundingStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), stream.PaymentsRemaining)
+
+	// once the community pool is refilled the stream completes
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, macc.GetAddress(), payment))
+	feePool = app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(payment...)
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	app.DistrKeeper.PayFundingStreams(ctx)
+	require.Equal(t, pool.Add(payment...), app.BankKeeper.GetAllBalances(ctx, recipient))
+
+	_, found = app.DistrKeeper.GetFundingStream(ctx, 1)
+	require.False(t, found)
+}
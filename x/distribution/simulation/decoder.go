@@ -64,6 +64,23 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.FundingStreamPrefix):
+			var streamA, streamB types.FundingStream
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &streamA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &streamB)
+			return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextFundingStreamIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundPrefix):
+			return fmt.Sprintf("%v\n%v", types.GetAutoCompoundAddress(kvA.Key), types.GetAutoCompoundAddress(kvB.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			delAddrA, valAddrA := types.GetAutoCompoundCursorAddresses(kvA.Value)
+			delAddrB, valAddrB := types.GetAutoCompoundCursorAddresses(kvB.Value)
+			return fmt.Sprintf("%v %v\n%v %v", delAddrA, valAddrA, delAddrB, valAddrB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
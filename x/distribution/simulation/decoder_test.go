@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -35,6 +36,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	stream := types.NewFundingStream(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), time.Hour, 3, time.Unix(0, 0).UTC())
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.FeePoolKey, Value: cdc.MustMarshalBinaryBare(&feePool)},
@@ -46,6 +48,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		tmkv.Pair{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&currentRewards)},
 		tmkv.Pair{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&commission)},
 		tmkv.Pair{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryBare(&slashEvent)},
+		tmkv.Pair{Key: types.GetFundingStreamKey(1), Value: cdc.MustMarshalBinaryBare(&stream)},
+		tmkv.Pair{Key: types.NextFundingStreamIDKey, Value: sdk.Uint64ToBigEndian(2)},
+		tmkv.Pair{Key: types.GetAutoCompoundKey(delAddr1), Value: []byte{0x01}},
+		tmkv.Pair{Key: types.AutoCompoundCursorKey, Value: types.GetAutoCompoundCursorValue(delAddr1, valAddr1)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -62,6 +68,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"FundingStream", fmt.Sprintf("%v\n%v", stream, stream)},
+		{"NextFundingStreamID", "2\n2"},
+		{"AutoCompound", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"AutoCompoundCursor", fmt.Sprintf("%v %v\n%v %v", delAddr1, valAddr1, delAddr1, valAddr1)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
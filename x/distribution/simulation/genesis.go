@@ -10,15 +10,18 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // Simulation parameter constants
 const (
-	CommunityTax        = "community_tax"
-	BaseProposerReward  = "base_proposer_reward"
-	BonusProposerReward = "bonus_proposer_reward"
-	WithdrawEnabled     = "withdraw_enabled"
+	CommunityTax             = "community_tax"
+	BaseProposerReward       = "base_proposer_reward"
+	BonusProposerReward      = "bonus_proposer_reward"
+	WithdrawEnabled          = "withdraw_enabled"
+	AutoCompoundInterval     = "auto_compound_interval"
+	MaxAutoCompoundsPerBlock = "max_auto_compounds_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -41,6 +44,16 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundInterval randomized AutoCompoundInterval
+func GenAutoCompoundInterval(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
+// GenMaxAutoCompoundsPerBlock randomized MaxAutoCompoundsPerBlock
+func GenMaxAutoCompoundsPerBlock(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 20))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -67,14 +80,29 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoCompoundInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundInterval, &autoCompoundInterval, simState.Rand,
+		func(r *rand.Rand) { autoCompoundInterval = GenAutoCompoundInterval(r) },
+	)
+
+	var maxAutoCompoundsPerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoCompoundsPerBlock, &maxAutoCompoundsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxAutoCompoundsPerBlock = GenMaxAutoCompoundsPerBlock(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:             communityTax,
+			BaseProposerReward:       baseProposerReward,
+			BonusProposerReward:      bonusProposerReward,
+			WithdrawAddrEnabled:      withdrawEnabled,
+			AutoCompoundInterval:     autoCompoundInterval,
+			MaxAutoCompoundsPerBlock: maxAutoCompoundsPerBlock,
 		},
+		NextFundingStreamID: 1,
 	}
 
 	fmt.Printf("Selected randomly generated distribution parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, distrGenesis))
@@ -22,6 +22,8 @@ const (
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgSetAutoCompound             = "op_weight_msg_set_auto_compound"
+	OpWeightMsgWithdrawTokenizeShareReward = "op_weight_msg_withdraw_tokenize_share_reward"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -58,6 +60,20 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgSetAutoCompound int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAutoCompound, &weightMsgSetAutoCompound, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoCompound = simappparams.DefaultWeightMsgSetAutoCompound
+		},
+	)
+
+	var weightMsgWithdrawTokenizeShareReward int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawTokenizeShareReward, &weightMsgWithdrawTokenizeShareReward, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawTokenizeShareReward = simappparams.DefaultWeightMsgWithdrawTokenizeShareReward
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSetWithdrawAddress,
@@ -75,6 +91,14 @@ func WeightedOperations(
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(ak, bk, k, sk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSetAutoCompound,
+			SimulateMsgSetAutoCompound(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawTokenizeShareReward,
+			SimulateMsgWithdrawTokenizeShareRecordReward(ak, bk, k, sk),
+		),
 	}
 }
 
@@ -265,3 +289,88 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgSetAutoCompound generates a MsgSetAutoCompound with random values.
+func SimulateMsgSetAutoCompound(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		enabled := r.Intn(2) == 0
+
+		if enabled && !k.GetDelegatorWithdrawAddr(ctx, simAccount.Address).Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoCompound, "withdraw address is not the delegator address"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoCompound, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgSetAutoCompound(simAccount.Address, enabled)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgWithdrawTokenizeShareRecordReward generates a
+// MsgWithdrawTokenizeShareRecordReward with random values.
+// nolint: interfacer
+func SimulateMsgWithdrawTokenizeShareRecordReward(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		if len(sk.GetTokenizeShareRecordsByOwner(ctx, simAccount.Address)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareReward, "no tokenize share records"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareReward, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgWithdrawTokenizeShareRecordReward(simAccount.Address)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -231,18 +236,122 @@ func (m *MsgFundCommunityPool) GetDepositor() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+// msg struct for opting a delegator in or out of the periodic auto-compounding
+// of its staking rewards
+type MsgSetAutoCompound struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Enabled          bool                                          `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{4}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetAutoCompound) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgSetAutoCompound) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// msg struct for withdrawing the rewards of the tokenized delegations of the
+// tokenize share records owned by an address
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner_address,omitempty" yaml:"owner_address"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{5}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordReward) GetOwnerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return nil
+}
+
 // Params defines the set of distribution parameters.
 type Params struct {
 	CommunityTax        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax" yaml:"community_tax"`
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	// number of blocks between two auto-compounding passes, zero disables auto-compounding
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty" yaml:"auto_compound_interval"`
+	// maximum number of delegations compounded in a single block
+	MaxAutoCompoundsPerBlock uint64 `protobuf:"varint,6,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty" yaml:"max_auto_compounds_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{4}
+	return fileDescriptor_49870d4e3df20cf9, []int{6}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,6 +387,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
 // historical rewards for a validator
 // height is implicit within the store key
 // cumulative reward ratio is the sum from the zeroeth period
@@ -286,10 +409,11 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // which might need to reference this historical entry
 // at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and might need to read
-//    that record)
-//  + number of slashes which ended the associated period (and might need to read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and might need to read
+//	  that record)
+//	+ number of slashes which ended the associated period (and might need to read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -299,7 +423,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{5}
+	return fileDescriptor_49870d4e3df20cf9, []int{7}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +478,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{6}
+	return fileDescriptor_49870d4e3df20cf9, []int{8}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +531,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{7}
+	return fileDescriptor_49870d4e3df20cf9, []int{9}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +577,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{8}
+	return fileDescriptor_49870d4e3df20cf9, []int{10}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +626,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{9}
+	return fileDescriptor_49870d4e3df20cf9, []int{11}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +670,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{10}
+	return fileDescriptor_49870d4e3df20cf9, []int{12}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +715,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{11}
+	return fileDescriptor_49870d4e3df20cf9, []int{13}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +762,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{12}
+	return fileDescriptor_49870d4e3df20cf9, []int{14}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,6 +791,174 @@ func (m *CommunityPoolSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposal proto.InternalMessageInfo
 
+// ContinuousCommunityPoolSpendProposal creates a funding stream which pays a
+// fixed amount from the community pool to a recipient once every period until
+// the given number of payments has been made
+type ContinuousCommunityPoolSpendProposal struct {
+	Title       string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Period      time.Duration                                 `protobuf:"bytes,5,opt,name=period,proto3,stdduration" json:"period"`
+	Payments    uint64                                        `protobuf:"varint,6,opt,name=payments,proto3" json:"payments,omitempty"`
+}
+
+func (m *ContinuousCommunityPoolSpendProposal) Reset()      { *m = ContinuousCommunityPoolSpendProposal{} }
+func (*ContinuousCommunityPoolSpendProposal) ProtoMessage() {}
+func (*ContinuousCommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{15}
+}
+func (m *ContinuousCommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousCommunityPoolSpendProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousCommunityPoolSpendProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousCommunityPoolSpendProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousCommunityPoolSpendProposal.Merge(m, src)
+}
+func (m *ContinuousCommunityPoolSpendProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousCommunityPoolSpendProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousCommunityPoolSpendProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousCommunityPoolSpendProposal proto.InternalMessageInfo
+
+// CancelCommunityPoolFundingProposal cancels an active funding stream
+type CancelCommunityPoolFundingProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StreamID    uint64 `protobuf:"varint,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty" yaml:"stream_id"`
+}
+
+func (m *CancelCommunityPoolFundingProposal) Reset()      { *m = CancelCommunityPoolFundingProposal{} }
+func (*CancelCommunityPoolFundingProposal) ProtoMessage() {}
+func (*CancelCommunityPoolFundingProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{16}
+}
+func (m *CancelCommunityPoolFundingProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelCommunityPoolFundingProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelCommunityPoolFundingProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelCommunityPoolFundingProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCommunityPoolFundingProposal.Merge(m, src)
+}
+func (m *CancelCommunityPoolFundingProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelCommunityPoolFundingProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCommunityPoolFundingProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCommunityPoolFundingProposal proto.InternalMessageInfo
+
+// FundingStream defines a stream of periodic payments from the community pool
+// to a recipient created by a ContinuousCommunityPoolSpendProposal
+type FundingStream struct {
+	ID                uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+	Amount            github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Period            time.Duration                                 `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	PaymentsRemaining uint64                                        `protobuf:"varint,5,opt,name=payments_remaining,json=paymentsRemaining,proto3" json:"payments_remaining,omitempty" yaml:"payments_remaining"`
+	NextPaymentTime   time.Time                                     `protobuf:"bytes,6,opt,name=next_payment_time,json=nextPaymentTime,proto3,stdtime" json:"next_payment_time" yaml:"next_payment_time"`
+}
+
+func (m *FundingStream) Reset()      { *m = FundingStream{} }
+func (*FundingStream) ProtoMessage() {}
+func (*FundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{17}
+}
+func (m *FundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStream.Merge(m, src)
+}
+func (m *FundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingStream proto.InternalMessageInfo
+
+func (m *FundingStream) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *FundingStream) GetRecipient() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *FundingStream) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *FundingStream) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *FundingStream) GetPaymentsRemaining() uint64 {
+	if m != nil {
+		return m.PaymentsRemaining
+	}
+	return 0
+}
+
+func (m *FundingStream) GetNextPaymentTime() time.Time {
+	if m != nil {
+		return m.NextPaymentTime
+	}
+	return time.Time{}
+}
+
 // starting info for a delegator reward period
 // tracks the previous validator period, the delegation's amount
 // of staking token, and the creation height (to check later on
@@ -684,7 +976,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{13}
+	return fileDescriptor_49870d4e3df20cf9, []int{18}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,6 +1024,8 @@ func init() {
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.MsgWithdrawDelegatorReward")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.MsgWithdrawValidatorCommission")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.MsgFundCommunityPool")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.distribution.MsgSetAutoCompound")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.MsgWithdrawTokenizeShareRecordReward")
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.ValidatorCurrentRewards")
@@ -741,6 +1035,9 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.CommunityPoolSpendProposal")
+	proto.RegisterType((*ContinuousCommunityPoolSpendProposal)(nil), "cosmos.distribution.ContinuousCommunityPoolSpendProposal")
+	proto.RegisterType((*CancelCommunityPoolFundingProposal)(nil), "cosmos.distribution.CancelCommunityPoolFundingProposal")
+	proto.RegisterType((*FundingStream)(nil), "cosmos.distribution.FundingStream")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.DelegatorStartingInfo")
 }
 
@@ -749,76 +1046,102 @@ func init() {
 }
 
 var fileDescriptor_49870d4e3df20cf9 = []byte{
-	// 1098 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x38, 0x6e, 0x9a, 0x4c, 0xd3, 0xa4, 0xdd, 0xd8, 0x49, 0xe4, 0x80, 0x37, 0x1a, 0x89,
-	0x2a, 0x12, 0x8a, 0x43, 0xe9, 0x2d, 0x07, 0xa4, 0x38, 0x1f, 0x02, 0xd4, 0x90, 0x68, 0x13, 0x82,
-	0xc4, 0x01, 0x6b, 0xbc, 0x3b, 0x71, 0x46, 0x59, 0xef, 0xac, 0x66, 0xc6, 0xf9, 0xe8, 0x05, 0xa9,
-	0xe2, 0xeb, 0x80, 0x44, 0x91, 0x38, 0x70, 0x40, 0xa8, 0x07, 0x0e, 0xd0, 0x7f, 0x82, 0x6b, 0x8f,
-	0xbd, 0x81, 0x38, 0xb8, 0x28, 0xb9, 0x71, 0xcc, 0x0d, 0x4e, 0x68, 0x67, 0x66, 0x3f, 0xec, 0xb8,
-	0x10, 0x47, 0x15, 0xdc, 0x3c, 0x6f, 0xde, 0xfc, 0x7e, 0xbf, 0x79, 0xef, 0xcd, 0x7b, 0x6b, 0x78,
-	0xc7, 0x65, 0xa2, 0xc5, 0xc4, 0xa2, 0x47, 0x85, 0xe4, 0xb4, 0xd1, 0x96, 0x94, 0x05, 0x5d, 0x8b,
-	0x6a, 0xc8, 0x99, 0x64, 0xd6, 0xa4, 0xf6, 0xab, 0x66, 0xb7, 0xca, 0xc5, 0x26, 0x6b, 0x32, 0xb5,
-	0xbf, 0x18, 0xfd, 0xd2, 0xae, 0x65, 0xe3, 0xba, 0x68, 0x4e, 0x28, 0x23, 0xfa, 0x32, 0x0f, 0x4b,
-	0x1b, 0xa2, 0xb9, 0x4d, 0xe4, 0x07, 0x54, 0xee, 0x7b, 0x1c, 0x1f, 0x2d, 0x7b, 0x1e, 0x27, 0x42,
-	0x58, 0x0f, 0xe0, 0x6d, 0x8f, 0xf8, 0xa4, 0x89, 0x25, 0xe3, 0x75, 0xac, 0x8d, 0x33, 0x60, 0x0e,
-	0xcc, 0x8f, 0xd5, 0x36, 0xce, 0x3b, 0xf6, 0xcc, 0x09, 0x6e, 0xf9, 0x4b, 0xe8, 0x82, 0x0b, 0xfa,
-	0xab, 0x63, 0x2f, 0x34, 0xa9, 0xdc, 0x6f, 0x37, 0xaa, 0x2e, 0x6b, 0x2d, 0x76, 0x91, 0x2e, 0x08,
-	0xef, 0x60, 0x51, 0x9e, 0x84, 0x44, 0x54, 0x97, 0x5d, 0xd7, 0x30, 0x39, 0xb7, 0x12, 0x90, 0x98,
-	0xfb, 0x08, 0xde, 0x3a, 0x32, 0x72, 0x12, 0xea, 0xbc, 0xa2, 0xbe, 0x7f, 0xde, 0xb1, 0xa7, 0x35,
-	0x75, 0xaf, 0xc7, 0x15, 0x98, 0x27, 0x8e, 0xba, 0x2f, 0x8d, 0xbe, 0xc9, 0xc3, 0xf2, 0x86, 0x68,
-	0xc6, 0xb1, 0x58, 0x8d, 0x85, 0x39, 0xe4, 0x08, 0x73, 0xef, 0x7f, 0x8d, 0xc9, 0x03, 0x78, 0xfb,
-	0x10, 0xfb, 0xd4, 0xeb, 0xe2, 0xce, 0xf7, 0x72, 0x5f, 0x70, 0xb9, 0x2c, 0xf7, 0x2e, 0xf6, 0x13,
-	0xee, 0x04, 0x24, 0x0e, 0xcb, 0x77, 0x00, 0x56, 0x32, 0x61, 0xd9, 0x8d, 0xf7, 0x57, 0x58, 0xab,
-	0x45, 0x85, 0xa0, 0x2c, 0xe8, 0x2f, 0x0f, 0xfc, 0x37, 0xf2, 0x7e, 0x06, 0xb0, 0xb8, 0x21, 0x9a,
-	0xeb, 0xed, 0xc0, 0x8b, 0x14, 0xb5, 0x03, 0x2a, 0x4f, 0xb6, 0x18, 0xf3, 0xad, 0x5d, 0x38, 0x8c,
-	0x5b, 0xac, 0x1d, 0xc8, 0x19, 0x30, 0x37, 0x34, 0x7f, 0xe3, 0xcd, 0xb1, 0xaa, 0x29, 0xfe, 0x15,
-	0x46, 0x83, 0xda, 0x1b, 0x4f, 0x3b, 0x76, 0xee, 0xc9, 0x73, 0x7b, 0xfe, 0x12, 0xfc, 0xd1, 0x01,
-	0xe1, 0x18, 0x34, 0x6b, 0x13, 0x8e, 0x7a, 0x24, 0x64, 0x82, 0x4a, 0xc6, 0x4d, 0x0e, 0xee, 0x0e,
-	0x9e, 0xe3, 0x14, 0x03, 0xfd, 0x32, 0x04, 0x87, 0xb7, 0x30, 0xc7, 0x2d, 0x61, 0x1d, 0xc0, 0x9b,
-	0x6e, 0x7c, 0x89, 0xba, 0xc4, 0xc7, 0x2a, 0x88, 0xa3, 0xb5, 0xf5, 0x48, 0xec, 0x6f, 0x1d, 0xfb,
-	0xce, 0x25, 0x38, 0x56, 0x89, 0x7b, 0xde, 0xb1, 0x8b, 0x3a, 0xe4, 0x5d, 0x60, 0xc8, 0x19, 0x4b,
-	0xd6, 0x3b, 0xf8, 0xd8, 0xfa, 0x18, 0x16, 0x1b, 0x58, 0x90, 0x7a, 0xc8, 0x59, 0xc8, 0x04, 0xe1,
-	0x75, 0xae, 0x0a, 0x5d, 0xdd, 0x69, 0xb4, 0xb6, 0x31, 0x30, 0xe7, 0xac, 0xe6, 0xec, 0x87, 0x89,
-	0x1c, 0x2b, 0x32, 0x6f, 0x19, 0xab, 0x79, 0x51, 0x0f, 0x01, 0x2c, 0x35, 0x58, 0xd0, 0x16, 0x17,
-	0x24, 0x0c, 0x29, 0x09, 0xef, 0x0d, 0x2c, 0xe1, 0x15, 0x23, 0xa1, 0x1f, 0x28, 0x72, 0x26, 0x95,
-	0xbd, 0x47, 0xc4, 0x0e, 0x2c, 0x75, 0x35, 0x93, 0x3a, 0x09, 0x70, 0xc3, 0x27, 0xde, 0x4c, 0x61,
-	0x0e, 0xcc, 0x8f, 0xd4, 0xe6, 0x52, 0xd4, 0xbe, 0x6e, 0xc8, 0x99, 0xcc, 0xf6, 0x91, 0x35, 0x6d,
-	0x5d, 0x2a, 0x7c, 0xfb, 0xd8, 0xce, 0xa1, 0x87, 0x79, 0x58, 0x4e, 0xde, 0xcb, 0xdb, 0x54, 0x48,
-	0xc6, 0xa9, 0x8b, 0x7d, 0xcd, 0x2c, 0xac, 0xef, 0x01, 0x9c, 0x76, 0xdb, 0xad, 0xb6, 0x8f, 0x25,
-	0x3d, 0x24, 0x46, 0x66, 0x9d, 0x63, 0x49, 0x99, 0xa9, 0xd9, 0x89, 0xb8, 0x66, 0x57, 0x89, 0xab,
-	0xca, 0xf6, 0xfd, 0x28, 0x24, 0xe7, 0x1d, 0xbb, 0x62, 0xf2, 0xdb, 0xff, 0x34, 0x7a, 0xf2, 0xdc,
-	0x7e, 0xfd, 0x72, 0x41, 0xd3, 0xb5, 0x5d, 0x4a, 0x81, 0xb4, 0x38, 0x27, 0x82, 0xb1, 0x56, 0xe0,
-	0x04, 0x27, 0x7b, 0x84, 0x93, 0xc0, 0x25, 0x75, 0x57, 0xbd, 0xa5, 0xa8, 0x38, 0x6e, 0xd6, 0xca,
-	0xe7, 0x1d, 0x7b, 0x4a, 0x4b, 0xe8, 0x71, 0x40, 0xce, 0x78, 0x62, 0x59, 0x51, 0x86, 0xaf, 0x01,
-	0x9c, 0x4e, 0x9b, 0x46, 0x9b, 0x73, 0x12, 0xc8, 0x38, 0x02, 0x1f, 0xc1, 0xeb, 0x5a, 0xb7, 0x78,
-	0xd1, 0x85, 0xef, 0x99, 0x77, 0x3a, 0xd0, 0x75, 0x62, 0x50, 0x6b, 0x0a, 0x0e, 0x87, 0x84, 0x53,
-	0xa6, 0x8b, 0xba, 0xe0, 0x98, 0x15, 0xfa, 0x0c, 0xc0, 0x4a, 0xa2, 0x69, 0xd9, 0x35, 0xb7, 0x27,
-	0x5e, 0xa6, 0xa7, 0x79, 0x10, 0xba, 0xc9, 0xea, 0xa5, 0xaa, 0xcb, 0xe0, 0xa2, 0xaf, 0x00, 0x9c,
-	0x4d, 0x84, 0x6c, 0xb6, 0xa5, 0x90, 0x38, 0xf0, 0x68, 0xd0, 0x8c, 0x03, 0x14, 0xfe, 0x6b, 0x80,
-	0xd6, 0x4c, 0x45, 0x8c, 0xc7, 0xe9, 0x50, 0xde, 0xe8, 0xaa, 0x21, 0x43, 0x3f, 0x01, 0x38, 0x99,
-	0x28, 0xda, 0xf6, 0xb1, 0xd8, 0x5f, 0x3b, 0x24, 0x81, 0xb4, 0xd6, 0x61, 0xda, 0x7b, 0xeb, 0x26,
-	0xa8, 0x51, 0x77, 0x2a, 0xd4, 0x66, 0xd3, 0xb1, 0xdc, 0xeb, 0x81, 0x9c, 0x89, 0xc4, 0xb4, 0xa5,
-	0x2c, 0xd6, 0xbb, 0x70, 0x64, 0x8f, 0x63, 0x37, 0xfa, 0x56, 0x31, 0x9d, 0xa6, 0x3a, 0xd8, 0x33,
-	0x77, 0x92, 0xf3, 0xe8, 0x07, 0x00, 0x8b, 0x7d, 0xb4, 0x0a, 0xeb, 0x53, 0x00, 0xa7, 0x52, 0x2d,
-	0x22, 0xda, 0xa9, 0x13, 0xb5, 0x65, 0xc2, 0x38, 0x5f, 0xed, 0xf3, 0xed, 0x54, 0xed, 0x83, 0x55,
-	0x7b, 0xcd, 0xc4, 0xf7, 0xd5, 0xde, 0x1b, 0x66, 0x51, 0x91, 0x53, 0x3c, 0xec, 0xa3, 0xc3, 0xb4,
-	0x81, 0x47, 0x00, 0x5e, 0x5f, 0x27, 0x44, 0x4d, 0xa5, 0x4f, 0x00, 0x1c, 0x4f, 0xbb, 0x72, 0xc8,
-	0x98, 0xff, 0xa2, 0xc4, 0xde, 0x37, 0xc4, 0xa5, 0xde, 0x56, 0x1e, 0x1d, 0x1a, 0x38, 0xbf, 0xe9,
-	0x5c, 0x89, 0x64, 0xa0, 0xcf, 0xf3, 0xb0, 0xdc, 0x35, 0x2e, 0xb7, 0x43, 0x12, 0x78, 0xba, 0x35,
-	0x62, 0xdf, 0x2a, 0xc2, 0x6b, 0x92, 0x4a, 0x9f, 0xe8, 0xf9, 0xe3, 0xe8, 0x85, 0x35, 0x07, 0x6f,
-	0x78, 0x44, 0xb8, 0x9c, 0x86, 0x69, 0xf6, 0x9c, 0xac, 0x29, 0x9a, 0x8d, 0x9c, 0xb8, 0x34, 0xa4,
-	0x24, 0x90, 0xaa, 0x89, 0x5f, 0x6d, 0x36, 0x26, 0x18, 0x99, 0x21, 0x5e, 0x78, 0x99, 0x43, 0x7c,
-	0x69, 0xe4, 0x8b, 0xc7, 0x76, 0x4e, 0x25, 0xe7, 0x4f, 0x00, 0x4b, 0xc9, 0xa7, 0xde, 0xb6, 0xc4,
-	0x5c, 0xd2, 0xa0, 0xf9, 0x4e, 0xb0, 0xa7, 0xba, 0x5f, 0xc8, 0xc9, 0x21, 0x65, 0xd1, 0x2c, 0xc9,
-	0x16, 0x7c, 0xa6, 0xfb, 0xf5, 0x38, 0x20, 0x67, 0x3c, 0xb6, 0x98, 0x72, 0xdf, 0x81, 0xd7, 0x84,
-	0xc4, 0x07, 0xc4, 0xd4, 0xfa, 0x5b, 0x03, 0x8f, 0xb4, 0x31, 0x4d, 0xa4, 0x40, 0x90, 0xa3, 0xc1,
-	0xac, 0x35, 0x38, 0xbc, 0x4f, 0x68, 0x73, 0x5f, 0x07, 0xb9, 0x50, 0x5b, 0xf8, 0xa3, 0x63, 0x4f,
-	0xb8, 0x9c, 0x44, 0x5d, 0x3b, 0xa8, 0xeb, 0xad, 0x54, 0x64, 0xcf, 0x06, 0x72, 0xcc, 0xe1, 0xda,
-	0xe6, 0x8f, 0xa7, 0x15, 0xf0, 0xf4, 0xb4, 0x02, 0x9e, 0x9d, 0x56, 0xc0, 0xef, 0xa7, 0x15, 0xf0,
-	0xe8, 0xac, 0x92, 0x7b, 0x76, 0x56, 0xc9, 0xfd, 0x7a, 0x56, 0xc9, 0x7d, 0x78, 0xf7, 0x1f, 0x35,
-	0x1e, 0x77, 0xff, 0x3d, 0x51, 0x92, 0x1b, 0xc3, 0xea, 0x8f, 0xc5, 0xbd, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xda, 0x06, 0xab, 0xc7, 0xc2, 0x0c, 0x00, 0x00,
+	// 1505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x37, 0x1f, 0x4d, 0x26, 0x5f, 0x8d, 0xb3, 0x49, 0xb7, 0xdb, 0x76, 0x1d, 0x86, 0x52,
+	0x22, 0xa1, 0x6e, 0x68, 0x7b, 0x2b, 0x02, 0x29, 0x9b, 0x0f, 0x11, 0xd4, 0xd0, 0xc8, 0x09, 0xad,
+	0xc4, 0x01, 0x6b, 0xd6, 0x9e, 0x6c, 0x46, 0xb1, 0x3d, 0xd6, 0xcc, 0x38, 0x1f, 0xbd, 0x20, 0x55,
+	0x7c, 0x1d, 0x90, 0x28, 0x12, 0x42, 0x45, 0x42, 0xa8, 0x07, 0x90, 0xa0, 0xff, 0x04, 0xd7, 0xde,
+	0xe8, 0x11, 0x71, 0x70, 0x51, 0x7a, 0xeb, 0x71, 0x6f, 0x70, 0x42, 0xf6, 0x8c, 0xbd, 0x1f, 0xd9,
+	0x96, 0x6c, 0x28, 0xf4, 0xc0, 0x6d, 0xe7, 0xcd, 0x9b, 0xdf, 0xfb, 0xcd, 0x7b, 0x6f, 0xde, 0x7b,
+	0x5e, 0x70, 0xc1, 0xa6, 0xdc, 0xa3, 0x7c, 0xce, 0x21, 0x5c, 0x30, 0x52, 0x0d, 0x05, 0xa1, 0x7e,
+	0xcb, 0xa2, 0x1c, 0x30, 0x2a, 0xa8, 0x3e, 0x29, 0xf5, 0xca, 0xcd, 0x5b, 0xc5, 0x7c, 0x8d, 0xd6,
+	0x68, 0xb2, 0x3f, 0x17, 0xff, 0x92, 0xaa, 0x45, 0xa5, 0x3a, 0xa7, 0x4e, 0x48, 0x61, 0xa9, 0x46,
+	0x69, 0xcd, 0xc5, 0x73, 0xc9, 0xaa, 0x1a, 0x6e, 0xce, 0x39, 0x21, 0x43, 0x0d, 0xfc, 0xa2, 0xd1,
+	0xbe, 0x2f, 0x88, 0x87, 0xb9, 0x40, 0x5e, 0x20, 0x15, 0xe0, 0xe7, 0x39, 0x30, 0xb5, 0xca, 0x6b,
+	0xeb, 0x58, 0xdc, 0x24, 0x62, 0xcb, 0x61, 0x68, 0x77, 0xde, 0x71, 0x18, 0xe6, 0x5c, 0xbf, 0x05,
+	0x26, 0x1c, 0xec, 0xe2, 0x1a, 0x12, 0x94, 0x59, 0x48, 0x0a, 0x0b, 0xda, 0x8c, 0x36, 0x3b, 0x52,
+	0x59, 0xad, 0x47, 0x46, 0x61, 0x1f, 0x79, 0xee, 0x55, 0x78, 0x48, 0x05, 0xfe, 0x19, 0x19, 0x17,
+	0x6b, 0x44, 0x6c, 0x85, 0xd5, 0xb2, 0x4d, 0xbd, 0xb9, 0x16, 0xd6, 0x17, 0xb9, 0xb3, 0x3d, 0x27,
+	0xf6, 0x03, 0xcc, 0xcb, 0xf3, 0xb6, 0xad, 0x2c, 0x99, 0x27, 0x33, 0x90, 0xd4, 0xf6, 0x2e, 0x38,
+	0xb9, 0xab, 0xe8, 0x64, 0xa6, 0x73, 0x89, 0xe9, 0x6b, 0xf5, 0xc8, 0x38, 0x25, 0x4d, 0xb7, 0x6b,
+	0x1c, 0xc3, 0xf2, 0xf8, 0x6e, 0xeb, 0xa5, 0xe1, 0x57, 0x39, 0x50, 0x5c, 0xe5, 0xb5, 0xd4, 0x17,
+	0x8b, 0x29, 0x31, 0x13, 0xef, 0x22, 0xe6, 0xbc, 0x50, 0x9f, 0xdc, 0x02, 0x13, 0x3b, 0xc8, 0x25,
+	0x4e, 0x8b, 0xed, 0x5c, 0xbb, 0xed, 0x43, 0x2a, 0x47, 0xb5, 0x7d, 0x03, 0xb9, 0x99, 0xed, 0x0c,
+	0x24, 0x75, 0xcb, 0xb7, 0x1a, 0x28, 0x35, 0xb9, 0xe5, 0x46, 0xba, 0xbf, 0x40, 0x3d, 0x8f, 0x70,
+	0x4e, 0xa8, 0xdf, 0x99, 0x9e, 0xf6, 0xdf, 0xd0, 0xfb, 0x59, 0x03, 0xf9, 0x55, 0x5e, 0x5b, 0x0e,
+	0x7d, 0x27, 0x66, 0x14, 0xfa, 0x44, 0xec, 0xaf, 0x51, 0xea, 0xea, 0x37, 0xc0, 0x00, 0xf2, 0x68,
+	0xe8, 0x8b, 0x82, 0x36, 0xd3, 0x3b, 0x3b, 0x7c, 0x79, 0xa4, 0xac, 0x5e, 0xcf, 0x02, 0x25, 0x7e,
+	0xe5, 0xf5, 0x07, 0x91, 0xd1, 0x73, 0xff, 0x91, 0x31, 0x7b, 0x04, 0xfb, 0xf1, 0x01, 0x6e, 0x2a,
+	0x34, 0xfd, 0x3a, 0x18, 0x72, 0x70, 0x40, 0x39, 0x11, 0x94, 0xa9, 0x18, 0x5c, 0xea, 0x3e, 0xc6,
+	0x0d, 0x0c, 0x78, 0x5f, 0x03, 0xba, 0x7c, 0x86, 0xf3, 0xa1, 0xa0, 0x0b, 0xd4, 0x0b, 0x68, 0xe8,
+	0xbf, 0xd8, 0x7c, 0x2b, 0x80, 0x13, 0xd8, 0x47, 0x55, 0x17, 0x3b, 0xc9, 0x0d, 0x07, 0xcd, 0x74,
+	0x09, 0xbf, 0xd6, 0xc0, 0xf9, 0xa6, 0x6c, 0xd8, 0xa0, 0xdb, 0xd8, 0x27, 0xb7, 0xf0, 0xfa, 0x16,
+	0x62, 0xd8, 0xc4, 0x36, 0x65, 0x8e, 0x7a, 0x2e, 0x3e, 0x18, 0xa5, 0xbb, 0x3e, 0x6e, 0xa7, 0xbe,
+	0x52, 0x8f, 0x8c, 0xbc, 0xa4, 0xde, 0xb2, 0x7d, 0x0c, 0xda, 0x23, 0x09, 0x80, 0x5a, 0xc1, 0x6f,
+	0xfa, 0xc1, 0xc0, 0x1a, 0x62, 0xc8, 0xe3, 0xfa, 0x36, 0x18, 0xb5, 0xd3, 0x54, 0xb0, 0x04, 0xda,
+	0x4b, 0x4c, 0x0f, 0x55, 0x96, 0xe3, 0x90, 0xff, 0x16, 0x19, 0x17, 0x8e, 0x60, 0x66, 0x11, 0xdb,
+	0x0d, 0xa2, 0x2d, 0x60, 0xd0, 0x1c, 0xc9, 0xd6, 0x1b, 0x68, 0x4f, 0xff, 0x10, 0xe4, 0xab, 0x88,
+	0x63, 0x2b, 0x60, 0x34, 0xa0, 0x1c, 0x33, 0x8b, 0x25, 0xf7, 0x4f, 0xfc, 0x36, 0x54, 0x59, 0xed,
+	0xda, 0xe6, 0x19, 0x69, 0xb3, 0x13, 0x26, 0x34, 0xf5, 0x58, 0xbc, 0xa6, 0xa4, 0xca, 0xd1, 0xb7,
+	0x35, 0x30, 0x55, 0xa5, 0x7e, 0xc8, 0x0f, 0x51, 0xe8, 0x4d, 0x28, 0xbc, 0xdb, 0x35, 0x85, 0xb3,
+	0x8a, 0x42, 0x27, 0x50, 0x68, 0x4e, 0x26, 0xf2, 0x36, 0x12, 0x1b, 0x60, 0xaa, 0xa5, 0x24, 0x5b,
+	0x69, 0xfa, 0xf4, 0xc5, 0xe9, 0x53, 0x99, 0x69, 0xa0, 0x76, 0x54, 0x83, 0xe6, 0x64, 0x73, 0x35,
+	0x5e, 0x92, 0x52, 0xfd, 0x26, 0x98, 0x46, 0xa1, 0xa0, 0x96, 0xad, 0xde, 0x84, 0x45, 0x7c, 0x81,
+	0xd9, 0x0e, 0x72, 0x0b, 0xfd, 0x33, 0xda, 0x6c, 0x5f, 0xe5, 0xa5, 0x7a, 0x64, 0x9c, 0x93, 0xb0,
+	0x9d, 0xf5, 0xa0, 0x99, 0x47, 0x4d, 0x6f, 0x6a, 0x45, 0x89, 0xf5, 0x1a, 0x38, 0xeb, 0xa1, 0x3d,
+	0xab, 0xe5, 0x10, 0xb7, 0x02, 0xcc, 0xac, 0xaa, 0x4b, 0xed, 0xed, 0xc2, 0x40, 0x02, 0xff, 0x6a,
+	0x3d, 0x32, 0x5e, 0x96, 0xf0, 0xcf, 0xd2, 0x86, 0x66, 0xc1, 0x43, 0x7b, 0xcd, 0x6f, 0x97, 0xaf,
+	0x61, 0x56, 0x89, 0xb7, 0xae, 0xf6, 0xdd, 0xbd, 0x67, 0xf4, 0xc0, 0xdb, 0x39, 0x50, 0xcc, 0xea,
+	0xe6, 0xdb, 0x84, 0x0b, 0xca, 0x88, 0x8d, 0x5c, 0xe9, 0x3b, 0xae, 0x7f, 0xa7, 0x81, 0x53, 0x76,
+	0xe8, 0x85, 0x2e, 0x12, 0x64, 0x07, 0x2b, 0x47, 0x5b, 0x49, 0x2f, 0x57, 0xb5, 0x6b, 0x3c, 0xad,
+	0x5d, 0x8b, 0xd8, 0x4e, 0xca, 0xd7, 0x7b, 0x71, 0x50, 0xeb, 0x91, 0x51, 0x52, 0x19, 0xda, 0xf9,
+	0x34, 0xbc, 0xff, 0xc8, 0x78, 0xed, 0x68, 0x61, 0x97, 0x35, 0x6e, 0xaa, 0x01, 0x24, 0xc9, 0x99,
+	0x31, 0x8c, 0xbe, 0x00, 0xc6, 0x19, 0xde, 0xc4, 0x0c, 0xfb, 0x36, 0xb6, 0xec, 0xa4, 0xa6, 0xc6,
+	0xe9, 0x3d, 0x5a, 0x29, 0xd6, 0x23, 0x63, 0x5a, 0x52, 0x68, 0x53, 0x80, 0xe6, 0x58, 0x26, 0x59,
+	0x48, 0x04, 0x5f, 0x6a, 0xe0, 0x54, 0xa3, 0x79, 0x84, 0x8c, 0x61, 0x5f, 0xa4, 0x1e, 0xf8, 0x00,
+	0x9c, 0x90, 0xbc, 0xf9, 0xd3, 0x2e, 0x7c, 0x45, 0xd5, 0xeb, 0xae, 0xae, 0x93, 0x82, 0xea, 0xd3,
+	0x60, 0x20, 0xc0, 0x8c, 0x50, 0xf9, 0x2c, 0xfb, 0x4c, 0xb5, 0x82, 0x9f, 0x68, 0xa0, 0x94, 0x71,
+	0x9a, 0xb7, 0xd5, 0xed, 0xb1, 0xd3, 0xd4, 0xdb, 0x1c, 0x00, 0xec, 0x6c, 0xf5, 0x5c, 0xd9, 0x35,
+	0xe1, 0xc2, 0x2f, 0x34, 0x70, 0x26, 0x23, 0x72, 0x3d, 0x14, 0x5c, 0x20, 0xdf, 0x21, 0x7e, 0x2d,
+	0x75, 0x50, 0xf0, 0xb7, 0x0e, 0x5a, 0x52, 0x19, 0x31, 0x96, 0x86, 0x23, 0xd1, 0x86, 0xc7, 0x75,
+	0x19, 0xfc, 0x49, 0x03, 0x93, 0x19, 0xa3, 0x75, 0x17, 0xf1, 0xad, 0xa5, 0x1d, 0xec, 0x0b, 0x7d,
+	0x19, 0x34, 0x7a, 0xb0, 0xa5, 0x9c, 0xaa, 0x25, 0xcf, 0xe5, 0x4c, 0x63, 0x3c, 0x6b, 0xd7, 0x80,
+	0xe6, 0x78, 0x26, 0x5a, 0x4b, 0x24, 0xfa, 0x3b, 0x60, 0x70, 0x93, 0x21, 0x3b, 0x9e, 0x57, 0x55,
+	0xad, 0x2c, 0x77, 0x57, 0xa8, 0xcc, 0xec, 0x3c, 0xfc, 0x5e, 0x03, 0xf9, 0x0e, 0x5c, 0xb9, 0xfe,
+	0xb1, 0x06, 0xa6, 0x1b, 0x5c, 0x78, 0xbc, 0x63, 0xe1, 0x64, 0x4b, 0xb9, 0x71, 0xb6, 0xdc, 0x61,
+	0x08, 0x2f, 0x77, 0xc0, 0xaa, 0xbc, 0xa2, 0xfc, 0x7b, 0xae, 0xfd, 0x86, 0xcd, 0xa8, 0xd0, 0xcc,
+	0xef, 0x74, 0xe0, 0xa1, 0xca, 0xc0, 0x1d, 0x0d, 0x9c, 0x58, 0xc6, 0x38, 0x99, 0x4e, 0x3e, 0xd2,
+	0xc0, 0x58, 0xa3, 0xaf, 0x04, 0x94, 0xba, 0x4f, 0x0b, 0xec, 0x35, 0x65, 0x78, 0xaa, 0xbd, 0x19,
+	0xc5, 0x87, 0xba, 0x8e, 0x6f, 0xa3, 0x33, 0xc6, 0x34, 0xe0, 0xa7, 0x39, 0x50, 0x6c, 0x19, 0x9b,
+	0xd6, 0x03, 0xec, 0x3b, 0xb2, 0xb8, 0x23, 0x57, 0xcf, 0x83, 0x7e, 0x41, 0x84, 0x8b, 0x65, 0x07,
+	0x35, 0xe5, 0x42, 0x9f, 0x01, 0xc3, 0x0e, 0xe6, 0x36, 0x23, 0x41, 0x23, 0x7a, 0x66, 0xb3, 0x28,
+	0x9e, 0x91, 0x18, 0xb6, 0x49, 0x40, 0xb0, 0x2f, 0x0a, 0xbd, 0xc7, 0x9e, 0x91, 0x32, 0x8c, 0xa6,
+	0x61, 0xae, 0xef, 0x79, 0x0e, 0x73, 0x57, 0x07, 0x3f, 0xbb, 0x67, 0xf4, 0x24, 0xc1, 0x79, 0x92,
+	0x03, 0xe7, 0x17, 0xa8, 0x2f, 0x88, 0x1f, 0xd2, 0x90, 0xff, 0x8f, 0x7d, 0xa2, 0xbf, 0x91, 0x15,
+	0xcb, 0xb8, 0xcb, 0x0e, 0x5f, 0x3e, 0x5d, 0x96, 0x1f, 0x92, 0xe5, 0xf4, 0x43, 0xb2, 0xbc, 0xa8,
+	0x3e, 0x34, 0x2b, 0x83, 0xb1, 0x91, 0xbb, 0x8f, 0x0c, 0x2d, 0xad, 0xa8, 0x7a, 0x11, 0x0c, 0x06,
+	0x68, 0xdf, 0x4b, 0x9e, 0x58, 0xd2, 0x45, 0xcd, 0x6c, 0xdd, 0xe4, 0xec, 0x1f, 0x34, 0x00, 0x17,
+	0x90, 0x6f, 0x63, 0xb7, 0xc5, 0xd1, 0xf1, 0x10, 0x4f, 0xfc, 0xda, 0x3f, 0x76, 0xf5, 0x9b, 0x60,
+	0x88, 0x0b, 0x86, 0x91, 0x67, 0x11, 0x39, 0x05, 0xf5, 0x55, 0x66, 0x0e, 0x22, 0x63, 0x70, 0x3d,
+	0x11, 0xae, 0x2c, 0xd6, 0x23, 0xe3, 0xa4, 0x7c, 0x4d, 0x99, 0x1a, 0x34, 0x07, 0xe5, 0xef, 0x15,
+	0xa7, 0x89, 0xe7, 0x2f, 0xbd, 0x60, 0x54, 0x91, 0x92, 0x67, 0xf5, 0x69, 0x90, 0x23, 0x69, 0xc1,
+	0x1b, 0x38, 0x88, 0x8c, 0xdc, 0xca, 0xa2, 0x99, 0x23, 0x4e, 0x6b, 0x74, 0x73, 0xcf, 0x35, 0xba,
+	0xbd, 0xff, 0x52, 0x74, 0xfb, 0xba, 0x8f, 0xee, 0x35, 0xa0, 0xa7, 0xd1, 0xb4, 0x18, 0xf6, 0x10,
+	0xf1, 0x89, 0x5f, 0x53, 0xc3, 0xd8, 0xb9, 0x7a, 0x64, 0x9c, 0x96, 0x5e, 0x3d, 0xac, 0x03, 0xcd,
+	0x89, 0x54, 0x68, 0xa6, 0x32, 0xdd, 0x05, 0x13, 0x3e, 0xde, 0x13, 0x96, 0xda, 0xb1, 0x04, 0xf1,
+	0x70, 0x92, 0x34, 0xc3, 0x97, 0x8b, 0x87, 0x58, 0x6d, 0xa4, 0x7f, 0x5e, 0x54, 0xce, 0xab, 0x82,
+	0xa8, 0xbe, 0x80, 0x0e, 0x41, 0xc0, 0x3b, 0x31, 0xe5, 0xf1, 0x58, 0xbe, 0x26, 0xc5, 0xf1, 0x59,
+	0x55, 0x83, 0xff, 0xd0, 0xc0, 0x54, 0xf6, 0x65, 0xbf, 0x2e, 0x10, 0x13, 0xc4, 0xaf, 0xad, 0xf8,
+	0x9b, 0xc9, 0x90, 0x13, 0x30, 0xbc, 0x43, 0x68, 0xc8, 0x5b, 0xfb, 0x5a, 0xd3, 0x90, 0xd3, 0xa6,
+	0x00, 0xcd, 0xb1, 0x54, 0xa2, 0xba, 0xda, 0x06, 0xe8, 0xe7, 0x02, 0x6d, 0x63, 0xd5, 0xd2, 0xde,
+	0xea, 0x7a, 0xf6, 0x1e, 0x49, 0xf3, 0x12, 0x6d, 0x63, 0x68, 0x4a, 0x30, 0x7d, 0x09, 0x0c, 0x6c,
+	0x61, 0x52, 0xdb, 0x12, 0x2a, 0x99, 0x2f, 0x3e, 0x89, 0x8c, 0x71, 0x9b, 0xe1, 0x24, 0x4c, 0x96,
+	0xdc, 0x6a, 0x90, 0x6c, 0xdb, 0x80, 0xa6, 0x3a, 0x5c, 0xb9, 0xfe, 0xe3, 0x41, 0x49, 0x7b, 0x70,
+	0x50, 0xd2, 0x1e, 0x1e, 0x94, 0xb4, 0xdf, 0x0f, 0x4a, 0xda, 0x9d, 0xc7, 0xa5, 0x9e, 0x87, 0x8f,
+	0x4b, 0x3d, 0xbf, 0x3e, 0x2e, 0xf5, 0xbc, 0x7f, 0xe9, 0x99, 0x1c, 0xf7, 0x5a, 0xff, 0xce, 0x4a,
+	0x28, 0x57, 0x07, 0x92, 0xe8, 0x5c, 0xf9, 0x6b, 0x00, 0x22, 0xd1, 0x5e, 0xae, 0xf2, 0x12, 0x00,
+	0x00,
 }
 
 func (this *MsgSetWithdrawAddress) Equal(that interface{}) bool {
@@ -931,6 +1254,57 @@ func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetAutoCompound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompound)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (this *MsgWithdrawTokenizeShareRecordReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordReward)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OwnerAddress, that1.OwnerAddress) {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -962,6 +1336,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.MaxAutoCompoundsPerBlock != that1.MaxAutoCompoundsPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1209,14 +1589,14 @@ func (this *CommunityPoolSpendProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+func (this *ContinuousCommunityPoolSpendProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DelegatorStartingInfo)
+	that1, ok := that.(*ContinuousCommunityPoolSpendProposal)
 	if !ok {
-		that2, ok := that.(DelegatorStartingInfo)
+		that2, ok := that.(ContinuousCommunityPoolSpendProposal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1228,13 +1608,131 @@ func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.PreviousPeriod != that1.PreviousPeriod {
+	if this.Title != that1.Title {
 		return false
 	}
-	if !this.Stake.Equal(that1.Stake) {
+	if this.Description != that1.Description {
 		return false
 	}
-	if this.Height != that1.Height {
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	if this.Payments != that1.Payments {
+		return false
+	}
+	return true
+}
+func (this *CancelCommunityPoolFundingProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CancelCommunityPoolFundingProposal)
+	if !ok {
+		that2, ok := that.(CancelCommunityPoolFundingProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.StreamID != that1.StreamID {
+		return false
+	}
+	return true
+}
+func (this *FundingStream) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FundingStream)
+	if !ok {
+		that2, ok := that.(FundingStream)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	if this.PaymentsRemaining != that1.PaymentsRemaining {
+		return false
+	}
+	if !this.NextPaymentTime.Equal(that1.NextPaymentTime) {
+		return false
+	}
+	return true
+}
+func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorStartingInfo)
+	if !ok {
+		that2, ok := that.(DelegatorStartingInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PreviousPeriod != that1.PreviousPeriod {
+		return false
+	}
+	if !this.Stake.Equal(that1.Stake) {
+		return false
+	}
+	if this.Height != that1.Height {
 		return false
 	}
 	return true
@@ -1387,6 +1885,76 @@ func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1407,6 +1975,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1778,7 +2356,7 @@ func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+func (m *ContinuousCommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1788,68 +2366,251 @@ func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContinuousCommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContinuousCommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+	if m.Payments != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Payments))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x30
 	}
-	{
-		size := m.Stake.Size()
-		i -= size
-		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err1 != nil {
+		return 0, err1
 	}
+	i -= n1
+	i = encodeVarintDistribution(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x12
-	if m.PreviousPeriod != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+	dAtA[i] = 0x2a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CancelCommunityPoolFundingProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetWithdrawAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CancelCommunityPoolFundingProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelCommunityPoolFundingProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if m.StreamID != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawDelegatorReward) Size() (n int) {
+func (m *FundingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextPaymentTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextPaymentTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDistribution(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.PaymentsRemaining != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PaymentsRemaining))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDistribution(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PreviousPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDelegatorReward) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1898,6 +2659,35 @@ func (m *MsgFundCommunityPool) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1913,6 +2703,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoCompoundsPerBlock))
+	}
 	return n
 }
 
@@ -2053,6 +2849,87 @@ func (m *CommunityPoolSpendProposal) Size() (n int) {
 	return n
 }
 
+func (m *ContinuousCommunityPoolSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Payments != 0 {
+		n += 1 + sovDistribution(uint64(m.Payments))
+	}
+	return n
+}
+
+func (m *CancelCommunityPoolFundingProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.StreamID != 0 {
+		n += 1 + sovDistribution(uint64(m.StreamID))
+	}
+	return n
+}
+
+func (m *FundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovDistribution(uint64(m.ID))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.PaymentsRemaining != 0 {
+		n += 1 + sovDistribution(uint64(m.PaymentsRemaining))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextPaymentTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
 func (m *DelegatorStartingInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2526,7 +3403,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2549,17 +3426,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2569,31 +3446,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2603,23 +3480,217 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = append(m.OwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerAddress == nil {
+				m.OwnerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2677,6 +3748,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3552,6 +4661,604 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContinuousCommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousCommunityPoolSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousCommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
+			}
+			m.Payments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Payments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelCommunityPoolFundingProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelCommunityPoolFundingProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelCommunityPoolFundingProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+			}
+			m.StreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentsRemaining", wireType)
+			}
+			m.PaymentsRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentsRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextPaymentTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,15 +2,28 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress          = "set_withdraw_address"
+	EventTypeRewards                     = "rewards"
+	EventTypeCommission                  = "commission"
+	EventTypeWithdrawRewards             = "withdraw_rewards"
+	EventTypeWithdrawCommission          = "withdraw_commission"
+	EventTypeProposerReward              = "proposer_reward"
+	EventTypeFundingStream               = "funding_stream"
+	EventTypeFundingPayment              = "funding_payment"
+	EventTypeFundingCancelled            = "funding_cancelled"
+	EventTypeSetAutoCompound             = "set_auto_compound"
+	EventTypeAutoCompound                = "auto_compound"
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyStreamID        = "stream_id"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyNewShares       = "new_shares"
+	AttributeKeyRecordOwner     = "record_owner"
+	AttributeKeyRecordID        = "record_id"
 
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFundingStream creates a new funding stream whose first payment is due one
+// period after the given start time.
+func NewFundingStream(
+	id uint64, recipient sdk.AccAddress, amount sdk.Coins, period time.Duration, payments uint64, startTime time.Time,
+) FundingStream {
+	return FundingStream{
+		ID:                id,
+		Recipient:         recipient,
+		Amount:            amount,
+		Period:            period,
+		PaymentsRemaining: payments,
+		NextPaymentTime:   startTime.Add(period),
+	}
+}
+
+// IsDue returns true if the next payment of the stream is due at the given
+// block time.
+func (fs FundingStream) IsDue(blockTime time.Time) bool {
+	return !fs.NextPaymentTime.After(blockTime)
+}
+
+// Validate performs a stateless validation of the funding stream.
+func (fs FundingStream) Validate() error {
+	if fs.Recipient.Empty() {
+		return ErrEmptyProposalRecipient
+	}
+	if !fs.Amount.IsValid() || fs.Amount.Empty() {
+		return ErrInvalidProposalAmount
+	}
+	if fs.Period <= 0 {
+		return ErrInvalidFundingPeriod
+	}
+	if fs.PaymentsRemaining == 0 {
+		return ErrInvalidFundingPayments
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (fs FundingStream) String() string {
+	return fmt.Sprintf(`Funding Stream %d:
+  Recipient:          %s
+  Amount:             %s
+  Period:             %s
+  Payments Remaining: %d
+  Next Payment Time:  %s
+`, fs.ID, fs.Recipient, fs.Amount, fs.Period, fs.PaymentsRemaining, fs.NextPaymentTime)
+}
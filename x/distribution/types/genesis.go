@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -43,6 +45,14 @@ type DelegatorStartingInfoRecord struct {
 	StartingInfo     DelegatorStartingInfo `json:"starting_info" yaml:"starting_info"`
 }
 
+// the position of the auto-compounding pass in progress: the next delegation to
+// compound is the delegation of the delegator to the validator, or the first
+// delegation of the delegator if the validator is empty
+type AutoCompoundCursor struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
 // used for import / export via genesis json
 type ValidatorSlashEventRecord struct {
 	ValidatorAddress sdk.ValAddress      `json:"validator_address" yaml:"validator_address"`
@@ -63,12 +73,17 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	FundingStreams                  []FundingStream                        `json:"funding_streams" yaml:"funding_streams"`
+	NextFundingStreamID             uint64                                 `json:"next_funding_stream_id" yaml:"next_funding_stream_id"`
+	AutoCompoundDelegators          []sdk.AccAddress                       `json:"auto_compound_delegators" yaml:"auto_compound_delegators"`
+	AutoCompoundCursor              *AutoCompoundCursor                    `json:"auto_compound_cursor" yaml:"auto_compound_cursor"`
 }
 
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	streams []FundingStream, nextStreamID uint64, autoCompound []sdk.AccAddress, autoCompoundCursor *AutoCompoundCursor,
 ) GenesisState {
 
 	return GenesisState{
@@ -82,6 +97,10 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		FundingStreams:                  streams,
+		NextFundingStreamID:             nextStreamID,
+		AutoCompoundDelegators:          autoCompound,
+		AutoCompoundCursor:              autoCompoundCursor,
 	}
 }
 
@@ -98,6 +117,9 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		FundingStreams:                  []FundingStream{},
+		NextFundingStreamID:             1,
+		AutoCompoundDelegators:          []sdk.AccAddress{},
 	}
 }
 
@@ -106,5 +128,34 @@ func ValidateGenesis(gs GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seenStreams := make(map[uint64]bool)
+	for _, stream := range gs.FundingStreams {
+		if err := stream.Validate(); err != nil {
+			return fmt.Errorf("invalid funding stream %d: %w", stream.ID, err)
+		}
+		if seenStreams[stream.ID] {
+			return fmt.Errorf("duplicate funding stream %d", stream.ID)
+		}
+		if stream.ID >= gs.NextFundingStreamID {
+			return fmt.Errorf("funding stream %d must be lower than the next funding stream ID %d", stream.ID, gs.NextFundingStreamID)
+		}
+		seenStreams[stream.ID] = true
+	}
+
+	seenAutoCompound := make(map[string]bool)
+	for _, delAddr := range gs.AutoCompoundDelegators {
+		if delAddr.Empty() {
+			return fmt.Errorf("empty auto-compound delegator address")
+		}
+		if seenAutoCompound[delAddr.String()] {
+			return fmt.Errorf("duplicate auto-compound delegator %s", delAddr)
+		}
+		seenAutoCompound[delAddr.String()] = true
+	}
+	if gs.AutoCompoundCursor != nil && gs.AutoCompoundCursor.DelegatorAddress.Empty() {
+		return fmt.Errorf("empty auto-compound cursor delegator address")
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -40,6 +40,14 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<streamID_Bytes>: FundingStream
+//
+// - 0x0A: next funding stream ID
+//
+// - 0x0B<accAddr_Bytes>: auto-compounding opt-in
+//
+// - 0x0C: sdk.AccAddress of the next delegator to auto-compound
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,6 +59,12 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	FundingStreamPrefix    = []byte{0x09} // key for community pool funding streams
+	NextFundingStreamIDKey = []byte{0x0A} // key for the next funding stream ID
+
+	AutoCompoundPrefix    = []byte{0x0B} // key for delegators opted in to auto-compounding
+	AutoCompoundCursorKey = []byte{0x0C} // key for the auto-compounding pass cursor
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -191,3 +205,41 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the key for a community pool funding stream
+func GetFundingStreamKey(id uint64) []byte {
+	return append(FundingStreamPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the key for a delegator's auto-compounding opt-in
+func GetAutoCompoundKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundPrefix, delAddr.Bytes()...)
+}
+
+// gets the value of the auto-compounding pass cursor
+func GetAutoCompoundCursorValue(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(delAddr.Bytes(), valAddr.Bytes()...)
+}
+
+// gets the delegator and validator addresses from the auto-compounding pass
+// cursor value, the validator address is empty if the pass resumes at the
+// first delegation of the delegator
+func GetAutoCompoundCursorAddresses(value []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if len(value) != sdk.AddrLen && len(value) != 2*sdk.AddrLen {
+		panic("unexpected value length")
+	}
+	delAddr = sdk.AccAddress(value[:sdk.AddrLen])
+	if len(value) > sdk.AddrLen {
+		valAddr = sdk.ValAddress(value[sdk.AddrLen:])
+	}
+	return
+}
+
+// gets the address from a delegator's auto-compounding opt-in key
+func GetAutoCompoundAddress(key []byte) (delAddr sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
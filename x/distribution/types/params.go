@@ -15,6 +15,9 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoCompoundInterval     = []byte("autocompoundinterval")
+	ParamStoreKeyMaxAutoCompoundsPerBlock = []byte("maxautocompoundsperblock")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -29,6 +32,9 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundInterval:     14400, // ~1 day at 6 second blocks
+		MaxAutoCompoundsPerBlock: 100,
 	}
 }
 
@@ -44,6 +50,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundInterval, &p.AutoCompoundInterval, validateAutoCompoundInterval),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAutoCompoundsPerBlock, &p.MaxAutoCompoundsPerBlock, validateMaxAutoCompoundsPerBlock),
 	}
 }
 
@@ -138,3 +146,21 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxAutoCompoundsPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -11,14 +12,26 @@ import (
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeContinuousCommunityPoolSpend defines the type for a ContinuousCommunityPoolSpendProposal
+	ProposalTypeContinuousCommunityPoolSpend = "ContinuousCommunityPoolSpend"
+	// ProposalTypeCancelCommunityPoolFunding defines the type for a CancelCommunityPoolFundingProposal
+	ProposalTypeCancelCommunityPoolFunding = "CancelCommunityPoolFunding"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolSpendProposal{}
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolSpendProposal{}
+	_ govtypes.Content = &ContinuousCommunityPoolSpendProposal{}
+	_ govtypes.Content = &CancelCommunityPoolFundingProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeContinuousCommunityPoolSpend)
+	govtypes.RegisterProposalTypeCodec(&ContinuousCommunityPoolSpendProposal{}, "cosmos-sdk/ContinuousCommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelCommunityPoolFunding)
+	govtypes.RegisterProposalTypeCodec(&CancelCommunityPoolFundingProposal{}, "cosmos-sdk/CancelCommunityPoolFundingProposal")
 }
 
 // NewCommunityPoolSpendProposal creates a new community pool spned proposal.
@@ -65,3 +78,108 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// NewContinuousCommunityPoolSpendProposal creates a new continuous community
+// pool spend proposal paying amount to recipient once every period, for the
+// given number of payments.
+func NewContinuousCommunityPoolSpendProposal(
+	title, description string, recipient sdk.AccAddress, amount sdk.Coins, period time.Duration, payments uint64,
+) *ContinuousCommunityPoolSpendProposal {
+	return &ContinuousCommunityPoolSpendProposal{title, description, recipient, amount, period, payments}
+}
+
+// GetTitle returns the title of a continuous community pool spend proposal.
+func (csp *ContinuousCommunityPoolSpendProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a continuous community pool spend proposal.
+func (csp *ContinuousCommunityPoolSpendProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a continuous community pool spend proposal.
+func (csp *ContinuousCommunityPoolSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a continuous community pool spend proposal.
+func (csp *ContinuousCommunityPoolSpendProposal) ProposalType() string {
+	return ProposalTypeContinuousCommunityPoolSpend
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (csp *ContinuousCommunityPoolSpendProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(csp)
+	if err != nil {
+		return err
+	}
+	if !csp.Amount.IsValid() || csp.Amount.Empty() {
+		return ErrInvalidProposalAmount
+	}
+	if csp.Recipient.Empty() {
+		return ErrEmptyProposalRecipient
+	}
+	if csp.Period <= 0 {
+		return ErrInvalidFundingPeriod
+	}
+	if csp.Payments == 0 {
+		return ErrInvalidFundingPayments
+	}
+
+	return nil
+}
+
+// TotalAmount returns the sum of all payments made by the proposed stream.
+func (csp ContinuousCommunityPoolSpendProposal) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, coin := range csp.Amount {
+		total = total.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(csp.Payments))))
+	}
+
+	return total
+}
+
+// String implements the Stringer interface.
+func (csp ContinuousCommunityPoolSpendProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Continuous Community Pool Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Period:      %s
+  Payments:    %d
+`, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.Period, csp.Payments))
+	return b.String()
+}
+
+// NewCancelCommunityPoolFundingProposal creates a new proposal cancelling the
+// funding stream with the given ID.
+func NewCancelCommunityPoolFundingProposal(title, description string, streamID uint64) *CancelCommunityPoolFundingProposal {
+	return &CancelCommunityPoolFundingProposal{title, description, streamID}
+}
+
+// GetTitle returns the title of a cancel community pool funding proposal.
+func (cfp *CancelCommunityPoolFundingProposal) GetTitle() string { return cfp.Title }
+
+// GetDescription returns the description of a cancel community pool funding proposal.
+func (cfp *CancelCommunityPoolFundingProposal) GetDescription() string { return cfp.Description }
+
+// ProposalRoute returns the routing key of a cancel community pool funding proposal.
+func (cfp *CancelCommunityPoolFundingProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel community pool funding proposal.
+func (cfp *CancelCommunityPoolFundingProposal) ProposalType() string {
+	return ProposalTypeCancelCommunityPoolFunding
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (cfp *CancelCommunityPoolFundingProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(cfp)
+}
+
+// String implements the Stringer interface.
+func (cfp CancelCommunityPoolFundingProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Community Pool Funding Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, cfp.Title, cfp.Description, cfp.StreamID))
+	return b.String()
+}
@@ -13,6 +13,9 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryFundingStreams              = "funding_streams"
+	QueryFundingStream               = "funding_stream"
+	QueryAutoCompound                = "auto_compound"
 )
 
 // params for query 'custom/distr/validator_outstanding_rewards'
@@ -69,7 +72,8 @@ func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress, validatorAddr
 	}
 }
 
-// params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
+// params for query 'custom/distr/delegator_total_rewards', 'custom/distr/delegator_validators'
+// and 'custom/distr/auto_compound'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 }
@@ -90,3 +94,13 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// params for query 'custom/distr/funding_stream'
+type QueryFundingStreamParams struct {
+	StreamID uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewQueryFundingStreamParams creates a new instance of QueryFundingStreamParams.
+func NewQueryFundingStreamParams(streamID uint64) QueryFundingStreamParams {
+	return QueryFundingStreamParams{StreamID: streamID}
+}
@@ -1,13 +1,24 @@
 package cli
 
 import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 
 	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+const flagTrustedCommit = "trusted-commit"
+
 // GetTxCmd returns a CLI command that has all the native evidence module tx
 // commands mounted. In addition, it mounts all childCmds, implemented by outside
 // modules, under a sub-command. This allows external modules to implement custom
@@ -23,11 +34,12 @@ func GetTxCmd(clientCtx client.Context, childCmds []*cobra.Command) *cobra.Comma
 	}
 
 	submitEvidenceCmd := SubmitEvidenceCmd(clientCtx)
+	submitEvidenceCmd.AddCommand(flags.PostCommands(NewSubmitLightClientAttackCmd(clientCtx))[0])
 	for _, childCmd := range childCmds {
 		submitEvidenceCmd.AddCommand(flags.PostCommands(childCmd)[0])
 	}
 
-	// TODO: Add tx commands.
+	cmd.AddCommand(submitEvidenceCmd)
 
 	return cmd
 }
@@ -43,3 +55,86 @@ func SubmitEvidenceCmd(_ client.Context) *cobra.Command {
 
 	return cmd
 }
+
+// NewSubmitLightClientAttackCmd returns a CLI command handler for submitting
+// evidence of a light client attack.
+func NewSubmitLightClientAttackCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "light-client-attack [path/to/conflicting_block.json] [common-height]",
+		Short: "Submit evidence of a light client attack",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit evidence of a light client attack, i.e. of a block conflicting with the
+chain which was signed by validators of the validator set at the common height.
+The conflicting block is provided as a JSON object holding its signed header
+and validator set:
+
+{"signed_header": {...}, "validator_set": {...}}
+
+The commit of the block of the chain at the conflicting height is required
+when the conflicting header is valid, so that the validators which signed both
+blocks can be identified.
+
+Example:
+$ %s tx evidence submit light-client-attack conflicting_block.json 100 --trusted-commit commit.json --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
+
+			var block types.LightBlock
+			if err := unmarshalJSONOrFile(clientCtx.Codec, args[0], &block); err != nil {
+				return fmt.Errorf("failed to parse conflicting block: %w", err)
+			}
+
+			commonHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid common height: %w", err)
+			}
+
+			var trustedCommit *tmtypes.Commit
+			if path, _ := cmd.Flags().GetString(flagTrustedCommit); path != "" {
+				trustedCommit = new(tmtypes.Commit)
+				if err := unmarshalJSONOrFile(clientCtx.Codec, path, trustedCommit); err != nil {
+					return fmt.Errorf("failed to parse trusted commit: %w", err)
+				}
+			}
+
+			evidence, err := types.NewLightClientAttack(block.SignedHeader, block.ValidatorSet, commonHeight, trustedCommit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitEvidence(clientCtx.GetFromAddress(), evidence)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagTrustedCommit, "", "JSON input or path to a .json file of the commit of the block of the chain at the conflicting height")
+
+	return cmd
+}
+
+// unmarshalJSONOrFile decodes JSON input, or the contents of the file at the
+// given path if the input is not JSON.
+func unmarshalJSONOrFile(cdc *codec.Codec, input string, ptr interface{}) error {
+	if err := cdc.UnmarshalJSON([]byte(input), ptr); err == nil {
+		return nil
+	}
+
+	contents, err := ioutil.ReadFile(input)
+	if err != nil {
+		return fmt.Errorf("neither JSON input nor path to .json file were provided")
+	}
+
+	return cdc.UnmarshalJSON(contents, ptr)
+}
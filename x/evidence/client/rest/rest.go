@@ -12,7 +12,8 @@ import (
 const (
 	RestParamEvidenceHash = "evidence-hash"
 
-	MethodGet = "GET"
+	MethodGet  = "GET"
+	MethodPost = "POST"
 )
 
 // EvidenceRESTHandler defines a REST service evidence handler implemented in
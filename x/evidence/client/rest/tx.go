@@ -1,11 +1,71 @@
 package rest
 
 import (
-	"github.com/cosmos/cosmos-sdk/client"
+	"fmt"
+	"net/http"
 
 	"github.com/gorilla/mux"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
+// LightClientAttackReq defines the properties of a light client attack
+// evidence submission request's body.
+type LightClientAttackReq struct {
+	BaseReq          rest.BaseReq     `json:"base_req" yaml:"base_req"`
+	ConflictingBlock types.LightBlock `json:"conflicting_block" yaml:"conflicting_block"`
+	CommonHeight     int64            `json:"common_height" yaml:"common_height"`
+	TrustedCommit    *tmtypes.Commit  `json:"trusted_commit" yaml:"trusted_commit"`
+}
+
 func registerTxRoutes(clientCtx client.Context, r *mux.Router, handlers []EvidenceRESTHandler) {
-	// TODO: Register tx handlers.
+	r.HandleFunc(
+		"/evidence/light_client_attack",
+		submitLightClientAttackHandlerFn(clientCtx),
+	).Methods(MethodPost)
+
+	for _, h := range handlers {
+		r.HandleFunc(fmt.Sprintf("/evidence/%s", h.SubRoute), h.Handler).Methods(MethodPost)
+	}
+}
+
+func submitLightClientAttackHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req LightClientAttackReq
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		evidence, err := types.NewLightClientAttack(
+			req.ConflictingBlock.SignedHeader, req.ConflictingBlock.ValidatorSet, req.CommonHeight, req.TrustedCommit,
+		)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg, err := types.NewMsgSubmitEvidence(fromAddr, evidence)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
 }
@@ -42,3 +42,17 @@ func handleMsgSubmitEvidence(ctx sdk.Context, k keeper.Keeper, msg exported.MsgS
 		Events: ctx.EventManager().ABCIEvents(),
 	}, nil
 }
+
+// NewLightClientAttackHandler returns the Evidence Handler of light client
+// attacks, to be registered on the evidence router under
+// RouteLightClientAttack.
+func NewLightClientAttackHandler(k keeper.Keeper) types.Handler {
+	return func(ctx sdk.Context, evidence exported.Evidence) error {
+		attack, ok := evidence.(*types.LightClientAttack)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidEvidence, "expected light client attack evidence, got %T", evidence)
+		}
+
+		return k.HandleLightClientAttack(ctx, attack)
+	}
+}
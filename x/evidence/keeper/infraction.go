@@ -1,11 +1,18 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmmath "github.com/tendermint/tendermint/libs/math"
+	tmtypes "github.com/tendermint/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // HandleDoubleSign implements an equivocation evidence handler. Assuming the
@@ -20,8 +27,9 @@ import (
 // - the signing info does not exist (will panic)
 // - is already tombstoned
 //
-// TODO: Some of the invalid constraints listed above may need to be reconsidered
-// in the case of a lunatic attack.
+// Evidence of an infraction committed with a consensus pubkey the validator
+// has since rotated away from is handled as long as the old consensus address
+// still refers to the validator, i.e. within an unbonding period.
 func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence *types.Equivocation) {
 	logger := k.Logger(ctx)
 	consAddr := evidence.GetConsensusAddress()
@@ -100,6 +108,209 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence *types.Equivocation)
 	// That's fine since this is just used to filter unbonding delegations & redelegations.
 	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
 
+	k.slashAndTombstone(ctx, validator, consAddr, evidence.GetValidatorPower(), distributionHeight)
+}
+
+// HandleLightClientAttack implements a light client attack evidence handler.
+// The conflicting block is checked against the historical info of the chain,
+// which must still be available at both the common and the conflicting
+// heights. As the staking module only keeps the historical info of the last
+// HistoricalEntries blocks, attacks with an older common height cannot be
+// handled. The attack is classified as either:
+//
+// - lunatic: the conflicting header was derived from an invalid state. The
+// validators of the common height which signed it are byzantine.
+// - equivocation: the conflicting header is valid and was committed in the
+// same round as the block of the chain. The validators which signed both
+// blocks are byzantine.
+// - amnesia: the conflicting header is valid but was committed in another
+// round than the block of the chain. The validators at fault cannot be told
+// apart from honest ones, hence none is punished.
+//
+// The byzantine validators are slashed, jailed and tombstoned as for an
+// equivocation. An error is returned if the evidence is invalid.
+func (k Keeper) HandleLightClientAttack(ctx sdk.Context, evidence *types.LightClientAttack) error {
+	logger := k.Logger(ctx)
+
+	header, err := evidence.GetConflictingHeader()
+	if err != nil {
+		return err
+	}
+	if header.ChainID != ctx.ChainID() {
+		return fmt.Errorf("conflicting header belongs to another chain %q", header.ChainID)
+	}
+
+	// the block of the chain at the conflicting height must be committed, its
+	// hash being recorded by the header of the following block
+	height, commonHeight := header.Height, evidence.CommonHeight
+	if height >= ctx.BlockHeight() {
+		return fmt.Errorf("conflicting height %d is not lower than the current height %d", height, ctx.BlockHeight())
+	}
+
+	historicalEntries := k.stakingKeeper.HistoricalEntries(ctx)
+	if commonHeight <= ctx.BlockHeight()-int64(historicalEntries) {
+		return sdkerrors.Wrapf(
+			types.ErrNoHistoricalInfo,
+			"common height %d is older than the last %d blocks kept by the staking module (HistoricalEntries)",
+			commonHeight, historicalEntries,
+		)
+	}
+
+	commonInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, commonHeight)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoHistoricalInfo, "common height %d", commonHeight)
+	}
+
+	trustedInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoHistoricalInfo, "conflicting height %d", height)
+	}
+
+	nextInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, height+1)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoHistoricalInfo, "height %d", height+1)
+	}
+
+	// Reject evidence if the attack is too old, as for a double-sign. The age
+	// is counted from the common block, which is the last one the attacked
+	// light client trusted.
+	ageDuration := ctx.BlockHeader().Time.Sub(commonInfo.Header.Time)
+	ageBlocks := ctx.BlockHeight() - commonHeight
+
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Evidence != nil {
+		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
+			return fmt.Errorf("evidence too old; common height %d, common time %s", commonHeight, commonInfo.Header.Time)
+		}
+	}
+
+	trustedBlockHash := nextInfo.Header.LastBlockId.Hash
+	if bytes.Equal(header.Hash(), trustedBlockHash) {
+		return fmt.Errorf("header at height %d does not conflict with the chain", height)
+	}
+
+	// The light client only trusts a block signed by more than a third of the
+	// validator set it trusts, which in turn makes the attack attributable.
+	commonValSet, err := k.historicalValidatorSet(ctx, commonHeight)
+	if err != nil {
+		return err
+	}
+
+	err = commonValSet.VerifyCommitTrusting(
+		ctx.ChainID(), header.Commit.BlockID, height, header.Commit, tmmath.Fraction{Numerator: 1, Denominator: 3},
+	)
+	if err != nil {
+		return fmt.Errorf("conflicting block not signed by a third of the common validator set: %w", err)
+	}
+
+	var (
+		attackType       string
+		byzantineVals    []*tmtypes.Validator
+		infractionHeight int64
+	)
+
+	if isLunaticHeader(trustedInfo.Header, *header.Header) {
+		attackType = types.AttributeValueLunaticAttack
+		byzantineVals = commitSigners(ctx.ChainID(), commonValSet, header.Commit)
+		infractionHeight = commonHeight
+	} else {
+		trustedCommit, err := evidence.GetTrustedCommit()
+		if err != nil {
+			return err
+		}
+		if trustedCommit == nil {
+			return fmt.Errorf("trusted commit required to attribute an attack with a valid conflicting header")
+		}
+		if !bytes.Equal(trustedCommit.BlockID.Hash, trustedBlockHash) {
+			return fmt.Errorf("trusted commit does not commit to the block of the chain at height %d", height)
+		}
+
+		valSet, err := k.historicalValidatorSet(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		err = valSet.VerifyCommitTrusting(
+			ctx.ChainID(), trustedCommit.BlockID, height, trustedCommit, tmmath.Fraction{Numerator: 2, Denominator: 3},
+		)
+		if err != nil {
+			return fmt.Errorf("invalid trusted commit: %w", err)
+		}
+
+		infractionHeight = height
+		if trustedCommit.Round == header.Commit.Round {
+			attackType = types.AttributeValueEquivocationAttack
+
+			trustedSigners := make(map[string]bool)
+			for _, val := range commitSigners(ctx.ChainID(), valSet, trustedCommit) {
+				trustedSigners[val.Address.String()] = true
+			}
+
+			for _, val := range commitSigners(ctx.ChainID(), valSet, header.Commit) {
+				if trustedSigners[val.Address.String()] {
+					byzantineVals = append(byzantineVals, val)
+				}
+			}
+		} else {
+			attackType = types.AttributeValueAmnesiaAttack
+		}
+	}
+
+	logger.Info(
+		"confirmed light client attack",
+		"attack_type", attackType,
+		"conflicting_height", height,
+		"common_height", commonHeight,
+		"byzantine_validators", len(byzantineVals),
+	)
+
+	event := sdk.NewEvent(
+		types.EventTypeLightClientAttack,
+		sdk.NewAttribute(types.AttributeKeyAttackType, attackType),
+	)
+
+	// the stake distribution which signed the block is retrieved as for a
+	// double-sign
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	for _, val := range byzantineVals {
+		consAddr := sdk.ConsAddress(val.Address)
+
+		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+		if validator == nil || validator.IsUnbonded() {
+			continue
+		}
+
+		if !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) || k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+			continue
+		}
+
+		k.slashAndTombstone(ctx, validator, consAddr, val.VotingPower, distributionHeight)
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyByzantineValidator, consAddr.String()))
+	}
+
+	ctx.EventManager().EmitEvent(event)
+
+	return nil
+}
+
+// historicalValidatorSet returns the validator set which signed the block at
+// the given height. As validator updates are applied with a delay, it is the
+// validator set recorded by the historical info of an earlier height.
+func (k Keeper) historicalValidatorSet(ctx sdk.Context, height int64) (*tmtypes.ValidatorSet, error) {
+	hi, found := k.stakingKeeper.GetHistoricalInfo(ctx, height-sdk.ValidatorUpdateDelay)
+	if !found {
+		return nil, fmt.Errorf("no historical info found at height %d", height-sdk.ValidatorUpdateDelay)
+	}
+
+	return tmtypes.NewValidatorSet(stakingtypes.Validators(hi.Valset).ToTmValidators()), nil
+}
+
+// slashAndTombstone slashes, jails and tombstones a validator which committed
+// a double-sign or took part in a light client attack.
+func (k Keeper) slashAndTombstone(
+	ctx sdk.Context, validator stakingexported.ValidatorI, consAddr sdk.ConsAddress, power, distributionHeight int64,
+) {
 	// Slash validator. The `power` is the int64 power of the validator as provided
 	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
@@ -108,7 +319,7 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence *types.Equivocation)
 		ctx,
 		consAddr,
 		k.slashingKeeper.SlashFractionDoubleSign(ctx),
-		evidence.GetValidatorPower(), distributionHeight,
+		power, distributionHeight,
 	)
 
 	// Jail the validator if not already jailed. This will begin unbonding the
@@ -119,4 +330,54 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence *types.Equivocation)
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
+
+	// The validator may have rotated its consensus pubkey since the infraction,
+	// in which case the signing info of its current consensus address must be
+	// tombstoned as well to prevent the validator from being unjailed.
+	currentConsAddr := validator.GetConsAddr()
+	if !currentConsAddr.Equals(consAddr) &&
+		k.slashingKeeper.HasValidatorSigningInfo(ctx, currentConsAddr) &&
+		!k.slashingKeeper.IsTombstoned(ctx, currentConsAddr) {
+		k.slashingKeeper.JailUntil(ctx, currentConsAddr, types.DoubleSignJailEndTime)
+		k.slashingKeeper.Tombstone(ctx, currentConsAddr)
+	}
+}
+
+// isLunaticHeader returns true if a conflicting header was derived from a state
+// differing from the one of the block of the chain at the same height.
+func isLunaticHeader(trusted abci.Header, conflicting tmtypes.Header) bool {
+	return !bytes.Equal(trusted.ValidatorsHash, conflicting.ValidatorsHash) ||
+		!bytes.Equal(trusted.NextValidatorsHash, conflicting.NextValidatorsHash) ||
+		!bytes.Equal(trusted.ConsensusHash, conflicting.ConsensusHash) ||
+		!bytes.Equal(trusted.AppHash, conflicting.AppHash) ||
+		!bytes.Equal(trusted.LastResultsHash, conflicting.LastResultsHash)
+}
+
+// commitSigners returns the validators of a validator set which signed the
+// block of a commit with a valid signature.
+func commitSigners(chainID string, valSet *tmtypes.ValidatorSet, commit *tmtypes.Commit) []*tmtypes.Validator {
+	var (
+		signers []*tmtypes.Validator
+		seen    = make(map[int]bool)
+	)
+
+	for idx, commitSig := range commit.Signatures {
+		if !commitSig.ForBlock() {
+			continue
+		}
+
+		valIdx, val := valSet.GetByAddress(commitSig.ValidatorAddress)
+		if val == nil || seen[valIdx] {
+			continue
+		}
+
+		if !val.PubKey.VerifyBytes(commit.VoteSignBytes(chainID, idx), commitSig.Signature) {
+			continue
+		}
+
+		seen[valIdx] = true
+		signers = append(signers, val)
+	}
+
+	return signers
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,7 +9,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func newTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) *stakingtypes.MsgCreateValidator {
@@ -124,3 +129,201 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 }
+
+// setupLightClientAttack creates a validator for each of the given consensus
+// keys and records the historical info of the heights 1 to 3, the block of the
+// chain at height 2 having the given hash. It returns the validator set and
+// the header of the block at height 2.
+func (suite *KeeperTestSuite) setupLightClientAttack(
+	ctx sdk.Context, privKeys []crypto.PrivKey, blockHash []byte,
+) (*tmtypes.ValidatorSet, abci.Header) {
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	for i, privKey := range privKeys {
+		msg := newTestMsgCreateValidator(valAddresses[i], privKey.PubKey(), sdk.TokensFromConsensusPower(power))
+		_, err := staking.NewHandler(suite.app.StakingKeeper)(ctx, msg)
+		suite.NoError(err)
+	}
+
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// handle a signature to set signing info
+	for _, privKey := range privKeys {
+		suite.app.SlashingKeeper.HandleValidatorSignature(ctx, privKey.PubKey().Address(), power, true)
+	}
+
+	validators := suite.app.StakingKeeper.GetLastValidators(ctx)
+	valSet := tmtypes.NewValidatorSet(stakingtypes.Validators(validators).ToTmValidators())
+
+	header := abci.Header{
+		ChainID:            ctx.ChainID(),
+		Height:             2,
+		Time:               ctx.BlockTime(),
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		AppHash:            tmhash.Sum([]byte("app_hash")),
+	}
+
+	suite.app.StakingKeeper.SetHistoricalInfo(ctx, 1, stakingtypes.NewHistoricalInfo(abci.Header{Height: 1}, validators))
+	suite.app.StakingKeeper.SetHistoricalInfo(ctx, 2, stakingtypes.NewHistoricalInfo(header, validators))
+	suite.app.StakingKeeper.SetHistoricalInfo(ctx, 3, stakingtypes.NewHistoricalInfo(
+		abci.Header{Height: 3, LastBlockId: abci.BlockID{Hash: blockHash}}, validators,
+	))
+
+	return valSet, header
+}
+
+// makeCommit returns the commit of the given block by all the validators of
+// the given validator set in the given round.
+func (suite *KeeperTestSuite) makeCommit(
+	chainID string, blockID tmtypes.BlockID, height int64, round int, valSet *tmtypes.ValidatorSet, privKeys []crypto.PrivKey,
+) *tmtypes.Commit {
+	// the validators must sign in the order of the validator set
+	privVals := make([]tmtypes.PrivValidator, len(privKeys))
+	for _, privKey := range privKeys {
+		idx, _ := valSet.GetByAddress(privKey.PubKey().Address())
+		privVals[idx] = tmtypes.NewMockPVWithParams(privKey, false, false)
+	}
+
+	voteSet := tmtypes.NewVoteSet(chainID, height, round, tmtypes.PrecommitType, valSet)
+	commit, err := tmtypes.MakeCommit(blockID, height, round, voteSet, privVals, time.Now().UTC())
+	suite.NoError(err)
+
+	return commit
+}
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack() {
+	trustedBlockHash := tmhash.Sum([]byte("trusted_block"))
+	partsHeader := tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))}
+
+	testCases := []struct {
+		name         string
+		appHash      []byte
+		round        int
+		trustedRound int
+		expSlashed   bool
+	}{
+		{"lunatic", tmhash.Sum([]byte("invalid_app_hash")), 0, 0, true},
+		{"equivocation", nil, 0, 0, true},
+		{"amnesia", nil, 1, 0, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(4).WithChainID("test-chain")
+
+			privKeys := []crypto.PrivKey{ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+			valSet, trustedHeader := suite.setupLightClientAttack(ctx, privKeys, trustedBlockHash)
+
+			// the conflicting header only differs from the block of the chain by its
+			// data, unless it was derived from another state
+			conflictingHeader := tmtypes.Header{
+				ChainID:            ctx.ChainID(),
+				Height:             2,
+				Time:               trustedHeader.Time,
+				DataHash:           tmhash.Sum([]byte("conflicting_data")),
+				ValidatorsHash:     trustedHeader.ValidatorsHash,
+				NextValidatorsHash: trustedHeader.NextValidatorsHash,
+				AppHash:            trustedHeader.AppHash,
+				ProposerAddress:    valSet.Validators[0].Address,
+			}
+			if tc.appHash != nil {
+				conflictingHeader.AppHash = tc.appHash
+			}
+
+			blockID := tmtypes.BlockID{Hash: conflictingHeader.Hash(), PartsHeader: partsHeader}
+			signedHeader := &tmtypes.SignedHeader{
+				Header: &conflictingHeader,
+				Commit: suite.makeCommit(ctx.ChainID(), blockID, 2, tc.round, valSet, privKeys),
+			}
+
+			trustedBlockID := tmtypes.BlockID{Hash: trustedBlockHash, PartsHeader: partsHeader}
+			trustedCommit := suite.makeCommit(ctx.ChainID(), trustedBlockID, 2, tc.trustedRound, valSet, privKeys)
+
+			evidence, err := types.NewLightClientAttack(signedHeader, valSet, 2, trustedCommit)
+			suite.NoError(err)
+			suite.NoError(evidence.ValidateBasic())
+			suite.Equal(int64(2), evidence.GetHeight())
+
+			oldTokens := suite.app.StakingKeeper.Validator(ctx, valAddresses[0]).GetTokens()
+			suite.NoError(suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence))
+
+			for i, privKey := range privKeys {
+				consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+				validator := suite.app.StakingKeeper.Validator(ctx, valAddresses[i])
+
+				suite.Equal(tc.expSlashed, validator.IsJailed())
+				suite.Equal(tc.expSlashed, suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+				suite.Equal(tc.expSlashed, validator.GetTokens().LT(oldTokens))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack_Invalid() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(4).WithChainID("test-chain")
+	privKeys := []crypto.PrivKey{ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+	partsHeader := tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))}
+
+	trustedBlockHash := tmhash.Sum([]byte("trusted_block"))
+	valSet, trustedHeader := suite.setupLightClientAttack(ctx, privKeys, trustedBlockHash)
+
+	conflictingHeader := tmtypes.Header{
+		ChainID:            ctx.ChainID(),
+		Height:             2,
+		Time:               trustedHeader.Time,
+		DataHash:           tmhash.Sum([]byte("conflicting_data")),
+		ValidatorsHash:     trustedHeader.ValidatorsHash,
+		NextValidatorsHash: trustedHeader.NextValidatorsHash,
+		AppHash:            trustedHeader.AppHash,
+		ProposerAddress:    valSet.Validators[0].Address,
+	}
+
+	blockID := tmtypes.BlockID{Hash: conflictingHeader.Hash(), PartsHeader: partsHeader}
+	signedHeader := &tmtypes.SignedHeader{
+		Header: &conflictingHeader,
+		Commit: suite.makeCommit(ctx.ChainID(), blockID, 2, 0, valSet, privKeys),
+	}
+
+	// a valid conflicting header cannot be attributed without a trusted commit
+	evidence, err := types.NewLightClientAttack(signedHeader, valSet, 2, nil)
+	suite.NoError(err)
+	suite.Error(suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence))
+
+	// the conflicting height must be committed
+	suite.Error(suite.app.EvidenceKeeper.HandleLightClientAttack(ctx.WithBlockHeight(2), evidence))
+
+	// the common height must be within the historical info kept by staking
+	stakingParams := suite.app.StakingKeeper.GetParams(ctx)
+	stakingParams.HistoricalEntries = 2
+	suite.app.StakingKeeper.SetParams(ctx, stakingParams)
+	err = suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence)
+	suite.True(errors.Is(err, types.ErrNoHistoricalInfo), err)
+	stakingParams.HistoricalEntries = 100
+	suite.app.StakingKeeper.SetParams(ctx, stakingParams)
+
+	// the historical info at the common height must be available
+	evidence, err = types.NewLightClientAttack(signedHeader, valSet, 1, nil)
+	suite.NoError(err)
+	suite.app.StakingKeeper.DeleteHistoricalInfo(ctx, 1)
+	err = suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence)
+	suite.True(errors.Is(err, types.ErrNoHistoricalInfo), err)
+
+	// the conflicting block must be signed by a third of the common validator set
+	otherPrivKeys := []crypto.PrivKey{ed25519.GenPrivKey()}
+	otherValSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(otherPrivKeys[0].PubKey(), 100)})
+	conflictingHeader.ValidatorsHash = otherValSet.Hash()
+	blockID = tmtypes.BlockID{Hash: conflictingHeader.Hash(), PartsHeader: partsHeader}
+	signedHeader.Commit = suite.makeCommit(ctx.ChainID(), blockID, 2, 0, otherValSet, otherPrivKeys)
+
+	evidence, err = types.NewLightClientAttack(signedHeader, otherValSet, 2, nil)
+	suite.NoError(err)
+	suite.NoError(evidence.ValidateBasic())
+	suite.Error(suite.app.EvidenceKeeper.HandleLightClientAttack(ctx, evidence))
+
+	for i := range privKeys {
+		suite.False(suite.app.StakingKeeper.Validator(ctx, valAddresses[i]).IsJailed())
+	}
+}
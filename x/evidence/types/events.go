@@ -2,8 +2,14 @@ package types
 
 // evidence module events
 const (
-	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeSubmitEvidence    = "submit_evidence"
+	EventTypeLightClientAttack = "light_client_attack"
 
-	AttributeValueCategory   = "evidence"
-	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeValueCategory           = "evidence"
+	AttributeKeyEvidenceHash         = "evidence_hash"
+	AttributeKeyAttackType           = "attack_type"
+	AttributeKeyByzantineValidator   = "byzantine_validator"
+	AttributeValueLunaticAttack      = "lunatic"
+	AttributeValueEquivocationAttack = "equivocation"
+	AttributeValueAmnesiaAttack      = "amnesia"
 )
@@ -1,25 +1,35 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"gopkg.in/yaml.v2"
 )
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "light_client_attack"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &LightClientAttack{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -90,3 +100,185 @@ func ConvertDuplicateVoteEvidence(dupVote abci.Evidence) exported.Evidence {
 		Time:             dupVote.Time,
 	}
 }
+
+// LightBlock defines the JSON representation of a block header along with its
+// commit and the validator set which signed it, as returned by the commit and
+// validators Tendermint RPC endpoints.
+type LightBlock struct {
+	SignedHeader *tmtypes.SignedHeader `json:"signed_header" yaml:"signed_header"`
+	ValidatorSet *tmtypes.ValidatorSet `json:"validator_set" yaml:"validator_set"`
+}
+
+// NewLightClientAttack returns a new LightClientAttack from the signed header
+// and the validator set of the conflicting block. The trusted commit is
+// optional and may be nil.
+func NewLightClientAttack(
+	conflictingHeader *tmtypes.SignedHeader, conflictingValSet *tmtypes.ValidatorSet,
+	commonHeight int64, trustedCommit *tmtypes.Commit,
+) (*LightClientAttack, error) {
+	if conflictingHeader == nil {
+		return nil, fmt.Errorf("missing conflicting header")
+	}
+	headerBz, err := proto.Marshal(conflictingHeader.ToProto())
+	if err != nil {
+		return nil, err
+	}
+
+	valSetProto, err := conflictingValSet.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	valSetBz, err := proto.Marshal(valSetProto)
+	if err != nil {
+		return nil, err
+	}
+
+	var commitBz []byte
+	if trustedCommit != nil {
+		if commitBz, err = proto.Marshal(trustedCommit.ToProto()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &LightClientAttack{
+		ConflictingHeader:       headerBz,
+		ConflictingValidatorSet: valSetBz,
+		CommonHeight:            commonHeight,
+		TrustedCommit:           commitBz,
+	}, nil
+}
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e *LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e *LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() tmbytes.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on a
+// LightClientAttack object. The conflicting block must have been committed by
+// its own validator set.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.CommonHeight < 1 {
+		return fmt.Errorf("invalid light client attack common height: %d", e.CommonHeight)
+	}
+
+	header, err := e.GetConflictingHeader()
+	if err != nil {
+		return fmt.Errorf("invalid light client attack conflicting header: %w", err)
+	}
+	if err := header.ValidateBasic(header.ChainID); err != nil {
+		return fmt.Errorf("invalid light client attack conflicting header: %w", err)
+	}
+	if header.Height < e.CommonHeight {
+		return fmt.Errorf(
+			"light client attack conflicting height %d is lower than common height %d", header.Height, e.CommonHeight,
+		)
+	}
+
+	valSet, err := e.GetConflictingValidatorSet()
+	if err != nil {
+		return fmt.Errorf("invalid light client attack conflicting validator set: %w", err)
+	}
+	if !bytes.Equal(header.ValidatorsHash, valSet.Hash()) {
+		return fmt.Errorf("light client attack conflicting validator set does not match the header validators hash")
+	}
+	if err := valSet.VerifyCommit(header.ChainID, header.Commit.BlockID, header.Height, header.Commit); err != nil {
+		return fmt.Errorf("invalid light client attack conflicting commit: %w", err)
+	}
+
+	commit, err := e.GetTrustedCommit()
+	if err != nil {
+		return fmt.Errorf("invalid light client attack trusted commit: %w", err)
+	}
+	if commit != nil {
+		if err := commit.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid light client attack trusted commit: %w", err)
+		}
+		if commit.Height != header.Height {
+			return fmt.Errorf(
+				"light client attack trusted commit height %d differs from conflicting height %d", commit.Height, header.Height,
+			)
+		}
+		if commit.BlockID.Equals(header.Commit.BlockID) {
+			return fmt.Errorf("light client attack trusted commit and conflicting header commit to the same block")
+		}
+	}
+
+	return nil
+}
+
+// GetConflictingHeader decodes the signed header of the conflicting block.
+func (e LightClientAttack) GetConflictingHeader() (*tmtypes.SignedHeader, error) {
+	var header tmproto.SignedHeader
+	if err := proto.Unmarshal(e.ConflictingHeader, &header); err != nil {
+		return nil, err
+	}
+
+	return tmtypes.SignedHeaderFromProto(&header)
+}
+
+// GetConflictingValidatorSet decodes the validator set of the conflicting
+// block.
+func (e LightClientAttack) GetConflictingValidatorSet() (*tmtypes.ValidatorSet, error) {
+	var valSetProto tmproto.ValidatorSet
+	if err := proto.Unmarshal(e.ConflictingValidatorSet, &valSetProto); err != nil {
+		return nil, err
+	}
+
+	valSet, err := tmtypes.ValidatorSetFromProto(&valSetProto)
+	if err != nil {
+		return nil, err
+	}
+	if valSet.IsNilOrEmpty() {
+		return nil, fmt.Errorf("empty validator set")
+	}
+
+	// the total voting power is decoded as is, so it must be checked against
+	// the power of the validators
+	var totalVotingPower int64
+	for _, val := range valSet.Validators {
+		totalVotingPower += val.VotingPower
+	}
+	if totalVotingPower != valSet.TotalVotingPower() {
+		return nil, fmt.Errorf("invalid total voting power: %d", valSet.TotalVotingPower())
+	}
+
+	return valSet, nil
+}
+
+// GetTrustedCommit decodes the commit of the block of the chain at the height
+// of the conflicting block. It returns nil if the evidence has no trusted
+// commit.
+func (e LightClientAttack) GetTrustedCommit() (*tmtypes.Commit, error) {
+	if len(e.TrustedCommit) == 0 {
+		return nil, nil
+	}
+
+	var commit tmproto.Commit
+	if err := proto.Unmarshal(e.TrustedCommit, &commit); err != nil {
+		return nil, err
+	}
+
+	return tmtypes.CommitFromProto(&commit)
+}
+
+// GetHeight returns the height of the conflicting block, or zero if its header
+// cannot be decoded.
+func (e LightClientAttack) GetHeight() int64 {
+	header, err := e.GetConflictingHeader()
+	if err != nil {
+		return 0
+	}
+
+	return header.Height
+}
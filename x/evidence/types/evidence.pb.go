@@ -111,40 +111,102 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// LightClientAttack implements the Evidence interface and defines evidence of
+// a light client attack, i.e. of a block conflicting with the chain which was
+// signed by validators of a trusted validator set.
+type LightClientAttack struct {
+	// conflicting_header is the protobuf encoded tendermint signed header of the
+	// conflicting block.
+	ConflictingHeader []byte `protobuf:"bytes,1,opt,name=conflicting_header,json=conflictingHeader,proto3" json:"conflicting_header,omitempty" yaml:"conflicting_header"`
+	// conflicting_validator_set is the protobuf encoded tendermint validator set
+	// which signed the conflicting block.
+	ConflictingValidatorSet []byte `protobuf:"bytes,2,opt,name=conflicting_validator_set,json=conflictingValidatorSet,proto3" json:"conflicting_validator_set,omitempty" yaml:"conflicting_validator_set"`
+	// common_height is the height of the last block the attacked light client
+	// and the chain have in common.
+	CommonHeight int64 `protobuf:"varint,3,opt,name=common_height,json=commonHeight,proto3" json:"common_height,omitempty" yaml:"common_height"`
+	// trusted_commit is the protobuf encoded tendermint commit of the block of
+	// the chain at the height of the conflicting block. It is only required to
+	// attribute an attack whose conflicting header is valid.
+	TrustedCommit []byte `protobuf:"bytes,4,opt,name=trusted_commit,json=trustedCommit,proto3" json:"trusted_commit,omitempty" yaml:"trusted_commit"`
+}
+
+func (m *LightClientAttack) Reset()      { *m = LightClientAttack{} }
+func (*LightClientAttack) ProtoMessage() {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2cafccc38cf08ce, []int{2}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitEvidence)(nil), "cosmos.evidence.MsgSubmitEvidence")
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.Equivocation")
+	proto.RegisterType((*LightClientAttack)(nil), "cosmos.evidence.LightClientAttack")
 }
 
 func init() { proto.RegisterFile("cosmos/evidence/evidence.proto", fileDescriptor_a2cafccc38cf08ce) }
 
 var fileDescriptor_a2cafccc38cf08ce = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0x85, 0x33, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xf8, 0x21, 0xf2, 0x7a, 0x30, 0x61, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c,
-	0xb0, 0x9c, 0x3e, 0x88, 0x05, 0x51, 0x26, 0x25, 0x9f, 0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x0f,
-	0xe6, 0x25, 0x95, 0xa6, 0xe9, 0x97, 0x64, 0xe6, 0xa6, 0x16, 0x97, 0x24, 0xe6, 0x16, 0x40, 0x15,
-	0x48, 0xa2, 0x2b, 0x48, 0xcc, 0xab, 0x84, 0x48, 0x29, 0xcd, 0x61, 0xe4, 0x12, 0xf4, 0x2d, 0x4e,
-	0x0f, 0x2e, 0x4d, 0xca, 0xcd, 0x2c, 0x71, 0x85, 0xda, 0x23, 0xe4, 0xcf, 0xc5, 0x59, 0x0c, 0x16,
-	0x29, 0x49, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x71, 0x32, 0xfc, 0x75, 0x4f, 0x5e, 0x37,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x74, 0x08, 0xa5, 0x5b,
-	0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0xe7, 0x98, 0x9c, 0xec, 0x98, 0x92, 0x52,
-	0x94, 0x5a, 0x5c, 0x1c, 0x84, 0x30, 0x43, 0xc8, 0x80, 0x8b, 0x03, 0xe6, 0x09, 0x09, 0x26, 0x05,
-	0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d, 0x88, 0xa3, 0xf4, 0x60, 0x8e, 0xd2, 0x73, 0xcc, 0xab, 0x0c,
-	0x82, 0xab, 0xb2, 0x62, 0xe9, 0x58, 0x20, 0xcf, 0xa0, 0xf4, 0x97, 0x91, 0x8b, 0xc7, 0xb5, 0xb0,
-	0x34, 0xb3, 0x2c, 0x3f, 0x39, 0xb1, 0x24, 0x33, 0x3f, 0x4f, 0x48, 0x8c, 0x8b, 0x2d, 0x23, 0x35,
-	0x33, 0x3d, 0xa3, 0x04, 0xec, 0x2c, 0xe6, 0x20, 0x28, 0x4f, 0xc8, 0x82, 0x8b, 0x05, 0xe4, 0x6b,
-	0xa8, 0xe1, 0x52, 0x18, 0x86, 0x87, 0xc0, 0x82, 0xc4, 0x89, 0xe3, 0xc4, 0x3d, 0x79, 0x86, 0x09,
-	0xf7, 0xe5, 0x19, 0x83, 0xc0, 0x3a, 0x84, 0x44, 0xb8, 0x58, 0x0b, 0xf2, 0xcb, 0x53, 0x8b, 0x24,
-	0x98, 0xc1, 0x06, 0x42, 0x38, 0x42, 0xd5, 0x5c, 0x82, 0xc9, 0xf9, 0x79, 0xc5, 0xa9, 0x79, 0xc5,
-	0xa5, 0xc5, 0xf1, 0x89, 0x10, 0x0f, 0x49, 0xb0, 0x80, 0x43, 0xc2, 0xef, 0xd3, 0x3d, 0x79, 0x89,
-	0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x0c, 0x25, 0x4a, 0xbf, 0xee, 0xc9, 0xeb, 0x11, 0x11, 0x4a,
-	0xce, 0xf9, 0x79, 0xc5, 0xb0, 0x60, 0x12, 0x80, 0x9b, 0x02, 0x15, 0xb1, 0xe2, 0x00, 0xf9, 0x7d,
-	0xc6, 0x02, 0x79, 0x06, 0x27, 0xef, 0x15, 0x8f, 0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0x0a, 0x7f, 0x7c, 0x54, 0x20, 0xd2, 0x15, 0xd8, 0xd2, 0x24, 0x36, 0x70,
-	0x68, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x56, 0x68, 0x52, 0xd8, 0x77, 0x02, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0xdb, 0x50, 0x85, 0x23, 0x05, 0x72, 0x0a, 0xe0, 0x44, 0xc2, 0x17, 0x59, 0x0c, 0x5d,
+	0x6a, 0xf3, 0x63, 0x41, 0x91, 0x90, 0x48, 0xa2, 0x4a, 0x95, 0x28, 0x20, 0xb9, 0x88, 0x81, 0x25,
+	0x38, 0xe7, 0xab, 0x73, 0x6a, 0x7c, 0x17, 0x7c, 0xe7, 0x40, 0xc4, 0x3f, 0xc0, 0xd8, 0x91, 0x81,
+	0x81, 0x91, 0x3f, 0xa5, 0x13, 0xea, 0xc8, 0x64, 0x50, 0xf2, 0x1f, 0x64, 0xac, 0x84, 0x84, 0x7c,
+	0xfe, 0x91, 0xb4, 0x91, 0x50, 0xa7, 0xdc, 0xf7, 0xde, 0xbb, 0x97, 0xef, 0x7b, 0xdf, 0x19, 0x18,
+	0x98, 0x8b, 0x80, 0x0b, 0x9b, 0x4c, 0xa8, 0x47, 0x18, 0x26, 0xc5, 0xc1, 0x1a, 0x87, 0x5c, 0x72,
+	0x78, 0x2b, 0xe5, 0xad, 0x1c, 0x6e, 0xd6, 0x7d, 0xee, 0x73, 0xc5, 0xd9, 0xc9, 0x29, 0x95, 0x35,
+	0x91, 0xcf, 0xb9, 0x3f, 0x22, 0xb6, 0xaa, 0x06, 0xd1, 0x91, 0x2d, 0x69, 0x40, 0x84, 0x74, 0x83,
+	0x71, 0x26, 0x68, 0x5c, 0x16, 0xb8, 0x6c, 0x9a, 0x52, 0xe6, 0x37, 0x0d, 0xd4, 0x5e, 0x0a, 0xff,
+	0x30, 0x1a, 0x04, 0x54, 0xee, 0x65, 0xff, 0x03, 0x5f, 0x83, 0xeb, 0x42, 0x21, 0x92, 0x84, 0xba,
+	0xd6, 0xd2, 0x76, 0xaa, 0xdd, 0x47, 0xe7, 0x31, 0xda, 0xf5, 0xa9, 0x1c, 0x46, 0x03, 0x0b, 0xf3,
+	0xc0, 0xce, 0x5a, 0x4f, 0x7f, 0x76, 0x85, 0x77, 0x6c, 0xcb, 0xe9, 0x98, 0x08, 0xab, 0x83, 0x71,
+	0xc7, 0xf3, 0x42, 0x22, 0x84, 0xb3, 0xf4, 0x80, 0x0f, 0x41, 0x25, 0x1f, 0x42, 0xdf, 0x68, 0x69,
+	0x3b, 0x37, 0x1e, 0xd7, 0xad, 0xb4, 0x29, 0x2b, 0x6f, 0xca, 0xea, 0xb0, 0xa9, 0x53, 0xa8, 0xda,
+	0xe5, 0x2f, 0xdf, 0x51, 0xc9, 0xfc, 0xab, 0x81, 0xea, 0xde, 0x87, 0x88, 0x4e, 0x38, 0x76, 0x25,
+	0xe5, 0x0c, 0xde, 0x05, 0x5b, 0x43, 0x42, 0xfd, 0xa1, 0x54, 0x6d, 0x6d, 0x3a, 0x59, 0x05, 0x9f,
+	0x82, 0x72, 0x32, 0x75, 0x66, 0xde, 0x5c, 0x33, 0x7f, 0x93, 0x47, 0xd2, 0xad, 0x9c, 0xc6, 0xa8,
+	0x74, 0xf2, 0x1b, 0x69, 0x8e, 0xba, 0x01, 0xeb, 0xe0, 0xda, 0x98, 0x7f, 0x24, 0xa1, 0xbe, 0xa9,
+	0x0c, 0xd3, 0x02, 0x7e, 0x06, 0x35, 0xcc, 0x99, 0x20, 0x4c, 0x44, 0xa2, 0xef, 0xa6, 0x03, 0xe9,
+	0x65, 0x95, 0xc4, 0xab, 0x45, 0x8c, 0xf4, 0xa9, 0x1b, 0x8c, 0xda, 0xe6, 0x9a, 0xc4, 0x3c, 0x8f,
+	0x91, 0x75, 0x85, 0x94, 0x7a, 0x9c, 0x89, 0x3c, 0xa6, 0xdb, 0x85, 0x4b, 0x86, 0xb4, 0x2b, 0xc9,
+	0xec, 0x5f, 0x93, 0xf9, 0x7f, 0x6e, 0x80, 0xda, 0x41, 0x32, 0x60, 0x6f, 0x44, 0x09, 0x93, 0x1d,
+	0x29, 0x5d, 0x7c, 0x0c, 0x0f, 0x00, 0xc4, 0x9c, 0x1d, 0x8d, 0x28, 0x96, 0x94, 0xf9, 0xfd, 0x21,
+	0x71, 0xbd, 0x62, 0x4f, 0xf7, 0x17, 0x31, 0x6a, 0x14, 0xdd, 0x5d, 0xd2, 0x98, 0x4e, 0x6d, 0x05,
+	0xdc, 0x57, 0x18, 0x7c, 0x0f, 0x1a, 0xab, 0xca, 0x89, 0x3b, 0xa2, 0x9e, 0x2b, 0x79, 0xd8, 0x17,
+	0x44, 0xaa, 0x3c, 0xab, 0xdd, 0x07, 0x8b, 0x18, 0xb5, 0xd6, 0x4d, 0x2f, 0x48, 0x4d, 0xe7, 0xde,
+	0x0a, 0xf7, 0x36, 0xa7, 0x0e, 0x89, 0x84, 0xcf, 0xc0, 0x36, 0xe6, 0x41, 0xc0, 0x59, 0x3f, 0xdb,
+	0x9d, 0x8a, 0xba, 0xab, 0x2f, 0x62, 0x54, 0xcf, 0x5d, 0x57, 0x68, 0xd3, 0xa9, 0xa6, 0xf5, 0x7e,
+	0xba, 0xdb, 0xe7, 0xe0, 0xa6, 0x0c, 0x23, 0x21, 0x89, 0xd7, 0x4f, 0x70, 0x2a, 0xb3, 0x45, 0x34,
+	0x16, 0x31, 0xba, 0x93, 0xde, 0xbf, 0xc8, 0x9b, 0xce, 0x76, 0x06, 0xf4, 0x54, 0xbd, 0x0c, 0xb4,
+	0xfb, 0xe2, 0xc7, 0xcc, 0xd0, 0x4e, 0x67, 0x86, 0x76, 0x36, 0x33, 0xb4, 0x3f, 0x33, 0x43, 0x3b,
+	0x99, 0x1b, 0xa5, 0xb3, 0xb9, 0x51, 0xfa, 0x35, 0x37, 0x4a, 0xef, 0xfe, 0xff, 0xc0, 0x3f, 0x2d,
+	0x3f, 0x54, 0xb5, 0xc5, 0xc1, 0x96, 0x7a, 0x5e, 0x4f, 0xfe, 0x0d, 0x00, 0x27, 0x3f, 0xa9, 0xc0,
+	0xc8, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgSubmitEvidence) Equal(that interface{}) bool {
@@ -207,6 +269,39 @@ func (this *Equivocation) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LightClientAttack) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LightClientAttack)
+	if !ok {
+		that2, ok := that.(LightClientAttack)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ConflictingHeader, that1.ConflictingHeader) {
+		return false
+	}
+	if !bytes.Equal(this.ConflictingValidatorSet, that1.ConflictingValidatorSet) {
+		return false
+	}
+	if this.CommonHeight != that1.CommonHeight {
+		return false
+	}
+	if !bytes.Equal(this.TrustedCommit, that1.TrustedCommit) {
+		return false
+	}
+	return true
+}
 func (m *MsgSubmitEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -297,6 +392,55 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrustedCommit) > 0 {
+		i -= len(m.TrustedCommit)
+		copy(dAtA[i:], m.TrustedCommit)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.TrustedCommit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CommonHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.CommonHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConflictingValidatorSet) > 0 {
+		i -= len(m.ConflictingValidatorSet)
+		copy(dAtA[i:], m.ConflictingValidatorSet)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConflictingValidatorSet)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConflictingHeader) > 0 {
+		i -= len(m.ConflictingHeader)
+		copy(dAtA[i:], m.ConflictingHeader)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConflictingHeader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -346,6 +490,30 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConflictingHeader)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ConflictingValidatorSet)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.CommonHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.CommonHeight))
+	}
+	l = len(m.TrustedCommit)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -633,6 +801,180 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictingHeader = append(m.ConflictingHeader[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConflictingHeader == nil {
+				m.ConflictingHeader = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingValidatorSet", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictingValidatorSet = append(m.ConflictingValidatorSet[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConflictingValidatorSet == nil {
+				m.ConflictingValidatorSet = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonHeight", wireType)
+			}
+			m.CommonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedCommit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedCommit = append(m.TrustedCommit[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrustedCommit == nil {
+				m.TrustedCommit = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -54,3 +56,62 @@ func TestEquivocationValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	valSet, privVals := tmtypes.RandValidatorSet(3, 10)
+	otherValSet, _ := tmtypes.RandValidatorSet(3, 10)
+	partsHeader := tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))}
+
+	makeCommit := func(blockID tmtypes.BlockID) *tmtypes.Commit {
+		voteSet := tmtypes.NewVoteSet("test-chain", 10, 0, tmtypes.PrecommitType, valSet)
+		commit, err := tmtypes.MakeCommit(blockID, 10, 0, voteSet, privVals, time.Now().UTC())
+		require.NoError(t, err)
+		return commit
+	}
+
+	header := &tmtypes.Header{
+		ChainID:         "test-chain",
+		Height:          10,
+		Time:            time.Now().UTC(),
+		ValidatorsHash:  valSet.Hash(),
+		ProposerAddress: valSet.Validators[0].Address,
+	}
+	blockID := tmtypes.BlockID{Hash: header.Hash(), PartsHeader: partsHeader}
+	signedHeader := &tmtypes.SignedHeader{Header: header, Commit: makeCommit(blockID)}
+	trustedCommit := makeCommit(tmtypes.BlockID{Hash: tmhash.Sum([]byte("trusted_block")), PartsHeader: partsHeader})
+
+	testCases := []struct {
+		name          string
+		valSet        *tmtypes.ValidatorSet
+		commonHeight  int64
+		trustedCommit *tmtypes.Commit
+		expectErr     bool
+	}{
+		{"valid", valSet, 5, nil, false},
+		{"valid with trusted commit", valSet, 10, trustedCommit, false},
+		{"invalid common height", valSet, 0, nil, true},
+		{"common height above conflicting height", valSet, 11, nil, true},
+		{"validator set not matching header", otherValSet, 5, nil, true},
+		{"trusted commit to conflicting block", valSet, 5, signedHeader.Commit, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := types.NewLightClientAttack(signedHeader, tc.valSet, tc.commonHeight, tc.trustedCommit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectErr, e.ValidateBasic() != nil)
+			require.Equal(t, int64(10), e.GetHeight())
+			require.Equal(t, types.RouteLightClientAttack, e.Route())
+			require.Equal(t, types.TypeLightClientAttack, e.Type())
+		})
+	}
+
+	// the evidence can be submitted
+	e, err := types.NewLightClientAttack(signedHeader, valSet, 5, trustedCommit)
+	require.NoError(t, err)
+	msg, err := types.NewMsgSubmitEvidence(sdk.AccAddress("test"), e)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type (
@@ -14,6 +15,8 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingexported.ValidatorI
+		GetHistoricalInfo(sdk.Context, int64) (stakingtypes.HistoricalInfo, bool)
+		HistoricalEntries(sdk.Context) uint32
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,6 +33,21 @@ type Content interface {
 // governance process.
 type Handler func(ctx sdk.Context, content Content) error
 
+type proposalIDContextKey struct{}
+
+// WithProposalID returns a copy of the context holding the ID of the proposal
+// whose content is being handled.
+func WithProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), proposalIDContextKey{}, proposalID))
+}
+
+// ProposalIDFromContext returns the ID of the proposal whose content is being
+// handled. It returns false if the context does not hold a proposal ID.
+func ProposalIDFromContext(ctx sdk.Context) (uint64, bool) {
+	proposalID, ok := ctx.Context().Value(proposalIDContextKey{}).(uint64)
+	return proposalID, ok
+}
+
 // ValidateAbstract validates a proposal's abstract contents returning an error
 // if invalid.
 func ValidateAbstract(c Content) error {
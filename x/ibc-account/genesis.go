@@ -0,0 +1,47 @@
+package ibcaccount
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+// InitGenesis binds to the host port and to the controller ports of the
+// registered owners and restores the active channels from genesis state
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, state types.GenesisState) {
+	// Only try to bind to a port if it is not already bound, since we may already
+	// own the port capability from capability InitGenesis
+	if !keeper.IsBound(ctx, types.HostPortID) {
+		if err := keeper.BindPort(ctx, types.HostPortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	for _, po := range state.PortOwners {
+		if !keeper.IsBound(ctx, po.PortID) {
+			if err := keeper.BindPort(ctx, po.PortID); err != nil {
+				panic(fmt.Sprintf("could not claim port capability: %v", err))
+			}
+		}
+		keeper.SetPortOwner(ctx, po.PortID, po.Owner)
+	}
+
+	for _, ac := range state.ControllerChannels {
+		keeper.SetControllerChannel(ctx, ac.PortID, ac.ConnectionID, ac.ChannelID)
+	}
+
+	for _, ac := range state.HostChannels {
+		keeper.SetHostChannel(ctx, ac.ConnectionID, ac.PortID, ac.ChannelID)
+	}
+}
+
+// ExportGenesis exports the interchain accounts module state
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(
+		keeper.GetAllPortOwners(ctx),
+		keeper.GetAllControllerChannels(ctx),
+		keeper.GetAllHostChannels(ctx),
+	)
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// RegisterInterchainAccount binds the controller port of the owner, if it isn't
+// bound yet, and initiates the opening of an ordered channel with the host port
+// of the counterparty chain on the given connection. The interchain account is
+// registered on the host chain once the channel handshake completes.
+func (k Keeper) RegisterInterchainAccount(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	connectionID,
+	channelID,
+	counterpartyChannelID string,
+) error {
+	if owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(counterpartyChannelID); err != nil {
+		return err
+	}
+
+	// check the handshake preconditions before binding the port since port
+	// capabilities are not reverted along with the transaction state
+	if _, found := k.connectionKeeper.GetConnection(ctx, connectionID); !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	portID := types.GetControllerPortID(owner)
+	if _, found := k.channelKeeper.GetChannel(ctx, portID, channelID); found {
+		return sdkerrors.Wrap(channeltypes.ErrChannelExists, channelID)
+	}
+
+	portOwner, found := k.GetPortOwner(ctx, portID)
+	switch {
+	case found && !portOwner.Equals(owner):
+		return sdkerrors.Wrapf(types.ErrPortAlreadyBound, "port %s is owned by %s", portID, portOwner)
+	case !found:
+		if err := k.BindPort(ctx, portID); err != nil {
+			return sdkerrors.Wrapf(err, "could not claim port capability %s", portID)
+		}
+		k.SetPortOwner(ctx, portID, owner)
+	}
+
+	if activeChannelID, found := k.GetControllerChannel(ctx, portID, connectionID); found {
+		channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+		if found && channel.State != channeltypes.CLOSED {
+			return sdkerrors.Wrapf(types.ErrActiveChannelExists, "channel %s on port %s", activeChannelID, portID)
+		}
+	}
+
+	portCap, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "module does not own port capability %s", portID)
+	}
+
+	chanCap, err := k.channelKeeper.ChanOpenInit(
+		ctx, channeltypes.ORDERED, []string{connectionID}, portID, channelID, portCap,
+		channeltypes.NewCounterparty(types.HostPortID, counterpartyChannelID), types.Version,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterAccount,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		),
+	)
+
+	return nil
+}
+
+// OnChanOpenAck marks the channel as the active channel of its controller port
+// on its connection. Only one channel can be active at a time.
+func (k Keeper) OnChanOpenAck(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrap(channeltypes.ErrChannelNotFound, channelID)
+	}
+
+	connectionID := channel.ConnectionHops[0]
+	if activeChannelID, found := k.GetControllerChannel(ctx, portID, connectionID); found {
+		activeChannel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+		if found && activeChannel.State == channeltypes.OPEN {
+			return sdkerrors.Wrapf(types.ErrActiveChannelExists, "channel %s on port %s", activeChannelID, portID)
+		}
+	}
+
+	k.SetControllerChannel(ctx, portID, connectionID, channelID)
+	return nil
+}
+
+// GetInterchainAccountAddress returns the address of the interchain account of
+// the owner on the chain at the other end of the given connection.
+func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, owner sdk.AccAddress, connectionID string) (sdk.AccAddress, error) {
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return nil, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	return types.GenerateAddress(connection.Counterparty.ConnectionID, types.GetControllerPortID(owner)), nil
+}
+
+// SendTx sends a packet over the active channel of the owner on the given
+// connection in order to execute the messages on the host chain with its
+// interchain account as signer. It returns the sequence of the packet sent.
+func (k Keeper) SendTx(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	connectionID string,
+	msgs []sdk.Msg,
+	memo string,
+	timeoutHeight,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	portID := types.GetControllerPortID(owner)
+
+	channelID, found := k.GetControllerChannel(ctx, portID, connectionID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrActiveChannelNotFound, "port %s, connection %s", portID, connectionID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, channelID)
+	}
+
+	data, err := types.NewInterchainAccountPacketData(msgs, memo)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", portID, channelID,
+		)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		portID,
+		channelID,
+		channel.Counterparty.PortID,
+		channel.Counterparty.ChannelID,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+// RegisterHostAccount registers the interchain account of the counterparty
+// port on the given connection and marks the host channel as its active
+// channel. The account is reused if the counterparty opens a new channel after
+// the previous one was closed.
+func (k Keeper) RegisterHostAccount(ctx sdk.Context, connectionID, counterpartyPortID, channelID string) (sdk.AccAddress, error) {
+	if activeChannelID, found := k.GetHostChannel(ctx, connectionID, counterpartyPortID); found {
+		channel, found := k.channelKeeper.GetChannel(ctx, types.HostPortID, activeChannelID)
+		if found && channel.State != channeltypes.CLOSED {
+			return nil, sdkerrors.Wrapf(
+				types.ErrActiveChannelExists, "channel %s for counterparty port %s", activeChannelID, counterpartyPortID,
+			)
+		}
+	}
+
+	address := types.GenerateAddress(connectionID, counterpartyPortID)
+	if acc := k.authKeeper.GetAccount(ctx, address); acc == nil {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, address))
+	}
+
+	k.SetHostChannel(ctx, connectionID, counterpartyPortID, channelID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterAccount,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyPortID, counterpartyPortID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		),
+	)
+
+	return address, nil
+}
+
+// OnRecvPacket executes the messages carried by a packet received on the host
+// port with the interchain account of the packet source port as signer. The
+// messages are executed atomically: either all of them succeed and their
+// results are returned, or none of their state changes are committed.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]types.MsgResult, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return nil, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, packet.DestinationChannel)
+	}
+
+	connectionID := channel.ConnectionHops[0]
+	activeChannelID, found := k.GetHostChannel(ctx, connectionID, packet.SourcePort)
+	if !found || activeChannelID != packet.DestinationChannel {
+		return nil, sdkerrors.Wrapf(
+			types.ErrActiveChannelNotFound, "channel %s is not the active channel of port %s", packet.DestinationChannel, packet.SourcePort,
+		)
+	}
+
+	data, err := k.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgs, err := data.GetSDKMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	address := types.GenerateAddress(connectionID, packet.SourcePort)
+	return k.executeTx(ctx, address, msgs)
+}
+
+// executeTx routes the messages to their handlers on a cached context which is
+// only written back if every message succeeds.
+func (k Keeper) executeTx(ctx sdk.Context, address sdk.AccAddress, msgs []sdk.Msg) ([]types.MsgResult, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	results := make([]types.MsgResult, len(msgs))
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(address) {
+				return nil, sdkerrors.Wrapf(types.ErrUnauthorizedSigner, "message %d: expected %s, got %s", i, address, signer)
+			}
+		}
+
+		handler := k.router.Route(cacheCtx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route %s for message %d", msg.Route(), i)
+		}
+
+		res, err := handler(cacheCtx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		results[i] = types.MsgResult{
+			Data: res.Data,
+			Log:  res.Log,
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return results, nil
+}
+
+// UnmarshalPacketData decodes the packet data and unpacks the messages it
+// carries using the application codec.
+func (k Keeper) UnmarshalPacketData(bz []byte) (types.InterchainAccountPacketData, error) {
+	var data types.InterchainAccountPacketData
+	if err := k.cdc.UnmarshalBinaryBare(bz, &data); err != nil {
+		return types.InterchainAccountPacketData{}, sdkerrors.Wrap(types.ErrInvalidPacketData, err.Error())
+	}
+
+	return data, nil
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// Keeper defines the IBC interchain accounts keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.Marshaler
+
+	channelKeeper    types.ChannelKeeper
+	connectionKeeper types.ConnectionKeeper
+	portKeeper       types.PortKeeper
+	authKeeper       types.AccountKeeper
+	scopedKeeper     capabilitykeeper.ScopedKeeper
+
+	// router is used to execute the messages received by the interchain
+	// accounts hosted on this chain
+	router sdk.Router
+}
+
+// NewKeeper creates a new IBC interchain accounts Keeper instance. The codec
+// must be able to unpack every sdk.Msg executable by a hosted account.
+func NewKeeper(
+	cdc codec.Marshaler, key sdk.StoreKey,
+	channelKeeper types.ChannelKeeper, connectionKeeper types.ConnectionKeeper, portKeeper types.PortKeeper,
+	authKeeper types.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, router sdk.Router,
+) Keeper {
+	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		channelKeeper:    channelKeeper,
+		connectionKeeper: connectionKeeper,
+		portKeeper:       portKeeper,
+		authKeeper:       authKeeper,
+		scopedKeeper:     scopedKeeper,
+		router:           router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// IsBound checks if the interchain accounts module is already bound to the
+// desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// ClaimCapability allows the interchain accounts module to claim a capability
+// that IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// GetPortOwner returns the owner of a controller port
+func (k Keeper) GetPortOwner(ctx sdk.Context, portID string) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOwnerKey(portID))
+	if bz == nil {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// SetPortOwner stores the owner of a controller port
+func (k Keeper) SetPortOwner(ctx sdk.Context, portID string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOwnerKey(portID), owner)
+}
+
+// GetAllPortOwners returns the owners of all the controller ports
+func (k Keeper) GetAllPortOwners(ctx sdk.Context) []types.PortOwner {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OwnerKeyPrefix)
+	defer iterator.Close()
+
+	owners := []types.PortOwner{}
+	for ; iterator.Valid(); iterator.Next() {
+		portID := string(iterator.Key()[len(types.OwnerKeyPrefix):])
+		owners = append(owners, types.NewPortOwner(portID, iterator.Value()))
+	}
+
+	return owners
+}
+
+// GetControllerChannel returns the active channel of a controller port on a
+// connection
+func (k Keeper) GetControllerChannel(ctx sdk.Context, portID, connectionID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetControllerChannelKey(portID, connectionID))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetControllerChannel stores the active channel of a controller port on a
+// connection
+func (k Keeper) SetControllerChannel(ctx sdk.Context, portID, connectionID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetControllerChannelKey(portID, connectionID), []byte(channelID))
+}
+
+// GetAllControllerChannels returns the active channels of all the controller
+// ports
+func (k Keeper) GetAllControllerChannels(ctx sdk.Context) []types.ActiveChannel {
+	return k.getAllActiveChannels(ctx, types.ControllerChannelKeyPrefix, false)
+}
+
+// GetHostChannel returns the active host channel of a counterparty port on a
+// connection
+func (k Keeper) GetHostChannel(ctx sdk.Context, connectionID, counterpartyPortID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHostChannelKey(connectionID, counterpartyPortID))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetHostChannel stores the active host channel of a counterparty port on a
+// connection
+func (k Keeper) SetHostChannel(ctx sdk.Context, connectionID, counterpartyPortID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHostChannelKey(connectionID, counterpartyPortID), []byte(channelID))
+}
+
+// GetAllHostChannels returns the active host channels of all the counterparty
+// ports
+func (k Keeper) GetAllHostChannels(ctx sdk.Context) []types.ActiveChannel {
+	return k.getAllActiveChannels(ctx, types.HostChannelKeyPrefix, true)
+}
+
+func (k Keeper) getAllActiveChannels(ctx sdk.Context, prefix []byte, isHost bool) []types.ActiveChannel {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	channels := []types.ActiveChannel{}
+	for ; iterator.Valid(); iterator.Next() {
+		first, second, err := types.ParseChannelKey(iterator.Key()[len(prefix):])
+		if err != nil {
+			panic(err)
+		}
+
+		// host channel keys are prefixed by the connection identifier
+		if isHost {
+			first, second = second, first
+		}
+
+		channels = append(channels, types.NewActiveChannel(first, second, string(iterator.Value())))
+	}
+
+	return channels
+}
@@ -0,0 +1,243 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcaccount "github.com/cosmos/cosmos-sdk/x/ibc-account"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+var defaultCoins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+
+// KeeperTestSuite is a testing suite to test keeper functions.
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convience and readability, chainA controls
+	// interchain accounts hosted on chainB
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	connA *ibctesting.TestConnection
+	connB *ibctesting.TestConnection
+
+	owner sdk.AccAddress
+}
+
+// TestKeeperTestSuite runs all the tests within this package.
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// SetupTest creates a coordinator with 2 test chains and an open connection
+// between them.
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	_, _, suite.connA, suite.connB = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, clientexported.Tendermint)
+	suite.owner = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+// registerAccount registers the interchain account of the owner on chainA and
+// relays the channel handshake to chainB. It returns the channel ids on both
+// chains.
+func (suite *KeeperTestSuite) registerAccount(owner sdk.AccAddress) (string, string) {
+	portA := types.GetControllerPortID(owner)
+	channelA := fmt.Sprintf("%s-%d", suite.connA.ID, len(suite.connA.Channels))
+	channelB := fmt.Sprintf("%s-%d", suite.connB.ID, len(suite.connB.Channels))
+	suite.connA.Channels = append(suite.connA.Channels, ibctesting.TestChannel{PortID: portA, ID: channelA})
+	suite.connB.Channels = append(suite.connB.Channels, ibctesting.TestChannel{PortID: types.HostPortID, ID: channelB})
+
+	err := suite.chainA.App.IBCAccountKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), owner, suite.connA.ID, channelA, channelB)
+	suite.Require().NoError(err)
+	suite.commit(suite.chainA, suite.chainB, suite.connB.ClientID)
+
+	proof, height := suite.chainA.QueryProof(host.KeyChannel(portA, channelA))
+	suite.Require().NoError(suite.chainB.SendMsg(channeltypes.NewMsgChannelOpenTry(
+		types.HostPortID, channelB, types.Version, channeltypes.ORDERED, []string{suite.connB.ID},
+		portA, channelA, types.Version, proof, height, suite.chainB.SenderAccount.GetAddress(),
+	)))
+	suite.updateClient(suite.chainB, suite.chainA, suite.connA.ClientID)
+
+	proof, height = suite.chainB.QueryProof(host.KeyChannel(types.HostPortID, channelB))
+	suite.Require().NoError(suite.chainA.SendMsg(channeltypes.NewMsgChannelOpenAck(
+		portA, channelA, types.Version, proof, height, suite.chainA.SenderAccount.GetAddress(),
+	)))
+	suite.updateClient(suite.chainA, suite.chainB, suite.connB.ClientID)
+
+	proof, height = suite.chainA.QueryProof(host.KeyChannel(portA, channelA))
+	suite.Require().NoError(suite.chainB.SendMsg(channeltypes.NewMsgChannelOpenConfirm(
+		types.HostPortID, channelB, proof, height, suite.chainB.SenderAccount.GetAddress(),
+	)))
+	suite.updateClient(suite.chainB, suite.chainA, suite.connA.ClientID)
+
+	return channelA, channelB
+}
+
+// commit commits the pending state of the source chain and updates its client
+// on the counterparty chain.
+func (suite *KeeperTestSuite) commit(source, counterparty *ibctesting.TestChain, counterpartyClientID string) {
+	source.App.Commit()
+	source.NextBlock()
+	suite.updateClient(source, counterparty, counterpartyClientID)
+}
+
+func (suite *KeeperTestSuite) updateClient(source, counterparty *ibctesting.TestChain, counterpartyClientID string) {
+	suite.coordinator.IncrementTime()
+	suite.Require().NoError(suite.coordinator.UpdateClient(counterparty, source, counterpartyClientID, clientexported.Tendermint))
+}
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
+	portA := types.GetControllerPortID(suite.owner)
+	channelA, channelB := suite.registerAccount(suite.owner)
+
+	ctxA := suite.chainA.GetContext()
+	owner, found := suite.chainA.App.IBCAccountKeeper.GetPortOwner(ctxA, portA)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.owner, owner)
+
+	activeChannel, found := suite.chainA.App.IBCAccountKeeper.GetControllerChannel(ctxA, portA, suite.connA.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(channelA, activeChannel)
+
+	ctxB := suite.chainB.GetContext()
+	activeChannel, found = suite.chainB.App.IBCAccountKeeper.GetHostChannel(ctxB, suite.connB.ID, portA)
+	suite.Require().True(found)
+	suite.Require().Equal(channelB, activeChannel)
+
+	address, err := suite.chainA.App.IBCAccountKeeper.GetInterchainAccountAddress(ctxA, suite.owner, suite.connA.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.GenerateAddress(suite.connB.ID, portA), address)
+	suite.Require().NotNil(suite.chainB.App.AccountKeeper.GetAccount(ctxB, address))
+
+	// a second channel cannot be registered while the first one is open
+	err = suite.chainA.App.IBCAccountKeeper.RegisterInterchainAccount(
+		ctxA, suite.owner, suite.connA.ID, suite.connA.NextTestChannel().ID, suite.connB.NextTestChannel().ID,
+	)
+	suite.Require().Error(err)
+
+	// channels cannot be opened through the IBC handler
+	am := ibcaccount.NewAppModule(suite.chainA.App.IBCAccountKeeper)
+	next := suite.connA.NextTestChannel()
+	err = am.OnChanOpenInit(
+		ctxA, channeltypes.ORDERED, []string{suite.connA.ID}, portA, next.ID, nil,
+		channeltypes.NewCounterparty(types.HostPortID, suite.connB.NextTestChannel().ID), types.Version,
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestSendTx() {
+	channelA, channelB := suite.registerAccount(suite.owner)
+	portA := types.GetControllerPortID(suite.owner)
+
+	address, err := suite.chainA.App.IBCAccountKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), suite.owner, suite.connA.ID)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainB.App.BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), address, defaultCoins))
+	suite.commit(suite.chainB, suite.chainA, suite.connA.ClientID)
+
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	msgs := []sdk.Msg{banktypes.NewMsgSend(address, receiver, amount)}
+
+	// owners without an active channel cannot send transactions
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err = suite.chainA.App.IBCAccountKeeper.SendTx(suite.chainA.GetContext(), other, suite.connA.ID, msgs, "", 1000, 0)
+	suite.Require().Error(err)
+
+	sequence, err := suite.chainA.App.IBCAccountKeeper.SendTx(suite.chainA.GetContext(), suite.owner, suite.connA.ID, msgs, "memo", 1000, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), sequence)
+	suite.commit(suite.chainA, suite.chainB, suite.connB.ClientID)
+
+	data, err := types.NewInterchainAccountPacketData(msgs, "memo")
+	suite.Require().NoError(err)
+	packet := channeltypes.NewPacket(data.GetBytes(), sequence, portA, channelA, types.HostPortID, channelB, 1000, 0)
+
+	// relay the packet to the host chain
+	proof, height := suite.chainA.QueryProof(host.KeyPacketCommitment(portA, channelA, sequence))
+	err = suite.chainB.SendMsg(channeltypes.NewMsgPacket(packet, proof, height, suite.chainB.SenderAccount.GetAddress()))
+	suite.Require().NoError(err)
+
+	ctxB := suite.chainB.GetContext()
+	suite.Require().Equal(amount, suite.chainB.App.BankKeeper.GetAllBalances(ctxB, receiver))
+	suite.Require().Equal(defaultCoins.Sub(amount), suite.chainB.App.BankKeeper.GetAllBalances(ctxB, address))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	channelA, channelB := suite.registerAccount(suite.owner)
+	portA := types.GetControllerPortID(suite.owner)
+	address := types.GenerateAddress(suite.connB.ID, portA)
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	testCases := []struct {
+		name    string
+		msgs    []sdk.Msg
+		expPass bool
+	}{
+		{"success", []sdk.Msg{banktypes.NewMsgSend(address, receiver, amount), banktypes.NewMsgSend(address, receiver, amount)}, true},
+		{"no messages", []sdk.Msg{}, false},
+		{"signer is not the interchain account", []sdk.Msg{banktypes.NewMsgSend(receiver, address, amount)}, false},
+		{"invalid message", []sdk.Msg{banktypes.NewMsgSend(address, receiver, sdk.Coins{})}, false},
+		{"insufficient funds reverts previous messages", []sdk.Msg{
+			banktypes.NewMsgSend(address, receiver, amount),
+			banktypes.NewMsgSend(address, receiver, defaultCoins),
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		ctx, _ := suite.chainB.GetContext().CacheContext()
+		suite.Require().NoError(suite.chainB.App.BankKeeper.SendCoins(ctx, suite.chainB.SenderAccount.GetAddress(), address, defaultCoins))
+
+		data, err := types.NewInterchainAccountPacketData(tc.msgs, "")
+		suite.Require().NoError(err)
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, portA, channelA, types.HostPortID, channelB, 1000, 0)
+
+		results, err := suite.chainB.App.IBCAccountKeeper.OnRecvPacket(ctx, packet)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Len(results, len(tc.msgs), tc.name)
+			suite.Require().Equal(amount.Add(amount...), suite.chainB.App.BankKeeper.GetAllBalances(ctx, receiver), tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+			suite.Require().Equal(defaultCoins, suite.chainB.App.BankKeeper.GetAllBalances(ctx, address), tc.name)
+			suite.Require().True(suite.chainB.App.BankKeeper.GetAllBalances(ctx, receiver).Empty(), tc.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestGenesisAccessors() {
+	channelA, channelB := suite.registerAccount(suite.owner)
+	portA := types.GetControllerPortID(suite.owner)
+
+	ctxA := suite.chainA.GetContext()
+	suite.Require().Equal(
+		[]types.PortOwner{types.NewPortOwner(portA, suite.owner)},
+		suite.chainA.App.IBCAccountKeeper.GetAllPortOwners(ctxA),
+	)
+	suite.Require().Equal(
+		[]types.ActiveChannel{types.NewActiveChannel(portA, suite.connA.ID, channelA)},
+		suite.chainA.App.IBCAccountKeeper.GetAllControllerChannels(ctxA),
+	)
+	suite.Require().Equal(
+		[]types.ActiveChannel{types.NewActiveChannel(portA, suite.connB.ID, channelB)},
+		suite.chainB.App.IBCAccountKeeper.GetAllHostChannels(suite.chainB.GetContext()),
+	)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// RegisterCodec registers the IBC interchain accounts types. The module does
+// not define any messages and its packets are only encoded with protobuf.
+func RegisterCodec(cdc *codec.Codec) {}
+
+// RegisterInterfaces register the IBC interchain accounts interfaces to
+// protobuf Any.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {}
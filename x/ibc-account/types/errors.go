@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC interchain accounts sentinel errors
+var (
+	ErrInvalidVersion            = sdkerrors.Register(ModuleName, 2, "invalid interchain accounts version")
+	ErrInvalidPort               = sdkerrors.Register(ModuleName, 3, "invalid interchain accounts port")
+	ErrInvalidChannelOrdering    = sdkerrors.Register(ModuleName, 4, "invalid channel ordering")
+	ErrPortAlreadyBound          = sdkerrors.Register(ModuleName, 5, "controller port already bound by another owner")
+	ErrActiveChannelExists       = sdkerrors.Register(ModuleName, 6, "active interchain accounts channel already exists")
+	ErrActiveChannelNotFound     = sdkerrors.Register(ModuleName, 7, "no active interchain accounts channel found")
+	ErrInvalidPacketData         = sdkerrors.Register(ModuleName, 8, "invalid interchain accounts packet data")
+	ErrInvalidAcknowledgement    = sdkerrors.Register(ModuleName, 9, "invalid interchain accounts acknowledgement")
+	ErrUnauthorizedSigner        = sdkerrors.Register(ModuleName, 10, "message signer is not the interchain account")
+	ErrInvalidChannelClosure     = sdkerrors.Register(ModuleName, 11, "interchain accounts channels cannot be closed by users")
+	ErrInvalidHandshakeInitiator = sdkerrors.Register(ModuleName, 12, "invalid channel handshake initiator")
+)
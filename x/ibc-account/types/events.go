@@ -0,0 +1,16 @@
+package types
+
+// IBC interchain accounts events
+const (
+	EventTypeRegisterAccount = "register_interchain_account"
+	EventTypePacket          = "interchain_account_packet"
+	EventTypeTimeout         = "interchain_account_timeout"
+
+	AttributeKeyOwner      = "owner"
+	AttributeKeyAddress    = "address"
+	AttributeKeyPortID     = "port_id"
+	AttributeKeyChannelID  = "channel_id"
+	AttributeKeySequence   = "sequence"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAckError   = "error"
+)
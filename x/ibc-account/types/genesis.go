@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// PortOwner defines the owner of a controller port
+type PortOwner struct {
+	PortID string         `json:"port_id" yaml:"port_id"`
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewPortOwner creates a new PortOwner instance
+func NewPortOwner(portID string, owner sdk.AccAddress) PortOwner {
+	return PortOwner{
+		PortID: portID,
+		Owner:  owner,
+	}
+}
+
+// ActiveChannel defines the channel used by a port on a connection. For
+// controller channels the port is the controller port, for host channels it is
+// the counterparty controller port.
+type ActiveChannel struct {
+	PortID       string `json:"port_id" yaml:"port_id"`
+	ConnectionID string `json:"connection_id" yaml:"connection_id"`
+	ChannelID    string `json:"channel_id" yaml:"channel_id"`
+}
+
+// NewActiveChannel creates a new ActiveChannel instance
+func NewActiveChannel(portID, connectionID, channelID string) ActiveChannel {
+	return ActiveChannel{
+		PortID:       portID,
+		ConnectionID: connectionID,
+		ChannelID:    channelID,
+	}
+}
+
+// Validate performs a basic validation of the active channel identifiers
+func (ac ActiveChannel) Validate() error {
+	if err := host.PortIdentifierValidator(ac.PortID); err != nil {
+		return sdkerrors.Wrap(err, "invalid active channel port ID")
+	}
+	if err := host.ConnectionIdentifierValidator(ac.ConnectionID); err != nil {
+		return sdkerrors.Wrap(err, "invalid active channel connection ID")
+	}
+	if err := host.ChannelIdentifierValidator(ac.ChannelID); err != nil {
+		return sdkerrors.Wrap(err, "invalid active channel ID")
+	}
+
+	return nil
+}
+
+// GenesisState defines the IBC interchain accounts genesis state
+type GenesisState struct {
+	PortOwners         []PortOwner     `json:"port_owners" yaml:"port_owners"`
+	ControllerChannels []ActiveChannel `json:"controller_channels" yaml:"controller_channels"`
+	HostChannels       []ActiveChannel `json:"host_channels" yaml:"host_channels"`
+}
+
+// NewGenesisState creates a new GenesisState instance
+func NewGenesisState(portOwners []PortOwner, controllerChannels, hostChannels []ActiveChannel) GenesisState {
+	return GenesisState{
+		PortOwners:         portOwners,
+		ControllerChannels: controllerChannels,
+		HostChannels:       hostChannels,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no registered interchain
+// accounts.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]PortOwner{}, []ActiveChannel{}, []ActiveChannel{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	owners := make(map[string]bool)
+	for _, po := range gs.PortOwners {
+		if po.Owner.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "empty owner for port %s", po.PortID)
+		}
+		if po.PortID != GetControllerPortID(po.Owner) {
+			return sdkerrors.Wrapf(ErrInvalidPort, "port %s is not the controller port of %s", po.PortID, po.Owner)
+		}
+		if owners[po.PortID] {
+			return fmt.Errorf("duplicate owner for port %s", po.PortID)
+		}
+		owners[po.PortID] = true
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, ac := range gs.ControllerChannels {
+		if err := ac.Validate(); err != nil {
+			return err
+		}
+		if !owners[ac.PortID] {
+			return sdkerrors.Wrapf(ErrInvalidPort, "no owner for controller port %s", ac.PortID)
+		}
+
+		key := string(GetControllerChannelKey(ac.PortID, ac.ConnectionID))
+		if seenChannels[key] {
+			return fmt.Errorf("duplicate controller channel for port %s on connection %s", ac.PortID, ac.ConnectionID)
+		}
+		seenChannels[key] = true
+	}
+
+	for _, ac := range gs.HostChannels {
+		if err := ac.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetHostChannelKey(ac.ConnectionID, ac.PortID))
+		if seenChannels[key] {
+			return fmt.Errorf("duplicate host channel for port %s on connection %s", ac.PortID, ac.ConnectionID)
+		}
+		seenChannels[key] = true
+	}
+
+	return nil
+}
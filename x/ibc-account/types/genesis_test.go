@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	portID := types.GetControllerPortID(owner)
+	channel := types.NewActiveChannel(portID, "connectionid", "channelidone")
+
+	testCases := []struct {
+		name     string
+		genState types.GenesisState
+		expPass  bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{
+			"valid genesis",
+			types.NewGenesisState([]types.PortOwner{types.NewPortOwner(portID, owner)}, []types.ActiveChannel{channel}, []types.ActiveChannel{channel}),
+			true,
+		},
+		{
+			"port not derived from owner",
+			types.NewGenesisState([]types.PortOwner{types.NewPortOwner("ica-0000000000000000", owner)}, nil, nil),
+			false,
+		},
+		{
+			"duplicate port owner",
+			types.NewGenesisState([]types.PortOwner{types.NewPortOwner(portID, owner), types.NewPortOwner(portID, owner)}, nil, nil),
+			false,
+		},
+		{
+			"controller channel without owner",
+			types.NewGenesisState(nil, []types.ActiveChannel{channel}, nil),
+			false,
+		},
+		{
+			"duplicate host channel",
+			types.NewGenesisState(nil, nil, []types.ActiveChannel{channel, channel}),
+			false,
+		},
+		{
+			"invalid channel identifier",
+			types.NewGenesisState(nil, nil, []types.ActiveChannel{types.NewActiveChannel(portID, "connectionid", "ch")}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
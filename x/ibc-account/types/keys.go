@@ -0,0 +1,87 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC interchain accounts name
+	ModuleName = "ibcaccount"
+
+	// Version defines the current version the IBC interchain accounts
+	// module supports
+	Version = "ics27-1"
+
+	// HostPortID is the port id that the interchain accounts module binds to
+	// in order to host accounts controlled by other chains
+	HostPortID = "icahost"
+
+	// ControllerPortPrefix prefixes the port ids bound by the interchain
+	// accounts module on behalf of the owners of interchain accounts
+	ControllerPortPrefix = "ica-"
+
+	// StoreKey is the store key string for IBC interchain accounts
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC interchain accounts
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes for IBC interchain accounts
+var (
+	OwnerKeyPrefix             = []byte{0x01}
+	ControllerChannelKeyPrefix = []byte{0x02}
+	HostChannelKeyPrefix       = []byte{0x03}
+)
+
+// GetControllerPortID returns the port id bound on behalf of the given owner.
+// Owner addresses do not fit within the port identifier length limit, so the
+// port id is derived from the hash of the owner address instead.
+//
+// CONTRACT: the keeper records the owner of every controller port it binds in
+// order to detect collisions.
+func GetControllerPortID(owner sdk.AccAddress) string {
+	hash := sha256.Sum256(owner)
+	return ControllerPortPrefix + hex.EncodeToString(hash[:8])
+}
+
+// GenerateAddress returns the address of the interchain account hosted for the
+// given counterparty port on the given connection of the host chain.
+func GenerateAddress(connectionID, counterpartyPortID string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/%s/%s", ModuleName, connectionID, counterpartyPortID))))
+}
+
+// GetOwnerKey returns the key storing the owner of a controller port
+func GetOwnerKey(portID string) []byte {
+	return append(OwnerKeyPrefix, []byte(portID)...)
+}
+
+// GetControllerChannelKey returns the key storing the active channel of a
+// controller port on a connection
+func GetControllerChannelKey(portID, connectionID string) []byte {
+	return append(ControllerChannelKeyPrefix, []byte(fmt.Sprintf("%s/%s", portID, connectionID))...)
+}
+
+// GetHostChannelKey returns the key storing the active channel of the host
+// port for a counterparty port on a connection
+func GetHostChannelKey(connectionID, counterpartyPortID string) []byte {
+	return append(HostChannelKeyPrefix, []byte(fmt.Sprintf("%s/%s", connectionID, counterpartyPortID))...)
+}
+
+// ParseChannelKey parses the two identifiers of an active channel key stripped
+// from its prefix
+func ParseChannelKey(key []byte) (string, string, error) {
+	ids := strings.Split(string(key), "/")
+	if len(ids) != 2 {
+		return "", "", fmt.Errorf("invalid active channel key %s", key)
+	}
+
+	return ids[0], ids[1], nil
+}
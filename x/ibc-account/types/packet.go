@@ -0,0 +1,105 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = InterchainAccountPacketData{}
+
+// NewInterchainAccountPacketData packs the given messages into the packet data
+// sent to the host chain
+func NewInterchainAccountPacketData(msgs []sdk.Msg, memo string) (InterchainAccountPacketData, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return InterchainAccountPacketData{}, err
+		}
+
+		anys[i] = any
+	}
+
+	return InterchainAccountPacketData{
+		Msgs: anys,
+		Memo: memo,
+	}, nil
+}
+
+// ValidateBasic performs a basic check of the packet fields
+func (pd InterchainAccountPacketData) ValidateBasic() error {
+	if len(pd.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "packet data must contain at least one message")
+	}
+
+	return nil
+}
+
+// GetSDKMsgs returns the messages carried by the packet. The packet data must
+// have been unpacked beforehand.
+func (pd InterchainAccountPacketData) GetSDKMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(pd.Msgs))
+	for i, any := range pd.Msgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidPacketData, "message %d of type %s does not implement sdk.Msg", i, any.TypeUrl)
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// GetBytes returns the protobuf encoded packet data
+func (pd InterchainAccountPacketData) GetBytes() []byte {
+	bz, err := proto.Marshal(&pd)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (pd InterchainAccountPacketData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range pd.Msgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewSuccessAcknowledgement returns an acknowledgement holding the results of
+// the executed messages
+func NewSuccessAcknowledgement(results []MsgResult) InterchainAccountAcknowledgement {
+	return InterchainAccountAcknowledgement{
+		Success: true,
+		Results: results,
+	}
+}
+
+// NewErrorAcknowledgement returns an acknowledgement holding the error that
+// aborted the execution of the packet messages
+func NewErrorAcknowledgement(err error) InterchainAccountAcknowledgement {
+	return InterchainAccountAcknowledgement{
+		Success: false,
+		Error:   err.Error(),
+	}
+}
+
+// GetBytes returns the protobuf encoded acknowledgement
+func (ack InterchainAccountAcknowledgement) GetBytes() []byte {
+	bz, err := proto.Marshal(&ack)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+func TestInterchainAccountPacketData(t *testing.T) {
+	appCodec, _ := simapp.MakeCodecs()
+
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msgs := []sdk.Msg{banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))}
+
+	data, err := types.NewInterchainAccountPacketData(msgs, "memo")
+	require.NoError(t, err)
+	require.NoError(t, data.ValidateBasic())
+
+	var decoded types.InterchainAccountPacketData
+	require.NoError(t, appCodec.UnmarshalBinaryBare(data.GetBytes(), &decoded))
+	require.Equal(t, "memo", decoded.Memo)
+
+	decodedMsgs, err := decoded.GetSDKMsgs()
+	require.NoError(t, err)
+	require.Equal(t, msgs, decodedMsgs)
+
+	// messages are only available once unpacked
+	var packed types.InterchainAccountPacketData
+	require.NoError(t, packed.Unmarshal(data.GetBytes()))
+	_, err = packed.GetSDKMsgs()
+	require.Error(t, err)
+
+	empty, err := types.NewInterchainAccountPacketData(nil, "")
+	require.NoError(t, err)
+	require.Error(t, empty.ValidateBasic())
+}
+
+func TestInterchainAccountAcknowledgement(t *testing.T) {
+	ack := types.NewSuccessAcknowledgement([]types.MsgResult{{Data: []byte("data"), Log: "log"}})
+
+	var decoded types.InterchainAccountAcknowledgement
+	require.NoError(t, decoded.Unmarshal(ack.GetBytes()))
+	require.True(t, decoded.Success)
+	require.Equal(t, ack.Results, decoded.Results)
+
+	ack = types.NewErrorAcknowledgement(types.ErrUnauthorizedSigner)
+	decoded = types.InterchainAccountAcknowledgement{}
+	require.NoError(t, decoded.Unmarshal(ack.GetBytes()))
+	require.False(t, decoded.Success)
+	require.Equal(t, types.ErrUnauthorizedSigner.Error(), decoded.Error)
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the query commands for the IBC fee middleware
+func GetQueryCmd(clientCtx client.Context) *cobra.Command {
+	ics29FeeQueryCmd := &cobra.Command{
+		Use:                        "ibc-fee",
+		Short:                      "IBC relayer incentivization query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ics29FeeQueryCmd.AddCommand(
+		GetCmdQueryIncentivizedPackets(clientCtx),
+		GetCmdQueryIncentivizedPacket(clientCtx),
+	)
+
+	return ics29FeeQueryCmd
+}
+
+// NewTxCmd returns the transaction commands for the IBC fee middleware
+func NewTxCmd(clientCtx client.Context) *cobra.Command {
+	ics29FeeTxCmd := &cobra.Command{
+		Use:                        "ibc-fee",
+		Short:                      "IBC relayer incentivization transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ics29FeeTxCmd.AddCommand(flags.PostCommands(
+		NewPayPacketFeeTxCmd(clientCtx),
+		NewRegisterCounterpartyAddressTxCmd(clientCtx),
+	)...)
+
+	return ics29FeeTxCmd
+}
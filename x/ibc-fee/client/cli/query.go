@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+)
+
+// GetCmdQueryIncentivizedPackets implements the command to query all the packets
+// with escrowed fees.
+func GetCmdQueryIncentivizedPackets(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "incentivized-packets",
+		Short:   "Query all the packets with escrowed relayer fees",
+		Example: fmt.Sprintf("%s query ibc-fee incentivized-packets --page=1 --limit=50", version.ClientName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentivizedPackets(context.Background(), &types.QueryIncentivizedPacketsRequest{Req: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.IncentivizedPackets)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of packets to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of packets to query for")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetCmdQueryIncentivizedPacket implements the command to query the fees
+// escrowed for a packet.
+func GetCmdQueryIncentivizedPacket(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "incentivized-packet [port-id] [channel-id] [sequence]",
+		Short:   "Query the relayer fees escrowed for a packet",
+		Example: fmt.Sprintf("%s query ibc-fee incentivized-packet transfer channel-0 1", version.ClientName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("sequence %s not a valid uint: %w", args[2], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentivizedPacket(context.Background(), &types.QueryIncentivizedPacketRequest{
+				PacketID: types.NewPacketID(args[0], args[1], sequence),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.IncentivizedPacket)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// readPageRequest builds an offset based page request from the page and limit
+// flags.
+func readPageRequest(cmd *cobra.Command) (*query.PageRequest, error) {
+	page, err := cmd.Flags().GetInt(flags.FlagPage)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := cmd.Flags().GetInt(flags.FlagLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if page < 1 || limit < 1 {
+		return nil, fmt.Errorf("page and limit must be positive, got %d and %d", page, limit)
+	}
+
+	return &query.PageRequest{Offset: uint64((page - 1) * limit), Limit: uint64(limit)}, nil
+}
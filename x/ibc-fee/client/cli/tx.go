@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+)
+
+const (
+	flagRecvFee    = "recv-fee"
+	flagAckFee     = "ack-fee"
+	flagTimeoutFee = "timeout-fee"
+)
+
+// NewPayPacketFeeTxCmd returns the command to create a MsgPayPacketFee transaction
+func NewPayPacketFeeTxCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-packet-fee [src-port] [src-channel] [sequence]",
+		Short: "Pay the relayer fees of a packet sent on a fee enabled channel",
+		Long: `Escrow the fees paid to the relayers of a packet sent on a fee enabled channel.
+The packet must either be in flight or be the next packet sent on the channel.
+The fees which are not paid to relayers are refunded to the sender.`,
+		Example: fmt.Sprintf(
+			"%s tx ibc-fee pay-packet-fee transfer channel-0 1 --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake",
+			version.ClientName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("sequence %s not a valid uint: %w", args[2], err)
+			}
+
+			var coins [3]sdk.Coins
+			for i, flag := range []string{flagRecvFee, flagAckFee, flagTimeoutFee} {
+				str, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+
+				if coins[i], err = sdk.ParseCoins(str); err != nil {
+					return err
+				}
+			}
+
+			fee := types.NewFee(coins[0], coins[1], coins[2])
+			msg := types.NewMsgPayPacketFee(fee, args[0], args[1], sequence, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecvFee, "", "Fee paid to the relayer delivering the packet")
+	cmd.Flags().String(flagAckFee, "", "Fee paid to the relayer delivering the acknowledgement")
+	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to the relayer delivering the timeout")
+
+	return cmd
+}
+
+// NewRegisterCounterpartyAddressTxCmd returns the command to create a
+// MsgRegisterCounterpartyAddress transaction
+func NewRegisterCounterpartyAddressTxCmd(clientCtx client.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "register-counterparty-address [counterparty-address] [channel-id]",
+		Short: "Register the address a relayer is paid on on the counterparty chain",
+		Long: `Register the address on the counterparty chain the relayer signing the transaction
+is paid on for the packets it delivers on the given channel.`,
+		Example: fmt.Sprintf("%s tx ibc-fee register-counterparty-address cosmos1... channel-0", version.ClientName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			msg := types.NewMsgRegisterCounterpartyAddress(clientCtx.GetFromAddress(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+}
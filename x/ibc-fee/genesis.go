@@ -0,0 +1,39 @@
+package fee
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+)
+
+// InitGenesis initializes the fee enabled channels, registered counterparty
+// addresses and escrowed fees from the genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, state types.GenesisState) {
+	for _, ch := range state.FeeEnabledChannels {
+		k.SetFeeEnabled(ctx, ch.PortID, ch.ChannelID)
+	}
+
+	for _, addr := range state.CounterpartyAddresses {
+		k.SetCounterpartyAddress(ctx, addr.Address, addr.CounterpartyAddress, addr.ChannelID)
+	}
+
+	for _, fees := range state.IdentifiedFees {
+		k.SetFeesInEscrow(ctx, fees.PacketID, types.PacketFees{PacketFees: fees.PacketFees})
+	}
+
+	// check if the module account exists
+	if moduleAcc := k.GetFeeModuleAccount(ctx); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+}
+
+// ExportGenesis exports the fee middleware state into its genesis state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(
+		k.GetAllFeeEnabledChannels(ctx),
+		k.GetAllCounterpartyAddresses(ctx),
+		k.GetAllIdentifiedPacketFees(ctx),
+	)
+}
@@ -0,0 +1,65 @@
+package fee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+)
+
+// NewHandler returns sdk.Handler for IBC fee middleware messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgPayPacketFee:
+			return handleMsgPayPacketFee(ctx, k, msg)
+		case *types.MsgRegisterCounterpartyAddress:
+			return handleMsgRegisterCounterpartyAddress(ctx, k, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized IBC fee message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgPayPacketFee(ctx sdk.Context, k keeper.Keeper, msg *types.MsgPayPacketFee) (*sdk.Result, error) {
+	packetID := types.NewPacketID(msg.SourcePortID, msg.SourceChannelID, msg.Sequence)
+	if err := k.EscrowPacketFee(ctx, packetID, types.NewPacketFee(msg.Fee, msg.Signer)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgRegisterCounterpartyAddress(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRegisterCounterpartyAddress) (*sdk.Result, error) {
+	k.SetCounterpartyAddress(ctx, msg.Address, msg.CounterpartyAddress, msg.ChannelID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterCounterpartyAddress,
+			sdk.NewAttribute(types.AttributeKeyRelayer, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyAddress, msg.CounterpartyAddress),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
@@ -0,0 +1,188 @@
+package fee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/05-port/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware implements the ICS-26 callbacks of the fee middleware. It wraps
+// the callbacks of an application, negotiating fee support through the channel
+// version and paying the relayers of the packets sent on fee enabled channels.
+// Channels whose version is not prefixed with the fee middleware version are
+// passed through to the application untouched.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new fee middleware wrapping the given application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	feeVersion, appVersion := types.SplitChannelVersion(version)
+	if feeVersion != "" {
+		im.keeper.SetFeeEnabled(ctx, portID, channelID)
+	}
+
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, appVersion)
+}
+
+// OnChanOpenTry implements the IBCModule interface. Fee support must be
+// negotiated by both ends of the channel.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	feeVersion, appVersion := types.SplitChannelVersion(version)
+	counterpartyFeeVersion, counterpartyAppVersion := types.SplitChannelVersion(counterpartyVersion)
+
+	if feeVersion != counterpartyFeeVersion {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidVersion, "fee version %q does not match counterparty fee version %q",
+			feeVersion, counterpartyFeeVersion,
+		)
+	}
+
+	if feeVersion != "" {
+		im.keeper.SetFeeEnabled(ctx, portID, channelID)
+	}
+
+	return im.app.OnChanOpenTry(
+		ctx, order, connectionHops, portID, channelID, chanCap, counterparty, appVersion, counterpartyAppVersion,
+	)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	counterpartyFeeVersion, counterpartyAppVersion := types.SplitChannelVersion(counterpartyVersion)
+
+	if feeEnabled := im.keeper.IsFeeEnabled(ctx, portID, channelID); feeEnabled != (counterpartyFeeVersion != "") {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidVersion, "counterparty version %q does not match fee support (%t) of the channel",
+			counterpartyVersion, feeEnabled,
+		)
+	}
+
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyAppVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. On fee enabled channels the
+// acknowledgement of the application is wrapped with the counterparty address
+// registered by the relayer, so that it can be paid on the sending chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, []byte, error) {
+	if !im.keeper.IsFeeEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	res, ack, err := im.app.OnRecvPacket(ctx, packet, relayer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	forwardRelayer, _ := im.keeper.GetCounterpartyAddress(ctx, relayer, packet.GetDestChannel())
+	return res, types.NewIncentivizedAcknowledgement(ack, forwardRelayer).GetBytes(), nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. On fee enabled
+// channels the escrowed fees are paid to the forward and reverse relayers
+// before the unwrapped acknowledgement is passed to the application.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	if !im.keeper.IsFeeEnabled(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	var ack types.IncentivizedAcknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement: %v", err)
+	}
+
+	packetID := types.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	im.keeper.DistributePacketFees(ctx, packetID, ack.ForwardRelayerAddress, relayer)
+
+	return im.app.OnAcknowledgementPacket(ctx, packet, ack.Result, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. On fee enabled channels
+// the timeout fee is paid to the relayer and the remaining fees are refunded.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	if im.keeper.IsFeeEnabled(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		packetID := types.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		im.keeper.DistributePacketFeesOnTimeout(ctx, packetID, relayer)
+	}
+
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
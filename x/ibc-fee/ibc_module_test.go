@@ -0,0 +1,256 @@
+package fee_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	fee "github.com/cosmos/cosmos-sdk/x/ibc-fee"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/05-port/types"
+)
+
+const (
+	testPort         = "transfer"
+	testChannel      = "testchannel"
+	testAppVersion   = "ics20-1"
+	counterpartyAddr = "counterpartyaddr"
+)
+
+var (
+	testAck      = []byte("ack")
+	feeVersion   = types.MergeChannelVersions(types.Version, testAppVersion)
+	relayer      = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	refundAddr   = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins        = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+)
+
+// mockApp records the versions and acknowledgements passed through by the
+// middleware.
+type mockApp struct {
+	porttypes.IBCModule
+
+	version             string
+	counterpartyVersion string
+	ack                 []byte
+}
+
+func (app *mockApp) OnChanOpenInit(
+	_ sdk.Context, _ channeltypes.Order, _ []string, _, _ string,
+	_ *capabilitytypes.Capability, _ channeltypes.Counterparty, version string,
+) error {
+	app.version = version
+	return nil
+}
+
+func (app *mockApp) OnChanOpenTry(
+	_ sdk.Context, _ channeltypes.Order, _ []string, _, _ string,
+	_ *capabilitytypes.Capability, _ channeltypes.Counterparty, version, counterpartyVersion string,
+) error {
+	app.version = version
+	app.counterpartyVersion = counterpartyVersion
+	return nil
+}
+
+func (app *mockApp) OnChanOpenAck(_ sdk.Context, _, _ string, counterpartyVersion string) error {
+	app.counterpartyVersion = counterpartyVersion
+	return nil
+}
+
+func (app *mockApp) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) (*sdk.Result, []byte, error) {
+	return &sdk.Result{}, testAck, nil
+}
+
+func (app *mockApp) OnAcknowledgementPacket(_ sdk.Context, _ channeltypes.Packet, ack []byte, _ sdk.AccAddress) (*sdk.Result, error) {
+	app.ack = ack
+	return &sdk.Result{}, nil
+}
+
+func (app *mockApp) OnTimeoutPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) (*sdk.Result, error) {
+	return &sdk.Result{}, nil
+}
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+
+	app        *simapp.SimApp
+	ctx        sdk.Context
+	mockApp    *mockApp
+	middleware fee.IBCMiddleware
+	packet     channeltypes.Packet
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{})
+	suite.mockApp = &mockApp{}
+	suite.middleware = fee.NewIBCMiddleware(suite.mockApp, suite.app.IBCFeeKeeper)
+	suite.packet = channeltypes.NewPacket([]byte("data"), 1, testPort, testChannel, testPort, testChannel, 100, 0)
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (suite *MiddlewareTestSuite) TestOnChanOpenInit() {
+	err := suite.middleware.OnChanOpenInit(
+		suite.ctx, channeltypes.UNORDERED, nil, testPort, testChannel, nil, channeltypes.Counterparty{}, testAppVersion,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testAppVersion, suite.mockApp.version)
+	suite.Require().False(suite.app.IBCFeeKeeper.IsFeeEnabled(suite.ctx, testPort, testChannel))
+
+	err = suite.middleware.OnChanOpenInit(
+		suite.ctx, channeltypes.UNORDERED, nil, testPort, testChannel, nil, channeltypes.Counterparty{}, feeVersion,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testAppVersion, suite.mockApp.version)
+	suite.Require().True(suite.app.IBCFeeKeeper.IsFeeEnabled(suite.ctx, testPort, testChannel))
+}
+
+func (suite *MiddlewareTestSuite) TestOnChanOpenTry() {
+	testCases := []struct {
+		msg                 string
+		version             string
+		counterpartyVersion string
+		expFeeEnabled       bool
+		expPass             bool
+	}{
+		{"fee enabled", feeVersion, feeVersion, true, true},
+		{"fee disabled", testAppVersion, testAppVersion, false, true},
+		{"counterparty without fee", feeVersion, testAppVersion, false, false},
+		{"counterparty with fee", testAppVersion, feeVersion, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			err := suite.middleware.OnChanOpenTry(
+				suite.ctx, channeltypes.UNORDERED, nil, testPort, testChannel, nil, channeltypes.Counterparty{},
+				tc.version, tc.counterpartyVersion,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(testAppVersion, suite.mockApp.version)
+				suite.Require().Equal(testAppVersion, suite.mockApp.counterpartyVersion)
+			} else {
+				suite.Require().Error(err)
+			}
+			suite.Require().Equal(tc.expFeeEnabled, suite.app.IBCFeeKeeper.IsFeeEnabled(suite.ctx, testPort, testChannel))
+		})
+	}
+}
+
+func (suite *MiddlewareTestSuite) TestOnChanOpenAck() {
+	testCases := []struct {
+		msg                 string
+		feeEnabled          bool
+		counterpartyVersion string
+		expPass             bool
+	}{
+		{"fee enabled", true, feeVersion, true},
+		{"fee disabled", false, testAppVersion, true},
+		{"counterparty without fee", true, testAppVersion, false},
+		{"counterparty with fee", false, feeVersion, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			if tc.feeEnabled {
+				suite.app.IBCFeeKeeper.SetFeeEnabled(suite.ctx, testPort, testChannel)
+			}
+
+			err := suite.middleware.OnChanOpenAck(suite.ctx, testPort, testChannel, tc.counterpartyVersion)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(testAppVersion, suite.mockApp.counterpartyVersion)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MiddlewareTestSuite) TestOnRecvPacket() {
+	// acknowledgements are passed through on channels without fees
+	_, ack, err := suite.middleware.OnRecvPacket(suite.ctx, suite.packet, relayer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testAck, ack)
+
+	suite.app.IBCFeeKeeper.SetFeeEnabled(suite.ctx, testPort, testChannel)
+
+	_, ack, err = suite.middleware.OnRecvPacket(suite.ctx, suite.packet, relayer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewIncentivizedAcknowledgement(testAck, "").GetBytes(), ack)
+
+	suite.app.IBCFeeKeeper.SetCounterpartyAddress(suite.ctx, relayer, counterpartyAddr, testChannel)
+
+	_, ack, err = suite.middleware.OnRecvPacket(suite.ctx, suite.packet, relayer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewIncentivizedAcknowledgement(testAck, counterpartyAddr).GetBytes(), ack)
+}
+
+// escrowFees stores fees for the test packet and funds the escrow account
+func (suite *MiddlewareTestSuite) escrowFees() types.PacketID {
+	k := suite.app.IBCFeeKeeper
+	packetID := types.NewPacketID(testPort, testChannel, suite.packet.GetSequence())
+	packetFee := types.NewPacketFee(types.NewFee(coins, coins, coins), refundAddr)
+
+	k.SetFeeEnabled(suite.ctx, testPort, testChannel)
+	k.SetFeesInEscrow(suite.ctx, packetID, types.PacketFees{PacketFees: []types.PacketFee{packetFee}})
+
+	moduleAddr := k.GetFeeModuleAccount(suite.ctx).GetAddress()
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(suite.ctx, moduleAddr, packetFee.Fee.Total()))
+
+	return packetID
+}
+
+func (suite *MiddlewareTestSuite) TestOnAcknowledgementPacket() {
+	packetID := suite.escrowFees()
+
+	_, err := suite.middleware.OnAcknowledgementPacket(suite.ctx, suite.packet, testAck, relayer)
+	suite.Require().Error(err)
+
+	ack := types.NewIncentivizedAcknowledgement(testAck, otherRelayer.String())
+	_, err = suite.middleware.OnAcknowledgementPacket(suite.ctx, suite.packet, ack.GetBytes(), relayer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testAck, suite.mockApp.ack)
+
+	bankKeeper := suite.app.BankKeeper
+	suite.Require().Equal(coins, bankKeeper.GetAllBalances(suite.ctx, otherRelayer))
+	suite.Require().Equal(coins, bankKeeper.GetAllBalances(suite.ctx, relayer))
+	suite.Require().Equal(coins, bankKeeper.GetAllBalances(suite.ctx, refundAddr))
+
+	_, found := suite.app.IBCFeeKeeper.GetFeesInEscrow(suite.ctx, packetID)
+	suite.Require().False(found)
+}
+
+func (suite *MiddlewareTestSuite) TestOnTimeoutPacket() {
+	packetID := suite.escrowFees()
+
+	_, err := suite.middleware.OnTimeoutPacket(suite.ctx, suite.packet, relayer)
+	suite.Require().NoError(err)
+
+	bankKeeper := suite.app.BankKeeper
+	suite.Require().Equal(coins, bankKeeper.GetAllBalances(suite.ctx, relayer))
+	suite.Require().Equal(coins.Add(coins...), bankKeeper.GetAllBalances(suite.ctx, refundAddr))
+
+	_, found := suite.app.IBCFeeKeeper.GetFeesInEscrow(suite.ctx, packetID)
+	suite.Require().False(found)
+}
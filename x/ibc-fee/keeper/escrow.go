@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+// EscrowPacketFee escrows the fee paid for a packet sent on a fee enabled
+// channel. The packet must either be in flight or be the next packet sent on the
+// channel, so that fees can be paid in the same transaction as the packet is
+// sent.
+func (k Keeper) EscrowPacketFee(ctx sdk.Context, packetID types.PacketID, packetFee types.PacketFee) error {
+	if !k.IsFeeEnabled(ctx, packetID.PortID, packetID.ChannelID) {
+		return sdkerrors.Wrapf(types.ErrFeeNotEnabled, "port ID (%s) channel ID (%s)", packetID.PortID, packetID.ChannelID)
+	}
+
+	if _, found := k.channelKeeper.GetChannel(ctx, packetID.PortID, packetID.ChannelID); !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packetID.PortID, packetID.ChannelID)
+	}
+
+	nextSequenceSend, found := k.channelKeeper.GetNextSequenceSend(ctx, packetID.PortID, packetID.ChannelID)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", packetID.PortID, packetID.ChannelID,
+		)
+	}
+
+	if packetID.Sequence != nextSequenceSend &&
+		!k.channelKeeper.HasPacketCommitment(ctx, packetID.PortID, packetID.ChannelID, packetID.Sequence) {
+		return sdkerrors.Wrapf(
+			types.ErrPacketNotFound, "packet with sequence %d is neither in flight nor the next to be sent", packetID.Sequence,
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, packetFee.RefundAddress, types.ModuleName, packetFee.Fee.Total(),
+	); err != nil {
+		return err
+	}
+
+	fees, _ := k.GetFeesInEscrow(ctx, packetID)
+	fees.PacketFees = append(fees.PacketFees, packetFee)
+	k.SetFeesInEscrow(ctx, packetID, fees)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayPacketFee,
+			sdk.NewAttribute(types.AttributeKeyPortID, packetID.PortID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packetID.ChannelID),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, packetFee.Fee.RecvFee.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, packetFee.Fee.AckFee.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutFee, packetFee.Fee.TimeoutFee.String()),
+		),
+	)
+
+	return nil
+}
+
+// DistributePacketFees pays the fees escrowed for an acknowledged packet. The
+// receive fee is paid to the forward relayer, whose address is taken from the
+// acknowledgement, the ack fee to the reverse relayer and the timeout fee is
+// refunded. The receive fee is refunded as well if the forward relayer address
+// is empty or invalid.
+func (k Keeper) DistributePacketFees(
+	ctx sdk.Context, packetID types.PacketID, forwardRelayer string, reverseRelayer sdk.AccAddress,
+) {
+	fees, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return
+	}
+
+	forwardRelayerAddr, err := sdk.AccAddressFromBech32(forwardRelayer)
+	if err != nil {
+		k.Logger(ctx).Info("refunding receive fee", "packet", packetID, "forward-relayer", forwardRelayer, "error", err)
+	}
+
+	for _, packetFee := range fees.PacketFees {
+		if forwardRelayerAddr.Empty() {
+			k.distributeFee(ctx, packetFee.RefundAddress, packetFee.RefundAddress, packetFee.Fee.RecvFee)
+		} else {
+			k.distributeFee(ctx, forwardRelayerAddr, packetFee.RefundAddress, packetFee.Fee.RecvFee)
+		}
+
+		k.distributeFee(ctx, reverseRelayer, packetFee.RefundAddress, packetFee.Fee.AckFee)
+		k.distributeFee(ctx, packetFee.RefundAddress, packetFee.RefundAddress, packetFee.Fee.TimeoutFee)
+	}
+
+	k.DeleteFeesInEscrow(ctx, packetID)
+}
+
+// DistributePacketFeesOnTimeout pays the fees escrowed for a timed out packet.
+// The timeout fee is paid to the relayer of the timeout while the receive and ack
+// fees are refunded.
+func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, packetID types.PacketID, timeoutRelayer sdk.AccAddress) {
+	fees, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return
+	}
+
+	for _, packetFee := range fees.PacketFees {
+		k.distributeFee(ctx, packetFee.RefundAddress, packetFee.RefundAddress, packetFee.Fee.RecvFee.Add(packetFee.Fee.AckFee...))
+		k.distributeFee(ctx, timeoutRelayer, packetFee.RefundAddress, packetFee.Fee.TimeoutFee)
+	}
+
+	k.DeleteFeesInEscrow(ctx, packetID)
+}
+
+// distributeFee sends the fee from the escrow to the receiver. The fee is
+// refunded if the receiver cannot be paid, and left in escrow if the refund
+// fails as well so that the acknowledgement or timeout of the packet never
+// fails because of its fees.
+func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAddress sdk.AccAddress, fee sdk.Coins) {
+	if fee.IsZero() {
+		return
+	}
+
+	for _, addr := range []sdk.AccAddress{receiver, refundAddress} {
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, addr, fee)
+		if err != nil {
+			k.Logger(ctx).Error("failed to distribute fee", "receiver", addr, "fee", fee, "error", err)
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeFee,
+				sdk.NewAttribute(types.AttributeKeyReceiver, addr.String()),
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			),
+		)
+
+		return
+	}
+}
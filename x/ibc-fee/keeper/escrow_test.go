@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestEscrowPacketFee() {
+	var (
+		packetID  types.PacketID
+		packetFee types.PacketFee
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: next packet sent", func() {}, true},
+		{"success: packet in flight", func() {
+			packet := channeltypes.NewPacket(
+				[]byte("data"), 1, suite.channelA.PortID, suite.channelA.ID, suite.channelB.PortID, suite.channelB.ID, 100, 0,
+			)
+			suite.Require().NoError(suite.chainA.SendPacket(packet))
+		}, true},
+		{"channel not fee enabled", func() {
+			suite.chainA.App.IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID)
+		}, false},
+		{"channel not found", func() {
+			packetID.ChannelID = "otherchannel"
+			suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), packetID.PortID, packetID.ChannelID)
+		}, false},
+		{"packet neither in flight nor next", func() {
+			packetID.Sequence = 2
+		}, false},
+		{"insufficient funds", func() {
+			packetFee.RefundAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			refundAddr := suite.chainA.SenderAccount.GetAddress()
+			packetID = types.NewPacketID(suite.channelA.PortID, suite.channelA.ID, 1)
+			packetFee = types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAddr)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			k := suite.chainA.App.IBCFeeKeeper
+			moduleAddr := k.GetFeeModuleAccount(ctx).GetAddress()
+
+			err := k.EscrowPacketFee(ctx, packetID, packetFee)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// fees accumulate for the same packet
+				suite.Require().NoError(k.EscrowPacketFee(ctx, packetID, packetFee))
+
+				fees, found := k.GetFeesInEscrow(ctx, packetID)
+				suite.Require().True(found)
+				suite.Require().Equal([]types.PacketFee{packetFee, packetFee}, fees.PacketFees)
+
+				expEscrow := packetFee.Fee.Total().Add(packetFee.Fee.Total()...)
+				suite.Require().Equal(expEscrow, suite.chainA.App.BankKeeper.GetAllBalances(ctx, moduleAddr))
+			} else {
+				suite.Require().Error(err)
+
+				_, found := k.GetFeesInEscrow(ctx, packetID)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributePacketFees() {
+	forwardRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	reverseRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		msg               string
+		forwardRelayer    string
+		expForwardBalance sdk.Coins
+		expRefund         sdk.Coins
+	}{
+		{"valid forward relayer", forwardRelayer.String(), defaultRecvFee, defaultTimeoutFee},
+		{"no forward relayer", "", sdk.NewCoins(), defaultRecvFee.Add(defaultTimeoutFee...)},
+		{"invalid forward relayer", "invalid", sdk.NewCoins(), defaultRecvFee.Add(defaultTimeoutFee...)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			k := suite.chainA.App.IBCFeeKeeper
+			bankKeeper := suite.chainA.App.BankKeeper
+
+			refundAddr := suite.chainA.SenderAccount.GetAddress()
+			packetID := types.NewPacketID(suite.channelA.PortID, suite.channelA.ID, 1)
+			packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAddr)
+
+			suite.Require().NoError(k.EscrowPacketFee(ctx, packetID, packetFee))
+			balance := bankKeeper.GetAllBalances(ctx, refundAddr)
+
+			k.DistributePacketFees(ctx, packetID, tc.forwardRelayer, reverseRelayer)
+
+			suite.Require().Equal(tc.expForwardBalance, bankKeeper.GetAllBalances(ctx, forwardRelayer))
+			suite.Require().Equal(defaultAckFee, bankKeeper.GetAllBalances(ctx, reverseRelayer))
+			suite.Require().Equal(balance.Add(tc.expRefund...), bankKeeper.GetAllBalances(ctx, refundAddr))
+			suite.Require().True(bankKeeper.GetAllBalances(ctx, k.GetFeeModuleAccount(ctx).GetAddress()).IsZero())
+
+			_, found := k.GetFeesInEscrow(ctx, packetID)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.App.IBCFeeKeeper
+	bankKeeper := suite.chainA.App.BankKeeper
+
+	timeoutRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	refundAddr := suite.chainA.SenderAccount.GetAddress()
+	packetID := types.NewPacketID(suite.channelA.PortID, suite.channelA.ID, 1)
+	packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAddr)
+
+	suite.Require().NoError(k.EscrowPacketFee(ctx, packetID, packetFee))
+	balance := bankKeeper.GetAllBalances(ctx, refundAddr)
+
+	k.DistributePacketFeesOnTimeout(ctx, packetID, timeoutRelayer)
+
+	suite.Require().Equal(defaultTimeoutFee, bankKeeper.GetAllBalances(ctx, timeoutRelayer))
+	suite.Require().Equal(balance.Add(defaultRecvFee...).Add(defaultAckFee...), bankKeeper.GetAllBalances(ctx, refundAddr))
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, k.GetFeeModuleAccount(ctx).GetAddress()).IsZero())
+
+	_, found := k.GetFeesInEscrow(ctx, packetID)
+	suite.Require().False(found)
+}
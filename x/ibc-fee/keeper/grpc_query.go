@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// IncentivizedPackets implements the Query/IncentivizedPackets gRPC method
+func (k Keeper) IncentivizedPackets(c context.Context, req *types.QueryIncentivizedPacketsRequest) (*types.QueryIncentivizedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	packets := []types.IdentifiedPacketFees{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeesInEscrowKeyPrefix)
+
+	res, err := query.Paginate(prefixStore, req.Req, func(key []byte, value []byte) error {
+		packetID, err := types.ParseFeesInEscrowKey(key)
+		if err != nil {
+			return err
+		}
+
+		var fees types.PacketFees
+		if err := k.cdc.UnmarshalBinaryBare(value, &fees); err != nil {
+			return err
+		}
+
+		packets = append(packets, types.NewIdentifiedPacketFees(packetID, fees.PacketFees))
+		return nil
+	})
+
+	if err != nil {
+		return &types.QueryIncentivizedPacketsResponse{}, err
+	}
+
+	return &types.QueryIncentivizedPacketsResponse{IncentivizedPackets: packets, Res: res}, nil
+}
+
+// IncentivizedPacket implements the Query/IncentivizedPacket gRPC method
+func (k Keeper) IncentivizedPacket(c context.Context, req *types.QueryIncentivizedPacketRequest) (*types.QueryIncentivizedPacketResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.PacketID.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fees, found := k.GetFeesInEscrow(ctx, req.PacketID)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound, "no fees escrowed for packet %s/%s/%d",
+			req.PacketID.PortID, req.PacketID.ChannelID, req.PacketID.Sequence,
+		)
+	}
+
+	return &types.QueryIncentivizedPacketResponse{
+		IncentivizedPacket: types.NewIdentifiedPacketFees(req.PacketID, fees.PacketFees),
+	}, nil
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPackets() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.App.IBCFeeKeeper
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	packetID := types.NewPacketID(suite.channelA.PortID, suite.channelA.ID, 1)
+
+	_, err := queryClient.IncentivizedPacket(gocontext.Background(), &types.QueryIncentivizedPacketRequest{PacketID: packetID})
+	suite.Require().Error(err)
+
+	res, err := queryClient.IncentivizedPackets(gocontext.Background(), &types.QueryIncentivizedPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.IncentivizedPackets)
+
+	packetFee := types.NewPacketFee(
+		types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), suite.chainA.SenderAccount.GetAddress(),
+	)
+	suite.Require().NoError(k.EscrowPacketFee(ctx, packetID, packetFee))
+
+	expPacket := types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee})
+
+	packetRes, err := queryClient.IncentivizedPacket(gocontext.Background(), &types.QueryIncentivizedPacketRequest{PacketID: packetID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expPacket, packetRes.IncentivizedPacket)
+
+	res, err = queryClient.IncentivizedPackets(gocontext.Background(), &types.QueryIncentivizedPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.IdentifiedPacketFees{expPacket}, res.IncentivizedPackets)
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// Keeper defines the IBC fee middleware keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.Marshaler
+
+	channelKeeper types.ChannelKeeper
+	authKeeper    types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper creates a new IBC fee middleware Keeper instance
+func NewKeeper(
+	cdc codec.Marshaler, key sdk.StoreKey,
+	channelKeeper types.ChannelKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+) Keeper {
+
+	// ensure the fee escrow module account is set
+	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the IBC fee module account has not been set")
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		channelKeeper: channelKeeper,
+		authKeeper:    authKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// GetFeeModuleAccount returns the fee escrow ModuleAccount
+func (k Keeper) GetFeeModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
+	return k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// SetFeeEnabled marks a channel as fee enabled
+func (k Keeper) SetFeeEnabled(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFeeEnabledKey(portID, channelID), []byte{1})
+}
+
+// IsFeeEnabled returns true if the channel negotiated fee support during its
+// handshake
+func (k Keeper) IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetFeeEnabledKey(portID, channelID))
+}
+
+// DeleteFeeEnabled removes the fee enabled mark of a channel
+func (k Keeper) DeleteFeeEnabled(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeeEnabledKey(portID, channelID))
+}
+
+// GetAllFeeEnabledChannels returns all the fee enabled channels
+func (k Keeper) GetAllFeeEnabledChannels(ctx sdk.Context) []types.FeeEnabledChannel {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeEnabledKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	channels := []types.FeeEnabledChannel{}
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseChannelKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		channels = append(channels, types.NewFeeEnabledChannel(portID, channelID))
+	}
+
+	return channels
+}
+
+// SetCounterpartyAddress stores the address on the counterparty chain a relayer
+// is paid on for the packets it delivers on a channel
+func (k Keeper) SetCounterpartyAddress(ctx sdk.Context, address sdk.AccAddress, counterpartyAddress, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCounterpartyAddressKey(channelID, address), []byte(counterpartyAddress))
+}
+
+// GetCounterpartyAddress returns the counterparty address registered by a relayer
+// for a channel
+func (k Keeper) GetCounterpartyAddress(ctx sdk.Context, address sdk.AccAddress, channelID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCounterpartyAddressKey(channelID, address))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// GetAllCounterpartyAddresses returns all the registered counterparty addresses
+func (k Keeper) GetAllCounterpartyAddresses(ctx sdk.Context) []types.RegisteredCounterpartyAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CounterpartyAddressKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	addresses := []types.RegisteredCounterpartyAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		channelID, address, err := types.ParseCounterpartyAddressKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		addresses = append(addresses, types.NewRegisteredCounterpartyAddress(address, string(iterator.Value()), channelID))
+	}
+
+	return addresses
+}
+
+// SetFeesInEscrow stores the fees escrowed for a packet
+func (k Keeper) SetFeesInEscrow(ctx sdk.Context, packetID types.PacketID, fees types.PacketFees) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&fees)
+	store.Set(types.GetFeesInEscrowKey(packetID.PortID, packetID.ChannelID, packetID.Sequence), bz)
+}
+
+// GetFeesInEscrow returns the fees escrowed for a packet
+func (k Keeper) GetFeesInEscrow(ctx sdk.Context, packetID types.PacketID) (types.PacketFees, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeesInEscrowKey(packetID.PortID, packetID.ChannelID, packetID.Sequence))
+	if bz == nil {
+		return types.PacketFees{}, false
+	}
+
+	var fees types.PacketFees
+	k.cdc.MustUnmarshalBinaryBare(bz, &fees)
+	return fees, true
+}
+
+// DeleteFeesInEscrow removes the fees escrowed for a packet
+func (k Keeper) DeleteFeesInEscrow(ctx sdk.Context, packetID types.PacketID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeesInEscrowKey(packetID.PortID, packetID.ChannelID, packetID.Sequence))
+}
+
+// IterateIdentifiedPacketFees iterates over the fees escrowed for all packets
+// and performs a callback function
+func (k Keeper) IterateIdentifiedPacketFees(ctx sdk.Context, cb func(types.IdentifiedPacketFees) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeesInEscrowKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		packetID, err := types.ParseFeesInEscrowKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var fees types.PacketFees
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &fees)
+
+		if cb(types.NewIdentifiedPacketFees(packetID, fees.PacketFees)) {
+			break
+		}
+	}
+}
+
+// GetAllIdentifiedPacketFees returns the fees escrowed for all packets
+func (k Keeper) GetAllIdentifiedPacketFees(ctx sdk.Context) []types.IdentifiedPacketFees {
+	identifiedFees := []types.IdentifiedPacketFees{}
+	k.IterateIdentifiedPacketFees(ctx, func(fees types.IdentifiedPacketFees) bool {
+		identifiedFees = append(identifiedFees, fees)
+		return false
+	})
+
+	return identifiedFees
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+var (
+	defaultRecvFee    = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	defaultAckFee     = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200)))
+	defaultTimeoutFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300)))
+)
+
+// KeeperTestSuite is a testing suite to test keeper functions.
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	channelA ibctesting.TestChannel
+	channelB ibctesting.TestChannel
+}
+
+// TestKeeperTestSuite runs all the tests within this package.
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// SetupTest creates a coordinator with 2 test chains and an open channel
+// between them, marked as fee enabled on chainA.
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	_, _, _, _, suite.channelA, suite.channelB = suite.coordinator.Setup(suite.chainA, suite.chainB)
+	suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID)
+}
+
+func (suite *KeeperTestSuite) TestFeeEnabled() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.App.IBCFeeKeeper
+
+	suite.Require().True(k.IsFeeEnabled(ctx, suite.channelA.PortID, suite.channelA.ID))
+	suite.Require().False(k.IsFeeEnabled(ctx, suite.channelA.PortID, "otherchannel"))
+	suite.Require().Equal(
+		[]types.FeeEnabledChannel{types.NewFeeEnabledChannel(suite.channelA.PortID, suite.channelA.ID)},
+		k.GetAllFeeEnabledChannels(ctx),
+	)
+
+	k.DeleteFeeEnabled(ctx, suite.channelA.PortID, suite.channelA.ID)
+	suite.Require().False(k.IsFeeEnabled(ctx, suite.channelA.PortID, suite.channelA.ID))
+	suite.Require().Empty(k.GetAllFeeEnabledChannels(ctx))
+}
+
+func (suite *KeeperTestSuite) TestCounterpartyAddress() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.App.IBCFeeKeeper
+	relayer := suite.chainA.SenderAccount.GetAddress()
+
+	_, found := k.GetCounterpartyAddress(ctx, relayer, suite.channelA.ID)
+	suite.Require().False(found)
+
+	k.SetCounterpartyAddress(ctx, relayer, "counterparty", suite.channelA.ID)
+
+	addr, found := k.GetCounterpartyAddress(ctx, relayer, suite.channelA.ID)
+	suite.Require().True(found)
+	suite.Require().Equal("counterparty", addr)
+
+	_, found = k.GetCounterpartyAddress(ctx, relayer, "otherchannel")
+	suite.Require().False(found)
+
+	suite.Require().Equal(
+		[]types.RegisteredCounterpartyAddress{types.NewRegisteredCounterpartyAddress(relayer, "counterparty", suite.channelA.ID)},
+		k.GetAllCounterpartyAddresses(ctx),
+	)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the IBC fee middleware types
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgPayPacketFee{}, "cosmos-sdk/MsgPayPacketFee", nil)
+	cdc.RegisterConcrete(&MsgRegisterCounterpartyAddress{}, "cosmos-sdk/MsgRegisterCounterpartyAddress", nil)
+	cdc.RegisterConcrete(IncentivizedAcknowledgement{}, "cosmos-sdk/IncentivizedAcknowledgement", nil)
+}
+
+// RegisterInterfaces register the IBC fee middleware interfaces to protobuf
+// Any.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPayPacketFee{},
+		&MsgRegisterCounterpartyAddress{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/ibc-fee module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as Amino
+	// is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/ibc-fee and
+	// defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino, cdctypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
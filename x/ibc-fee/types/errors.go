@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC fee middleware sentinel errors
+var (
+	ErrInvalidVersion             = sdkerrors.Register(ModuleName, 2, "invalid fee middleware version")
+	ErrFeeNotEnabled              = sdkerrors.Register(ModuleName, 3, "fee middleware not enabled on channel")
+	ErrInvalidFee                 = sdkerrors.Register(ModuleName, 4, "invalid fee")
+	ErrPacketNotFound             = sdkerrors.Register(ModuleName, 5, "packet not found")
+	ErrInvalidAcknowledgement     = sdkerrors.Register(ModuleName, 6, "invalid incentivized acknowledgement")
+	ErrInvalidCounterpartyAddress = sdkerrors.Register(ModuleName, 7, "invalid counterparty address")
+)
@@ -0,0 +1,19 @@
+package types
+
+// IBC fee middleware events
+const (
+	EventTypePayPacketFee                = "pay_packet_fee"
+	EventTypeDistributeFee               = "distribute_fee"
+	EventTypeRegisterCounterpartyAddress = "register_counterparty_address"
+
+	AttributeKeyPortID              = "port_id"
+	AttributeKeyChannelID           = "channel_id"
+	AttributeKeySequence            = "sequence"
+	AttributeKeyRecvFee             = "recv_fee"
+	AttributeKeyAckFee              = "ack_fee"
+	AttributeKeyTimeoutFee          = "timeout_fee"
+	AttributeKeyReceiver            = "receiver"
+	AttributeKeyFee                 = "fee"
+	AttributeKeyRelayer             = "relayer"
+	AttributeKeyCounterpartyAddress = "counterparty_address"
+)
@@ -0,0 +1,136 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// NewFee creates a new Fee instance
+func NewFee(recvFee, ackFee, timeoutFee sdk.Coins) Fee {
+	return Fee{
+		RecvFee:    recvFee,
+		AckFee:     ackFee,
+		TimeoutFee: timeoutFee,
+	}
+}
+
+// Total returns the total amount escrowed for the fee
+func (f Fee) Total() sdk.Coins {
+	return f.RecvFee.Add(f.AckFee...).Add(f.TimeoutFee...)
+}
+
+// Validate performs a stateless validation of the fee
+func (f Fee) Validate() error {
+	for _, coins := range []sdk.Coins{f.RecvFee, f.AckFee, f.TimeoutFee} {
+		if !coins.IsValid() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coins.String())
+		}
+	}
+
+	if f.Total().IsZero() {
+		return sdkerrors.Wrap(ErrInvalidFee, "fee cannot be empty")
+	}
+
+	return nil
+}
+
+// NewPacketFee creates a new PacketFee instance
+func NewPacketFee(fee Fee, refundAddress sdk.AccAddress) PacketFee {
+	return PacketFee{
+		Fee:           fee,
+		RefundAddress: refundAddress,
+	}
+}
+
+// Validate performs a stateless validation of the packet fee
+func (pf PacketFee) Validate() error {
+	if pf.RefundAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing refund address")
+	}
+
+	return pf.Fee.Validate()
+}
+
+// NewPacketID creates a new PacketID instance
+func NewPacketID(portID, channelID string, sequence uint64) PacketID {
+	return PacketID{
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequence:  sequence,
+	}
+}
+
+// Validate performs a stateless validation of the packet identifier
+func (p PacketID) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortID); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelID); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(ErrPacketNotFound, "packet sequence cannot be 0")
+	}
+
+	return nil
+}
+
+// NewIdentifiedPacketFees creates a new IdentifiedPacketFees instance
+func NewIdentifiedPacketFees(packetID PacketID, packetFees []PacketFee) IdentifiedPacketFees {
+	return IdentifiedPacketFees{
+		PacketID:   packetID,
+		PacketFees: packetFees,
+	}
+}
+
+// Validate performs a stateless validation of the packet fees
+func (ipf IdentifiedPacketFees) Validate() error {
+	if err := ipf.PacketID.Validate(); err != nil {
+		return err
+	}
+
+	if len(ipf.PacketFees) == 0 {
+		return sdkerrors.Wrap(ErrInvalidFee, "packet fees cannot be empty")
+	}
+
+	for _, pf := range ipf.PacketFees {
+		if err := pf.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewFeeEnabledChannel creates a new FeeEnabledChannel instance
+func NewFeeEnabledChannel(portID, channelID string) FeeEnabledChannel {
+	return FeeEnabledChannel{
+		PortID:    portID,
+		ChannelID: channelID,
+	}
+}
+
+// NewRegisteredCounterpartyAddress creates a new RegisteredCounterpartyAddress
+// instance
+func NewRegisteredCounterpartyAddress(address sdk.AccAddress, counterpartyAddress, channelID string) RegisteredCounterpartyAddress {
+	return RegisteredCounterpartyAddress{
+		Address:             address,
+		CounterpartyAddress: counterpartyAddress,
+		ChannelID:           channelID,
+	}
+}
+
+// NewIncentivizedAcknowledgement creates a new IncentivizedAcknowledgement
+// instance
+func NewIncentivizedAcknowledgement(result []byte, forwardRelayerAddress string) IncentivizedAcknowledgement {
+	return IncentivizedAcknowledgement{
+		Result:                result,
+		ForwardRelayerAddress: forwardRelayerAddress,
+	}
+}
+
+// GetBytes is a helper for serialising
+func (ack IncentivizedAcknowledgement) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(ack))
+}
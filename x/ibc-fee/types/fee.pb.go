@@ -0,0 +1,2797 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/fee/fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Fee defines the fees paid to the relayers of a packet. The receive fee is paid
+// to the relayer delivering the packet to the counterparty chain, the ack fee to
+// the relayer delivering the acknowledgement back and the timeout fee to the
+// relayer delivering the timeout of the packet.
+type Fee struct {
+	RecvFee    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=recv_fee,json=recvFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recv_fee" yaml:"recv_fee"`
+	AckFee     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=ack_fee,json=ackFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ack_fee" yaml:"ack_fee"`
+	TimeoutFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=timeout_fee,json=timeoutFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"timeout_fee" yaml:"timeout_fee"`
+}
+
+func (m *Fee) Reset()         { *m = Fee{} }
+func (m *Fee) String() string { return proto.CompactTextString(m) }
+func (*Fee) ProtoMessage()    {}
+func (*Fee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{0}
+}
+func (m *Fee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fee.Merge(m, src)
+}
+func (m *Fee) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fee) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fee proto.InternalMessageInfo
+
+func (m *Fee) GetRecvFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecvFee
+	}
+	return nil
+}
+
+func (m *Fee) GetAckFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AckFee
+	}
+	return nil
+}
+
+func (m *Fee) GetTimeoutFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TimeoutFee
+	}
+	return nil
+}
+
+// PacketFee defines a fee escrowed for a packet along with the address the
+// unused part of the fee is refunded to.
+type PacketFee struct {
+	Fee           Fee                                           `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	RefundAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"refund_address,omitempty" yaml:"refund_address"`
+}
+
+func (m *PacketFee) Reset()         { *m = PacketFee{} }
+func (m *PacketFee) String() string { return proto.CompactTextString(m) }
+func (*PacketFee) ProtoMessage()    {}
+func (*PacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{1}
+}
+func (m *PacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketFee.Merge(m, src)
+}
+func (m *PacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketFee proto.InternalMessageInfo
+
+func (m *PacketFee) GetFee() Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return Fee{}
+}
+
+func (m *PacketFee) GetRefundAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return nil
+}
+
+// PacketFees defines the list of fees escrowed for a single packet.
+type PacketFees struct {
+	PacketFees []PacketFee `protobuf:"bytes,1,rep,name=packet_fees,json=packetFees,proto3" json:"packet_fees" yaml:"packet_fees"`
+}
+
+func (m *PacketFees) Reset()         { *m = PacketFees{} }
+func (m *PacketFees) String() string { return proto.CompactTextString(m) }
+func (*PacketFees) ProtoMessage()    {}
+func (*PacketFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{2}
+}
+func (m *PacketFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketFees.Merge(m, src)
+}
+func (m *PacketFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketFees proto.InternalMessageInfo
+
+func (m *PacketFees) GetPacketFees() []PacketFee {
+	if m != nil {
+		return m.PacketFees
+	}
+	return nil
+}
+
+// PacketID identifies a packet sent on a given port and channel.
+type PacketID struct {
+	PortID    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelID string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *PacketID) Reset()         { *m = PacketID{} }
+func (m *PacketID) String() string { return proto.CompactTextString(m) }
+func (*PacketID) ProtoMessage()    {}
+func (*PacketID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{3}
+}
+func (m *PacketID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketID.Merge(m, src)
+}
+func (m *PacketID) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketID) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketID proto.InternalMessageInfo
+
+func (m *PacketID) GetPortID() string {
+	if m != nil {
+		return m.PortID
+	}
+	return ""
+}
+
+func (m *PacketID) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *PacketID) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// IdentifiedPacketFees defines the fees escrowed for a packet along with its
+// identifier.
+type IdentifiedPacketFees struct {
+	PacketID   PacketID    `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id" yaml:"packet_id"`
+	PacketFees []PacketFee `protobuf:"bytes,2,rep,name=packet_fees,json=packetFees,proto3" json:"packet_fees" yaml:"packet_fees"`
+}
+
+func (m *IdentifiedPacketFees) Reset()         { *m = IdentifiedPacketFees{} }
+func (m *IdentifiedPacketFees) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedPacketFees) ProtoMessage()    {}
+func (*IdentifiedPacketFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{4}
+}
+func (m *IdentifiedPacketFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedPacketFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedPacketFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedPacketFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedPacketFees.Merge(m, src)
+}
+func (m *IdentifiedPacketFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedPacketFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedPacketFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedPacketFees proto.InternalMessageInfo
+
+func (m *IdentifiedPacketFees) GetPacketID() PacketID {
+	if m != nil {
+		return m.PacketID
+	}
+	return PacketID{}
+}
+
+func (m *IdentifiedPacketFees) GetPacketFees() []PacketFee {
+	if m != nil {
+		return m.PacketFees
+	}
+	return nil
+}
+
+// FeeEnabledChannel defines a channel which negotiated fee support during its
+// handshake.
+type FeeEnabledChannel struct {
+	PortID    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelID string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *FeeEnabledChannel) Reset()         { *m = FeeEnabledChannel{} }
+func (m *FeeEnabledChannel) String() string { return proto.CompactTextString(m) }
+func (*FeeEnabledChannel) ProtoMessage()    {}
+func (*FeeEnabledChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{5}
+}
+func (m *FeeEnabledChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeEnabledChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeEnabledChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeEnabledChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEnabledChannel.Merge(m, src)
+}
+func (m *FeeEnabledChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeEnabledChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEnabledChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEnabledChannel proto.InternalMessageInfo
+
+func (m *FeeEnabledChannel) GetPortID() string {
+	if m != nil {
+		return m.PortID
+	}
+	return ""
+}
+
+func (m *FeeEnabledChannel) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+// RegisteredCounterpartyAddress defines the address on the counterparty chain a
+// relayer of a channel is paid on.
+type RegisteredCounterpartyAddress struct {
+	Address             github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	CounterpartyAddress string                                        `protobuf:"bytes,2,opt,name=counterparty_address,json=counterpartyAddress,proto3" json:"counterparty_address,omitempty" yaml:"counterparty_address"`
+	ChannelID           string                                        `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *RegisteredCounterpartyAddress) Reset()         { *m = RegisteredCounterpartyAddress{} }
+func (m *RegisteredCounterpartyAddress) String() string { return proto.CompactTextString(m) }
+func (*RegisteredCounterpartyAddress) ProtoMessage()    {}
+func (*RegisteredCounterpartyAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{6}
+}
+func (m *RegisteredCounterpartyAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredCounterpartyAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredCounterpartyAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredCounterpartyAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredCounterpartyAddress.Merge(m, src)
+}
+func (m *RegisteredCounterpartyAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredCounterpartyAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredCounterpartyAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredCounterpartyAddress proto.InternalMessageInfo
+
+func (m *RegisteredCounterpartyAddress) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *RegisteredCounterpartyAddress) GetCounterpartyAddress() string {
+	if m != nil {
+		return m.CounterpartyAddress
+	}
+	return ""
+}
+
+func (m *RegisteredCounterpartyAddress) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+// MsgPayPacketFee defines a msg to escrow the relayer fees of a packet sent on
+// a fee enabled channel. The packet may either be in flight or be the next
+// packet sent on the channel.
+type MsgPayPacketFee struct {
+	Fee             Fee    `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	SourcePortID    string `protobuf:"bytes,2,opt,name=source_port_id,json=sourcePortId,proto3" json:"source_port_id,omitempty" yaml:"source_port_id"`
+	SourceChannelID string `protobuf:"bytes,3,opt,name=source_channel_id,json=sourceChannelId,proto3" json:"source_channel_id,omitempty" yaml:"source_channel_id"`
+	Sequence        uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the account paying the fee, refunded with the unused part of the fee
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgPayPacketFee) Reset()         { *m = MsgPayPacketFee{} }
+func (m *MsgPayPacketFee) String() string { return proto.CompactTextString(m) }
+func (*MsgPayPacketFee) ProtoMessage()    {}
+func (*MsgPayPacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{7}
+}
+func (m *MsgPayPacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayPacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayPacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayPacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayPacketFee.Merge(m, src)
+}
+func (m *MsgPayPacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayPacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayPacketFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayPacketFee proto.InternalMessageInfo
+
+func (m *MsgPayPacketFee) GetFee() Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return Fee{}
+}
+
+func (m *MsgPayPacketFee) GetSourcePortID() string {
+	if m != nil {
+		return m.SourcePortID
+	}
+	return ""
+}
+
+func (m *MsgPayPacketFee) GetSourceChannelID() string {
+	if m != nil {
+		return m.SourceChannelID
+	}
+	return ""
+}
+
+func (m *MsgPayPacketFee) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MsgPayPacketFee) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+// MsgRegisterCounterpartyAddress defines a msg for a relayer to register the
+// address it is paid on for the packets it delivers on a channel. The address is
+// sent back to the sending chain in the packet acknowledgement.
+type MsgRegisterCounterpartyAddress struct {
+	Address             github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	CounterpartyAddress string                                        `protobuf:"bytes,2,opt,name=counterparty_address,json=counterpartyAddress,proto3" json:"counterparty_address,omitempty" yaml:"counterparty_address"`
+	ChannelID           string                                        `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *MsgRegisterCounterpartyAddress) Reset()         { *m = MsgRegisterCounterpartyAddress{} }
+func (m *MsgRegisterCounterpartyAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCounterpartyAddress) ProtoMessage()    {}
+func (*MsgRegisterCounterpartyAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{8}
+}
+func (m *MsgRegisterCounterpartyAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCounterpartyAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCounterpartyAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCounterpartyAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCounterpartyAddress.Merge(m, src)
+}
+func (m *MsgRegisterCounterpartyAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCounterpartyAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCounterpartyAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCounterpartyAddress proto.InternalMessageInfo
+
+func (m *MsgRegisterCounterpartyAddress) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *MsgRegisterCounterpartyAddress) GetCounterpartyAddress() string {
+	if m != nil {
+		return m.CounterpartyAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterCounterpartyAddress) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+// IncentivizedAcknowledgement wraps the acknowledgement of the application on
+// a fee enabled channel with the counterparty address of the relayer who
+// delivered the packet.
+type IncentivizedAcknowledgement struct {
+	Result                []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ForwardRelayerAddress string `protobuf:"bytes,2,opt,name=forward_relayer_address,json=forwardRelayerAddress,proto3" json:"forward_relayer_address,omitempty" yaml:"forward_relayer_address"`
+}
+
+func (m *IncentivizedAcknowledgement) Reset()         { *m = IncentivizedAcknowledgement{} }
+func (m *IncentivizedAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*IncentivizedAcknowledgement) ProtoMessage()    {}
+func (*IncentivizedAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe49d73abb8a1f5d, []int{9}
+}
+func (m *IncentivizedAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivizedAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivizedAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentivizedAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivizedAcknowledgement.Merge(m, src)
+}
+func (m *IncentivizedAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivizedAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivizedAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivizedAcknowledgement proto.InternalMessageInfo
+
+func (m *IncentivizedAcknowledgement) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *IncentivizedAcknowledgement) GetForwardRelayerAddress() string {
+	if m != nil {
+		return m.ForwardRelayerAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Fee)(nil), "ibc.fee.Fee")
+	proto.RegisterType((*PacketFee)(nil), "ibc.fee.PacketFee")
+	proto.RegisterType((*PacketFees)(nil), "ibc.fee.PacketFees")
+	proto.RegisterType((*PacketID)(nil), "ibc.fee.PacketID")
+	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.fee.IdentifiedPacketFees")
+	proto.RegisterType((*FeeEnabledChannel)(nil), "ibc.fee.FeeEnabledChannel")
+	proto.RegisterType((*RegisteredCounterpartyAddress)(nil), "ibc.fee.RegisteredCounterpartyAddress")
+	proto.RegisterType((*MsgPayPacketFee)(nil), "ibc.fee.MsgPayPacketFee")
+	proto.RegisterType((*MsgRegisterCounterpartyAddress)(nil), "ibc.fee.MsgRegisterCounterpartyAddress")
+	proto.RegisterType((*IncentivizedAcknowledgement)(nil), "ibc.fee.IncentivizedAcknowledgement")
+}
+
+func init() { proto.RegisterFile("ibc/fee/fee.proto", fileDescriptor_fe49d73abb8a1f5d) }
+
+var fileDescriptor_fe49d73abb8a1f5d = []byte{
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x93, 0x92, 0x34, 0xaf, 0xa5, 0x25, 0xb3, 0x5d, 0xa8, 0xba, 0x60, 0xaf, 0x46, 0x7b,
+	0x28, 0x12, 0x4d, 0xc4, 0x22, 0x2e, 0xdc, 0xea, 0x96, 0x48, 0x06, 0xad, 0xb6, 0x32, 0x9c, 0x16,
+	0xa1, 0xc8, 0x99, 0x79, 0xf1, 0x5a, 0x49, 0xec, 0xec, 0x8c, 0xbd, 0x4b, 0xb8, 0xc2, 0x15, 0x09,
+	0xfe, 0x01, 0x07, 0x4e, 0xfc, 0x07, 0xee, 0x7b, 0xe0, 0xb0, 0x17, 0x24, 0x4e, 0x06, 0xb9, 0xff,
+	0x20, 0x47, 0x4e, 0x68, 0x3c, 0x93, 0x34, 0x2e, 0x05, 0x91, 0x72, 0xe0, 0xb0, 0x87, 0x28, 0x7e,
+	0x7e, 0xdf, 0x7b, 0xdf, 0x7b, 0xdf, 0x7b, 0x33, 0x32, 0xb4, 0xa3, 0x01, 0xeb, 0x0e, 0x11, 0xd5,
+	0xaf, 0x33, 0x15, 0x49, 0x9a, 0x90, 0x66, 0x34, 0x60, 0x9d, 0x21, 0xe2, 0xe1, 0x7e, 0x98, 0x84,
+	0x49, 0xf9, 0xae, 0xab, 0x9e, 0xb4, 0xfb, 0xf0, 0x16, 0x4b, 0xe4, 0x24, 0x91, 0x5d, 0xfd, 0xa7,
+	0x5f, 0xd2, 0x5f, 0x6a, 0x50, 0xef, 0x21, 0x92, 0x31, 0x6c, 0x09, 0x64, 0x4f, 0xfb, 0x43, 0xc4,
+	0x03, 0xeb, 0x6e, 0xfd, 0x68, 0xfb, 0xfe, 0x4e, 0xc7, 0x00, 0x4f, 0x93, 0x28, 0x76, 0x4f, 0x9f,
+	0xe7, 0xce, 0xc6, 0x3c, 0x77, 0xf6, 0x66, 0xc1, 0x64, 0xfc, 0x01, 0x5d, 0x60, 0xe9, 0x8f, 0xbf,
+	0x39, 0x47, 0x61, 0x94, 0x3e, 0xce, 0x06, 0x1d, 0x96, 0x4c, 0xba, 0x15, 0x8a, 0x63, 0xc9, 0x47,
+	0xdd, 0x74, 0x36, 0x45, 0x9d, 0x43, 0xfa, 0x4d, 0x15, 0xa6, 0xd8, 0x22, 0x68, 0x06, 0x6c, 0x54,
+	0x92, 0xd5, 0xae, 0x21, 0x73, 0x0d, 0xd9, 0xae, 0x26, 0x33, 0xd0, 0xf5, 0xb8, 0x1a, 0x01, 0x1b,
+	0x29, 0xaa, 0x0c, 0xb6, 0xd3, 0x68, 0x82, 0x49, 0x96, 0x96, 0x74, 0xf5, 0x6b, 0xe8, 0x7a, 0x86,
+	0x8e, 0x68, 0xba, 0x15, 0xf8, 0x7a, 0x94, 0x60, 0x22, 0x7b, 0x88, 0xf4, 0x07, 0x0b, 0x5a, 0xe7,
+	0x01, 0x1b, 0xa1, 0xb2, 0xc8, 0x3d, 0xa8, 0x6b, 0x61, 0xad, 0x92, 0xdc, 0xcc, 0xa9, 0xd3, 0x43,
+	0x74, 0x37, 0x15, 0xb9, 0xaf, 0xdc, 0xe4, 0x09, 0xec, 0x0a, 0x1c, 0x66, 0x31, 0xef, 0x07, 0x9c,
+	0x0b, 0x94, 0xf2, 0xa0, 0x76, 0xd7, 0x3a, 0xda, 0x71, 0x3f, 0x9a, 0xe7, 0xce, 0xed, 0x85, 0xee,
+	0xab, 0x7e, 0xfa, 0x47, 0xee, 0x1c, 0xff, 0x8b, 0xf2, 0x4e, 0x18, 0x3b, 0xd1, 0x11, 0xfe, 0xab,
+	0x3a, 0x83, 0x31, 0xe9, 0xe7, 0x00, 0xcb, 0x2a, 0x25, 0x79, 0x08, 0xdb, 0xd3, 0xd2, 0x52, 0xbd,
+	0x4b, 0xb3, 0x07, 0x64, 0x59, 0xee, 0x12, 0xe9, 0x1e, 0x56, 0x15, 0x5b, 0x09, 0xa2, 0x3e, 0x4c,
+	0x97, 0x09, 0xe9, 0xf7, 0x16, 0x6c, 0xe9, 0x28, 0xef, 0x8c, 0xbc, 0x0f, 0xcd, 0x69, 0x22, 0xd2,
+	0x7e, 0xc4, 0x4b, 0x21, 0x5a, 0xee, 0x9b, 0x45, 0xee, 0x34, 0xce, 0x13, 0x91, 0x7a, 0x67, 0x97,
+	0xc3, 0x36, 0x10, 0xea, 0x37, 0xd4, 0x93, 0xc7, 0xc9, 0x09, 0x00, 0x7b, 0x1c, 0xc4, 0x31, 0x8e,
+	0x55, 0x64, 0xad, 0x8c, 0xa4, 0x45, 0xee, 0xb4, 0x4e, 0xf5, 0xdb, 0x32, 0xb8, 0xad, 0x83, 0x2f,
+	0x81, 0xd4, 0x6f, 0x19, 0xc3, 0xe3, 0xe4, 0x10, 0xb6, 0x24, 0x3e, 0xc9, 0x30, 0x66, 0x6a, 0x01,
+	0xac, 0xa3, 0x4d, 0x7f, 0x69, 0xd3, 0x9f, 0x2c, 0xd8, 0xf7, 0x38, 0xc6, 0x69, 0x34, 0x8c, 0x90,
+	0xaf, 0x88, 0xf1, 0x29, 0xb4, 0x4c, 0x5f, 0xa6, 0xe0, 0xed, 0xfb, 0xed, 0x2b, 0x52, 0x78, 0x67,
+	0xee, 0x3d, 0xa5, 0x44, 0x91, 0x3b, 0xcb, 0x36, 0xe7, 0xb9, 0xf3, 0x5a, 0x45, 0x15, 0x55, 0xcb,
+	0x96, 0x7e, 0xf6, 0xf8, 0x55, 0x89, 0x6b, 0xff, 0x59, 0xe2, 0x6f, 0x2c, 0x68, 0xf7, 0x10, 0x3f,
+	0x8c, 0x83, 0xc1, 0x18, 0xb9, 0xd1, 0xe4, 0xff, 0xd3, 0x9a, 0x7e, 0x55, 0x83, 0xb7, 0x7c, 0x0c,
+	0x23, 0x99, 0xa2, 0x40, 0x7e, 0x9a, 0x64, 0x71, 0x8a, 0x62, 0x1a, 0x88, 0x74, 0x66, 0x76, 0x8e,
+	0x7c, 0x0c, 0xcd, 0xc5, 0x7e, 0x5b, 0xe5, 0x7e, 0xbf, 0xbb, 0xfe, 0x1a, 0x2f, 0x32, 0x10, 0x1f,
+	0xf6, 0xd9, 0x0a, 0x47, 0xe5, 0xe4, 0xb4, 0x5c, 0x67, 0x9e, 0x3b, 0x77, 0x4c, 0xb9, 0xd7, 0xa0,
+	0xa8, 0x7f, 0x8b, 0x5d, 0x53, 0x60, 0x55, 0x85, 0xfa, 0x4d, 0x54, 0xf8, 0xb9, 0x06, 0x7b, 0x0f,
+	0x64, 0x78, 0x1e, 0xcc, 0xd6, 0xbd, 0x04, 0x1e, 0xc2, 0xae, 0x4c, 0x32, 0xc1, 0xb0, 0xbf, 0x18,
+	0xa0, 0x6e, 0xe5, 0xed, 0x22, 0x77, 0x76, 0x3e, 0x29, 0x3d, 0xcb, 0x31, 0x9a, 0x4b, 0xa1, 0x8a,
+	0xa7, 0xfe, 0x8e, 0xbc, 0x84, 0x71, 0xf2, 0x19, 0xb4, 0x0d, 0xe0, 0x2f, 0x4d, 0x75, 0x8b, 0xdc,
+	0xd9, 0xd3, 0x39, 0x57, 0x5b, 0x3b, 0xa8, 0xa4, 0x5d, 0xed, 0x70, 0x4f, 0x56, 0xc0, 0xd5, 0x93,
+	0xb5, 0x59, 0x3d, 0x59, 0xc4, 0x83, 0x86, 0x8c, 0xc2, 0x18, 0xc5, 0xc1, 0x2b, 0x37, 0x1d, 0xb3,
+	0x49, 0x40, 0xbf, 0xae, 0x81, 0xfd, 0x40, 0x86, 0x8b, 0xbd, 0x7a, 0x59, 0xb7, 0xea, 0x3b, 0x0b,
+	0xee, 0x78, 0x31, 0x53, 0x97, 0xd5, 0xd3, 0xe8, 0x4b, 0xe4, 0x27, 0x6c, 0x14, 0x27, 0xcf, 0xc6,
+	0xc8, 0x43, 0x9c, 0x60, 0x9c, 0x92, 0xd7, 0xa1, 0x21, 0x50, 0x66, 0xe3, 0x54, 0x4b, 0xe0, 0x1b,
+	0x8b, 0x3c, 0x82, 0x37, 0x86, 0x89, 0x78, 0x16, 0x08, 0xde, 0x17, 0x38, 0x0e, 0x66, 0x28, 0xae,
+	0x74, 0x44, 0xe7, 0xb9, 0x63, 0x6b, 0xea, 0xbf, 0x01, 0x52, 0xff, 0xb6, 0xf1, 0xf8, 0xda, 0x61,
+	0xda, 0x72, 0x7b, 0xcf, 0x0b, 0xdb, 0x7a, 0x51, 0xd8, 0xd6, 0xef, 0x85, 0x6d, 0x7d, 0x7b, 0x61,
+	0x6f, 0xbc, 0xb8, 0xb0, 0x37, 0x7e, 0xbd, 0xb0, 0x37, 0x1e, 0xbd, 0xf3, 0x8f, 0xe2, 0x7f, 0xd1,
+	0x8d, 0x06, 0xec, 0x58, 0x7d, 0xc0, 0x94, 0x63, 0x18, 0x34, 0xca, 0xef, 0x91, 0xf7, 0xfe, 0x1c,
+	0x00, 0x4d, 0xad, 0x21, 0x9c, 0xd8, 0x08, 0x00, 0x00,
+}
+
+func (m *Fee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimeoutFee) > 0 {
+		for iNdEx := len(m.TimeoutFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecvFee) > 0 {
+		for iNdEx := len(m.RecvFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortID) > 0 {
+		i -= len(m.PortID)
+		copy(dAtA[i:], m.PortID)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.PortID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedPacketFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedPacketFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.PacketID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeEnabledChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeEnabledChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeEnabledChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortID) > 0 {
+		i -= len(m.PortID)
+		copy(dAtA[i:], m.PortID)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.PortID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredCounterpartyAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredCounterpartyAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredCounterpartyAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyAddress) > 0 {
+		i -= len(m.CounterpartyAddress)
+		copy(dAtA[i:], m.CounterpartyAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.CounterpartyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayPacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayPacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SourceChannelID) > 0 {
+		i -= len(m.SourceChannelID)
+		copy(dAtA[i:], m.SourceChannelID)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.SourceChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourcePortID) > 0 {
+		i -= len(m.SourcePortID)
+		copy(dAtA[i:], m.SourcePortID)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.SourcePortID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCounterpartyAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCounterpartyAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCounterpartyAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyAddress) > 0 {
+		i -= len(m.CounterpartyAddress)
+		copy(dAtA[i:], m.CounterpartyAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.CounterpartyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivizedAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivizedAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivizedAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForwardRelayerAddress) > 0 {
+		i -= len(m.ForwardRelayerAddress)
+		copy(dAtA[i:], m.ForwardRelayerAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ForwardRelayerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for _, e := range m.TimeoutFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortID)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovFee(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *IdentifiedPacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketID.Size()
+	n += 1 + l + sovFee(uint64(l))
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEnabledChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortID)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredCounterpartyAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.CounterpartyAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPayPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	l = len(m.SourcePortID)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.SourceChannelID)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovFee(uint64(m.Sequence))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCounterpartyAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.CounterpartyAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func (m *IncentivizedAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ForwardRelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFee = append(m.RecvFee, types.Coin{})
+			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
+			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = append(m.RefundAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.RefundAddress == nil {
+				m.RefundAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEnabledChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredCounterpartyAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredCounterpartyAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredCounterpartyAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentivizedAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivizedAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivizedAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRelayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFee = fmt.Errorf("proto: unexpected end of group")
+)
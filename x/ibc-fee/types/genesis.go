@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// GenesisState defines the IBC fee middleware genesis state
+type GenesisState struct {
+	FeeEnabledChannels    []FeeEnabledChannel             `json:"fee_enabled_channels" yaml:"fee_enabled_channels"`
+	CounterpartyAddresses []RegisteredCounterpartyAddress `json:"counterparty_addresses" yaml:"counterparty_addresses"`
+	IdentifiedFees        []IdentifiedPacketFees          `json:"identified_fees" yaml:"identified_fees"`
+}
+
+// NewGenesisState creates a new GenesisState instance
+func NewGenesisState(
+	feeEnabledChannels []FeeEnabledChannel,
+	counterpartyAddresses []RegisteredCounterpartyAddress,
+	identifiedFees []IdentifiedPacketFees,
+) GenesisState {
+	return GenesisState{
+		FeeEnabledChannels:    feeEnabledChannels,
+		CounterpartyAddresses: counterpartyAddresses,
+		IdentifiedFees:        identifiedFees,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no fee enabled channels,
+// registered addresses nor escrowed fees.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(
+		[]FeeEnabledChannel{}, []RegisteredCounterpartyAddress{}, []IdentifiedPacketFees{},
+	)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, ch := range gs.FeeEnabledChannels {
+		if err := host.PortIdentifierValidator(ch.PortID); err != nil {
+			return sdkerrors.Wrap(err, "invalid fee enabled port ID")
+		}
+		if err := host.ChannelIdentifierValidator(ch.ChannelID); err != nil {
+			return sdkerrors.Wrap(err, "invalid fee enabled channel ID")
+		}
+	}
+
+	for _, addr := range gs.CounterpartyAddresses {
+		if err := NewMsgRegisterCounterpartyAddress(addr.Address, addr.CounterpartyAddress, addr.ChannelID).ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, fees := range gs.IdentifiedFees {
+		if err := fees.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetFeesInEscrowKey(fees.PacketID.PortID, fees.PacketID.ChannelID, fees.PacketID.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicate fees for packet %s/%s/%d", fees.PacketID.PortID, fees.PacketID.ChannelID, fees.PacketID.Sequence)
+		}
+		seenPackets[key] = true
+	}
+
+	return nil
+}
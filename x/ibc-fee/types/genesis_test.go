@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-fee/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	addr := sdk.AccAddress("testaddr1")
+	coins := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100)))
+	packetFees := []types.PacketFee{types.NewPacketFee(types.NewFee(coins, coins, coins), addr)}
+	packetID := types.NewPacketID("portidone", "channelidone", 1)
+
+	testCases := []struct {
+		name     string
+		genState types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState(
+				[]types.FeeEnabledChannel{types.NewFeeEnabledChannel("portidone", "channelidone")},
+				[]types.RegisteredCounterpartyAddress{types.NewRegisteredCounterpartyAddress(addr, "counterparty", "channelidone")},
+				[]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(packetID, packetFees)},
+			),
+			true,
+		},
+		{
+			"invalid fee enabled channel",
+			types.NewGenesisState(
+				[]types.FeeEnabledChannel{types.NewFeeEnabledChannel("portidone", "(INVALIDCHANNEL)")},
+				nil, nil,
+			),
+			false,
+		},
+		{
+			"invalid counterparty address",
+			types.NewGenesisState(
+				nil,
+				[]types.RegisteredCounterpartyAddress{types.NewRegisteredCounterpartyAddress(addr, "", "channelidone")},
+				nil,
+			),
+			false,
+		},
+		{
+			"empty packet fees",
+			types.NewGenesisState(
+				nil, nil, []types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(packetID, nil)},
+			),
+			false,
+		},
+		{
+			"duplicate packet fees",
+			types.NewGenesisState(
+				nil, nil,
+				[]types.IdentifiedPacketFees{
+					types.NewIdentifiedPacketFees(packetID, packetFees),
+					types.NewIdentifiedPacketFees(packetID, packetFees),
+				},
+			),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC fee middleware name
+	ModuleName = "feeibc"
+
+	// Version defines the current version of the fee middleware. It prefixes the
+	// version of the wrapped application on fee enabled channels.
+	Version = "ics29-1"
+
+	// VersionDelimiter separates the fee middleware version from the version of
+	// the wrapped application in the channel version.
+	VersionDelimiter = ":"
+
+	// StoreKey is the store key string for the IBC fee middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the IBC fee middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the IBC fee middleware
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes for the IBC fee middleware
+var (
+	FeeEnabledKeyPrefix          = []byte{0x01}
+	CounterpartyAddressKeyPrefix = []byte{0x02}
+	FeesInEscrowKeyPrefix        = []byte{0x03}
+)
+
+// GetFeeEnabledKey returns the key marking a channel as fee enabled
+func GetFeeEnabledKey(portID, channelID string) []byte {
+	return append(FeeEnabledKeyPrefix, []byte(fmt.Sprintf("%s/%s", portID, channelID))...)
+}
+
+// GetCounterpartyAddressKey returns the key storing the counterparty address of
+// a relayer on a channel
+func GetCounterpartyAddressKey(channelID string, address []byte) []byte {
+	key := append(CounterpartyAddressKeyPrefix, []byte(channelID+"/")...)
+	return append(key, address...)
+}
+
+// GetFeesInEscrowKey returns the key storing the fees escrowed for a packet
+func GetFeesInEscrowKey(portID, channelID string, sequence uint64) []byte {
+	key := append(FeesInEscrowKeyPrefix, []byte(fmt.Sprintf("%s/%s/", portID, channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ParseChannelKey parses the port and channel identifiers of a fee enabled
+// channel key stripped from its prefix
+func ParseChannelKey(key []byte) (string, string, error) {
+	ids := strings.Split(string(key), "/")
+	if len(ids) != 2 {
+		return "", "", fmt.Errorf("invalid fee enabled channel key %s", key)
+	}
+
+	return ids[0], ids[1], nil
+}
+
+// ParseCounterpartyAddressKey parses the channel identifier and the relayer
+// address of a counterparty address key stripped from its prefix
+func ParseCounterpartyAddressKey(key []byte) (string, []byte, error) {
+	idx := strings.Index(string(key), "/")
+	if idx < 0 {
+		return "", nil, fmt.Errorf("invalid counterparty address key %s", key)
+	}
+
+	return string(key[:idx]), key[idx+1:], nil
+}
+
+// ParseFeesInEscrowKey parses the packet identifier of a fees in escrow key
+// stripped from its prefix
+func ParseFeesInEscrowKey(key []byte) (PacketID, error) {
+	if len(key) < 8 {
+		return PacketID{}, fmt.Errorf("invalid fees in escrow key %s", key)
+	}
+
+	ids := strings.Split(string(key[:len(key)-8]), "/")
+	if len(ids) != 3 || ids[2] != "" {
+		return PacketID{}, fmt.Errorf("invalid fees in escrow key %s", key)
+	}
+
+	return NewPacketID(ids[0], ids[1], sdk.BigEndianToUint64(key[len(key)-8:])), nil
+}
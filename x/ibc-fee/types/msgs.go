@@ -0,0 +1,111 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// msg types
+const (
+	TypeMsgPayPacketFee                = "pay_packet_fee"
+	TypeMsgRegisterCounterpartyAddress = "register_counterparty_address"
+)
+
+var (
+	_ sdk.Msg = &MsgPayPacketFee{}
+	_ sdk.Msg = &MsgRegisterCounterpartyAddress{}
+)
+
+// NewMsgPayPacketFee creates a new MsgPayPacketFee instance
+func NewMsgPayPacketFee(
+	fee Fee, sourcePortID, sourceChannelID string, sequence uint64, signer sdk.AccAddress,
+) *MsgPayPacketFee {
+	return &MsgPayPacketFee{
+		Fee:             fee,
+		SourcePortID:    sourcePortID,
+		SourceChannelID: sourceChannelID,
+		Sequence:        sequence,
+		Signer:          signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgPayPacketFee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgPayPacketFee) Type() string {
+	return TypeMsgPayPacketFee
+}
+
+// ValidateBasic performs a basic check of the MsgPayPacketFee fields.
+func (msg MsgPayPacketFee) ValidateBasic() error {
+	if err := NewPacketID(msg.SourcePortID, msg.SourceChannelID, msg.Sequence).Validate(); err != nil {
+		return err
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing signer address")
+	}
+	return msg.Fee.Validate()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgPayPacketFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPayPacketFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// NewMsgRegisterCounterpartyAddress creates a new MsgRegisterCounterpartyAddress
+// instance
+func NewMsgRegisterCounterpartyAddress(
+	address sdk.AccAddress, counterpartyAddress, channelID string,
+) *MsgRegisterCounterpartyAddress {
+	return &MsgRegisterCounterpartyAddress{
+		Address:             address,
+		CounterpartyAddress: counterpartyAddress,
+		ChannelID:           channelID,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterCounterpartyAddress) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterCounterpartyAddress) Type() string {
+	return TypeMsgRegisterCounterpartyAddress
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterCounterpartyAddress
+// fields.
+func (msg MsgRegisterCounterpartyAddress) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing relayer address")
+	}
+	if strings.TrimSpace(msg.CounterpartyAddress) == "" {
+		return sdkerrors.Wrap(ErrInvalidCounterpartyAddress, "counterparty address cannot be blank")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRegisterCounterpartyAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterCounterpartyAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
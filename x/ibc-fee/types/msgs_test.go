@@ -0,0 +1,135 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// define constants used for testing
+const (
+	validPort      = "testportid"
+	invalidPort    = "(invalidport1)"
+	validChannel   = "testchannel"
+	invalidChannel = "(invalidchannel1)"
+)
+
+var (
+	addr1     = sdk.AccAddress("testaddr1")
+	addr2     = sdk.AccAddress("testaddr2").String()
+	emptyAddr sdk.AccAddress
+
+	coins, _      = sdk.ParseCoins("100atom")
+	negativeCoins = sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-100)}}
+	validFee      = NewFee(coins, coins, coins)
+)
+
+// TestMsgPayPacketFeeValidation tests ValidateBasic for MsgPayPacketFee
+func TestMsgPayPacketFeeValidation(t *testing.T) {
+	testMsgs := []*MsgPayPacketFee{
+		NewMsgPayPacketFee(validFee, validPort, validChannel, 1, addr1),                          // valid msg
+		NewMsgPayPacketFee(NewFee(coins, nil, nil), validPort, validChannel, 1, addr1),           // only receive fee
+		NewMsgPayPacketFee(validFee, invalidPort, validChannel, 1, addr1),                        // invalid port id
+		NewMsgPayPacketFee(validFee, validPort, invalidChannel, 1, addr1),                        // invalid channel id
+		NewMsgPayPacketFee(validFee, validPort, validChannel, 0, addr1),                          // zero sequence
+		NewMsgPayPacketFee(NewFee(nil, nil, nil), validPort, validChannel, 1, addr1),             // empty fee
+		NewMsgPayPacketFee(NewFee(coins, negativeCoins, nil), validPort, validChannel, 1, addr1), // negative fee
+		NewMsgPayPacketFee(validFee, validPort, validChannel, 1, emptyAddr),                      // missing signer
+	}
+
+	testCases := []struct {
+		msg     *MsgPayPacketFee
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], true, "only receive fee"},
+		{testMsgs[2], false, "invalid port id"},
+		{testMsgs[3], false, "invalid channel id"},
+		{testMsgs[4], false, "zero sequence"},
+		{testMsgs[5], false, "empty fee"},
+		{testMsgs[6], false, "negative fee"},
+		{testMsgs[7], false, "missing signer"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.errMsg)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgRegisterCounterpartyAddressValidation tests ValidateBasic for
+// MsgRegisterCounterpartyAddress
+func TestMsgRegisterCounterpartyAddressValidation(t *testing.T) {
+	testMsgs := []*MsgRegisterCounterpartyAddress{
+		NewMsgRegisterCounterpartyAddress(addr1, addr2, validChannel),     // valid msg
+		NewMsgRegisterCounterpartyAddress(emptyAddr, addr2, validChannel), // missing address
+		NewMsgRegisterCounterpartyAddress(addr1, " ", validChannel),       // blank counterparty address
+		NewMsgRegisterCounterpartyAddress(addr1, addr2, invalidChannel),   // invalid channel id
+	}
+
+	testCases := []struct {
+		msg     *MsgRegisterCounterpartyAddress
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "missing address"},
+		{testMsgs[2], false, "blank counterparty address"},
+		{testMsgs[3], false, "invalid channel id"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.errMsg)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+func TestSplitChannelVersion(t *testing.T) {
+	testCases := []struct {
+		version       string
+		expFeeVersion string
+		expAppVersion string
+	}{
+		{MergeChannelVersions(Version, "ics20-1"), Version, "ics20-1"},
+		{"ics20-1", "", "ics20-1"},
+		{Version, "", Version},
+		{"ics29-2:ics20-1", "", "ics29-2:ics20-1"},
+		{MergeChannelVersions(Version, ""), Version, ""},
+	}
+
+	for _, tc := range testCases {
+		feeVersion, appVersion := SplitChannelVersion(tc.version)
+		require.Equal(t, tc.expFeeVersion, feeVersion, tc.version)
+		require.Equal(t, tc.expAppVersion, appVersion, tc.version)
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	key := GetFeesInEscrowKey(validPort, validChannel, 5)
+	packetID, err := ParseFeesInEscrowKey(key[len(FeesInEscrowKeyPrefix):])
+	require.NoError(t, err)
+	require.Equal(t, NewPacketID(validPort, validChannel, 5), packetID)
+
+	key = GetFeeEnabledKey(validPort, validChannel)
+	portID, channelID, err := ParseChannelKey(key[len(FeeEnabledKeyPrefix):])
+	require.NoError(t, err)
+	require.Equal(t, validPort, portID)
+	require.Equal(t, validChannel, channelID)
+
+	key = GetCounterpartyAddressKey(validChannel, addr1)
+	channelID, address, err := ParseCounterpartyAddressKey(key[len(CounterpartyAddressKeyPrefix):])
+	require.NoError(t, err)
+	require.Equal(t, validChannel, channelID)
+	require.Equal(t, []byte(addr1), address)
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"strings"
+)
+
+// MergeChannelVersions prefixes the version of the wrapped application with the
+// fee middleware version.
+func MergeChannelVersions(feeVersion, appVersion string) string {
+	return feeVersion + VersionDelimiter + appVersion
+}
+
+// SplitChannelVersion splits a channel version into the fee middleware version
+// and the version of the wrapped application. The returned fee version is empty
+// if the channel version is not prefixed with the fee middleware version, in
+// which case the channel version is returned as the application version.
+func SplitChannelVersion(version string) (feeVersion, appVersion string) {
+	if !strings.HasPrefix(version, Version+VersionDelimiter) {
+		return "", version
+	}
+
+	return Version, strings.TrimPrefix(version, Version+VersionDelimiter)
+}
@@ -58,20 +58,24 @@ type IBCModule interface {
 		channelID string,
 	) error
 
-	// OnRecvPacket must return the acknowledgement bytes
+	// OnRecvPacket must return the acknowledgement bytes. The relayer is the
+	// signer of the message relaying the packet.
 	OnRecvPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, []byte, error)
 
 	OnAcknowledgementPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
 		acknowledgement []byte,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, error)
 
 	OnTimeoutPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, error)
 }
@@ -172,7 +172,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			}
 
 			// Perform application logic callback
-			res, ack, err := cbs.OnRecvPacket(ctx, msg.Packet)
+			res, ack, err := cbs.OnRecvPacket(ctx, msg.Packet, msg.Signer)
 			if err != nil {
 				return nil, err
 			}
@@ -198,7 +198,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			}
 
 			// Perform application logic callback
-			res, err := cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement)
+			res, err := cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, msg.Signer)
 			if err != nil {
 				return nil, err
 			}
@@ -224,7 +224,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			}
 
 			// Perform application logic callback
-			res, err := cbs.OnTimeoutPacket(ctx, msg.Packet)
+			res, err := cbs.OnTimeoutPacket(ctx, msg.Packet, msg.Signer)
 			if err != nil {
 				return nil, err
 			}
@@ -18,8 +18,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	minter = k.NextMinter(ctx, minter, params, bondedRatio, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
 	// mint coins, update supply
@@ -0,0 +1,44 @@
+package mint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestBeginBlockerInflationCalculator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(1000))
+
+	rate := sdk.NewDecWithPrec(42, 2)
+	mintKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper,
+		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, types.FixedInflationCalculationFn(rate),
+	)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	params := mintKeeper.GetParams(ctx)
+	totalSupply := mintKeeper.StakingTokenSupply(ctx)
+	require.True(t, totalSupply.IsPositive())
+
+	mint.BeginBlocker(ctx, mintKeeper)
+
+	// the block is minted with the inflation of the keeper calculator
+	minter := mintKeeper.GetMinter(ctx)
+	require.Equal(t, rate, minter.Inflation)
+	require.Equal(t, rate.MulInt(totalSupply), minter.AnnualProvisions)
+
+	provision := minter.BlockProvision(params)
+	require.True(t, provision.IsPositive())
+	require.Equal(t, provision, app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom))
+	require.Equal(t, totalSupply.Add(provision.Amount), mintKeeper.StakingTokenSupply(ctx))
+}
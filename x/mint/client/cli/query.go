@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -9,9 +10,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
+const flagBlocks = "blocks"
+
 // GetQueryCmd returns the cli query commands for the minting module.
 func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	mintingQueryCmd := &cobra.Command{
@@ -27,6 +31,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryParams(cdc),
 			GetCmdQueryInflation(cdc),
 			GetCmdQueryAnnualProvisions(cdc),
+			GetCmdQueryProjectedProvisions(cdc),
 		)...,
 	)
 
@@ -110,3 +115,53 @@ func GetCmdQueryAnnualProvisions(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryProjectedProvisions implements a command to return the provisions
+// projected by the active inflation calculator over a number of blocks.
+func GetCmdQueryProjectedProvisions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-provisions",
+		Short: "Query the provisions projected over a number of upcoming blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the provisions the active inflation calculator would mint over a number
+of upcoming blocks, assuming the bonded ratio remains unchanged.
+
+Example:
+$ %s query %s projected-provisions --blocks=100
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			blocks, err := cmd.Flags().GetUint64(flagBlocks)
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryProjectedProvisionsParams(blocks))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProjectedProvisions)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var projected types.ProjectedProvisions
+			if err := cdc.UnmarshalJSON(res, &projected); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(projected)
+		},
+	}
+
+	cmd.Flags().Uint64(flagBlocks, 1, "Number of upcoming blocks to project")
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -26,6 +27,11 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 		"/minting/annual-provisions",
 		queryAnnualProvisionsHandlerFn(clientCtx),
 	).Methods("GET")
+
+	r.HandleFunc(
+		"/minting/projected-provisions",
+		queryProjectedProvisionsHandlerFn(clientCtx),
+	).Methods("GET")
 }
 
 func queryParamsHandlerFn(clientCtx client.Context) http.HandlerFunc {
@@ -84,3 +90,38 @@ func queryAnnualProvisionsHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, clientCtx, res)
 	}
 }
+
+func queryProjectedProvisionsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		blocks := uint64(1)
+
+		if v := r.URL.Query().Get("blocks"); v != "" {
+			n, err := strconv.ParseUint(v, 10, 64)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			blocks = n
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := clientCtx.JSONMarshaler.MarshalJSON(types.NewQueryProjectedProvisionsParams(blocks))
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProjectedProvisions)
+
+		res, height, err := clientCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
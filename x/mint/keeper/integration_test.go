@@ -5,6 +5,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -18,3 +20,12 @@ func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 
 	return app, ctx
 }
+
+// returns a mint keeper sharing the store of the app mint keeper, using the
+// given inflation calculator
+func newMintKeeper(app *simapp.SimApp, inflationCalculator types.InflationCalculationFn) keeper.Keeper {
+	return keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper,
+		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, inflationCalculator,
+	)
+}
@@ -19,13 +19,17 @@ type Keeper struct {
 	stakingKeeper    types.StakingKeeper
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
+
+	inflationCalculator types.InflationCalculationFn
 }
 
-// NewKeeper creates a new mint Keeper instance
+// NewKeeper creates a new mint Keeper instance. The inflation calculator
+// computes the annual inflation rate of each block, a nil calculator uses the
+// default bonded ratio feedback model.
 func NewKeeper(
 	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string,
+	feeCollectorName string, inflationCalculator types.InflationCalculationFn,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -37,6 +41,10 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	if inflationCalculator == nil {
+		inflationCalculator = types.DefaultInflationCalculationFn
+	}
+
 	return Keeper{
 		cdc:              cdc,
 		storeKey:         key,
@@ -44,6 +52,8 @@ func NewKeeper(
 		stakingKeeper:    sk,
 		bankKeeper:       bk,
 		feeCollectorName: feeCollectorName,
+
+		inflationCalculator: inflationCalculator,
 	}
 }
 
@@ -88,6 +98,54 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 //______________________________________________________________________
 
+// NextMinter returns the minter for the block of the given context, using the
+// configured inflation calculator to recompute the inflation rate and annual
+// provisions.
+func (k Keeper) NextMinter(
+	ctx sdk.Context, minter types.Minter, params types.Params, bondedRatio sdk.Dec, totalSupply sdk.Int,
+) types.Minter {
+	minter.Inflation = k.inflationCalculator(ctx, minter, params, bondedRatio, totalSupply)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalSupply)
+	return minter
+}
+
+// ProjectProvisions projects the provisions minted over the given number of
+// blocks following the current one, assuming the bonded ratio stays constant
+// and all provisions are added to the staking token supply.
+func (k Keeper) ProjectProvisions(ctx sdk.Context, blocks uint64) types.ProjectedProvisions {
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+	totalSupply := k.StakingTokenSupply(ctx)
+	bondedRatio := k.BondedRatio(ctx)
+
+	projected := types.ProjectedProvisions{
+		Height:           ctx.BlockHeight() + 1,
+		Blocks:           blocks,
+		Inflation:        minter.Inflation,
+		AnnualProvisions: minter.AnnualProvisions,
+		BlockProvision:   sdk.NewCoin(params.MintDenom, sdk.ZeroInt()),
+		TotalProvisions:  sdk.NewCoin(params.MintDenom, sdk.ZeroInt()),
+	}
+
+	for i := uint64(0); i < blocks; i++ {
+		minter = k.NextMinter(ctx.WithBlockHeight(projected.Height+int64(i)), minter, params, bondedRatio, totalSupply)
+		provision := minter.BlockProvision(params)
+
+		if i == 0 {
+			projected.Inflation = minter.Inflation
+			projected.AnnualProvisions = minter.AnnualProvisions
+			projected.BlockProvision = provision
+		}
+
+		projected.TotalProvisions = projected.TotalProvisions.Add(provision)
+		totalSupply = totalSupply.Add(provision.Amount)
+	}
+
+	return projected
+}
+
+//______________________________________________________________________
+
 // StakingTokenSupply implements an alias call to the underlying staking keeper's
 // StakingTokenSupply to be used in BeginBlocker.
 func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
@@ -11,7 +11,7 @@ import (
 
 // NewQuerier returns a minting Querier handler.
 func NewQuerier(k Keeper) sdk.Querier {
-	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		switch path[0] {
 		case types.QueryParameters:
 			return queryParams(ctx, k)
@@ -22,6 +22,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryAnnualProvisions:
 			return queryAnnualProvisions(ctx, k)
 
+		case types.QueryProjectedProvisions:
+			return queryProjectedProvisions(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -60,3 +63,26 @@ func queryAnnualProvisions(ctx sdk.Context, k Keeper) ([]byte, error) {
 
 	return res, nil
 }
+
+func queryProjectedProvisions(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	params := types.NewQueryProjectedProvisionsParams(1)
+
+	if len(req.Data) != 0 {
+		if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	if params.Blocks == 0 || params.Blocks > types.MaxProjectedBlocks {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "number of blocks must be between 1 and %d", types.MaxProjectedBlocks,
+		)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.ProjectProvisions(ctx, params.Blocks))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
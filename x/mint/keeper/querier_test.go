@@ -78,3 +78,41 @@ func TestQueryAnnualProvisions(t *testing.T) {
 
 	require.Equal(t, app.MintKeeper.GetMinter(ctx).AnnualProvisions, annualProvisions)
 }
+
+func TestQueryProjectedProvisions(t *testing.T) {
+	app, ctx := createTestApp(false)
+	params := app.MintKeeper.GetParams(ctx)
+	mintKeeper := newMintKeeper(app, types.FixedInflationCalculationFn(sdk.NewDecWithPrec(10, 2)))
+	querier := keep.NewQuerier(mintKeeper)
+
+	bz, err := app.Codec().MarshalJSON(types.NewQueryProjectedProvisionsParams(10))
+	require.NoError(t, err)
+
+	res, sdkErr := querier(ctx, []string{types.QueryProjectedProvisions}, abci.RequestQuery{Data: bz})
+	require.NoError(t, sdkErr)
+
+	var projected types.ProjectedProvisions
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &projected))
+
+	totalSupply := app.MintKeeper.StakingTokenSupply(ctx)
+	annualProvisions := sdk.NewDecWithPrec(10, 2).MulInt(totalSupply)
+
+	require.Equal(t, ctx.BlockHeight()+1, projected.Height)
+	require.Equal(t, uint64(10), projected.Blocks)
+	require.Equal(t, sdk.NewDecWithPrec(10, 2), projected.Inflation)
+	require.Equal(t, annualProvisions, projected.AnnualProvisions)
+	require.Equal(t, params.MintDenom, projected.BlockProvision.Denom)
+	require.True(t, projected.TotalProvisions.Amount.GTE(projected.BlockProvision.Amount.MulRaw(10)))
+
+	// the projection does not alter the stored minter
+	require.Equal(t, types.DefaultInitialMinter(), app.MintKeeper.GetMinter(ctx))
+
+	// the number of blocks is bounded
+	for _, blocks := range []uint64{0, types.MaxProjectedBlocks + 1} {
+		bz, err := app.Codec().MarshalJSON(types.NewQueryProjectedProvisionsParams(blocks))
+		require.NoError(t, err)
+
+		_, err = querier(ctx, []string{types.QueryProjectedProvisions}, abci.RequestQuery{Data: bz})
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InflationCalculationFn defines the function signature used to compute the
+// annual inflation rate for the block being processed. It receives the
+// current minter, the minting parameters, the staking token bonded ratio and
+// the staking token total supply. Applications may provide their own
+// implementation to the mint keeper through NewKeeper.
+type InflationCalculationFn func(
+	ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec, totalSupply sdk.Int,
+) sdk.Dec
+
+// DefaultInflationCalculationFn is the default inflation calculator. It
+// adjusts the inflation rate towards the bonded ratio goal as described by
+// Minter.NextInflationRate.
+func DefaultInflationCalculationFn(
+	_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec, _ sdk.Int,
+) sdk.Dec {
+	return minter.NextInflationRate(params, bondedRatio)
+}
+
+// FixedInflationCalculationFn returns an inflation calculator which always
+// yields the given annual inflation rate.
+func FixedInflationCalculationFn(rate sdk.Dec) InflationCalculationFn {
+	if rate.IsNegative() {
+		panic("inflation rate cannot be negative")
+	}
+
+	return func(_ sdk.Context, _ Minter, _ Params, _ sdk.Dec, _ sdk.Int) sdk.Dec {
+		return rate
+	}
+}
+
+// HalvingInflationCalculationFn returns an inflation calculator which starts
+// at the given annual inflation rate and halves it every halvingBlocks blocks.
+func HalvingInflationCalculationFn(initialRate sdk.Dec, halvingBlocks int64) InflationCalculationFn {
+	if initialRate.IsNegative() {
+		panic("inflation rate cannot be negative")
+	}
+	if halvingBlocks <= 0 {
+		panic("halving interval must be positive")
+	}
+
+	return func(ctx sdk.Context, _ Minter, _ Params, _ sdk.Dec, _ sdk.Int) sdk.Dec {
+		halvings := ctx.BlockHeight() / halvingBlocks
+
+		// after enough halvings the rate is below the decimal precision
+		if halvings >= 64 {
+			return sdk.ZeroDec()
+		}
+
+		return initialRate.Quo(sdk.NewDec(2).Power(uint64(halvings)))
+	}
+}
+
+// MaxSupplyInflationCalculationFn wraps an inflation calculator such that the
+// provisions minted in a single block never take the total supply beyond
+// maxSupply. Once the maximum supply is reached the inflation rate is zero.
+func MaxSupplyInflationCalculationFn(maxSupply sdk.Int, fn InflationCalculationFn) InflationCalculationFn {
+	if maxSupply.IsNegative() {
+		panic("max supply cannot be negative")
+	}
+
+	return func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec, totalSupply sdk.Int) sdk.Dec {
+		inflation := fn(ctx, minter, params, bondedRatio, totalSupply)
+
+		remaining := maxSupply.Sub(totalSupply)
+		if !remaining.IsPositive() {
+			return sdk.ZeroDec()
+		}
+		if !totalSupply.IsPositive() {
+			return inflation
+		}
+
+		// the block provision is inflation * totalSupply / blocksPerYear
+		maxInflation := remaining.MulRaw(int64(params.BlocksPerYear)).ToDec().QuoTruncate(totalSupply.ToDec())
+		if inflation.GT(maxInflation) {
+			return maxInflation
+		}
+
+		return inflation
+	}
+}
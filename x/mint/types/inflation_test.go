@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDefaultInflationCalculationFn(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+
+	inflation := DefaultInflationCalculationFn(sdk.Context{}, minter, params, bondedRatio, sdk.NewInt(1000))
+	require.Equal(t, minter.NextInflationRate(params, bondedRatio), inflation)
+}
+
+func TestFixedInflationCalculationFn(t *testing.T) {
+	rate := sdk.NewDecWithPrec(5, 2)
+	fn := FixedInflationCalculationFn(rate)
+
+	for _, bondedRatio := range []sdk.Dec{sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), sdk.OneDec()} {
+		inflation := fn(sdk.Context{}, DefaultInitialMinter(), DefaultParams(), bondedRatio, sdk.NewInt(1000))
+		require.Equal(t, rate, inflation)
+	}
+
+	require.Panics(t, func() { FixedInflationCalculationFn(sdk.NewDec(-1)) })
+}
+
+func TestHalvingInflationCalculationFn(t *testing.T) {
+	fn := HalvingInflationCalculationFn(sdk.NewDecWithPrec(16, 2), 100)
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+
+	tests := []struct {
+		height       int64
+		expInflation sdk.Dec
+	}{
+		{0, sdk.NewDecWithPrec(16, 2)},
+		{99, sdk.NewDecWithPrec(16, 2)},
+		{100, sdk.NewDecWithPrec(8, 2)},
+		{250, sdk.NewDecWithPrec(4, 2)},
+		{399, sdk.NewDecWithPrec(2, 2)},
+		{6400, sdk.ZeroDec()},
+	}
+	for i, tc := range tests {
+		inflation := fn(ctx.WithBlockHeight(tc.height), DefaultInitialMinter(), DefaultParams(), sdk.ZeroDec(), sdk.NewInt(1000))
+		require.True(t, tc.expInflation.Equal(inflation), "test %d: expected %s, got %s", i, tc.expInflation, inflation)
+	}
+
+	require.Panics(t, func() { HalvingInflationCalculationFn(sdk.OneDec(), 0) })
+}
+
+func TestMaxSupplyInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 100
+	fn := MaxSupplyInflationCalculationFn(sdk.NewInt(1010), FixedInflationCalculationFn(sdk.NewDecWithPrec(10, 2)))
+
+	tests := []struct {
+		totalSupply  sdk.Int
+		expInflation sdk.Dec
+	}{
+		// far from the cap the wrapped rate applies
+		{sdk.NewInt(100), sdk.NewDecWithPrec(10, 2)},
+		// 1000 * 10% / 100 = 1 per block, 10 remaining
+		{sdk.NewInt(1000), sdk.NewDecWithPrec(10, 2)},
+		// 1009 * 10% / 100 > 1 remaining
+		{sdk.NewInt(1009), sdk.NewDec(100).QuoTruncate(sdk.NewDec(1009))},
+		// cap reached
+		{sdk.NewInt(1010), sdk.ZeroDec()},
+		{sdk.NewInt(2000), sdk.ZeroDec()},
+	}
+	for i, tc := range tests {
+		minter := DefaultInitialMinter()
+		minter.Inflation = fn(sdk.Context{}, minter, params, sdk.ZeroDec(), tc.totalSupply)
+		require.True(t, tc.expInflation.Equal(minter.Inflation), "test %d: expected %s, got %s", i, tc.expInflation, minter.Inflation)
+
+		// the minted provision never exceeds the remaining supply
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, tc.totalSupply)
+		provision := minter.BlockProvision(params)
+		require.True(t, tc.totalSupply.Add(provision.Amount).LTE(sdk.MaxInt(tc.totalSupply, sdk.NewInt(1010))), "test %d", i)
+	}
+}
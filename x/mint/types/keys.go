@@ -14,7 +14,8 @@ const (
 	QuerierRoute = StoreKey
 
 	// Query endpoints supported by the minting querier
-	QueryParameters       = "parameters"
-	QueryInflation        = "inflation"
-	QueryAnnualProvisions = "annual_provisions"
+	QueryParameters          = "parameters"
+	QueryInflation           = "inflation"
+	QueryAnnualProvisions    = "annual_provisions"
+	QueryProjectedProvisions = "projected_provisions"
 )
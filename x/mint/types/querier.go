@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxProjectedBlocks is the maximum number of blocks that can be projected by
+// a single projected provisions query.
+const MaxProjectedBlocks = 100000
+
+// QueryProjectedProvisionsParams defines the params for the following queries:
+//
+// - 'custom/mint/projected_provisions'
+type QueryProjectedProvisionsParams struct {
+	Blocks uint64 `json:"blocks" yaml:"blocks"`
+}
+
+// NewQueryProjectedProvisionsParams creates a new instance to query the
+// provisions of the given number of upcoming blocks.
+func NewQueryProjectedProvisionsParams(blocks uint64) QueryProjectedProvisionsParams {
+	return QueryProjectedProvisionsParams{blocks}
+}
+
+// ProjectedProvisions defines the provisions the active inflation calculator
+// would mint over a number of upcoming blocks, assuming the bonded ratio
+// remains unchanged.
+type ProjectedProvisions struct {
+	// height of the first projected block
+	Height int64 `json:"height" yaml:"height"`
+	// number of projected blocks
+	Blocks uint64 `json:"blocks" yaml:"blocks"`
+	// inflation rate of the first projected block
+	Inflation sdk.Dec `json:"inflation" yaml:"inflation"`
+	// annual provisions of the first projected block
+	AnnualProvisions sdk.Dec `json:"annual_provisions" yaml:"annual_provisions"`
+	// provision minted in the first projected block
+	BlockProvision sdk.Coin `json:"block_provision" yaml:"block_provision"`
+	// sum of the provisions minted over all projected blocks
+	TotalProvisions sdk.Coin `json:"total_provisions" yaml:"total_provisions"`
+}
+
+// String implements the Stringer interface.
+func (pp ProjectedProvisions) String() string {
+	return fmt.Sprintf(`Projected Provisions:
+  Height:            %d
+  Blocks:            %d
+  Inflation:         %s
+  Annual Provisions: %s
+  Block Provision:   %s
+  Total Provisions:  %s
+`,
+		pp.Height, pp.Blocks, pp.Inflation, pp.AnnualProvisions, pp.BlockProvision, pp.TotalProvisions,
+	)
+}
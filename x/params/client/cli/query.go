@@ -1,14 +1,21 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 // NewQueryCmd returns a root CLI command handler for all x/params query commands.
@@ -21,7 +28,14 @@ func NewQueryCmd(m codec.JSONMarshaler) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(NewQuerySubspaceParamsCmd(m))
+	cmd.AddCommand(flags.GetCommands(
+		NewQuerySubspaceParamsCmd(m),
+		NewQueryAllSubspaceParamsCmd(m),
+		NewQueryDryRunCmd(m),
+		NewGenerateParamChangeProposalCmd(m),
+		NewQueryPendingChangesCmd(m),
+		NewQueryChangeLogCmd(m),
+	)...)
 
 	return cmd
 }
@@ -58,5 +72,293 @@ func NewQuerySubspaceParamsCmd(m codec.JSONMarshaler) *cobra.Command {
 		},
 	}
 
-	return flags.GetCommands(cmd)[0]
+	return cmd
+}
+
+// NewQueryAllSubspaceParamsCmd returns a CLI command handler for querying all
+// the raw parameters registered in a subspace.
+func NewQueryAllSubspaceParamsCmd(m codec.JSONMarshaler) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subspace-all [subspace]",
+		Short: "Query for all the raw parameters registered in a subspace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithJSONMarshaler(m)
+
+			resp, err := queryAllSubspaceParams(clientCtx, m, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+
+	return cmd
+}
+
+// NewQueryDryRunCmd returns a CLI command handler for dry-running the changes of
+// a parameter change proposal against the current state.
+func NewQueryDryRunCmd(m codec.JSONMarshaler) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [proposal-file]",
+		Short: "Dry-run the changes of a parameter change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Validate the changes of a parameter change proposal and apply them on top of
+the current state without persisting them. The resulting parameters of every
+affected subspace are returned.
+
+The proposal file uses the same format as for submitting a parameter change
+proposal.
+
+Example:
+$ %s query params dry-run <path/to/proposal.json>
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithJSONMarshaler(m)
+
+			prop, err := paramscutils.ParseParamChangeProposalJSON(m, args[0])
+			if err != nil {
+				return err
+			}
+
+			params := proposal.NewQueryDryRunParams(prop.Changes.ToParamChanges())
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDryRun)
+
+			bz, err := m.MarshalJSON(params)
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			bz, _, err = clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp []types.SubspaceParamsResponse
+			if err := m.UnmarshalJSON(bz, &resp); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+
+	return cmd
+}
+
+// NewGenerateParamChangeProposalCmd returns a CLI command handler for
+// generating a parameter change proposal file from the current parameters of a
+// subspace.
+func NewGenerateParamChangeProposalCmd(m codec.JSONMarshaler) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-proposal [subspace] [key...]",
+		Short: "Generate a parameter change proposal from the current parameters of a subspace",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate a parameter change proposal file holding the current values of the
+given parameter keys of a subspace, or of all its parameters if no key is given.
+The values can then be edited and the file submitted as a parameter change
+proposal.
+
+Example:
+$ %s query params generate-proposal staking MaxValidators --title="Staking Param Change" --deposit=1000stake > proposal.json
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithJSONMarshaler(m)
+
+			params, err := queryAllSubspaceParams(clientCtx, m, args[0])
+			if err != nil {
+				return err
+			}
+
+			changes, err := selectParamChanges(params, args[1:])
+			if err != nil {
+				return err
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+			deposit, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+
+			bz, err := m.MarshalJSON(paramscutils.ParamChangeProposalJSON{
+				Title:       title,
+				Description: description,
+				Changes:     changes,
+				Deposit:     deposit,
+			})
+			if err != nil {
+				return err
+			}
+
+			var out bytes.Buffer
+			if err := json.Indent(&out, bz, "", "  "); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), out.String())
+			return err
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+// NewQueryPendingChangesCmd returns a CLI command handler for querying the
+// parameter changes scheduled for a future height.
+func NewQueryPendingChangesCmd(m codec.JSONMarshaler) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-changes",
+		Short: "Query the parameter changes scheduled for a future height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithJSONMarshaler(m)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingChanges)
+			bz, _, err := clientCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var resp []types.PendingParamChange
+			if err := m.UnmarshalJSON(bz, &resp); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+
+	return cmd
+}
+
+// NewQueryChangeLogCmd returns a CLI command handler for querying the parameter
+// change log.
+func NewQueryChangeLogCmd(m codec.JSONMarshaler) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-log [subspace] [key]",
+		Short: "Query the log of applied parameter changes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the log of the parameter changes applied by governance proposals,
+optionally restricted to a subspace or to a single parameter of a subspace.
+
+Example:
+$ %s query params change-log staking MaxValidators
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithJSONMarshaler(m)
+
+			var subspace, key string
+			if len(args) > 0 {
+				subspace = args[0]
+			}
+			if len(args) > 1 {
+				key = args[1]
+			}
+
+			page, _ := cmd.Flags().GetInt(flags.FlagPage)
+			limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
+
+			params := types.NewQueryChangeLogParams(subspace, key, page, limit)
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryChangeLog)
+
+			bz, err := m.MarshalJSON(params)
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			bz, _, err = clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp []types.ParamChangeRecord
+			if err := m.UnmarshalJSON(bz, &resp); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of change records to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of change records to query for")
+
+	return cmd
+}
+
+func queryAllSubspaceParams(clientCtx client.Context, m codec.JSONMarshaler, subspace string) ([]types.SubspaceParamsResponse, error) {
+	params := types.NewQueryAllSubspaceParams(subspace)
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllParams)
+
+	bz, err := m.MarshalJSON(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	bz, _, err = clientCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []types.SubspaceParamsResponse
+	if err := m.UnmarshalJSON(bz, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// selectParamChanges converts the given subspace parameters to parameter
+// changes. If keys are provided, only the matching parameters are kept, in the
+// order of the keys.
+func selectParamChanges(params []types.SubspaceParamsResponse, keys []string) (paramscutils.ParamChangesJSON, error) {
+	changes := make(paramscutils.ParamChangesJSON, 0, len(params))
+	if len(keys) == 0 {
+		for _, p := range params {
+			if len(p.Value) == 0 {
+				continue
+			}
+			changes = append(changes, paramscutils.NewParamChangeJSON(p.Subspace, p.Key, json.RawMessage(p.Value)))
+		}
+
+		return changes, nil
+	}
+
+	for _, key := range keys {
+		found := false
+		for _, p := range params {
+			if p.Key != key {
+				continue
+			}
+			if len(p.Value) == 0 {
+				return nil, fmt.Errorf("parameter %s of subspace %s is not set", p.Key, p.Subspace)
+			}
+
+			changes = append(changes, paramscutils.NewParamChangeJSON(p.Subspace, p.Key, json.RawMessage(p.Value)))
+			found = true
+			break
+		}
+
+		if !found {
+			return nil, fmt.Errorf("parameter %s is not registered", key)
+		}
+	}
+
+	return changes, nil
 }
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/params/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestSelectParamChanges(t *testing.T) {
+	params := []types.SubspaceParamsResponse{
+		types.NewSubspaceParamsResponse("staking", "BondDenom", `"stake"`),
+		types.NewSubspaceParamsResponse("staking", "MaxValidators", `100`),
+		types.NewSubspaceParamsResponse("staking", "Unset", ``),
+	}
+
+	changes, err := selectParamChanges(params, nil)
+	require.NoError(t, err)
+	require.Equal(t, utils.ParamChangesJSON{
+		utils.NewParamChangeJSON("staking", "BondDenom", json.RawMessage(`"stake"`)),
+		utils.NewParamChangeJSON("staking", "MaxValidators", json.RawMessage(`100`)),
+	}, changes)
+
+	changes, err = selectParamChanges(params, []string{"MaxValidators"})
+	require.NoError(t, err)
+	require.Equal(t, utils.ParamChangesJSON{
+		utils.NewParamChangeJSON("staking", "MaxValidators", json.RawMessage(`100`)),
+	}, changes)
+
+	_, err = selectParamChanges(params, []string{"Unset"})
+	require.Error(t, err)
+
+	_, err = selectParamChanges(params, []string{"Unknown"})
+	require.Error(t, err)
+}
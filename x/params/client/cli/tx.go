@@ -27,13 +27,14 @@ func NewSubmitParamChangeProposalTxCmd(clientCtx client.Context) *cobra.Command
 The proposal details must be supplied via a JSON file. For values that contains
 objects, only non-empty fields will be updated.
 
-IMPORTANT: Currently parameter changes are evaluated but not validated, so it is
-very important that any "value" change is valid (ie. correct type and within bounds)
-for its respective parameter, eg. "MaxValidators" should be an integer and not a decimal.
-
-Proper vetting of a parameter change proposal should prevent this from happening
-(no deposits should occur during the governance process), but it should be noted
-regardless.
+Every "value" change is validated on submission against the type and the
+validation function registered for its parameter, eg. "MaxValidators" must be an
+integer and not a decimal, and the proposal is rejected if any change is invalid.
+A change may be given an "activation_height", in which case it is scheduled when
+the proposal passes and applied at the beginning of the block at that height.
+The changes can be checked beforehand with the "query params dry-run" command,
+and a proposal file holding the current values of a subspace can be generated
+with the "query params generate-proposal" command.
 
 Example:
 $ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
@@ -47,7 +48,8 @@ Where proposal.json contains:
     {
       "subspace": "staking",
       "key": "MaxValidators",
-      "value": 105
+      "value": 105,
+      "activation_height": 1000000
     }
   ],
   "deposit": "1000stake"
@@ -17,10 +17,13 @@ type (
 
 	// ParamChangeJSON defines a parameter change used in JSON input. This
 	// allows values to be specified in raw JSON instead of being string encoded.
+	// A change with an activation height is applied at that height instead of
+	// on the proposal execution.
 	ParamChangeJSON struct {
-		Subspace string          `json:"subspace" yaml:"subspace"`
-		Key      string          `json:"key" yaml:"key"`
-		Value    json.RawMessage `json:"value" yaml:"value"`
+		Subspace         string          `json:"subspace" yaml:"subspace"`
+		Key              string          `json:"key" yaml:"key"`
+		Value            json.RawMessage `json:"value" yaml:"value"`
+		ActivationHeight int64           `json:"activation_height,omitempty" yaml:"activation_height,omitempty"`
 	}
 
 	// ParamChangeProposalJSON defines a ParameterChangeProposal with a deposit used
@@ -45,12 +48,12 @@ type (
 )
 
 func NewParamChangeJSON(subspace, key string, value json.RawMessage) ParamChangeJSON {
-	return ParamChangeJSON{subspace, key, value}
+	return ParamChangeJSON{Subspace: subspace, Key: key, Value: value}
 }
 
 // ToParamChange converts a ParamChangeJSON object to ParamChange.
 func (pcj ParamChangeJSON) ToParamChange() proposal.ParamChange {
-	return proposal.NewParamChange(pcj.Subspace, pcj.Key, string(pcj.Value))
+	return proposal.NewScheduledParamChange(pcj.Subspace, pcj.Key, string(pcj.Value), pcj.ActivationHeight)
 }
 
 // ToParamChanges converts a slice of ParamChangeJSON objects to a slice of
@@ -0,0 +1,36 @@
+package params
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// InitGenesis sets the pending parameter changes and the parameter change log
+// from the genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	var maxSequence uint64
+
+	for _, pc := range data.PendingChanges {
+		k.SetPendingChange(ctx, pc)
+		if pc.Sequence > maxSequence {
+			maxSequence = pc.Sequence
+		}
+	}
+
+	for _, r := range data.ChangeRecords {
+		k.SetChangeRecord(ctx, r)
+		if r.Sequence > maxSequence {
+			maxSequence = r.Sequence
+		}
+	}
+
+	if maxSequence > 0 {
+		k.SetNextSequence(ctx, maxSequence+1)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetPendingChanges(ctx), k.GetChangeRecords(ctx, "", ""))
+}
@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// GetNextSequence returns the sequence to be assigned to the next pending
+// parameter change or change record.
+func (k Keeper) GetNextSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextSequenceKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextSequence sets the sequence to be assigned to the next pending
+// parameter change or change record.
+func (k Keeper) SetNextSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextSequenceKey, sdk.Uint64ToBigEndian(sequence))
+}
+
+func (k Keeper) incrementSequence(ctx sdk.Context) uint64 {
+	sequence := k.GetNextSequence(ctx)
+	k.SetNextSequence(ctx, sequence+1)
+	return sequence
+}
+
+// ValidateChange checks that a parameter change can be applied to the current
+// state of its subspace. The value is validated by the validation function
+// registered in the subspace's KeyTable.
+func (k Keeper) ValidateChange(ctx sdk.Context, change proposal.ParamChange) error {
+	ss, ok := k.GetSubspace(change.Subspace)
+	if !ok {
+		return sdkerrors.Wrap(proposal.ErrUnknownSubspace, change.Subspace)
+	}
+
+	if err := ss.ValidateUpdate(ctx, []byte(change.Key), []byte(change.Value)); err != nil {
+		return sdkerrors.Wrapf(proposal.ErrInvalidChange, "subspace: %s, key: %s, value: %s, err: %s", change.Subspace, change.Key, change.Value, err.Error())
+	}
+
+	return nil
+}
+
+// ApplyChange applies a parameter change of a proposal to its subspace and
+// appends the corresponding record to the change log.
+func (k Keeper) ApplyChange(ctx sdk.Context, proposalID uint64, change proposal.ParamChange) error {
+	if err := k.ValidateChange(ctx, change); err != nil {
+		return err
+	}
+
+	ss, _ := k.GetSubspace(change.Subspace)
+	key := []byte(change.Key)
+
+	oldValue := ss.GetRaw(ctx, key)
+	if err := ss.Update(ctx, key, []byte(change.Value)); err != nil {
+		return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", change.Key, change.Value, err.Error())
+	}
+
+	k.SetChangeRecord(ctx, types.NewParamChangeRecord(
+		change.Subspace, change.Key, ctx.BlockHeight(), proposalID,
+		string(oldValue), string(ss.GetRaw(ctx, key)), k.incrementSequence(ctx),
+	))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamChange,
+			sdk.NewAttribute(types.AttributeKeySubspace, change.Subspace),
+			sdk.NewAttribute(types.AttributeKeyKey, change.Key),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
+// ScheduleChange stores a parameter change of a proposal to be applied at the
+// beginning of the block at its activation height.
+func (k Keeper) ScheduleChange(ctx sdk.Context, proposalID uint64, change proposal.ParamChange) types.PendingParamChange {
+	pc := types.NewPendingParamChange(
+		change.Subspace, change.Key, change.Value, change.ActivationHeight, proposalID, k.incrementSequence(ctx),
+	)
+	k.SetPendingChange(ctx, pc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamChangeScheduled,
+			sdk.NewAttribute(types.AttributeKeySubspace, change.Subspace),
+			sdk.NewAttribute(types.AttributeKeyKey, change.Key),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, fmt.Sprintf("%d", change.ActivationHeight)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return pc
+}
+
+// ApplyPendingChanges applies the pending parameter changes whose activation
+// height has been reached, in activation height and sequence order. A change
+// which fails to apply is dropped and does not prevent the application of the
+// others.
+func (k Keeper) ApplyPendingChanges(ctx sdk.Context) {
+	var pending []types.PendingParamChange
+	k.IteratePendingChanges(ctx, func(pc types.PendingParamChange) (stop bool) {
+		if pc.ActivationHeight > ctx.BlockHeight() {
+			return true
+		}
+
+		pending = append(pending, pc)
+		return false
+	})
+
+	for _, pc := range pending {
+		k.DeletePendingChange(ctx, pc)
+
+		// apply the change in a cache-wrapped context so that a failed change
+		// does not leave any partial state behind
+		cacheCtx, writeCache := ctx.CacheContext()
+		change := proposal.NewScheduledParamChange(pc.Subspace, pc.Key, pc.Value, pc.ActivationHeight)
+
+		if err := k.ApplyChange(cacheCtx, pc.ProposalID, change); err != nil {
+			k.Logger(ctx).Error(
+				"failed to apply pending parameter change",
+				"subspace", pc.Subspace, "key", pc.Key, "proposal_id", pc.ProposalID, "err", err,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeParamChangeFailed,
+					sdk.NewAttribute(types.AttributeKeySubspace, pc.Subspace),
+					sdk.NewAttribute(types.AttributeKeyKey, pc.Key),
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pc.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		k.Logger(ctx).Info(
+			fmt.Sprintf("applied pending parameter change; key: %s, value: %s", pc.Key, pc.Value),
+		)
+
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+	}
+}
+
+// SetPendingChange stores a pending parameter change.
+func (k Keeper) SetPendingChange(ctx sdk.Context, pc types.PendingParamChange) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryBare(&pc)
+	store.Set(types.GetPendingChangeKey(pc.ActivationHeight, pc.Sequence), bz)
+}
+
+// DeletePendingChange removes a pending parameter change.
+func (k Keeper) DeletePendingChange(ctx sdk.Context, pc types.PendingParamChange) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.GetPendingChangeKey(pc.ActivationHeight, pc.Sequence))
+}
+
+// IteratePendingChanges iterates through the pending parameter changes in
+// ascending activation height and sequence order.
+func (k Keeper) IteratePendingChanges(ctx sdk.Context, fn func(pc types.PendingParamChange) (stop bool)) {
+	store := ctx.KVStore(k.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingChangeKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pc types.PendingParamChange
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pc)
+
+		if fn(pc) {
+			break
+		}
+	}
+}
+
+// GetPendingChanges returns all the pending parameter changes.
+func (k Keeper) GetPendingChanges(ctx sdk.Context) []types.PendingParamChange {
+	pendingChanges := []types.PendingParamChange{}
+	k.IteratePendingChanges(ctx, func(pc types.PendingParamChange) (stop bool) {
+		pendingChanges = append(pendingChanges, pc)
+		return false
+	})
+
+	return pendingChanges
+}
+
+// SetChangeRecord stores a parameter change record.
+func (k Keeper) SetChangeRecord(ctx sdk.Context, r types.ParamChangeRecord) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryBare(&r)
+	store.Set(types.GetChangeRecordKey(r.Subspace, r.Key, r.Sequence), bz)
+}
+
+// IterateChangeRecords iterates through the parameter change records. If a
+// subspace is given, only its records are iterated, and if a key is given as
+// well, only the records of that parameter are iterated in sequence order.
+func (k Keeper) IterateChangeRecords(ctx sdk.Context, subspace, key string, fn func(r types.ParamChangeRecord) (stop bool)) {
+	store := ctx.KVStore(k.key)
+
+	prefix := types.ChangeRecordKeyPrefix
+	switch {
+	case subspace != "" && key != "":
+		prefix = types.GetChangeRecordsByKeyKey(subspace, key)
+	case subspace != "":
+		prefix = types.GetChangeRecordsBySubspaceKey(subspace)
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var r types.ParamChangeRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &r)
+
+		if fn(r) {
+			break
+		}
+	}
+}
+
+// GetChangeRecords returns the parameter change records, optionally filtered
+// by subspace and key.
+func (k Keeper) GetChangeRecords(ctx sdk.Context, subspace, key string) []types.ParamChangeRecord {
+	records := []types.ParamChangeRecord{}
+	k.IterateChangeRecords(ctx, subspace, key, func(r types.ParamChangeRecord) (stop bool) {
+		records = append(records, r)
+		return false
+	})
+
+	return records
+}
@@ -59,3 +59,37 @@ func (k Keeper) GetSubspace(s string) (types.Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// DryRunChanges applies a set of parameter changes in order on top of the
+// current state, regardless of their activation height, and returns the
+// resulting parameters of every subspace affected by the changes. Each value is
+// validated by the validation function registered in the subspace's KeyTable.
+// No state is persisted.
+func (k Keeper) DryRunChanges(ctx sdk.Context, changes []proposal.ParamChange) ([]types.SubspaceParamsResponse, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	for _, c := range changes {
+		if err := k.ApplyChange(cacheCtx, 0, c); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		seen = make(map[string]bool)
+		res  []types.SubspaceParamsResponse
+	)
+
+	for _, c := range changes {
+		if seen[c.Subspace] {
+			continue
+		}
+		seen[c.Subspace] = true
+
+		ss, _ := k.GetSubspace(c.Subspace)
+		for _, key := range ss.Keys() {
+			rawValue := ss.GetRaw(cacheCtx, []byte(key))
+			res = append(res, types.NewSubspaceParamsResponse(c.Subspace, key, string(rawValue)))
+		}
+	}
+
+	return res, nil
+}
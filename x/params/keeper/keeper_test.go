@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 func validateNoOp(_ interface{}) error { return nil }
@@ -227,3 +229,67 @@ func TestJSONUpdate(t *testing.T) {
 	space.Get(ctx, key, &param)
 	require.Equal(t, paramJSON{40964096, "goodbyeworld"}, param)
 }
+
+func TestDryRunChanges(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	validatePositive := func(i interface{}) error {
+		if i.(int64) <= 0 {
+			return fmt.Errorf("value must be positive: %d", i)
+		}
+		return nil
+	}
+
+	space := keeper.Subspace("test").WithKeyTable(types.NewKeyTable(
+		types.NewParamSetPair([]byte("key1"), int64(0), validatePositive),
+		types.NewParamSetPair([]byte("key2"), paramJSON{}, validateNoOp),
+	))
+	space.Set(ctx, []byte("key1"), int64(10))
+
+	testCases := []struct {
+		name    string
+		changes []proposal.ParamChange
+		expErr  bool
+	}{
+		{"valid change", []proposal.ParamChange{proposal.NewParamChange("test", "key1", `"20"`)}, false},
+		{"invalid value", []proposal.ParamChange{proposal.NewParamChange("test", "key1", `"-1"`)}, true},
+		{"invalid type", []proposal.ParamChange{proposal.NewParamChange("test", "key1", `"abc"`)}, true},
+		{"unregistered key", []proposal.ParamChange{proposal.NewParamChange("test", "key3", `"1"`)}, true},
+		{"unknown subspace", []proposal.ParamChange{proposal.NewParamChange("other", "key1", `"1"`)}, true},
+		{
+			"invalid change after a valid one",
+			[]proposal.ParamChange{
+				proposal.NewParamChange("test", "key1", `"20"`),
+				proposal.NewParamChange("test", "key1", `"-20"`),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := keeper.DryRunChanges(ctx, tc.changes)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var param int64
+			space.Get(ctx, []byte("key1"), &param)
+			require.Equal(t, int64(10), param)
+		})
+	}
+
+	res, err := keeper.DryRunChanges(ctx, []proposal.ParamChange{
+		proposal.NewParamChange("test", "key2", `{"param2": "hello"}`),
+		proposal.NewParamChange("test", "key1", `"20"`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.SubspaceParamsResponse{
+		types.NewSubspaceParamsResponse("test", "key1", `"20"`),
+		types.NewSubspaceParamsResponse("test", "key2", `{"param2":"hello"}`),
+	}, res)
+	require.False(t, space.Has(ctx, []byte("key2")))
+}
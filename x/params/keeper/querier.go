@@ -3,6 +3,7 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +19,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryParams:
 			return queryParams(ctx, req, k)
 
+		case types.QueryAllParams:
+			return queryAllParams(ctx, req, k)
+
+		case types.QueryDryRun:
+			return queryDryRun(ctx, req, k)
+
+		case types.QueryPendingChanges:
+			return queryPendingChanges(ctx, k)
+
+		case types.QueryChangeLog:
+			return queryChangeLog(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -46,3 +59,90 @@ func queryParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, erro
 
 	return bz, nil
 }
+
+func queryAllParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAllSubspaceParams
+
+	if err := legacy.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	ss, ok := k.GetSubspace(params.Subspace)
+	if !ok {
+		return nil, sdkerrors.Wrap(proposal.ErrUnknownSubspace, params.Subspace)
+	}
+
+	resp := []types.SubspaceParamsResponse{}
+	for _, key := range ss.Keys() {
+		rawValue := ss.GetRaw(ctx, []byte(key))
+		resp = append(resp, types.NewSubspaceParamsResponse(params.Subspace, key, string(rawValue)))
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacy.Cdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDryRun(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params proposal.QueryDryRunParams
+
+	if err := legacy.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := proposal.ValidateChanges(params.Changes); err != nil {
+		return nil, err
+	}
+
+	resp, err := k.DryRunChanges(ctx, params.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacy.Cdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryPendingChanges(ctx sdk.Context, k Keeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(legacy.Cdc, k.GetPendingChanges(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryChangeLog(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryChangeLogParams
+
+	if err := legacy.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.Key != "" && params.Subspace == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "a subspace is required to filter by key")
+	}
+
+	records := k.GetChangeRecords(ctx, params.Subspace, params.Key)
+
+	start, end := client.Paginate(len(records), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		records = []types.ParamChangeRecord{}
+	} else {
+		records = records[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacy.Cdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
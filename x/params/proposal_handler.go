@@ -24,20 +24,37 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
+	proposalID, _ := govtypes.ProposalIDFromContext(ctx)
+
+	// apply the changes in a cache-wrapped context so that a proposal is either
+	// applied entirely or rejected, both at submission and at execution time
+	cacheCtx, writeCache := ctx.CacheContext()
+
 	for _, c := range p.Changes {
-		ss, ok := k.GetSubspace(c.Subspace)
-		if !ok {
-			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
+		if c.ActivationHeight > ctx.BlockHeight() {
+			k.Logger(ctx).Info(
+				fmt.Sprintf("schedule new parameter value; key: %s, value: %s, activation height: %d", c.Key, c.Value, c.ActivationHeight),
+			)
+
+			if err := k.ValidateChange(cacheCtx, c); err != nil {
+				return err
+			}
+
+			k.ScheduleChange(cacheCtx, proposalID, c)
+			continue
 		}
 
 		k.Logger(ctx).Info(
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
 
-		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
-			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+		if err := k.ApplyChange(cacheCtx, proposalID, c); err != nil {
+			return err
 		}
 	}
 
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+
 	return nil
 }
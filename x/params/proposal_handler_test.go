@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/keeper"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
@@ -130,3 +131,117 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalHandlerUnregisteredKey(t *testing.T) {
+	input := newTestInput(t)
+	input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	tp := testProposal(proposal.NewParamChange(testSubspace, "UnknownKey", "1"))
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	require.NotPanics(t, func() {
+		require.True(t, proposal.ErrInvalidChange.Is(hdlr(input.ctx, tp)))
+	})
+}
+
+func TestProposalHandlerAtomic(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	tp := testProposal(
+		proposal.NewParamChange(testSubspace, keyMaxValidators, "1"),
+		proposal.NewParamChange(testSubspace, keySlashingRate, "invalidType"),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.Error(t, hdlr(input.ctx, tp))
+
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+}
+
+func TestProposalHandlerScheduledChange(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	ctx := input.ctx.WithBlockHeight(10)
+
+	tp := testProposal(
+		proposal.NewParamChange(testSubspace, keyMaxValidators, "1"),
+		proposal.NewScheduledParamChange(testSubspace, keyMaxValidators, "2", 12),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(govtypes.WithProposalID(ctx, 5), tp))
+
+	var param uint16
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(1), param)
+	require.Equal(t, []types.PendingParamChange{
+		types.NewPendingParamChange(testSubspace, keyMaxValidators, "2", 12, 5, 2),
+	}, input.keeper.GetPendingChanges(ctx))
+
+	// the pending change is not applied before its activation height
+	ctx = ctx.WithBlockHeight(11)
+	params.BeginBlocker(ctx, input.keeper)
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(1), param)
+
+	ctx = ctx.WithBlockHeight(12)
+	params.BeginBlocker(ctx, input.keeper)
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(2), param)
+	require.Empty(t, input.keeper.GetPendingChanges(ctx))
+
+	require.Equal(t, []types.ParamChangeRecord{
+		types.NewParamChangeRecord(testSubspace, keyMaxValidators, 10, 5, "", `1`, 1),
+		types.NewParamChangeRecord(testSubspace, keyMaxValidators, 12, 5, `1`, `2`, 3),
+	}, input.keeper.GetChangeRecords(ctx, testSubspace, keyMaxValidators))
+	require.Empty(t, input.keeper.GetChangeRecords(ctx, testSubspace, keySlashingRate))
+}
+
+func TestBeginBlockerFailedPendingChange(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	ctx := input.ctx.WithBlockHeight(10)
+
+	input.keeper.ScheduleChange(ctx, 1, proposal.NewScheduledParamChange(testSubspace, keyMaxValidators, "invalidType", 10))
+	input.keeper.ScheduleChange(ctx, 1, proposal.NewScheduledParamChange(testSubspace, keyMaxValidators, "3", 10))
+
+	params.BeginBlocker(ctx, input.keeper)
+
+	var param uint16
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(3), param)
+	require.Empty(t, input.keeper.GetPendingChanges(ctx))
+	require.Len(t, input.keeper.GetChangeRecords(ctx, "", ""), 1)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	input := newTestInput(t)
+	input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	ctx := input.ctx.WithBlockHeight(10)
+
+	tp := testProposal(
+		proposal.NewParamChange(testSubspace, keyMaxValidators, "1"),
+		proposal.NewScheduledParamChange(testSubspace, keyMaxValidators, "2", 12),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(govtypes.WithProposalID(ctx, 5), tp))
+
+	genState := params.ExportGenesis(ctx, input.keeper)
+	require.NoError(t, types.ValidateGenesis(genState))
+	require.Len(t, genState.PendingChanges, 1)
+	require.Len(t, genState.ChangeRecords, 1)
+
+	newInput := newTestInput(t)
+	params.InitGenesis(newInput.ctx, newInput.keeper, genState)
+	require.Equal(t, genState, params.ExportGenesis(newInput.ctx, newInput.keeper))
+	require.Equal(t, input.keeper.GetNextSequence(ctx), newInput.keeper.GetNextSequence(newInput.ctx))
+}
@@ -12,7 +12,7 @@ import (
 // It will generate a ParameterChangeProposal object with anywhere between 1 and
 // the total amount of defined parameters changes, all of which have random valid values.
 func SimulateParamChangeProposalContent(paramChangePool []simulation.ParamChange) simulation.ContentSimulatorFn {
-	return func(r *rand.Rand, _ sdk.Context, _ []simulation.Account) simulation.Content {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simulation.Account) simulation.Content {
 
 		lenParamChange := len(paramChangePool)
 		if lenParamChange == 0 {
@@ -40,6 +40,11 @@ func SimulateParamChangeProposalContent(paramChangePool []simulation.ParamChange
 			// to avoid further duplicates
 			paramChangesKeys[spc.ComposedKey()] = struct{}{}
 			paramChanges[i] = proposal.NewParamChange(spc.Subspace(), spc.Key(), spc.SimValue()(r))
+
+			// schedule some of the changes at a later height
+			if r.Intn(4) == 0 {
+				paramChanges[i].ActivationHeight = ctx.BlockHeight() + int64(simulation.RandIntBetween(r, 1, 500))
+			}
 		}
 
 		return proposal.NewParameterChangeProposal(
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// NewPendingParamChange creates a new PendingParamChange instance
+func NewPendingParamChange(
+	subspace, key, value string, activationHeight int64, proposalID, sequence uint64,
+) PendingParamChange {
+	return PendingParamChange{
+		Subspace:         subspace,
+		Key:              key,
+		Value:            value,
+		ActivationHeight: activationHeight,
+		ProposalID:       proposalID,
+		Sequence:         sequence,
+	}
+}
+
+// String implements the Stringer interface for PendingParamChange
+func (pc PendingParamChange) String() string {
+	out, _ := yaml.Marshal(pc)
+	return string(out)
+}
+
+// Validate performs a basic validation of the pending parameter change.
+func (pc PendingParamChange) Validate() error {
+	if len(pc.Subspace) == 0 || len(pc.Key) == 0 {
+		return fmt.Errorf("pending parameter change #%d has an empty subspace or key", pc.Sequence)
+	}
+	if len(pc.Value) == 0 {
+		return fmt.Errorf("pending parameter change #%d has an empty value", pc.Sequence)
+	}
+	if pc.ActivationHeight <= 0 {
+		return fmt.Errorf("pending parameter change #%d has a non-positive activation height: %d", pc.Sequence, pc.ActivationHeight)
+	}
+
+	return nil
+}
+
+// NewParamChangeRecord creates a new ParamChangeRecord instance
+func NewParamChangeRecord(
+	subspace, key string, height int64, proposalID uint64, oldValue, newValue string, sequence uint64,
+) ParamChangeRecord {
+	return ParamChangeRecord{
+		Subspace:   subspace,
+		Key:        key,
+		Height:     height,
+		ProposalID: proposalID,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+		Sequence:   sequence,
+	}
+}
+
+// String implements the Stringer interface for ParamChangeRecord
+func (r ParamChangeRecord) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// Validate performs a basic validation of the parameter change record.
+func (r ParamChangeRecord) Validate() error {
+	if len(r.Subspace) == 0 || len(r.Key) == 0 {
+		return fmt.Errorf("parameter change record #%d has an empty subspace or key", r.Sequence)
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("parameter change record #%d has a negative height: %d", r.Sequence, r.Height)
+	}
+
+	return nil
+}
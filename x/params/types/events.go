@@ -0,0 +1,14 @@
+package types
+
+// params module event types
+const (
+	EventTypeParamChange          = "param_change"
+	EventTypeParamChangeScheduled = "param_change_scheduled"
+	EventTypeParamChangeFailed    = "param_change_failed"
+
+	AttributeKeySubspace         = "subspace"
+	AttributeKeyKey              = "key"
+	AttributeKeyActivationHeight = "activation_height"
+	AttributeKeyProposalID       = "proposal_id"
+	AttributeKeyError            = "error"
+)
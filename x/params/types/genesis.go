@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState - params genesis state
+type GenesisState struct {
+	PendingChanges []PendingParamChange `json:"pending_changes" yaml:"pending_changes"`
+	ChangeRecords  []ParamChangeRecord  `json:"change_records" yaml:"change_records"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(pendingChanges []PendingParamChange, changeRecords []ParamChangeRecord) GenesisState {
+	return GenesisState{
+		PendingChanges: pendingChanges,
+		ChangeRecords:  changeRecords,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		PendingChanges: []PendingParamChange{},
+		ChangeRecords:  []ParamChangeRecord{},
+	}
+}
+
+// ValidateGenesis - validate params genesis data
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[uint64]bool)
+
+	for _, pc := range data.PendingChanges {
+		if err := pc.Validate(); err != nil {
+			return err
+		}
+		if seen[pc.Sequence] {
+			return fmt.Errorf("duplicate pending parameter change sequence: %d", pc.Sequence)
+		}
+		seen[pc.Sequence] = true
+	}
+
+	for _, r := range data.ChangeRecords {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if seen[r.Sequence] {
+			return fmt.Errorf("duplicate parameter change record sequence: %d", r.Sequence)
+		}
+		seen[r.Sequence] = true
+	}
+
+	return nil
+}
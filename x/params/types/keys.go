@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "params"
@@ -7,3 +11,49 @@ const (
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
+
+// Keys for the params store. Subspaces store their parameters under their
+// name followed by '/', so the following prefixes, which are not printable
+// characters, do not collide with any subspace.
+//
+// - 0x01<activationHeight_Bytes><sequence_Bytes>: PendingParamChange
+//
+// - 0x02<subspaceLen (1 Byte)><subspace_Bytes><keyLen (1 Byte)><key_Bytes><sequence_Bytes>: ParamChangeRecord
+//
+// - 0x03: NextSequence
+var (
+	PendingChangeKeyPrefix = []byte{0x01}
+	ChangeRecordKeyPrefix  = []byte{0x02}
+	NextSequenceKey        = []byte{0x03}
+)
+
+// GetPendingChangeKey returns the key of a pending parameter change. Pending
+// changes are sorted by activation height and then by sequence.
+func GetPendingChangeKey(activationHeight int64, sequence uint64) []byte {
+	return append(GetPendingChangesByHeightKey(activationHeight), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetPendingChangesByHeightKey returns the prefix of the pending parameter
+// changes activated at the given height.
+func GetPendingChangesByHeightKey(activationHeight int64) []byte {
+	return append(PendingChangeKeyPrefix, sdk.Uint64ToBigEndian(uint64(activationHeight))...)
+}
+
+// GetChangeRecordKey returns the key of a parameter change record.
+func GetChangeRecordKey(subspace, key string, sequence uint64) []byte {
+	return append(GetChangeRecordsByKeyKey(subspace, key), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetChangeRecordsByKeyKey returns the prefix of the change records of a
+// parameter.
+func GetChangeRecordsByKeyKey(subspace, key string) []byte {
+	bz := append(GetChangeRecordsBySubspaceKey(subspace), byte(len(key)))
+	return append(bz, []byte(key)...)
+}
+
+// GetChangeRecordsBySubspaceKey returns the prefix of the change records of a
+// subspace.
+func GetChangeRecordsBySubspaceKey(subspace string) []byte {
+	bz := append(ChangeRecordKeyPrefix, byte(len(subspace)))
+	return append(bz, []byte(subspace)...)
+}
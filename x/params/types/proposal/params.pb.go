@@ -63,11 +63,14 @@ func (m *ParameterChangeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ParameterChangeProposal proto.InternalMessageInfo
 
-// ParamChange defines a parameter change.
+// ParamChange defines a parameter change. A change with an activation height
+// ahead of the execution height of its proposal is scheduled and applied at the
+// beginning of the block at the activation height.
 type ParamChange struct {
-	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
-	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Subspace         string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key              string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value            string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ActivationHeight int64  `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty" yaml:"activation_height"`
 }
 
 func (m *ParamChange) Reset()      { *m = ParamChange{} }
@@ -123,6 +126,13 @@ func (m *ParamChange) GetValue() string {
 	return ""
 }
 
+func (m *ParamChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ParameterChangeProposal)(nil), "cosmos.params.ParameterChangeProposal")
 	proto.RegisterType((*ParamChange)(nil), "cosmos.params.ParamChange")
@@ -131,26 +141,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/params/params.proto", fileDescriptor_5ac7103bc6a10dd8) }
 
 var fileDescriptor_5ac7103bc6a10dd8 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x51, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0xbc, 0x10, 0x39, 0x3d, 0x88, 0xa0, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x46, 0x1f,
-	0xc4, 0x82, 0x28, 0x52, 0x9a, 0xce, 0xc8, 0x25, 0x1e, 0x00, 0x52, 0x90, 0x5a, 0x92, 0x5a, 0xe4,
-	0x9c, 0x91, 0x98, 0x97, 0x9e, 0x1a, 0x50, 0x94, 0x5f, 0x90, 0x5f, 0x9c, 0x98, 0x23, 0x24, 0xc2,
-	0xc5, 0x5a, 0x92, 0x59, 0x92, 0x93, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe1, 0x08,
-	0x29, 0x70, 0x71, 0xa7, 0xa4, 0x16, 0x27, 0x17, 0x65, 0x16, 0x94, 0x64, 0xe6, 0xe7, 0x49, 0x30,
-	0x81, 0xe5, 0x90, 0x85, 0x84, 0xac, 0xb8, 0xd8, 0x93, 0xc1, 0x26, 0x15, 0x4b, 0x30, 0x2b, 0x30,
-	0x6b, 0x70, 0x1b, 0x49, 0xe9, 0xa1, 0x38, 0x45, 0x0f, 0x6c, 0x21, 0xc4, 0x32, 0x27, 0x96, 0x13,
-	0xf7, 0xe4, 0x19, 0x82, 0x60, 0x1a, 0xac, 0x38, 0x3a, 0x16, 0xc8, 0x33, 0xcc, 0x58, 0x20, 0xcf,
-	0xa0, 0x14, 0xce, 0xc5, 0x8d, 0xa4, 0x4e, 0x48, 0x8a, 0x8b, 0xa3, 0xb8, 0x34, 0xa9, 0xb8, 0x20,
-	0x31, 0x19, 0xe6, 0x1e, 0x38, 0x5f, 0x48, 0x80, 0x8b, 0x39, 0x3b, 0xb5, 0x12, 0xea, 0x14, 0x10,
-	0x13, 0xe4, 0xf4, 0xb2, 0xc4, 0x9c, 0xd2, 0x54, 0x09, 0x66, 0x88, 0xd3, 0xc1, 0x1c, 0x2b, 0x16,
-	0x90, 0xc1, 0x4e, 0x41, 0x2b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
-	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1,
-	0x1c, 0x43, 0x94, 0x49, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x34,
-	0x70, 0x21, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05, 0x2c, 0xa4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0xf5, 0x0b, 0xa0, 0x41, 0x95, 0xc4, 0x06, 0x0e, 0x4d, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x67, 0x91, 0x5a, 0x29, 0x90, 0x01, 0x00, 0x00,
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0x3f, 0xfd, 0xa1, 0xb8, 0x42, 0x2a, 0x56, 0x25, 0xa2, 0x08, 0x39, 0x51, 0xa6,
+	0x2e, 0x24, 0x12, 0x30, 0x65, 0x2c, 0x0b, 0x6c, 0x55, 0x46, 0x16, 0xe4, 0xa6, 0x56, 0x12, 0x35,
+	0xa9, 0xad, 0xd8, 0xad, 0xe8, 0x1b, 0x30, 0xb2, 0xc1, 0x58, 0x31, 0xf1, 0x28, 0x1d, 0x3b, 0x32,
+	0x55, 0x28, 0x7d, 0x03, 0x9e, 0x00, 0xc5, 0x6e, 0xa0, 0x88, 0xe9, 0xde, 0x7b, 0xee, 0x49, 0xee,
+	0x27, 0x1f, 0x68, 0xc7, 0x4c, 0x14, 0x4c, 0x04, 0x9c, 0x94, 0xa4, 0x68, 0x8a, 0xcf, 0x4b, 0x26,
+	0x19, 0x3a, 0xd6, 0x3b, 0x5f, 0x8b, 0x76, 0x2f, 0x61, 0x09, 0x53, 0x9b, 0xa0, 0xee, 0xb4, 0xc9,
+	0x7b, 0x06, 0xf0, 0x74, 0x58, 0x1b, 0xa8, 0xa4, 0xe5, 0x75, 0x4a, 0xa6, 0x09, 0x1d, 0x96, 0x8c,
+	0x33, 0x41, 0x72, 0xd4, 0x83, 0xff, 0x65, 0x26, 0x73, 0x6a, 0x01, 0x17, 0xf4, 0x8f, 0x22, 0x3d,
+	0x20, 0x17, 0x76, 0xc6, 0x54, 0xc4, 0x65, 0xc6, 0x65, 0xc6, 0xa6, 0xd6, 0x3f, 0xb5, 0xdb, 0x97,
+	0x50, 0x08, 0x0f, 0x63, 0xf5, 0x27, 0x61, 0x99, 0xae, 0xd9, 0xef, 0x5c, 0xd8, 0xfe, 0x2f, 0x14,
+	0x5f, 0x1d, 0xd4, 0xc7, 0x06, 0xad, 0xd5, 0xc6, 0x31, 0xa2, 0xe6, 0x83, 0xb0, 0xfd, 0xb8, 0x74,
+	0x8c, 0x97, 0xa5, 0x63, 0x78, 0xaf, 0x00, 0x76, 0xf6, 0x8c, 0xc8, 0x86, 0x6d, 0x31, 0x1b, 0x09,
+	0x4e, 0xe2, 0x06, 0xe8, 0x7b, 0x46, 0x5d, 0x68, 0x4e, 0xe8, 0x62, 0xc7, 0x52, 0xb7, 0x35, 0xfb,
+	0x9c, 0xe4, 0x33, 0x6a, 0x99, 0x9a, 0x5d, 0x0d, 0xe8, 0x16, 0x9e, 0x90, 0x58, 0x66, 0x73, 0x52,
+	0x73, 0xde, 0xa7, 0x34, 0x4b, 0x52, 0x69, 0xb5, 0x5c, 0xd0, 0x37, 0x07, 0x67, 0x9f, 0x1b, 0xc7,
+	0x5a, 0x90, 0x22, 0x0f, 0xbd, 0x3f, 0x16, 0x2f, 0xea, 0xfe, 0x68, 0x37, 0x4a, 0x0a, 0x5b, 0x35,
+	0xe4, 0x20, 0x7a, 0xab, 0x30, 0x58, 0x55, 0x18, 0xac, 0x2b, 0x0c, 0x3e, 0x2a, 0x0c, 0x9e, 0xb6,
+	0xd8, 0x58, 0x6f, 0xb1, 0xf1, 0xbe, 0xc5, 0xc6, 0xdd, 0x55, 0x92, 0xc9, 0x74, 0x36, 0xf2, 0x63,
+	0x56, 0x04, 0xbb, 0xa0, 0x74, 0x39, 0x17, 0xe3, 0x49, 0xf0, 0xd0, 0xa4, 0x26, 0x17, 0x9c, 0x8a,
+	0x80, 0xef, 0x9e, 0x7d, 0x74, 0xa0, 0x92, 0xb9, 0xfc, 0x1a, 0x00, 0xcd, 0xe7, 0xc3, 0x2f, 0xdc,
+	0x01, 0x00, 0x00,
 }
 
 func (this *ParameterChangeProposal) Equal(that interface{}) bool {
@@ -216,6 +229,9 @@ func (this *ParamChange) Equal(that interface{}) bool {
 	if this.Value != that1.Value {
 		return false
 	}
+	if this.ActivationHeight != that1.ActivationHeight {
+		return false
+	}
 	return true
 }
 func (m *ParameterChangeProposal) Marshal() (dAtA []byte, err error) {
@@ -289,6 +305,11 @@ func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
@@ -365,6 +386,9 @@ func (m *ParamChange) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
 	return n
 }
 
@@ -650,6 +674,25 @@ func (m *ParamChange) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
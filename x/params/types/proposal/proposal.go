@@ -60,17 +60,24 @@ func (pcp ParameterChangeProposal) String() string {
 
 	for _, pc := range pcp.Changes {
 		b.WriteString(fmt.Sprintf(`    Param Change:
-      Subspace: %s
-      Key:      %s
-      Value:    %X
-`, pc.Subspace, pc.Key, pc.Value))
+      Subspace:          %s
+      Key:               %s
+      Value:             %X
+      Activation Height: %d
+`, pc.Subspace, pc.Key, pc.Value, pc.ActivationHeight))
 	}
 
 	return b.String()
 }
 
 func NewParamChange(subspace, key, value string) ParamChange {
-	return ParamChange{subspace, key, value}
+	return ParamChange{subspace, key, value, 0}
+}
+
+// NewScheduledParamChange creates a ParamChange to be applied at the given
+// activation height.
+func NewScheduledParamChange(subspace, key, value string, activationHeight int64) ParamChange {
+	return ParamChange{subspace, key, value, activationHeight}
 }
 
 // String implements the Stringer interface.
@@ -96,6 +103,9 @@ func ValidateChanges(changes []ParamChange) error {
 		if len(pc.Value) == 0 {
 			return ErrEmptyValue
 		}
+		if pc.ActivationHeight < 0 {
+			return ErrInvalidActivationHeight
+		}
 	}
 
 	return nil
@@ -0,0 +1,13 @@
+package proposal
+
+// QueryDryRunParams defines the params for dry-running a set of parameter
+// changes against the current state.
+type QueryDryRunParams struct {
+	Changes []ParamChange
+}
+
+func NewQueryDryRunParams(changes []ParamChange) QueryDryRunParams {
+	return QueryDryRunParams{
+		Changes: changes,
+	}
+}
@@ -2,7 +2,11 @@ package types
 
 // Querier path constants
 const (
-	QueryParams = "params"
+	QueryParams         = "params"
+	QueryAllParams      = "allParams"
+	QueryDryRun         = "dryRun"
+	QueryPendingChanges = "pendingChanges"
+	QueryChangeLog      = "changeLog"
 )
 
 // QuerySubspaceParams defines the params for querying module params by a given
@@ -12,6 +16,21 @@ type QuerySubspaceParams struct {
 	Key      string
 }
 
+// QueryAllSubspaceParams defines the params for querying all the parameters
+// registered in a given subspace.
+type QueryAllSubspaceParams struct {
+	Subspace string
+}
+
+// QueryChangeLogParams defines the params for querying the parameter change
+// log. If a subspace is given, only its change records are returned, and if a
+// key is given as well, only the change records of that parameter.
+type QueryChangeLogParams struct {
+	Subspace    string
+	Key         string
+	Page, Limit int
+}
+
 // SubspaceParamsResponse defines the response for quering parameters by subspace.
 type SubspaceParamsResponse struct {
 	Subspace string
@@ -33,3 +52,18 @@ func NewSubspaceParamsResponse(ss, key, value string) SubspaceParamsResponse {
 		Value:    value,
 	}
 }
+
+func NewQueryAllSubspaceParams(ss string) QueryAllSubspaceParams {
+	return QueryAllSubspaceParams{
+		Subspace: ss,
+	}
+}
+
+func NewQueryChangeLogParams(ss, key string, page, limit int) QueryChangeLogParams {
+	return QueryChangeLogParams{
+		Subspace: ss,
+		Key:      key,
+		Page:     page,
+		Limit:    limit,
+	}
+}
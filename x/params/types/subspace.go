@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -188,28 +189,63 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, value interface{}) {
 // key or if the new value is invalid as determined by the registered type's
 // validation function.
 func (s Subspace) Update(ctx sdk.Context, key, value []byte) error {
-	attr, ok := s.table.m[string(key)]
-	if !ok {
+	if _, ok := s.table.m[string(key)]; !ok {
 		panic(fmt.Sprintf("parameter %s not registered", string(key)))
 	}
 
-	ty := attr.ty
+	dest, err := s.updatedValue(ctx, key, value)
+	if err != nil {
+		return err
+	}
+
+	s.Set(ctx, key, dest)
+	return nil
+}
+
+// ValidateUpdate checks that a raw value could be applied to a parameter by key
+// through Update without storing it. Contrary to Update, an error is returned
+// if the parameter key has not been registered.
+func (s Subspace) ValidateUpdate(ctx sdk.Context, key, value []byte) error {
+	if _, ok := s.table.m[string(key)]; !ok {
+		return fmt.Errorf("parameter %s not registered", string(key))
+	}
+
+	_, err := s.updatedValue(ctx, key, value)
+	return err
+}
+
+// updatedValue decodes a raw value on top of the current value of a registered
+// parameter and validates the result with the registered validation function.
+// It returns a pointer to the updated value.
+func (s Subspace) updatedValue(ctx sdk.Context, key, value []byte) (interface{}, error) {
+	ty := s.table.m[string(key)].ty
 	dest := reflect.New(ty).Interface()
 	s.GetIfExists(ctx, key, dest)
 
 	if err := s.cdc.UnmarshalJSON(value, dest); err != nil {
-		return err
+		return nil, err
 	}
 
 	// destValue contains the dereferenced value of dest so validation function do
 	// not have to operate on pointers.
 	destValue := reflect.Indirect(reflect.ValueOf(dest)).Interface()
 	if err := s.Validate(ctx, key, destValue); err != nil {
-		return err
+		return nil, err
 	}
 
-	s.Set(ctx, key, dest)
-	return nil
+	return dest, nil
+}
+
+// Keys returns the sorted keys of all the parameters registered in the
+// Subspace's KeyTable.
+func (s Subspace) Keys() []string {
+	keys := make([]string, 0, len(s.table.m))
+	for k := range s.table.m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
 }
 
 // GetParamSet iterates through each ParamSetPair where for each pair, it will
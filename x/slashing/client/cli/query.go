@@ -28,6 +28,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	slashingQueryCmd.AddCommand(
 		flags.GetCommands(
 			GetCmdQuerySigningInfo(queryRoute, cdc),
+			GetCmdQueryOffenses(cdc),
 			GetCmdQueryParams(cdc),
 		)...,
 	)
@@ -77,6 +78,44 @@ $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdge
 	}
 }
 
+// GetCmdQueryOffenses implements the command to query the downtime offense
+// history of a validator.
+func GetCmdQueryOffenses(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "offenses [validator-conspub]",
+		Short: "Query a validator's downtime offense history",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the downtime offenses of that validator,
+along with the number of offenses which still escalate the penalties of its next offense:
+
+$ <appcli> query slashing offenses cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address())))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryOffenses)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var history types.OffenseHistory
+			cdc.MustUnmarshalJSON(res, &history)
+			return clientCtx.PrintOutput(history)
+		},
+	}
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
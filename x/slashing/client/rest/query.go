@@ -18,6 +18,11 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 		signingInfoHandlerFn(clientCtx),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/slashing/validators/{validatorPubKey}/offenses",
+		offensesHandlerFn(clientCtx),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/slashing/signing_infos",
 		signingInfoHandlerListFn(clientCtx),
@@ -61,6 +66,38 @@ func signingInfoHandlerFn(clientCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// http request handler to query the downtime offense history of a validator
+func offensesHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, vars["validatorPubKey"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address()))
+
+		bz, err := clientCtx.JSONMarshaler.MarshalJSON(params)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryOffenses)
+		res, height, err := clientCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
 // http request handler to query signing info
 func signingInfoHandlerListFn(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // InitGenesis initialize default parameters
@@ -17,6 +18,15 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		},
 	)
 
+	// evidence of infractions committed with the old pubkeys may still be
+	// handled until their rotation is pruned
+	stakingKeeper.IterateConsPubKeyRotations(ctx,
+		func(rotation stakingtypes.ConsPubKeyRotation) bool {
+			keeper.AddPubkey(ctx, rotation.GetOldConsPubKey())
+			return false
+		},
+	)
+
 	for addr, info := range data.SigningInfos {
 		address, err := sdk.ConsAddressFromBech32(addr)
 		if err != nil {
@@ -35,7 +45,26 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		}
 	}
 
+	for addr, offenses := range data.DowntimeOffenses {
+		address, err := sdk.ConsAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		for _, offense := range offenses {
+			keeper.SetDowntimeOffense(ctx, address, offense)
+		}
+	}
+
 	keeper.SetParams(ctx, data.Params)
+
+	// the pruning queue of the downtime offense histories is rebuilt from the
+	// signing infos
+	for addr := range data.DowntimeOffenses {
+		address, _ := sdk.ConsAddressFromBech32(addr)
+		if info, found := keeper.GetValidatorSigningInfo(ctx, address); found {
+			keeper.ScheduleDowntimeOffensesPruning(ctx, address, info)
+		}
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -45,6 +74,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data types.GenesisSta
 	params := keeper.GetParams(ctx)
 	signingInfos := make(map[string]types.ValidatorSigningInfo)
 	missedBlocks := make(map[string][]types.MissedBlock)
+	downtimeOffenses := make(map[string][]types.DowntimeOffense)
 	keeper.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
 		bechAddr := address.String()
 		signingInfos[bechAddr] = info
@@ -56,8 +86,12 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data types.GenesisSta
 		})
 		missedBlocks[bechAddr] = localMissedBlocks
 
+		if offenses := keeper.GetDowntimeOffenses(ctx, address); len(offenses) > 0 {
+			downtimeOffenses[bechAddr] = offenses
+		}
+
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	return types.NewGenesisState(params, signingInfos, missedBlocks, downtimeOffenses)
 }
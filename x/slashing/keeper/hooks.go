@@ -36,6 +36,41 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
 }
 
+// When a validator rotates its consensus pubkey, add the new address-pubkey
+// relation and carry the signing info over to the new consensus address. The
+// signing info of the old address is kept so that evidence of infractions
+// committed with the old pubkey can still be handled.
+func (k Keeper) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	validator := k.sk.Validator(ctx, valAddr)
+	k.AddPubkey(ctx, validator.GetConsPubKey())
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return
+	}
+
+	signingInfo.Address = newConsAddr
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+
+	k.IterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) (stop bool) {
+		k.SetValidatorMissedBlockBitArray(ctx, newConsAddr, index, missed)
+		return false
+	})
+
+	for _, offense := range k.GetDowntimeOffenses(ctx, oldConsAddr) {
+		k.SetDowntimeOffense(ctx, newConsAddr, offense)
+	}
+	k.ScheduleDowntimeOffensesPruning(ctx, newConsAddr, signingInfo)
+}
+
+// When a consensus pubkey rotation is pruned, delete the address-pubkey
+// relation of the old pubkey, unless it was claimed again by a validator.
+func (k Keeper) AfterConsPubKeyRotationPruned(ctx sdk.Context, oldConsAddr sdk.ConsAddress) {
+	if k.sk.ValidatorByConsAddr(ctx, oldConsAddr) == nil {
+		k.deleteAddrPubkeyRelation(ctx, crypto.Address(oldConsAddr))
+	}
+}
+
 //_________________________________________________________________________________________
 
 // Hooks wrapper struct for slashing keeper
@@ -65,6 +100,16 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.AfterConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+}
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsPubKeyRotationPruned(ctx sdk.Context, oldConsAddr sdk.ConsAddress, _ sdk.ValAddress) {
+	h.k.AfterConsPubKeyRotationPruned(ctx, oldConsAddr)
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
 func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
@@ -72,3 +117,4 @@ func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
+func (h Hooks) BeforeTokenizeShareRecordRemoved(_ sdk.Context, _ uint64) error                   { return nil }
@@ -82,6 +82,14 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
+			// Repeat offenders get escalated penalties. Recent offenses are
+			// forgiven over time, so the count is decayed before adding this one.
+			params := k.GetParams(ctx)
+			signInfo.DecayDowntimeOffenses(ctx.BlockHeader().Time, params.DowntimeOffenseDecayPeriod)
+			signInfo.DowntimeOffenses++
+			signInfo.DowntimeOffensesUpdated = ctx.BlockHeader().Time
+			jailDuration, slashFraction := params.DowntimePenalties(signInfo.DowntimeOffenses)
+
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -89,12 +97,20 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyOffenseCount, fmt.Sprintf("%d", signInfo.DowntimeOffenses)),
+					sdk.NewAttribute(types.AttributeKeyFraction, slashFraction.String()),
+					sdk.NewAttribute(types.AttributeKeyJailUntil, ctx.BlockHeader().Time.Add(jailDuration).String()),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+			k.SetDowntimeOffense(ctx, consAddr, types.NewDowntimeOffense(
+				height, ctx.BlockHeader().Time, signInfo.DowntimeOffenses, slashFraction, jailDuration,
+			))
+			k.pruneDowntimeOffenses(ctx, consAddr, signInfo.DowntimeOffenses)
+			k.ScheduleDowntimeOffensesPruning(ctx, consAddr, signInfo)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
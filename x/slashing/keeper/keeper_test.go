@@ -318,3 +318,158 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test a validator being down twice in a row
+// Ensure that the penalties of the second offense are escalated
+func TestHandleRepeatDowntime(t *testing.T) {
+	// initial setup
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(0, 0).UTC()})
+	power := int64(100)
+
+	amt := sdk.TokensFromConsensusPower(power)
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	sh := staking.NewHandler(app.StakingKeeper)
+	res, err := sh(ctx, keeper.NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	maxMissed := window - app.SlashingKeeper.MinSignedPerWindow(ctx)
+
+	// goDown signs a full window and then misses enough blocks to be jailed
+	height := int64(0)
+	goDown := func() {
+		info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+
+		for end := info.StartHeight + window; height <= end; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+		}
+		for end := height + maxMissed; height <= end; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+
+		staking.EndBlocker(ctx, app.StakingKeeper)
+	}
+
+	// first offense is penalized with the base penalties
+	goDown()
+
+	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, validator.IsJailed())
+	tokens := amt.Sub(sdk.TokensFromConsensusPower(1))
+	require.Equal(t, tokens, validator.GetTokens())
+
+	info, _ := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, uint64(1), info.DowntimeOffenses)
+	require.Equal(t, ctx.BlockHeader().Time.Add(params.DowntimeJailDuration), info.JailedUntil)
+
+	// unjail and rebond the validator
+	ctx = ctx.WithBlockTime(info.JailedUntil)
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// second offense doubles the penalties
+	goDown()
+
+	validator, _ = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, validator.IsJailed())
+	tokens = tokens.Sub(sdk.TokensFromConsensusPower(2))
+	require.Equal(t, tokens, validator.GetTokens())
+
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, uint64(2), info.DowntimeOffenses)
+	require.Equal(t, ctx.BlockHeader().Time.Add(2*params.DowntimeJailDuration), info.JailedUntil)
+
+	offenses := app.SlashingKeeper.GetDowntimeOffenses(ctx, consAddr)
+	require.Len(t, offenses, 2)
+	require.Equal(t, uint64(1), offenses[0].OffenseCount)
+	require.Equal(t, params.SlashFractionDowntime, offenses[0].SlashFraction)
+	require.Equal(t, uint64(2), offenses[1].OffenseCount)
+	require.Equal(t, params.SlashFractionDowntime.MulInt64(2), offenses[1].SlashFraction)
+	require.Equal(t, 2*params.DowntimeJailDuration, offenses[1].JailDuration)
+
+	// a clean decay period forgives the last offense
+	ctx = ctx.WithBlockTime(info.DowntimeOffensesUpdated.Add(params.DowntimeOffenseDecayPeriod))
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	goDown()
+
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, uint64(2), info.DowntimeOffenses)
+	require.Equal(t, ctx.BlockHeader().Time.Add(2*params.DowntimeJailDuration), info.JailedUntil)
+}
+
+// Test that the signing info follows a validator rotating its consensus pubkey
+// while the old consensus address can still be handled
+func TestHandleRotatedConsPubKey(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	addr, oldPk, newPk := valAddrs[0], pks[0], pks[1]
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPk.Address()), sdk.ConsAddress(newPk.Address())
+
+	power := int64(100)
+	sh := staking.NewHandler(app.StakingKeeper)
+	_, err := sh(ctx, keeper.NewTestMsgCreateValidator(addr, oldPk, sdk.TokensFromConsensusPower(power)))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// miss a block with the old pubkey
+	ctx = ctx.WithBlockHeight(1)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), power, false)
+
+	_, err = sh(ctx, stakingtypes.NewMsgRotateConsPubKey(addr, newPk))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the signing info is carried over to the new consensus address
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr, info.Address)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, info.IndexOffset-1))
+
+	pk, err := app.SlashingKeeper.GetPubkey(ctx, newPk.Address())
+	require.NoError(t, err)
+	require.Equal(t, newPk, pk)
+
+	// signatures of both pubkeys are handled during the transition
+	ctx = ctx.WithBlockHeight(2)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), power, true)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, newPk.Address(), power, true)
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, oldConsAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), info.IndexOffset)
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), info.IndexOffset)
+
+	// the old address-pubkey relation is removed once the rotation is pruned
+	_, err = app.SlashingKeeper.GetPubkey(ctx, oldPk.Address())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	_, err = app.SlashingKeeper.GetPubkey(ctx, oldPk.Address())
+	require.Error(t, err)
+	_, err = app.SlashingKeeper.GetPubkey(ctx, newPk.Address())
+	require.NoError(t, err)
+}
@@ -42,6 +42,36 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeJailDurationMultiplier - multiplier of the jail duration of repeat downtime offenses
+func (k Keeper) DowntimeJailDurationMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailDurationMultiplier, &res)
+	return
+}
+
+// MaxDowntimeJailDuration - maximum jail duration of repeat downtime offenses
+func (k Keeper) MaxDowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyMaxDowntimeJailDuration, &res)
+	return
+}
+
+// SlashFractionDowntimeMultiplier - multiplier of the slash fraction of repeat downtime offenses
+func (k Keeper) SlashFractionDowntimeMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeySlashFractionDowntimeMultiplier, &res)
+	return
+}
+
+// MaxSlashFractionDowntime - maximum slash fraction of repeat downtime offenses
+func (k Keeper) MaxSlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyMaxSlashFractionDowntime, &res)
+	return
+}
+
+// DowntimeOffenseDecayPeriod - period without downtime offense after which an offense is forgiven
+func (k Keeper) DowntimeOffenseDecayPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeOffenseDecayPeriod, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
@@ -52,3 +82,27 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramspace.SetParamSet(ctx, &params)
 }
+
+// MigrateParams sets the parameters escalating the penalties of repeat downtime
+// offenses to their default value if they are missing from the param space, as
+// on chains upgrading from a version without them. GetParams panics until they
+// are set, so it must be called by the upgrade handler of such chains.
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	params := []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.KeyDowntimeJailDurationMultiplier, defaults.DowntimeJailDurationMultiplier},
+		{types.KeyMaxDowntimeJailDuration, defaults.MaxDowntimeJailDuration},
+		{types.KeySlashFractionDowntimeMultiplier, defaults.SlashFractionDowntimeMultiplier},
+		{types.KeyMaxSlashFractionDowntime, defaults.MaxSlashFractionDowntime},
+		{types.KeyDowntimeOffenseDecayPeriod, defaults.DowntimeOffenseDecayPeriod},
+	}
+
+	for _, param := range params {
+		if !k.paramspace.Has(ctx, param.key) {
+			k.paramspace.Set(ctx, param.key, param.value)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 1000
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	// remove the params added for repeat downtime offenses, as on a chain
+	// upgrading from a version without them
+	store := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	for _, key := range [][]byte{
+		types.KeyDowntimeJailDurationMultiplier, types.KeyMaxDowntimeJailDuration,
+		types.KeySlashFractionDowntimeMultiplier, types.KeyMaxSlashFractionDowntime,
+		types.KeyDowntimeOffenseDecayPeriod,
+	} {
+		store.Delete(append([]byte(types.ModuleName+"/"), key...))
+	}
+	require.Panics(t, func() { app.SlashingKeeper.GetParams(ctx) })
+
+	// the missing params are set to their default value, the others are kept
+	app.SlashingKeeper.MigrateParams(ctx)
+	params = app.SlashingKeeper.GetParams(ctx)
+	require.Equal(t, int64(1000), params.SignedBlocksWindow)
+	require.Equal(t, types.DefaultDowntimeJailDurationMultiplier, params.DowntimeJailDurationMultiplier)
+	require.Equal(t, types.DefaultMaxDowntimeJailDuration, params.MaxDowntimeJailDuration)
+	require.Equal(t, types.DefaultSlashFractionDowntimeMultiplier, params.SlashFractionDowntimeMultiplier)
+	require.Equal(t, types.DefaultMaxSlashFractionDowntime, params.MaxSlashFractionDowntime)
+	require.Equal(t, types.DefaultDowntimeOffenseDecayPeriod, params.DowntimeOffenseDecayPeriod)
+}
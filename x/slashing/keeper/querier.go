@@ -23,6 +23,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QuerySigningInfos:
 			return querySigningInfos(ctx, req, k)
 
+		case types.QueryOffenses:
+			return queryOffenses(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -90,3 +93,28 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 
 	return res, nil
 }
+
+func queryOffenses(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySigningInfoParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, params.ConsAddress)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoSigningInfoFound, params.ConsAddress.String())
+	}
+
+	// the stored count is only decayed when the next offense is handled
+	active := signingInfo.DecayDowntimeOffenses(ctx.BlockTime(), k.DowntimeOffenseDecayPeriod(ctx))
+	history := types.NewOffenseHistory(params.ConsAddress, active, k.GetDowntimeOffenses(ctx, params.ConsAddress))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, history)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -51,3 +53,48 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, app.SlashingKeeper.GetParams(ctx), params)
 }
+
+func TestQueryOffenses(t *testing.T) {
+	cdc := codec.New()
+	app := simapp.Setup(false)
+	now := time.Unix(100000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+	app.SlashingKeeper.SetParams(ctx, keeper.TestParams())
+	decayPeriod := app.SlashingKeeper.DowntimeOffenseDecayPeriod(ctx)
+
+	querier := keeper.NewQuerier(app.SlashingKeeper)
+	consAddr := sdk.ConsAddress(simapp.CreateTestPubKeys(1)[0].Address())
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: cdc.MustMarshalJSON(types.NewQuerySigningInfoParams(consAddr)),
+	}
+
+	// unknown validator
+	_, err := querier(ctx, []string{types.QueryOffenses}, query)
+	require.Error(t, err)
+
+	// two offenses, the oldest of which has been forgiven since
+	first := types.NewDowntimeOffense(10, now.Add(-2*decayPeriod), 1, sdk.NewDecWithPrec(1, 2), time.Hour)
+	second := types.NewDowntimeOffense(20, now.Add(-decayPeriod/2), 2, sdk.NewDecWithPrec(2, 2), 2*time.Hour)
+	app.SlashingKeeper.SetDowntimeOffense(ctx, consAddr, first)
+	app.SlashingKeeper.SetDowntimeOffense(ctx, consAddr, second)
+
+	info := types.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0)
+	info.DowntimeOffenses = 2
+	info.DowntimeOffensesUpdated = second.Time.Add(-decayPeriod)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	res, err := querier(ctx, []string{types.QueryOffenses}, query)
+	require.NoError(t, err)
+
+	var history types.OffenseHistory
+	require.NoError(t, cdc.UnmarshalJSON(res, &history))
+	require.Equal(t, consAddr, history.Address)
+	require.Equal(t, uint64(1), history.ActiveOffenses)
+	require.Equal(t, []types.DowntimeOffense{first, second}, history.Offenses)
+
+	// querying does not decay the stored offenses
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, uint64(2), info.DowntimeOffenses)
+}
@@ -145,3 +145,97 @@ func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.C
 		store.Delete(iter.Key())
 	}
 }
+
+// SetDowntimeOffense records a downtime offense in the validator's offense history
+func (k Keeper) SetDowntimeOffense(ctx sdk.Context, address sdk.ConsAddress, offense types.DowntimeOffense) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&offense)
+	store.Set(types.DowntimeOffenseKey(address, offense.Height), bz)
+}
+
+// IterateDowntimeOffenses iterates over the downtime offense history of a
+// validator, from the oldest to the most recent offense
+func (k Keeper) IterateDowntimeOffenses(ctx sdk.Context,
+	address sdk.ConsAddress, handler func(offense types.DowntimeOffense) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeOffensePrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var offense types.DowntimeOffense
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &offense)
+		if handler(offense) {
+			break
+		}
+	}
+}
+
+// GetDowntimeOffenses returns the downtime offense history of a validator
+func (k Keeper) GetDowntimeOffenses(ctx sdk.Context, address sdk.ConsAddress) []types.DowntimeOffense {
+	offenses := []types.DowntimeOffense{}
+	k.IterateDowntimeOffenses(ctx, address, func(offense types.DowntimeOffense) (stop bool) {
+		offenses = append(offenses, offense)
+		return false
+	})
+
+	return offenses
+}
+
+// pruneDowntimeOffenses deletes the oldest offenses of the downtime offense
+// history of a validator, keeping at most the given number of offenses
+func (k Keeper) pruneDowntimeOffenses(ctx sdk.Context, address sdk.ConsAddress, keep uint64) {
+	offenses := k.GetDowntimeOffenses(ctx, address)
+	if uint64(len(offenses)) <= keep {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, offense := range offenses[:uint64(len(offenses))-keep] {
+		store.Delete(types.DowntimeOffenseKey(address, offense.Height))
+	}
+}
+
+// ScheduleDowntimeOffensesPruning queues the downtime offense history of a
+// validator for pruning at the time all its recent offenses are forgiven
+func (k Keeper) ScheduleDowntimeOffensesPruning(ctx sdk.Context, address sdk.ConsAddress, info types.ValidatorSigningInfo) {
+	if info.DowntimeOffenses == 0 {
+		return
+	}
+
+	decayPeriod := k.DowntimeOffenseDecayPeriod(ctx)
+	forgiven := info.DowntimeOffensesUpdated.Add(time.Duration(info.DowntimeOffenses) * decayPeriod)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DowntimeOffenseQueueKey(forgiven, address), []byte{})
+}
+
+// PruneDowntimeOffenses prunes the downtime offense histories queued up to the
+// block time, keeping only the offenses which are not forgiven yet. The
+// histories with offenses left are queued again.
+func (k Keeper) PruneDowntimeOffenses(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.DowntimeOffenseQueueTimeKey(ctx.BlockTime()))
+
+	var keys [][]byte
+	iter := store.Iterator(types.DowntimeOffenseQueueKeyPrefix, end)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	decayPeriod := k.DowntimeOffenseDecayPeriod(ctx)
+	for _, key := range keys {
+		store.Delete(key)
+
+		address := types.DowntimeOffenseQueueAddress(key)
+		info, found := k.GetValidatorSigningInfo(ctx, address)
+		if !found {
+			k.pruneDowntimeOffenses(ctx, address, 0)
+			continue
+		}
+
+		active := info.DecayDowntimeOffenses(ctx.BlockTime(), decayPeriod)
+		k.pruneDowntimeOffenses(ctx, address, active)
+		k.ScheduleDowntimeOffensesPruning(ctx, address, info)
+	}
+}
@@ -95,3 +95,39 @@ func TestJailUntil(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, time.Unix(253402300799, 0).UTC(), info.JailedUntil)
 }
+
+func TestPruneDowntimeOffenses(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Unix(100000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+	consAddr := sdk.ConsAddress(simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))[0])
+	decayPeriod := app.SlashingKeeper.DowntimeOffenseDecayPeriod(ctx)
+
+	info := types.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0)
+	info.DowntimeOffenses = 2
+	info.DowntimeOffensesUpdated = now
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	for height := int64(1); height <= 3; height++ {
+		app.SlashingKeeper.SetDowntimeOffense(ctx, consAddr, types.NewDowntimeOffense(height, now, uint64(height), sdk.ZeroDec(), 0))
+	}
+	app.SlashingKeeper.ScheduleDowntimeOffensesPruning(ctx, consAddr, info)
+
+	// a new offense is recorded before the offenses are forgiven
+	info.DowntimeOffenses = 3
+	info.DowntimeOffensesUpdated = now.Add(decayPeriod / 3)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	// nothing is pruned before the queued time
+	app.SlashingKeeper.PruneDowntimeOffenses(ctx.WithBlockTime(now.Add(2*decayPeriod - time.Second)))
+	require.Len(t, app.SlashingKeeper.GetDowntimeOffenses(ctx, consAddr), 3)
+
+	// the offenses which are still counted are kept
+	app.SlashingKeeper.PruneDowntimeOffenses(ctx.WithBlockTime(now.Add(2 * decayPeriod)))
+	offenses := app.SlashingKeeper.GetDowntimeOffenses(ctx, consAddr)
+	require.Len(t, offenses, 2)
+	require.Equal(t, int64(2), offenses[0].Height)
+
+	// the history is empty once all the offenses are forgiven
+	app.SlashingKeeper.PruneDowntimeOffenses(ctx.WithBlockTime(now.Add(4*decayPeriod + decayPeriod/3)))
+	require.Empty(t, app.SlashingKeeper.GetDowntimeOffenses(ctx, consAddr))
+}
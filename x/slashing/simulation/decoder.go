@@ -34,6 +34,15 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &pubKeyB)
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA.Value, pubKeyB.Value)
 
+		case bytes.Equal(kvA.Key[:1], types.DowntimeOffenseKeyPrefix):
+			var offenseA, offenseB types.DowntimeOffense
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &offenseA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &offenseB)
+			return fmt.Sprintf("%v\n%v", offenseA, offenseB)
+
+		case bytes.Equal(kvA.Key[:1], types.DowntimeOffenseQueueKeyPrefix):
+			return fmt.Sprintf("%v\n%v", types.DowntimeOffenseQueueAddress(kvA.Key), types.DowntimeOffenseQueueAddress(kvB.Key))
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
@@ -33,11 +33,13 @@ func TestDecodeStore(t *testing.T) {
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	bechPK := sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, delPk1)
 	missed := gogotypes.BoolValue{Value: true}
+	offense := types.NewDowntimeOffense(10, time.Now().UTC(), 2, sdk.NewDecWithPrec(2, 2), time.Hour)
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshalBinaryBare(&info)},
 		tmkv.Pair{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshalBinaryBare(&missed)},
 		tmkv.Pair{Key: types.AddrPubkeyRelationKey(delAddr1), Value: cdc.MustMarshalBinaryBare(&gogotypes.StringValue{Value: bechPK})},
+		tmkv.Pair{Key: types.DowntimeOffenseKey(consAddr1, 10), Value: cdc.MustMarshalBinaryBare(&offense)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -48,6 +50,7 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info)},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value)},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPK, bechPK)},
+		{"DowntimeOffense", fmt.Sprintf("%v\n%v", offense, offense)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -22,6 +22,12 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeJailDurationMultiplier  = "downtime_jail_duration_multiplier"
+	MaxDowntimeJailDuration         = "max_downtime_jail_duration"
+	SlashFractionDowntimeMultiplier = "slash_fraction_downtime_multiplier"
+	MaxSlashFractionDowntime        = "max_slash_fraction_downtime"
+	DowntimeOffenseDecayPeriod      = "downtime_offense_decay_period"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +55,27 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeMultiplier randomized DowntimeJailDurationMultiplier and
+// SlashFractionDowntimeMultiplier
+func GenDowntimeMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(31)), 1))
+}
+
+// GenMaxDowntimeJailDuration randomized MaxDowntimeJailDuration
+func GenMaxDowntimeJailDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60*24, 60*60*24*30)) * time.Second
+}
+
+// GenMaxSlashFractionDowntime randomized MaxSlashFractionDowntime
+func GenMaxSlashFractionDowntime(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(20) + 1)))
+}
+
+// GenDowntimeOffenseDecayPeriod randomized DowntimeOffenseDecayPeriod
+func GenDowntimeOffenseDecayPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60, 60*60*24*60)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,12 +108,44 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeJailDurationMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailDurationMultiplier, &downtimeJailDurationMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeJailDurationMultiplier = GenDowntimeMultiplier(r) },
+	)
+
+	var maxDowntimeJailDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxDowntimeJailDuration, &maxDowntimeJailDuration, simState.Rand,
+		func(r *rand.Rand) { maxDowntimeJailDuration = GenMaxDowntimeJailDuration(r) },
+	)
+
+	var slashFractionDowntimeMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionDowntimeMultiplier, &slashFractionDowntimeMultiplier, simState.Rand,
+		func(r *rand.Rand) { slashFractionDowntimeMultiplier = GenDowntimeMultiplier(r) },
+	)
+
+	var maxSlashFractionDowntime sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSlashFractionDowntime, &maxSlashFractionDowntime, simState.Rand,
+		func(r *rand.Rand) { maxSlashFractionDowntime = GenMaxSlashFractionDowntime(r) },
+	)
+
+	var downtimeOffenseDecayPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeOffenseDecayPeriod, &downtimeOffenseDecayPeriod, simState.Rand,
+		func(r *rand.Rand) { downtimeOffenseDecayPeriod = GenDowntimeOffenseDecayPeriod(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		downtimeJailDurationMultiplier, maxDowntimeJailDuration,
+		slashFractionDowntimeMultiplier, maxSlashFractionDowntime, downtimeOffenseDecayPeriod,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, nil, nil)
+	slashingGenesis := types.NewGenesisState(params, nil, nil, nil)
 
 	fmt.Printf("Selected randomly generated slashing parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, slashingGenesis.Params))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(slashingGenesis)
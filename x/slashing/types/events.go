@@ -12,6 +12,9 @@ const (
 	AttributeKeyReason       = "reason"
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyOffenseCount = "offense_count"
+	AttributeKeyFraction     = "slash_fraction"
+	AttributeKeyJailUntil    = "jailed_until"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
@@ -12,17 +12,21 @@ type GenesisState struct {
 	Params       Params                          `json:"params" yaml:"params"`
 	SigningInfos map[string]ValidatorSigningInfo `json:"signing_infos" yaml:"signing_infos"`
 	MissedBlocks map[string][]MissedBlock        `json:"missed_blocks" yaml:"missed_blocks"`
+	// DowntimeOffenses holds the downtime offense history of each validator
+	DowntimeOffenses map[string][]DowntimeOffense `json:"downtime_offenses" yaml:"downtime_offenses"`
 }
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos map[string]ValidatorSigningInfo, missedBlocks map[string][]MissedBlock,
+	downtimeOffenses map[string][]DowntimeOffense,
 ) GenesisState {
 
 	return GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:           params,
+		SigningInfos:     signingInfos,
+		MissedBlocks:     missedBlocks,
+		DowntimeOffenses: downtimeOffenses,
 	}
 }
 
@@ -43,9 +47,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: make(map[string]ValidatorSigningInfo),
-		MissedBlocks: make(map[string][]MissedBlock),
+		Params:           DefaultParams(),
+		SigningInfos:     make(map[string]ValidatorSigningInfo),
+		MissedBlocks:     make(map[string][]MissedBlock),
+		DowntimeOffenses: make(map[string][]DowntimeOffense),
 	}
 }
 
@@ -76,5 +81,27 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateDowntimeMultiplier(data.Params.DowntimeJailDurationMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeMultiplier(data.Params.SlashFractionDowntimeMultiplier); err != nil {
+		return err
+	}
+
+	maxDowntimeJail := data.Params.MaxDowntimeJailDuration
+	if maxDowntimeJail < downtimeJail {
+		return fmt.Errorf("max downtime jail duration must be at least the downtime jail duration %s, is %s", downtimeJail, maxDowntimeJail)
+	}
+
+	maxDowntime := data.Params.MaxSlashFractionDowntime
+	if maxDowntime.LT(downtime) || maxDowntime.GT(sdk.OneDec()) {
+		return fmt.Errorf("max slashing fraction downtime should be less than or equal to one and at least the slashing fraction downtime, is %s", maxDowntime)
+	}
+
+	if err := validateDowntimeOffenseDecayPeriod(data.Params.DowntimeOffenseDecayPeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
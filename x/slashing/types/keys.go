@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -28,10 +29,16 @@ const (
 // - 0x02<consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddr_Bytes>: crypto.PubKey
+//
+// - 0x04<consAddress_Bytes><height_Bytes>: DowntimeOffense
+//
+// - 0x05<time_Bytes><consAddress_Bytes>: []byte{}
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	DowntimeOffenseKeyPrefix              = []byte{0x04} // Prefix for downtime offense history
+	DowntimeOffenseQueueKeyPrefix         = []byte{0x05} // Prefix for the downtime offense history pruning queue
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -64,3 +71,34 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address...)
 }
+
+// DowntimeOffensePrefixKey - stored by *Consensus* address (not operator address)
+func DowntimeOffensePrefixKey(v sdk.ConsAddress) []byte {
+	return append(DowntimeOffenseKeyPrefix, v.Bytes()...)
+}
+
+// DowntimeOffenseKey - stored by *Consensus* address (not operator address)
+func DowntimeOffenseKey(v sdk.ConsAddress, height int64) []byte {
+	return append(DowntimeOffensePrefixKey(v), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// DowntimeOffenseAddress - extract the address from a downtime offense key
+func DowntimeOffenseAddress(key []byte) (v sdk.ConsAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	return sdk.ConsAddress(addr)
+}
+
+// DowntimeOffenseQueueTimeKey - prefix of the downtime offense histories to prune at the given time
+func DowntimeOffenseQueueTimeKey(t time.Time) []byte {
+	return append(DowntimeOffenseQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
+
+// DowntimeOffenseQueueKey - stored by *Consensus* address (not operator address)
+func DowntimeOffenseQueueKey(t time.Time, v sdk.ConsAddress) []byte {
+	return append(DowntimeOffenseQueueTimeKey(t), v.Bytes()...)
+}
+
+// DowntimeOffenseQueueAddress - extract the address from a downtime offense queue key
+func DowntimeOffenseQueueAddress(key []byte) (v sdk.ConsAddress) {
+	return sdk.ConsAddress(key[len(key)-sdk.AddrLen:])
+}
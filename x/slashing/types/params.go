@@ -10,14 +10,20 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow         = int64(100)
+	DefaultDowntimeJailDuration       = 60 * 10 * time.Second
+	DefaultMaxDowntimeJailDuration    = 60 * 60 * 24 * 7 * time.Second
+	DefaultDowntimeOffenseDecayPeriod = 60 * 60 * 24 * 30 * time.Second
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+
+	DefaultDowntimeJailDurationMultiplier  = sdk.NewDec(2)
+	DefaultSlashFractionDowntimeMultiplier = sdk.NewDec(2)
+	DefaultMaxSlashFractionDowntime        = sdk.NewDec(5).Quo(sdk.NewDec(100))
 )
 
 // Parameter store keys
@@ -27,6 +33,12 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyDowntimeJailDurationMultiplier  = []byte("DowntimeJailDurationMultiplier")
+	KeyMaxDowntimeJailDuration         = []byte("MaxDowntimeJailDuration")
+	KeySlashFractionDowntimeMultiplier = []byte("SlashFractionDowntimeMultiplier")
+	KeyMaxSlashFractionDowntime        = []byte("MaxSlashFractionDowntime")
+	KeyDowntimeOffenseDecayPeriod      = []byte("DowntimeOffenseDecayPeriod")
 )
 
 // ParamKeyTable for slashing module
@@ -41,20 +53,36 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+
+	// Repeat downtime offenses multiply the downtime jail duration and slash
+	// fraction of the previous offense, up to a maximum. An offense is
+	// forgiven for every decay period elapsed without a new offense.
+	DowntimeJailDurationMultiplier  sdk.Dec       `json:"downtime_jail_duration_multiplier" yaml:"downtime_jail_duration_multiplier"`
+	MaxDowntimeJailDuration         time.Duration `json:"max_downtime_jail_duration" yaml:"max_downtime_jail_duration"`
+	SlashFractionDowntimeMultiplier sdk.Dec       `json:"slash_fraction_downtime_multiplier" yaml:"slash_fraction_downtime_multiplier"`
+	MaxSlashFractionDowntime        sdk.Dec       `json:"max_slash_fraction_downtime" yaml:"max_slash_fraction_downtime"`
+	DowntimeOffenseDecayPeriod      time.Duration `json:"downtime_offense_decay_period" yaml:"downtime_offense_decay_period"`
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	downtimeJailDurationMultiplier sdk.Dec, maxDowntimeJailDuration time.Duration,
+	slashFractionDowntimeMultiplier, maxSlashFractionDowntime sdk.Dec, downtimeOffenseDecayPeriod time.Duration,
 ) Params {
 
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:              signedBlocksWindow,
+		MinSignedPerWindow:              minSignedPerWindow,
+		DowntimeJailDuration:            downtimeJailDuration,
+		SlashFractionDoubleSign:         slashFractionDoubleSign,
+		SlashFractionDowntime:           slashFractionDowntime,
+		DowntimeJailDurationMultiplier:  downtimeJailDurationMultiplier,
+		MaxDowntimeJailDuration:         maxDowntimeJailDuration,
+		SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+		MaxSlashFractionDowntime:        maxSlashFractionDowntime,
+		DowntimeOffenseDecayPeriod:      downtimeOffenseDecayPeriod,
 	}
 }
 
@@ -65,10 +93,17 @@ func (p Params) String() string {
   MinSignedPerWindow:      %s
   DowntimeJailDuration:    %s
   SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`,
+  SlashFractionDowntime:   %s
+  DowntimeJailDurationMultiplier:  %s
+  MaxDowntimeJailDuration:         %s
+  SlashFractionDowntimeMultiplier: %s
+  MaxSlashFractionDowntime:        %s
+  DowntimeOffenseDecayPeriod:      %s`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		p.SlashFractionDowntime, p.DowntimeJailDurationMultiplier,
+		p.MaxDowntimeJailDuration, p.SlashFractionDowntimeMultiplier,
+		p.MaxSlashFractionDowntime, p.DowntimeOffenseDecayPeriod)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -79,6 +114,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeJailDurationMultiplier, &p.DowntimeJailDurationMultiplier, validateDowntimeMultiplier),
+		paramtypes.NewParamSetPair(KeyMaxDowntimeJailDuration, &p.MaxDowntimeJailDuration, validateDowntimeJailDuration),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntimeMultiplier, &p.SlashFractionDowntimeMultiplier, validateDowntimeMultiplier),
+		paramtypes.NewParamSetPair(KeyMaxSlashFractionDowntime, &p.MaxSlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeOffenseDecayPeriod, &p.DowntimeOffenseDecayPeriod, validateDowntimeOffenseDecayPeriod),
 	}
 }
 
@@ -87,9 +127,45 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultDowntimeJailDurationMultiplier, DefaultMaxDowntimeJailDuration,
+		DefaultSlashFractionDowntimeMultiplier, DefaultMaxSlashFractionDowntime, DefaultDowntimeOffenseDecayPeriod,
 	)
 }
 
+// DowntimePenalties returns the jail duration and slash fraction of a downtime
+// offense, given the number of recent downtime offenses including this one.
+// The first offense is penalized with DowntimeJailDuration and
+// SlashFractionDowntime, and every repeat offense multiplies the penalties of
+// the previous one, without exceeding the configured maximums.
+func (p Params) DowntimePenalties(offenses uint64) (jailDuration time.Duration, slashFraction sdk.Dec) {
+	jailDuration = p.DowntimeJailDuration
+	slashFraction = p.SlashFractionDowntime
+
+	// the maximums never lower the penalties of a first offense
+	maxJailDuration := p.MaxDowntimeJailDuration
+	if maxJailDuration < jailDuration {
+		maxJailDuration = jailDuration
+	}
+	maxSlashFraction := p.MaxSlashFractionDowntime
+	if maxSlashFraction.LT(slashFraction) {
+		maxSlashFraction = slashFraction
+	}
+
+	jail := sdk.NewDec(int64(jailDuration))
+	maxJail := sdk.NewDec(int64(maxJailDuration))
+
+	for i := uint64(1); i < offenses; i++ {
+		if jail.GTE(maxJail) && slashFraction.GTE(maxSlashFraction) {
+			break
+		}
+
+		jail = sdk.MinDec(jail.Mul(p.DowntimeJailDurationMultiplier), maxJail)
+		slashFraction = sdk.MinDec(slashFraction.Mul(p.SlashFractionDowntimeMultiplier), maxSlashFraction)
+	}
+
+	return time.Duration(jail.TruncateInt64()), slashFraction
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -163,3 +239,29 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime multiplier cannot be lower than one: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeOffenseDecayPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("downtime offense decay period must be positive: %s", v)
+	}
+
+	return nil
+}
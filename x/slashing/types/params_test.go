@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDowntimePenalties(t *testing.T) {
+	params := DefaultParams()
+	params.DowntimeJailDuration = time.Hour
+	params.MaxDowntimeJailDuration = 5 * time.Hour
+	params.SlashFractionDowntime = sdk.NewDecWithPrec(1, 2)
+	params.MaxSlashFractionDowntime = sdk.NewDecWithPrec(3, 2)
+	params.DowntimeJailDurationMultiplier = sdk.NewDec(2)
+	params.SlashFractionDowntimeMultiplier = sdk.NewDecWithPrec(15, 1)
+
+	tests := []struct {
+		offenses      uint64
+		jailDuration  time.Duration
+		slashFraction sdk.Dec
+	}{
+		{0, time.Hour, sdk.NewDecWithPrec(1, 2)},
+		{1, time.Hour, sdk.NewDecWithPrec(1, 2)},
+		{2, 2 * time.Hour, sdk.NewDecWithPrec(15, 3)},
+		{3, 4 * time.Hour, sdk.NewDecWithPrec(225, 4)},
+		{4, 5 * time.Hour, sdk.NewDecWithPrec(3, 2)},
+		{1000, 5 * time.Hour, sdk.NewDecWithPrec(3, 2)},
+	}
+
+	for _, tc := range tests {
+		jailDuration, slashFraction := params.DowntimePenalties(tc.offenses)
+		require.Equal(t, tc.jailDuration, jailDuration, "offenses: %d", tc.offenses)
+		require.Equal(t, tc.slashFraction, slashFraction, "offenses: %d", tc.offenses)
+	}
+
+	// maximums lower than the base penalties do not lower them
+	params.MaxDowntimeJailDuration = time.Minute
+	params.MaxSlashFractionDowntime = sdk.ZeroDec()
+	jailDuration, slashFraction := params.DowntimePenalties(3)
+	require.Equal(t, time.Hour, jailDuration)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), slashFraction)
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,10 +14,12 @@ const (
 	QueryParameters   = "parameters"
 	QuerySigningInfo  = "signingInfo"
 	QuerySigningInfos = "signingInfos"
+	QueryOffenses     = "offenses"
 )
 
 // QuerySigningInfoParams defines the params for the following queries:
 // - 'custom/slashing/signingInfo'
+// - 'custom/slashing/offenses'
 type QuerySigningInfoParams struct {
 	ConsAddress sdk.ConsAddress
 }
@@ -34,3 +39,35 @@ type QuerySigningInfosParams struct {
 func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
 	return QuerySigningInfosParams{page, limit}
 }
+
+// OffenseHistory defines the downtime offense history of a validator
+type OffenseHistory struct {
+	Address sdk.ConsAddress `json:"address" yaml:"address"`
+	// number of downtime offenses not forgiven yet, which escalate the
+	// penalties of the next downtime offense
+	ActiveOffenses uint64            `json:"active_offenses" yaml:"active_offenses"`
+	Offenses       []DowntimeOffense `json:"offenses" yaml:"offenses"`
+}
+
+// NewOffenseHistory creates a new OffenseHistory instance
+func NewOffenseHistory(consAddr sdk.ConsAddress, activeOffenses uint64, offenses []DowntimeOffense) OffenseHistory {
+	return OffenseHistory{
+		Address:        consAddr,
+		ActiveOffenses: activeOffenses,
+		Offenses:       offenses,
+	}
+}
+
+// String implements the stringer interface for OffenseHistory
+func (h OffenseHistory) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `Offense History:
+  Address:         %s
+  Active Offenses: %d`, h.Address, h.ActiveOffenses)
+
+	for _, offense := range h.Offenses {
+		fmt.Fprintf(&b, "\n%s", offense)
+	}
+
+	return b.String()
+}
@@ -33,9 +33,63 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Offenses:     %d
+  Offenses Updated:      %v`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeOffenses,
+		i.DowntimeOffensesUpdated)
+}
+
+// DecayDowntimeOffenses forgives one downtime offense for every decay period
+// elapsed since the downtime offenses were last updated, and returns the
+// number of remaining offenses.
+func (i *ValidatorSigningInfo) DecayDowntimeOffenses(now time.Time, decayPeriod time.Duration) uint64 {
+	if i.DowntimeOffenses == 0 || decayPeriod <= 0 {
+		return i.DowntimeOffenses
+	}
+
+	elapsed := now.Sub(i.DowntimeOffensesUpdated)
+	if elapsed < decayPeriod {
+		return i.DowntimeOffenses
+	}
+
+	periods := uint64(elapsed / decayPeriod)
+	if periods >= i.DowntimeOffenses {
+		i.DowntimeOffenses = 0
+		i.DowntimeOffensesUpdated = now
+		return 0
+	}
+
+	// keep the remainder of the elapsed time towards the next decay
+	i.DowntimeOffenses -= periods
+	i.DowntimeOffensesUpdated = i.DowntimeOffensesUpdated.Add(time.Duration(periods) * decayPeriod)
+	return i.DowntimeOffenses
+}
+
+// NewDowntimeOffense creates a new DowntimeOffense instance
+func NewDowntimeOffense(
+	height int64, offenseTime time.Time, offenseCount uint64, slashFraction sdk.Dec, jailDuration time.Duration,
+) DowntimeOffense {
+
+	return DowntimeOffense{
+		Height:        height,
+		Time:          offenseTime,
+		OffenseCount:  offenseCount,
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+	}
+}
+
+// String implements the stringer interface for DowntimeOffense
+func (o DowntimeOffense) String() string {
+	return fmt.Sprintf(`Downtime Offense:
+  Height:         %d
+  Time:           %v
+  Offense Count:  %d
+  Slash Fraction: %s
+  Jail Duration:  %s`,
+		o.Height, o.Time, o.OffenseCount, o.SlashFraction, o.JailDuration)
 }
 
 // unmarshal a validator signing info from a store value
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecayDowntimeOffenses(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	decay := time.Hour
+
+	info := ValidatorSigningInfo{DowntimeOffenses: 3, DowntimeOffensesUpdated: start}
+
+	// nothing is forgiven before a full decay period
+	require.Equal(t, uint64(3), info.DecayDowntimeOffenses(start.Add(59*time.Minute), decay))
+	require.Equal(t, start, info.DowntimeOffensesUpdated)
+
+	// the remainder of the elapsed time counts towards the next decay
+	require.Equal(t, uint64(2), info.DecayDowntimeOffenses(start.Add(90*time.Minute), decay))
+	require.Equal(t, start.Add(time.Hour), info.DowntimeOffensesUpdated)
+	require.Equal(t, uint64(1), info.DecayDowntimeOffenses(start.Add(2*time.Hour), decay))
+
+	// offenses never decay below zero
+	require.Equal(t, uint64(0), info.DecayDowntimeOffenses(start.Add(10*time.Hour), decay))
+	require.Equal(t, start.Add(10*time.Hour), info.DowntimeOffensesUpdated)
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -87,6 +88,10 @@ type ValidatorSigningInfo struct {
 	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
 	// missed blocks counter (to avoid scanning the array every time)
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+	// number of recent downtime offenses, used to escalate the downtime penalties
+	DowntimeOffenses uint64 `protobuf:"varint,7,opt,name=downtime_offenses,json=downtimeOffenses,proto3" json:"downtime_offenses,omitempty" yaml:"downtime_offenses"`
+	// time of the last downtime offense, or of the last decay of the downtime offenses
+	DowntimeOffensesUpdated time.Time `protobuf:"bytes,8,opt,name=downtime_offenses_updated,json=downtimeOffensesUpdated,proto3,stdtime" json:"downtime_offenses_updated" yaml:"downtime_offenses_updated"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -163,45 +168,149 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeOffenses() uint64 {
+	if m != nil {
+		return m.DowntimeOffenses
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetDowntimeOffensesUpdated() time.Time {
+	if m != nil {
+		return m.DowntimeOffensesUpdated
+	}
+	return time.Time{}
+}
+
+// DowntimeOffense defines a record of a downtime offense committed by a
+// validator and of the penalties applied for it
+type DowntimeOffense struct {
+	// height at which the offense was handled
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time at which the offense was handled
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// number of recent downtime offenses including this one
+	OffenseCount uint64 `protobuf:"varint,3,opt,name=offense_count,json=offenseCount,proto3" json:"offense_count,omitempty" yaml:"offense_count"`
+	// fraction of the validator's stake slashed
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	// duration the validator was jailed for
+	JailDuration time.Duration `protobuf:"bytes,5,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration" yaml:"jail_duration"`
+}
+
+func (m *DowntimeOffense) Reset()      { *m = DowntimeOffense{} }
+func (*DowntimeOffense) ProtoMessage() {}
+func (*DowntimeOffense) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d04e6c6c2071212, []int{2}
+}
+func (m *DowntimeOffense) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeOffense) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeOffense.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeOffense) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeOffense.Merge(m, src)
+}
+func (m *DowntimeOffense) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeOffense) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeOffense.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeOffense proto.InternalMessageInfo
+
+func (m *DowntimeOffense) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DowntimeOffense) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeOffense) GetOffenseCount() uint64 {
+	if m != nil {
+		return m.OffenseCount
+	}
+	return 0
+}
+
+func (m *DowntimeOffense) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.MsgUnjail")
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.ValidatorSigningInfo")
+	proto.RegisterType((*DowntimeOffense)(nil), "cosmos.slashing.DowntimeOffense")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/slashing.proto", fileDescriptor_3d04e6c6c2071212) }
 
 var fileDescriptor_3d04e6c6c2071212 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6f, 0xd4, 0x30,
-	0x14, 0xc7, 0xcf, 0x1c, 0x94, 0xe2, 0x3b, 0x8a, 0x94, 0x16, 0x11, 0x9d, 0x90, 0x1d, 0x65, 0xba,
-	0xa5, 0x89, 0x54, 0xb6, 0xdb, 0x48, 0x17, 0x10, 0xbf, 0xa4, 0xd0, 0x76, 0x60, 0x20, 0x72, 0x2e,
-	0x3e, 0x9f, 0x69, 0x62, 0x9f, 0x62, 0x07, 0xb5, 0x2b, 0x7f, 0x41, 0x47, 0xc6, 0x8e, 0xfc, 0x11,
-	0xfc, 0x01, 0x1d, 0x3b, 0x32, 0x05, 0x74, 0xb7, 0x20, 0xc6, 0x1b, 0x3b, 0xa1, 0xd8, 0x97, 0xf6,
-	0x84, 0x10, 0x62, 0xb2, 0xbf, 0x9f, 0xf7, 0x9e, 0xbf, 0xb6, 0xdf, 0x83, 0x68, 0x2c, 0x55, 0x21,
-	0x55, 0xa8, 0x72, 0xa2, 0xa6, 0x5c, 0xb0, 0xeb, 0x4d, 0x30, 0x2b, 0xa5, 0x96, 0xce, 0x03, 0x1b,
-	0x0f, 0x5a, 0x3c, 0xd8, 0x61, 0x92, 0x49, 0x13, 0x0b, 0x9b, 0x9d, 0x4d, 0x1b, 0x60, 0x26, 0x25,
-	0xcb, 0x69, 0x68, 0x54, 0x5a, 0x4d, 0x42, 0xcd, 0x0b, 0xaa, 0x34, 0x29, 0x66, 0x36, 0xc1, 0xff,
-	0x04, 0xe0, 0xbd, 0x57, 0x8a, 0x1d, 0x8a, 0x0f, 0x84, 0xe7, 0x4e, 0x05, 0xb7, 0x3e, 0x92, 0x9c,
-	0x67, 0x44, 0xcb, 0x32, 0x21, 0x59, 0x56, 0xba, 0xc0, 0x03, 0xc3, 0x7e, 0xf4, 0xfa, 0x57, 0x8d,
-	0xef, 0x36, 0x9a, 0x2a, 0xb5, 0xac, 0xf1, 0xd6, 0x29, 0x29, 0xf2, 0x91, 0xbf, 0x02, 0xfe, 0x55,
-	0x8d, 0x77, 0x19, 0xd7, 0xd3, 0x2a, 0x0d, 0xc6, 0xb2, 0x08, 0x57, 0x37, 0xb7, 0xcb, 0xae, 0xca,
-	0x8e, 0x43, 0x7d, 0x3a, 0xa3, 0x2a, 0x38, 0x22, 0xf9, 0x53, 0x5b, 0x11, 0xdf, 0xbf, 0x76, 0x69,
-	0x88, 0xff, 0xb5, 0x0b, 0x77, 0x8e, 0x5a, 0xf2, 0x96, 0x33, 0xc1, 0x05, 0x7b, 0x2e, 0x26, 0xd2,
-	0x79, 0x09, 0x5b, 0xd7, 0xd5, 0x45, 0xf6, 0xae, 0x6a, 0x1c, 0xfc, 0x87, 0xd7, 0xbe, 0x14, 0xaa,
-	0x35, 0x6b, 0x8f, 0x70, 0x46, 0xb0, 0xaf, 0x34, 0x29, 0x75, 0x32, 0xa5, 0x9c, 0x4d, 0xb5, 0x7b,
-	0xcb, 0x03, 0xc3, 0x6e, 0xf4, 0x68, 0x59, 0xe3, 0x6d, 0xfb, 0xa0, 0xf5, 0xa8, 0x1f, 0xf7, 0x8c,
-	0x7c, 0x66, 0x54, 0x53, 0xcb, 0x45, 0x46, 0x4f, 0x12, 0x39, 0x99, 0x28, 0xaa, 0xdd, 0xee, 0x9f,
-	0xb5, 0xeb, 0x51, 0x3f, 0xee, 0x19, 0xf9, 0xc6, 0x28, 0xe7, 0x3d, 0xec, 0x37, 0xbf, 0x4b, 0xb3,
-	0xa4, 0x12, 0x9a, 0xe7, 0xee, 0x6d, 0x0f, 0x0c, 0x7b, 0x7b, 0x83, 0xc0, 0xf6, 0x26, 0x68, 0x7b,
-	0x13, 0x1c, 0xb4, 0xbd, 0x89, 0xf0, 0x45, 0x8d, 0x3b, 0x37, 0x67, 0xaf, 0x57, 0xfb, 0x67, 0xdf,
-	0x31, 0x88, 0x7b, 0x16, 0x1d, 0x36, 0xc4, 0x41, 0x10, 0x6a, 0x59, 0xa4, 0x4a, 0x4b, 0x41, 0x33,
-	0xf7, 0x8e, 0x07, 0x86, 0x9b, 0xf1, 0x1a, 0x71, 0x0e, 0xe0, 0xc3, 0x82, 0x2b, 0x45, 0xb3, 0x24,
-	0xcd, 0xe5, 0xf8, 0x58, 0x25, 0x63, 0x59, 0x09, 0x4d, 0x4b, 0x77, 0xc3, 0x3c, 0xc2, 0x5b, 0xd6,
-	0xf8, 0xb1, 0x35, 0xfa, 0x6b, 0x9a, 0x1f, 0x6f, 0x5b, 0x1e, 0x19, 0xbc, 0x6f, 0xe9, 0x68, 0xf3,
-	0xf3, 0x39, 0xee, 0xfc, 0x3c, 0xc7, 0x20, 0x7a, 0xf1, 0x65, 0x8e, 0xc0, 0xc5, 0x1c, 0x81, 0xcb,
-	0x39, 0x02, 0x3f, 0xe6, 0x08, 0x9c, 0x2d, 0x50, 0xe7, 0x72, 0x81, 0x3a, 0xdf, 0x16, 0xa8, 0xf3,
-	0xee, 0xdf, 0xa3, 0x71, 0x72, 0x33, 0xe1, 0xa6, 0x73, 0xe9, 0x86, 0xf9, 0x8e, 0x27, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xe3, 0x3b, 0x55, 0x14, 0x01, 0x03, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbd, 0x4f, 0xdb, 0x4e,
+	0x18, 0x8e, 0x21, 0x40, 0xb8, 0x24, 0xf0, 0xfb, 0x99, 0x50, 0x0c, 0x42, 0x3e, 0xcb, 0x43, 0x95,
+	0xa1, 0x38, 0x12, 0x5d, 0xaa, 0x48, 0x1d, 0x6a, 0x50, 0x5b, 0xd4, 0x0f, 0x24, 0x17, 0x18, 0x3a,
+	0xd4, 0x75, 0xe2, 0x8b, 0xe3, 0xe2, 0xdc, 0x45, 0xb9, 0x4b, 0x0b, 0x6b, 0xd5, 0xb9, 0x62, 0x64,
+	0x64, 0xec, 0x9f, 0xc2, 0xc8, 0x58, 0x75, 0x70, 0xab, 0xb0, 0x54, 0x1d, 0x33, 0x32, 0x55, 0xf7,
+	0x61, 0x08, 0xd0, 0x16, 0x26, 0xfb, 0x7d, 0xde, 0xe7, 0xbd, 0xf7, 0xeb, 0xb9, 0x03, 0x66, 0x93,
+	0xd0, 0x0e, 0xa1, 0x35, 0x9a, 0x04, 0xb4, 0x1d, 0xe3, 0xe8, 0xfc, 0xc7, 0xe9, 0xf6, 0x08, 0x23,
+	0xfa, 0xac, 0xf4, 0x3b, 0x19, 0xbc, 0x54, 0x89, 0x48, 0x44, 0x84, 0xaf, 0xc6, 0xff, 0x24, 0x6d,
+	0xc9, 0x8c, 0x08, 0x89, 0x12, 0x54, 0x13, 0x56, 0xa3, 0xdf, 0xaa, 0x85, 0xfd, 0x5e, 0xc0, 0x62,
+	0x82, 0x95, 0x1f, 0x5e, 0xf5, 0xb3, 0xb8, 0x83, 0x28, 0x0b, 0x3a, 0x5d, 0x49, 0xb0, 0x3f, 0x6a,
+	0x60, 0xfa, 0x05, 0x8d, 0xb6, 0xf1, 0xbb, 0x20, 0x4e, 0xf4, 0x3e, 0x98, 0x79, 0x1f, 0x24, 0x71,
+	0x18, 0x30, 0xd2, 0xf3, 0x83, 0x30, 0xec, 0x19, 0x9a, 0xa5, 0x55, 0x4b, 0xee, 0xcb, 0x5f, 0x29,
+	0x9c, 0xe2, 0x36, 0xa2, 0x74, 0x98, 0xc2, 0x99, 0xfd, 0xa0, 0x93, 0xd4, 0x6d, 0x05, 0xd8, 0x67,
+	0x29, 0x5c, 0x89, 0x62, 0xd6, 0xee, 0x37, 0x9c, 0x26, 0xe9, 0xd4, 0x54, 0x67, 0xf2, 0xb3, 0x42,
+	0xc3, 0xdd, 0x1a, 0xdb, 0xef, 0x22, 0xea, 0xec, 0x04, 0xc9, 0x23, 0x19, 0xe1, 0x95, 0xcf, 0xb3,
+	0x70, 0xc4, 0xfe, 0x3c, 0x01, 0x2a, 0x3b, 0x19, 0xf2, 0x2a, 0x8e, 0x70, 0x8c, 0xa3, 0x0d, 0xdc,
+	0x22, 0xfa, 0x73, 0x90, 0x65, 0x55, 0x85, 0xac, 0x9e, 0xa5, 0xd0, 0xb9, 0x45, 0xae, 0x35, 0x82,
+	0x69, 0x96, 0x2c, 0x3b, 0x42, 0xaf, 0x83, 0x12, 0x65, 0x41, 0x8f, 0xf9, 0x6d, 0x14, 0x47, 0x6d,
+	0x66, 0x8c, 0x59, 0x5a, 0x75, 0xdc, 0x5d, 0x18, 0xa6, 0x70, 0x4e, 0x36, 0x34, 0xea, 0xb5, 0xbd,
+	0xa2, 0x30, 0x9f, 0x0a, 0x8b, 0xc7, 0xc6, 0x38, 0x44, 0x7b, 0x3e, 0x69, 0xb5, 0x28, 0x62, 0xc6,
+	0xf8, 0xd5, 0xd8, 0x51, 0xaf, 0xed, 0x15, 0x85, 0xb9, 0x29, 0x2c, 0xfd, 0x0d, 0x28, 0xf1, 0xe9,
+	0xa2, 0xd0, 0xef, 0x63, 0x16, 0x27, 0x46, 0xde, 0xd2, 0xaa, 0xc5, 0xd5, 0x25, 0x47, 0xee, 0xc6,
+	0xc9, 0x76, 0xe3, 0x6c, 0x65, 0xbb, 0x71, 0xe1, 0x71, 0x0a, 0x73, 0x17, 0x67, 0x8f, 0x46, 0xdb,
+	0x07, 0xdf, 0xa1, 0xe6, 0x15, 0x25, 0xb4, 0xcd, 0x11, 0xdd, 0x04, 0x80, 0x91, 0x4e, 0x83, 0x32,
+	0x82, 0x51, 0x68, 0x4c, 0x58, 0x5a, 0xb5, 0xe0, 0x8d, 0x20, 0xfa, 0x16, 0x98, 0xef, 0xc4, 0x94,
+	0xa2, 0xd0, 0x6f, 0x24, 0xa4, 0xb9, 0x4b, 0xfd, 0x26, 0xe9, 0x63, 0x86, 0x7a, 0xc6, 0xa4, 0x68,
+	0xc2, 0x1a, 0xa6, 0x70, 0x59, 0x26, 0xfa, 0x23, 0xcd, 0xf6, 0xe6, 0x24, 0xee, 0x0a, 0x78, 0x4d,
+	0xa2, 0xfa, 0x06, 0xf8, 0x3f, 0x24, 0x1f, 0x30, 0x17, 0x14, 0x6f, 0x1b, 0x61, 0x8a, 0xa8, 0x31,
+	0x65, 0x69, 0xd5, 0xbc, 0xbb, 0x3c, 0x4c, 0xa1, 0x21, 0x4f, 0xbc, 0x46, 0xb1, 0xbd, 0xff, 0x32,
+	0x6c, 0x53, 0x41, 0xfa, 0x27, 0x0d, 0x2c, 0x5e, 0x23, 0xfa, 0xfd, 0x6e, 0x18, 0x30, 0x14, 0x1a,
+	0x85, 0x1b, 0xc7, 0x75, 0x4f, 0x8d, 0xcb, 0xfa, 0x4b, 0xce, 0xec, 0x28, 0x39, 0xbb, 0x85, 0xab,
+	0xf9, 0xb7, 0xa5, 0xb7, 0x5e, 0x38, 0x3c, 0x82, 0xb9, 0x9f, 0x47, 0x50, 0xb3, 0xcf, 0xc6, 0xc0,
+	0xec, 0xfa, 0x65, 0x96, 0x7e, 0x07, 0x4c, 0x2a, 0xdd, 0x70, 0x29, 0x8e, 0x7b, 0xca, 0xd2, 0x1f,
+	0x80, 0x3c, 0xa7, 0x19, 0x63, 0x37, 0x96, 0x59, 0xe0, 0x65, 0x8a, 0x12, 0x44, 0x84, 0xfe, 0x10,
+	0x94, 0x55, 0x85, 0x72, 0xd4, 0x42, 0x54, 0x79, 0xd7, 0x18, 0xa6, 0xb0, 0x22, 0x3b, 0xb9, 0xe4,
+	0xb6, 0xbd, 0x92, 0xb2, 0xc5, 0x0a, 0x74, 0x0c, 0x66, 0xc4, 0xeb, 0xe0, 0xb7, 0x7a, 0x41, 0x93,
+	0xdf, 0x79, 0x21, 0xac, 0x69, 0xf7, 0x09, 0x4f, 0xf3, 0x2d, 0x85, 0x77, 0x6f, 0x71, 0x4f, 0xd6,
+	0x51, 0x73, 0x98, 0xc2, 0x79, 0x25, 0xff, 0x4b, 0xa7, 0xd9, 0x5e, 0x59, 0x00, 0x8f, 0x95, 0xad,
+	0xbf, 0x05, 0x65, 0xae, 0x3a, 0x3f, 0x7b, 0x62, 0x84, 0xd2, 0x8a, 0xab, 0x8b, 0xd7, 0x3a, 0x5e,
+	0x57, 0x04, 0xd7, 0x52, 0x7b, 0xa9, 0x5c, 0xc8, 0xf8, 0x3c, 0xda, 0x3e, 0xe4, 0x83, 0x10, 0x17,
+	0x23, 0xe3, 0xd7, 0xf3, 0x7c, 0x01, 0xee, 0xb3, 0x2f, 0x03, 0x53, 0x3b, 0x1e, 0x98, 0xda, 0xc9,
+	0xc0, 0xd4, 0x7e, 0x0c, 0x4c, 0xed, 0xe0, 0xd4, 0xcc, 0x9d, 0x9c, 0x9a, 0xb9, 0xaf, 0xa7, 0x66,
+	0xee, 0xf5, 0xbf, 0x5f, 0x9a, 0xbd, 0x8b, 0x07, 0x55, 0x34, 0xd8, 0x98, 0x14, 0x55, 0xdd, 0xff,
+	0x3d, 0x00, 0x33, 0xf9, 0x9e, 0x62, 0x70, 0x05, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -265,6 +374,48 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeOffenses != that1.DowntimeOffenses {
+		return false
+	}
+	if !this.DowntimeOffensesUpdated.Equal(that1.DowntimeOffensesUpdated) {
+		return false
+	}
+	return true
+}
+func (this *DowntimeOffense) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimeOffense)
+	if !ok {
+		that2, ok := that.(DowntimeOffense)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.OffenseCount != that1.OffenseCount {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
 	return true
 }
 func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
@@ -317,6 +468,19 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DowntimeOffensesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DowntimeOffensesUpdated):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.DowntimeOffenses != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeOffenses))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -332,12 +496,12 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if m.IndexOffset != 0 {
@@ -360,6 +524,65 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimeOffense) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeOffense) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeOffense) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OffenseCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.OffenseCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -408,6 +631,32 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.DowntimeOffenses != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeOffenses))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DowntimeOffensesUpdated)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *DowntimeOffense) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.OffenseCount != 0 {
+		n += 1 + sovSlashing(uint64(m.OffenseCount))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -677,6 +926,249 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenses", wireType)
+			}
+			m.DowntimeOffenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeOffenses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffensesUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DowntimeOffensesUpdated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeOffense) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeOffense: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeOffense: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenseCount", wireType)
+			}
+			m.OffenseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
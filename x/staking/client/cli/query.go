@@ -38,6 +38,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorRedelegations(queryRoute, cdc),
 		GetCmdQueryHistoricalInfo(queryRoute, cdc),
+		GetCmdQueryConsPubKeyRotations(queryRoute, cdc),
+		GetCmdQueryTokenizeShareRecord(queryRoute, cdc),
+		GetCmdQueryTokenizeShareRecordsByOwner(queryRoute, cdc),
+		GetCmdQueryTotalLiquidStaked(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryPool(queryRoute, cdc))...)
 
@@ -86,6 +90,165 @@ $ %s query staking validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhff
 	}
 }
 
+// GetCmdQueryConsPubKeyRotations implements the query of the consensus pubkey
+// rotations of a validator command.
+func GetCmdQueryConsPubKeyRotations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cons-pubkey-rotations [validator-addr]",
+		Short: "Query the consensus pubkey rotations of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consensus pubkey rotations of a validator which took effect
+within the last unbonding period.
+
+Example:
+$ %s query staking cons-pubkey-rotations cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr, 0, 0))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryConsPubKeyRotations)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var rotations types.ConsPubKeyRotations
+			cdc.MustUnmarshalJSON(res, &rotations)
+			return clientCtx.PrintOutput(rotations)
+		},
+	}
+}
+
+// GetCmdQueryTokenizeShareRecord implements the query of a tokenize share
+// record command.
+func GetCmdQueryTokenizeShareRecord(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share-record [id]",
+		Short: "Query a tokenize share record by its ID",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a tokenize share record by its ID.
+
+Example:
+$ %s query staking tokenize-share-record 1
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid tokenize share record ID %s: %w", args[0], err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTokenizeShareRecordParams(id))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenizeShareRecord)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var record types.TokenizeShareRecord
+			cdc.MustUnmarshalJSON(res, &record)
+			return clientCtx.PrintOutput(record)
+		},
+	}
+}
+
+// GetCmdQueryTokenizeShareRecordsByOwner implements the query of the tokenize
+// share records of an owner command.
+func GetCmdQueryTokenizeShareRecordsByOwner(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share-records [owner-addr]",
+		Short: "Query the tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records owned by an address.
+
+Example:
+$ %s query staking tokenize-share-records cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(owner))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenizeShareRecordsByOwner)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var records types.TokenizeShareRecords
+			cdc.MustUnmarshalJSON(res, &records)
+			return clientCtx.PrintOutput(records)
+		},
+	}
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query of the total liquid staked
+// tokens command.
+func GetCmdQueryTotalLiquidStaked(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the total amount of tokens delegated through tokenize share records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of tokens delegated through tokenize share records.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc).WithJSONMarshaler(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTotalLiquidStaked)
+			res, _, err := clientCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var total sdk.Int
+			cdc.MustUnmarshalJSON(res, &total)
+			return clientCtx.PrintOutput(total)
+		},
+	}
+}
+
 // GetCmdQueryValidators implements the query all validators command.
 func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
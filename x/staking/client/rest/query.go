@@ -87,6 +87,30 @@ func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
 		validatorUnbondingDelegationsHandlerFn(clientCtx),
 	).Methods("GET")
 
+	// Get the consensus pubkey rotations of a validator
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}/cons_pubkey_rotations",
+		validatorConsPubKeyRotationsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	// Get the tokenize share records owned by a delegator
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/tokenize_share_records",
+		delegatorTokenizeShareRecordsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	// Get a tokenize share record
+	r.HandleFunc(
+		"/staking/tokenize_share_records/{recordID}",
+		tokenizeShareRecordHandlerFn(clientCtx),
+	).Methods("GET")
+
+	// Get the total amount of liquid staked tokens
+	r.HandleFunc(
+		"/staking/total_liquid_staked",
+		totalLiquidStakedHandlerFn(clientCtx),
+	).Methods("GET")
+
 	// Get HistoricalInfo at a given height
 	r.HandleFunc(
 		"/staking/historical_info/{height}",
@@ -316,6 +340,62 @@ func validatorUnbondingDelegationsHandlerFn(cliCtx client.Context) http.HandlerF
 	return queryValidator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorUnbondingDelegations))
 }
 
+// HTTP request handler to query the consensus pubkey rotations of a validator
+func validatorConsPubKeyRotationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return queryValidator(clientCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryConsPubKeyRotations))
+}
+
+// HTTP request handler to query the tokenize share records of an owner
+func delegatorTokenizeShareRecordsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return queryDelegator(clientCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokenizeShareRecordsByOwner))
+}
+
+// HTTP request handler to query a tokenize share record
+func tokenizeShareRecordHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["recordID"])
+		if !ok {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := clientCtx.JSONMarshaler.MarshalJSON(types.NewQueryTokenizeShareRecordParams(id))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokenizeShareRecord), bz)
+		if rest.CheckNotFoundError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+// HTTP request handler to query the total amount of liquid staked tokens
+func totalLiquidStakedHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTotalLiquidStaked), nil)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
 // HTTP request handler to query historical info at a given height
 func historicalInfoHandlerFn(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -23,10 +23,26 @@ func registerTxHandlers(clientCtx client.Context, r *mux.Router) {
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
 		newPostUnbondingDelegationsHandlerFn(clientCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations/cancel",
+		newPostCancelUnbondingDelegationHandlerFn(clientCtx),
+	).Methods("POST")
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		newPostRedelegationsHandlerFn(clientCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}/cons_pubkey_rotations",
+		newPostConsPubKeyRotationsHandlerFn(clientCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/tokenize_shares",
+		newPostTokenizeSharesHandlerFn(clientCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redeem_tokens",
+		newPostRedeemTokensHandlerFn(clientCtx),
+	).Methods("POST")
 }
 
 type (
@@ -54,6 +70,41 @@ type (
 		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
 		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
 	}
+
+	// CancelUnbondingDelegationRequest defines the properties of an unbonding
+	// delegation cancellation request's body.
+	CancelUnbondingDelegationRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+		CreationHeight   int64          `json:"creation_height" yaml:"creation_height"`
+	}
+
+	// TokenizeSharesRequest defines the properties of a delegation shares
+	// tokenization request's body.
+	TokenizeSharesRequest struct {
+		BaseReq             rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress    sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		ValidatorAddress    sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
+		Amount              sdk.Coin       `json:"amount" yaml:"amount"`
+		TokenizedShareOwner sdk.AccAddress `json:"tokenized_share_owner" yaml:"tokenized_share_owner"` // in bech32
+	}
+
+	// RedeemTokensRequest defines the properties of a share tokens redemption
+	// request's body.
+	RedeemTokensRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+	}
+
+	// RotateConsPubKeyRequest defines the properties of a consensus pubkey
+	// rotation request's body.
+	RotateConsPubKeyRequest struct {
+		BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+		NewPubKey string       `json:"new_pubkey" yaml:"new_pubkey"` // in bech32
+	}
 )
 
 func newPostDelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
@@ -149,6 +200,142 @@ func newPostUnbondingDelegationsHandlerFn(clientCtx client.Context) http.Handler
 	}
 }
 
+func newPostCancelUnbondingDelegationHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelUnbondingDelegationRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			req.DelegatorAddress, req.ValidatorAddress, req.CreationHeight, req.Amount,
+		)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func newPostTokenizeSharesHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TokenizeSharesRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgTokenizeShares(req.DelegatorAddress, req.ValidatorAddress, req.Amount, req.TokenizedShareOwner)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func newPostRedeemTokensHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RedeemTokensRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(req.DelegatorAddress, req.Amount)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func newPostConsPubKeyRotationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RotateConsPubKeyRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, req.NewPubKey)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgRotateConsPubKey(valAddr, pk)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, valAddr) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own validator address")
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Deprecated
 //
@@ -163,10 +350,26 @@ func registerTxRoutes(clientCtx client.Context, r *mux.Router) {
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
 		postUnbondingDelegationsHandlerFn(clientCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations/cancel",
+		postCancelUnbondingDelegationHandlerFn(clientCtx),
+	).Methods("POST")
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		postRedelegationsHandlerFn(clientCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}/cons_pubkey_rotations",
+		postConsPubKeyRotationsHandlerFn(clientCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/tokenize_shares",
+		postTokenizeSharesHandlerFn(clientCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redeem_tokens",
+		postRedeemTokensHandlerFn(clientCtx),
+	).Methods("POST")
 }
 
 func postDelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
@@ -264,3 +467,139 @@ func postUnbondingDelegationsHandlerFn(clientCtx client.Context) http.HandlerFun
 		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postCancelUnbondingDelegationHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelUnbondingDelegationRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			req.DelegatorAddress, req.ValidatorAddress, req.CreationHeight, req.Amount,
+		)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postTokenizeSharesHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TokenizeSharesRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgTokenizeShares(req.DelegatorAddress, req.ValidatorAddress, req.Amount, req.TokenizedShareOwner)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postRedeemTokensHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RedeemTokensRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(req.DelegatorAddress, req.Amount)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postConsPubKeyRotationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RotateConsPubKeyRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, req.NewPubKey)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgRotateConsPubKey(valAddr, pk)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, valAddr) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own validator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, clientCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -91,6 +91,26 @@ func InitGenesis(
 		}
 	}
 
+	for _, rotation := range data.ConsPubKeyRotations {
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+		keeper.InsertConsPubKeyRotationQueue(ctx, rotation, rotation.Time.Add(data.Params.UnbondingTime))
+		keeper.SetValidatorByOldConsAddr(ctx, rotation)
+	}
+
+	// the liquid shares of the validators are derived from the delegations of
+	// the tokenize share records
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordID)
+
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		delegation, found := keeper.GetDelegation(ctx, record.GetModuleAddress(), record.Validator)
+		if found {
+			liquidShares := keeper.GetValidatorLiquidShares(ctx, record.Validator)
+			keeper.SetValidatorLiquidShares(ctx, record.Validator, liquidShares.Add(delegation.Shares))
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -162,6 +182,13 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		return false
 	})
 
+	var rotations []types.ConsPubKeyRotation
+
+	keeper.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) (stop bool) {
+		rotations = append(rotations, rotation)
+		return false
+	})
+
 	var lastValidatorPowers []types.LastValidatorPower
 
 	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
@@ -170,14 +197,17 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		Params:               keeper.GetParams(ctx),
-		LastTotalPower:       keeper.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           keeper.GetAllValidators(ctx),
-		Delegations:          keeper.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    keeper.GetParams(ctx),
+		LastTotalPower:            keeper.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                keeper.GetAllValidators(ctx),
+		Delegations:               keeper.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		ConsPubKeyRotations:       rotations,
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordID: keeper.GetLastTokenizeShareRecordID(ctx),
+		Exported:                  true,
 	}
 }
 
@@ -204,6 +234,14 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateConsPubKeyRotations(data.ConsPubKeyRotations); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordID); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -231,3 +269,47 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 
 	return
 }
+
+func validateGenesisStateConsPubKeyRotations(rotations []types.ConsPubKeyRotation) error {
+	for _, rotation := range rotations {
+		if rotation.ValidatorAddress.Empty() {
+			return fmt.Errorf("consensus pubkey rotation with empty validator address: %v", rotation)
+		}
+
+		if _, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, rotation.OldPubkey); err != nil {
+			return fmt.Errorf("invalid old pubkey in consensus pubkey rotation of %s: %w", rotation.ValidatorAddress, err)
+		}
+
+		if _, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, rotation.NewPubkey); err != nil {
+			return fmt.Errorf("invalid new pubkey in consensus pubkey rotation of %s: %w", rotation.ValidatorAddress, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if record.ID == 0 || record.ID > lastID {
+			return fmt.Errorf("invalid tokenize share record ID %d, last ID is %d", record.ID, lastID)
+		}
+
+		if ids[record.ID] {
+			return fmt.Errorf("duplicate tokenize share record ID %d", record.ID)
+		}
+
+		ids[record.ID] = true
+
+		if record.Owner.Empty() || record.Validator.Empty() {
+			return fmt.Errorf("tokenize share record %d with empty owner or validator address", record.ID)
+		}
+
+		if record.ModuleAccount != types.NewTokenizeShareRecord(record.ID, record.Owner, record.Validator).ModuleAccount {
+			return fmt.Errorf("invalid module account %s of tokenize share record %d", record.ModuleAccount, record.ID)
+		}
+	}
+
+	return nil
+}
@@ -10,11 +10,15 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -1503,3 +1507,324 @@ func TestInvalidCoinDenom(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
+
+func TestRotateConsPubKey(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 1000000000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, validatorAddr2 := valAddrs[0], valAddrs[1]
+	oldPk, newPk := PKs[0], PKs[1]
+
+	_, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, oldPk, initBond))
+	require.NoError(t, err)
+	_, err = handler(ctx, NewTestMsgCreateValidator(validatorAddr2, PKs[2], initBond))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the rotation fee is burned
+	fee := app.StakingKeeper.KeyRotationFee(ctx)
+	balance := app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(validatorAddr))
+	supply := app.BankKeeper.GetSupply(ctx).GetTotal()
+
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, newPk))
+	require.NoError(t, err)
+	require.Equal(t, balance.Sub(fee), app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(validatorAddr)))
+	require.Equal(t, supply.Sub(fee), app.BankKeeper.GetSupply(ctx).GetTotal())
+
+	// a single rotation can be pending and the new pubkey is reserved
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[3]))
+	require.True(t, types.ErrConsPubKeyRotationPending.Is(err))
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr2, newPk))
+	require.True(t, types.ErrValidatorPubKeyExists.Is(err))
+
+	// the rotation is applied at the end of the block
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, oldPk, validator.GetConsPubKey())
+
+	updates := staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t, []abci.ValidatorUpdate{
+		{PubKey: tmtypes.TM2PB.PubKey(oldPk), Power: 0},
+		{PubKey: tmtypes.TM2PB.PubKey(newPk), Power: initPower},
+	}, updates)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newPk, validator.GetConsPubKey())
+
+	// both consensus addresses refer to the validator
+	for _, pk := range []crypto.PubKey{oldPk, newPk} {
+		validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(pk.Address()))
+		require.True(t, found)
+		require.Equal(t, validatorAddr, validator.OperatorAddress)
+	}
+
+	rotations := app.StakingKeeper.GetConsPubKeyRotations(ctx, validatorAddr)
+	require.Len(t, rotations, 1)
+	require.Equal(t, oldPk, rotations[0].GetOldConsPubKey())
+	require.Equal(t, newPk, rotations[0].GetNewConsPubKey())
+
+	// the old pubkey cannot be claimed within the unbonding period
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr2, oldPk))
+	require.True(t, types.ErrValidatorPubKeyExists.Is(err))
+
+	// rotations are rate limited
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[3]))
+	require.True(t, types.ErrMaxConsPubKeyRotations.Is(err))
+
+	// the rotation is pruned after the unbonding period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	require.Empty(t, app.StakingKeeper.GetConsPubKeyRotations(ctx, validatorAddr))
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.False(t, found)
+
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[3]))
+	require.NoError(t, err)
+}
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 1000000000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	_, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens))
+	require.NoError(t, err)
+	_, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, valTokens))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	ctx = ctx.WithBlockHeight(10)
+	unbondAmt := sdk.TokensFromConsensusPower(6)
+	_, err = handler(ctx, types.NewMsgUndelegate(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, unbondAmt)))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	completionTime := ubd.Entries[0].CompletionTime
+
+	// the entry must exist and hold enough tokens
+	cancelAmt := sdk.TokensFromConsensusPower(2)
+	_, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 11, sdk.NewCoin(bondDenom, cancelAmt)))
+	require.True(t, types.ErrNoUnbondingDelegationEntry.Is(err))
+	_, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, sdk.NewCoin(bondDenom, unbondAmt.AddRaw(1))))
+	require.True(t, types.ErrNotEnoughUnbondingBalance.Is(err))
+	_, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, sdk.NewInt64Coin("foo", 1)))
+	require.True(t, types.ErrBadDenom.Is(err))
+
+	// cancel part of the entry
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	oldBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+	oldNotBonded := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount
+
+	_, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, sdk.NewCoin(bondDenom, cancelAmt)))
+	require.NoError(t, err)
+
+	require.Equal(t, oldBonded.Add(cancelAmt), app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount)
+	require.Equal(t, oldNotBonded.Sub(cancelAmt), app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondAmt.Sub(cancelAmt), ubd.Entries[0].Balance)
+	require.Equal(t, unbondAmt.Sub(cancelAmt), ubd.Entries[0].InitialBalance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens.Sub(unbondAmt).Add(cancelAmt), delegation.Shares.RoundInt())
+
+	// cancel the rest of the entry, which removes it from the unbonding queue
+	_, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, sdk.NewCoin(bondDenom, unbondAmt.Sub(cancelAmt))))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime))
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens, delegation.Shares.RoundInt())
+
+	_, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, sdk.NewCoin(bondDenom, cancelAmt)))
+	require.True(t, types.ErrNoUnbondingDelegation.Is(err))
+
+	// mature entries are completed instead
+	_, err = handler(ctx, types.NewMsgUndelegate(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, unbondAmt)))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, sdk.NewCoin(bondDenom, cancelAmt)))
+	require.True(t, types.ErrNoUnbondingDelegationEntry.Is(err))
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 3, 1000000000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delegatorAddr, owner := valAddrs[0], delAddrs[1], delAddrs[2]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	_, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens))
+	require.NoError(t, err)
+	_, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, valTokens))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// only existing bonded tokens can be tokenized
+	tokenizeAmt := sdk.TokensFromConsensusPower(4)
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewInt64Coin("foo", 1), owner))
+	require.True(t, types.ErrBadDenom.Is(err))
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, valTokens.AddRaw(1)), owner))
+	require.True(t, types.ErrBadSharesAmount.Is(err))
+	_, err = handler(ctx, types.NewMsgTokenizeShares(owner, validatorAddr, sdk.NewCoin(bondDenom, tokenizeAmt), owner))
+	require.True(t, types.ErrNoDelegation.Is(err))
+
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	oldBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, tokenizeAmt), owner))
+	require.NoError(t, err)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, owner, record.Owner)
+	require.Equal(t, validatorAddr, record.Validator)
+	require.Equal(t, types.TokenizeShareRecords{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+
+	// the shares are moved to the record's module account while the bonded
+	// tokens stay in place
+	shareDenom := record.GetShareTokenDenom()
+	require.Equal(t, tokenizeAmt, app.BankKeeper.GetBalance(ctx, delegatorAddr, shareDenom).Amount)
+	require.Equal(t, oldBonded, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens.Sub(tokenizeAmt), delegation.Shares.RoundInt())
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), validatorAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeAmt, delegation.Shares.RoundInt())
+
+	require.Equal(t, tokenizeAmt.ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr))
+	require.Equal(t, tokenizeAmt, app.StakingKeeper.TotalLiquidStakedTokens(ctx))
+
+	// share tokens are transferable and redeemable by any holder
+	halfAmt := tokenizeAmt.QuoRaw(2)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delegatorAddr, owner, sdk.NewCoins(sdk.NewCoin(shareDenom, halfAmt))))
+
+	_, err = handler(ctx, types.NewMsgRedeemTokensForShares(owner, sdk.NewCoin(shareDenom, tokenizeAmt)))
+	require.Error(t, err)
+	_, err = handler(ctx, types.NewMsgRedeemTokensForShares(owner, sdk.NewInt64Coin("foo", 1)))
+	require.True(t, types.ErrTokenizeShareRecordNotExists.Is(err))
+
+	_, err = handler(ctx, types.NewMsgRedeemTokensForShares(owner, sdk.NewCoin(shareDenom, halfAmt)))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, owner, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, halfAmt, delegation.Shares.RoundInt())
+	require.Equal(t, tokenizeAmt.Sub(halfAmt).ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr))
+
+	// redeeming the remaining share tokens removes the record
+	_, err = handler(ctx, types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewCoin(shareDenom, tokenizeAmt.Sub(halfAmt))))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens.Sub(halfAmt), delegation.Shares.RoundInt())
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), validatorAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.ID)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(shareDenom).IsZero())
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 1000000000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	_, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens))
+	require.NoError(t, err)
+	_, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, valTokens))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// at most 25% of the shares of the validator may be tokenized
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(6)), delegatorAddr))
+	require.True(t, types.ErrValidatorLiquidStakingCapExceeded.Is(err))
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(4)), delegatorAddr))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(2)), delegatorAddr))
+	require.True(t, types.ErrValidatorLiquidStakingCapExceeded.Is(err))
+
+	// at most 25% of the bonded tokens may be tokenized
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(2)), delegatorAddr))
+	require.True(t, types.ErrGlobalLiquidStakingCapExceeded.Is(err))
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(1)), delegatorAddr))
+	require.NoError(t, err)
+}
+
+func TestTokenizeSharesRestrictions(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 1000000000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	_, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens))
+	require.NoError(t, err)
+	_, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, valTokens))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the record owner must be allowed to receive its rewards
+	tokenizeAmt := sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(1))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, tokenizeAmt, feeCollector))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	// a clawback vesting account cannot tokenize its locked delegation
+	vestingAddr := sdk.AccAddress([]byte("vesting_delegator___"))
+	vestingCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, valTokens))
+	periods := vestingtypes.Periods{{Length: 365 * 24 * 60 * 60, Amount: vestingCoins}}
+	vacc := vestingtypes.NewClawbackVestingAccount(
+		authtypes.NewBaseAccountWithAddress(vestingAddr), delegatorAddr, vestingCoins, ctx.BlockTime().Unix(), periods, periods,
+	)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, vacc))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delegatorAddr, vestingAddr, vestingCoins))
+
+	_, err = handler(ctx, NewTestMsgDelegate(vestingAddr, validatorAddr, valTokens))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgTokenizeShares(vestingAddr, validatorAddr, tokenizeAmt, vestingAddr))
+	require.True(t, types.ErrTokenizeSharesVestingAccount.Is(err))
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, vestingAddr))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, vestingAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens, delegation.Shares.RoundInt())
+}
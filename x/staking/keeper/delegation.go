@@ -660,18 +660,31 @@ func (k Keeper) getBeginInfo(
 func (k Keeper) Undelegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
 ) (time.Time, error) {
+	completionTime, _, err := k.UndelegateTo(ctx, delAddr, valAddr, delAddr, sharesAmount)
+	return completionTime, err
+}
+
+// UndelegateTo unbonds an amount of delegator shares from a given validator in
+// the same way as Undelegate, except that the resulting unbonding delegation
+// entry is owned by the recipient. The unbonding tokens therefore remain
+// slashable for the full unbonding period and are credited to the recipient
+// once it completes. It returns the completion time and the amount of tokens
+// unbonded.
+func (k Keeper) UndelegateTo(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, recipient sdk.AccAddress, sharesAmount sdk.Dec,
+) (time.Time, sdk.Int, error) {
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
-		return time.Time{}, types.ErrNoDelegatorForAddress
+		return time.Time{}, sdk.ZeroInt(), types.ErrNoDelegatorForAddress
 	}
 
-	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
-		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
+	if k.HasMaxUnbondingDelegationEntries(ctx, recipient, valAddr) {
+		return time.Time{}, sdk.ZeroInt(), types.ErrMaxUnbondingDelegationEntries
 	}
 
 	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
-		return time.Time{}, err
+		return time.Time{}, sdk.ZeroInt(), err
 	}
 
 	// transfer the validator tokens to the not bonded pool
@@ -680,10 +693,10 @@ func (k Keeper) Undelegate(
 	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	ubd := k.SetUnbondingDelegationEntry(ctx, recipient, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
-	return completionTime, nil
+	return completionTime, returnAmount, nil
 }
 
 // CompleteUnbonding completes the unbonding of all mature entries in the
@@ -730,6 +743,89 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation re-bonds an amount of the unbonding delegation
+// entry created at the given height back to the validator it is unbonding
+// from. Mature entries cannot be cancelled since they are completed at the end
+// of the block. The entry is removed, along with its record in the unbonding
+// queue, once its whole balance is re-bonded. It returns the newly issued
+// delegator shares.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) (sdk.Dec, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoValidatorFound
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctx.BlockHeader().Time) {
+			entryIndex = i
+			break
+		}
+	}
+
+	if entryIndex == -1 {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegationEntry
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if entry.Balance.LT(amount) {
+		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrNotEnoughUnbondingBalance, entry.Balance.String())
+	}
+
+	// the unbonding tokens are held by the not bonded pool
+	newShares, err := k.Delegate(ctx, delAddr, amount, sdk.Unbonding, validator, false)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// the initial balance is reduced as well so that slashing the remainder of
+	// the entry stays proportional to what is still unbonding
+	entry.Balance = entry.Balance.Sub(amount)
+	entry.InitialBalance = entry.InitialBalance.Sub(amount)
+
+	if entry.Balance.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.removeUBDQueueEntry(ctx, ubd, entry.CompletionTime)
+	} else {
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return newShares, nil
+}
+
+// removeUBDQueueEntry removes a single record of the unbonding delegation from
+// the unbonding queue timeslice at the given completion time.
+func (k Keeper) removeUBDQueueEntry(ctx sdk.Context, ubd types.UnbondingDelegation, completionTime time.Time) {
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) && dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
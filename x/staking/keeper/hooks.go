@@ -43,6 +43,20 @@ func (k Keeper) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsA
 	}
 }
 
+// AfterConsPubKeyRotated - call hook if registered
+func (k Keeper) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+	}
+}
+
+// AfterConsPubKeyRotationPruned - call hook if registered
+func (k Keeper) AfterConsPubKeyRotationPruned(ctx sdk.Context, oldConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterConsPubKeyRotationPruned(ctx, oldConsAddr, valAddr)
+	}
+}
+
 // BeforeDelegationCreated - call hook if registered
 func (k Keeper) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	if k.hooks != nil {
@@ -77,3 +91,11 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 		k.hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+
+// BeforeTokenizeShareRecordRemoved - call hook if registered
+func (k Keeper) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeTokenizeShareRecordRemoved(ctx, recordID)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TokenizeShares moves the delegator shares worth the given amount of tokens
+// from a delegation to the module account of a new tokenize share record and
+// mints the corresponding share tokens to the delegator. The record, and thus
+// the rewards accrued by the tokenized delegation, is owned by the given
+// owner, which must be allowed to receive funds. Vesting accounts cannot
+// tokenize their delegations.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress,
+) (sdk.Coin, types.TokenizeShareRecord, error) {
+	if amount.Denom != k.BondDenom(ctx) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrBadDenom
+	}
+
+	// the rewards of the record are paid to its owner
+	if k.bankKeeper.BlockedAddr(owner) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", owner,
+		)
+	}
+
+	// share tokens are freely transferable, so tokenizing the delegation of a
+	// vesting account would unlock it before its vesting schedule and move it
+	// out of reach of a clawback
+	if _, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTokenizeSharesVestingAccount
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrNoValidatorFound
+	}
+
+	// tokenized shares must not hide a redelegation which may still be slashed
+	// on its source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount.Amount)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	// only whole shares are tokenized so that each share token represents
+	// exactly one delegator share of the record
+	shares = shares.TruncateDec()
+	if !shares.IsPositive() {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTinyTokenizeAmount
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	id := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(id, owner, valAddr)
+	k.SetLastTokenizeShareRecordID(ctx, id)
+	k.SetTokenizeShareRecord(ctx, record)
+
+	if err := k.transferDelegationShares(ctx, validator, delAddr, record.GetModuleAddress(), shares); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), shares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(shares))
+
+	return shareToken, record, nil
+}
+
+// RedeemTokensForShares burns share tokens and moves the delegator shares they
+// represent from the tokenize share record back to a regular delegation of the
+// redeemer. The record is removed once its delegation is fully redeemed. It
+// returns the record and the amount of tokens the redeemed shares are worth.
+func (k Keeper) RedeemTokensForShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin,
+) (types.TokenizeShareRecord, sdk.Int, error) {
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, amount.Denom)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.ZeroInt(), types.ErrTokenizeShareRecordNotExists
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, amount.Denom)
+	if balance.Amount.LT(amount.Amount) {
+		return types.TokenizeShareRecord{}, sdk.ZeroInt(), sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, amount,
+		)
+	}
+
+	validator, found := k.GetValidator(ctx, record.Validator)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.ZeroInt(), types.ErrNoValidatorFound
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	shares := amount.Amount.ToDec()
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.ZeroInt(), err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.ZeroInt(), err
+	}
+
+	if err := k.transferDelegationShares(ctx, validator, moduleAddr, delAddr, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.ZeroInt(), err
+	}
+
+	k.SetValidatorLiquidShares(ctx, record.Validator, k.GetValidatorLiquidShares(ctx, record.Validator).Sub(shares))
+
+	if _, found := k.GetDelegation(ctx, moduleAddr, record.Validator); !found {
+		if err := k.DeleteTokenizeShareRecord(ctx, record); err != nil {
+			return types.TokenizeShareRecord{}, sdk.ZeroInt(), err
+		}
+	}
+
+	return record, validator.TokensFromShares(shares).TruncateInt(), nil
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the given shares of a
+// validator would exceed the global or the validator liquid staking cap. A cap
+// of one disables the corresponding check.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	if globalCap := k.GlobalLiquidStakingCap(ctx); globalCap.LT(sdk.OneDec()) {
+		tokens := validator.TokensFromShares(shares).TruncateInt()
+		liquidTokens := k.TotalLiquidStakedTokens(ctx).Add(tokens)
+
+		if liquidTokens.ToDec().GT(globalCap.MulInt(k.TotalBondedTokens(ctx))) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	if validatorCap := k.ValidatorLiquidStakingCap(ctx); validatorCap.LT(sdk.OneDec()) {
+		liquidShares := k.GetValidatorLiquidShares(ctx, validator.OperatorAddress).Add(shares)
+
+		if liquidShares.GT(validatorCap.Mul(validator.DelegatorShares)) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
+
+// transferDelegationShares moves delegator shares of a validator from one
+// delegation to another. The validator tokens and the staking pools are left
+// untouched.
+func (k Keeper) transferDelegationShares(
+	ctx sdk.Context, validator types.Validator, from, to sdk.AccAddress, shares sdk.Dec,
+) error {
+	valAddr := validator.OperatorAddress
+
+	srcDelegation, found := k.GetDelegation(ctx, from, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+
+	if srcDelegation.Shares.LT(shares) {
+		return sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, srcDelegation.Shares.String())
+	}
+
+	k.BeforeDelegationSharesModified(ctx, from, valAddr)
+
+	srcDelegation.Shares = srcDelegation.Shares.Sub(shares)
+
+	// jail the validator if its operator tokenizes its self-delegation below
+	// the minimum, as done upon unbonding
+	if srcDelegation.DelegatorAddress.Equals(valAddr) && !validator.Jailed &&
+		validator.TokensFromShares(srcDelegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	if srcDelegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, srcDelegation)
+	} else {
+		k.SetDelegation(ctx, srcDelegation)
+		k.AfterDelegationModified(ctx, from, valAddr)
+	}
+
+	dstDelegation, found := k.GetDelegation(ctx, to, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, to, valAddr)
+	} else {
+		dstDelegation = types.NewDelegation(to, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, to, valAddr)
+	}
+
+	dstDelegation.Shares = dstDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, dstDelegation)
+	k.AfterDelegationModified(ctx, to, valAddr)
+
+	return nil
+}
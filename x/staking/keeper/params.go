@@ -39,6 +39,33 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// KeyRotationFee - Fee charged to rotate the consensus pubkey of a validator
+func (k Keeper) KeyRotationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, types.KeyKeyRotationFee, &res)
+	return
+}
+
+// MaxKeyRotations - Maximum number of consensus pubkey rotations of a
+// validator within an unbonding period
+func (k Keeper) MaxKeyRotations(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxKeyRotations, &res)
+	return
+}
+
+// GlobalLiquidStakingCap - Maximum fraction of the total bonded tokens which
+// can be tokenized
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of the shares of a validator
+// which can be tokenized
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -47,6 +74,10 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.KeyRotationFee(ctx),
+		k.MaxKeyRotations(ctx),
+		k.GlobalLiquidStakingCap(ctx),
+		k.ValidatorLiquidStakingCap(ctx),
 	)
 }
 
@@ -53,6 +53,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryHistoricalInfo:
 			return queryHistoricalInfo(ctx, req, k)
 
+		case types.QueryConsPubKeyRotations:
+			return queryConsPubKeyRotations(ctx, req, k)
+
+		case types.QueryTokenizeShareRecord:
+			return queryTokenizeShareRecord(ctx, req, k)
+
+		case types.QueryTokenizeShareRecordsByOwner:
+			return queryTokenizeShareRecordsByOwner(ctx, req, k)
+
+		case types.QueryTotalLiquidStaked:
+			return queryTotalLiquidStaked(ctx, k)
+
 		case types.QueryPool:
 			return queryPool(ctx, k)
 
@@ -118,6 +130,28 @@ func queryValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 	return res, nil
 }
 
+func queryConsPubKeyRotations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryValidatorParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, found := k.GetValidator(ctx, params.ValidatorAddr); !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	rotations := k.GetConsPubKeyRotations(ctx, params.ValidatorAddr)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, rotations)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryValidatorParams
 
@@ -381,6 +415,54 @@ func queryHistoricalInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]by
 	return res, nil
 }
 
+func queryTokenizeShareRecord(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTokenizeShareRecordParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, params.ID)
+	if !found {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, record)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTokenizeShareRecordsByOwner(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	records := k.GetTokenizeShareRecordsByOwner(ctx, params.DelegatorAddr)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTotalLiquidStaked(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.TotalLiquidStakedTokens(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryPool(ctx sdk.Context, k Keeper) ([]byte, error) {
 	bondDenom := k.BondDenom(ctx)
 	bondedPool := k.GetBondedPool(ctx)
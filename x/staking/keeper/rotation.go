@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetPendingConsPubKeyRotation returns the consensus pubkey rotation requested
+// by a validator in the current block.
+func (k Keeper) GetPendingConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetPendingConsPubKeyRotationKey(valAddr))
+	if bz == nil {
+		return rotation, false
+	}
+
+	return types.MustUnmarshalConsPubKeyRotation(k.cdc, bz), true
+}
+
+// SetPendingConsPubKeyRotation sets a consensus pubkey rotation to apply at the
+// end of the current block.
+func (k Keeper) SetPendingConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalConsPubKeyRotation(k.cdc, rotation)
+	store.Set(types.GetPendingConsPubKeyRotationKey(rotation.ValidatorAddress), bz)
+}
+
+// SetConsPubKeyRotation sets an applied consensus pubkey rotation.
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalConsPubKeyRotation(k.cdc, rotation)
+	store.Set(types.GetConsPubKeyRotationKey(rotation.ValidatorAddress, rotation.Height), bz)
+}
+
+// GetConsPubKeyRotations returns the consensus pubkey rotations of a validator
+// which took effect within the last unbonding period, oldest first.
+func (k Keeper) GetConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) types.ConsPubKeyRotations {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetConsPubKeyRotationsKey(valAddr))
+	defer iterator.Close()
+
+	rotations := types.ConsPubKeyRotations{}
+	for ; iterator.Valid(); iterator.Next() {
+		rotations = append(rotations, types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value()))
+	}
+
+	return rotations
+}
+
+// IterateConsPubKeyRotations iterates through the applied consensus pubkey
+// rotations of all validators.
+func (k Keeper) IterateConsPubKeyRotations(ctx sdk.Context, fn func(rotation types.ConsPubKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value())) {
+			break
+		}
+	}
+}
+
+// SetValidatorByOldConsAddr keeps the consensus address replaced by a rotation
+// referring to the validator.
+func (k Keeper) SetValidatorByOldConsAddr(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorByConsAddrKey(rotation.GetOldConsAddr()), rotation.ValidatorAddress)
+}
+
+// InsertConsPubKeyRotationQueue inserts an applied consensus pubkey rotation
+// into the queue of rotations to prune once the unbonding period is over.
+func (k Keeper) InsertConsPubKeyRotationQueue(ctx sdk.Context, rotation types.ConsPubKeyRotation, pruneTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalConsPubKeyRotation(k.cdc, rotation)
+	store.Set(types.GetConsPubKeyRotationQueueKey(pruneTime, rotation.ValidatorAddress), bz)
+}
+
+// RotateConsPubKey charges the key rotation fee to the validator operator and
+// schedules the rotation of the validator's consensus pubkey at the end of the
+// block. The new consensus address is reserved right away so that no other
+// validator can claim it in the meantime.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey crypto.PubKey) (sdk.Coins, error) {
+	valAddr := validator.GetOperator()
+
+	if _, found := k.GetPendingConsPubKeyRotation(ctx, valAddr); found {
+		return nil, types.ErrConsPubKeyRotationPending
+	}
+
+	if uint32(len(k.GetConsPubKeyRotations(ctx, valAddr))) >= k.MaxKeyRotations(ctx) {
+		return nil, types.ErrMaxConsPubKeyRotations
+	}
+
+	// the consensus addresses kept for the previous pubkeys are reserved as well
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return nil, types.ErrValidatorPubKeyExists
+	}
+
+	fee := k.KeyRotationFee(ctx)
+	if !fee.IsZero() {
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.NotBondedPoolName, fee)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, fee); err != nil {
+			return nil, err
+		}
+	}
+
+	rotation := types.NewConsPubKeyRotation(
+		valAddr, validator.GetConsPubKey(), newPubKey, ctx.BlockHeight(), ctx.BlockTime(),
+	)
+	k.SetPendingConsPubKeyRotation(ctx, rotation)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorByConsAddrKey(newConsAddr), valAddr)
+
+	return fee, nil
+}
+
+// ApplyPendingConsPubKeyRotations swaps the consensus pubkey of the validators
+// which requested a rotation in the current block. The old consensus address
+// keeps referring to the validator for an unbonding period so that evidence of
+// infractions committed with the old pubkey can still be handled. It returns
+// the rotations of the validators which are part of the last validator set,
+// since Tendermint must be notified of those.
+func (k Keeper) ApplyPendingConsPubKeyRotations(ctx sdk.Context) (bonded []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	var rotations []types.ConsPubKeyRotation
+	for ; iterator.Valid(); iterator.Next() {
+		rotations = append(rotations, types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value()))
+		store.Delete(iterator.Key())
+	}
+
+	for _, rotation := range rotations {
+		validator, found := k.GetValidator(ctx, rotation.ValidatorAddress)
+		if !found {
+			// the validator was removed within the block, release the new address
+			store.Delete(types.GetValidatorByConsAddrKey(rotation.GetNewConsAddr()))
+			continue
+		}
+
+		validator.ConsensusPubkey = rotation.NewPubkey
+		k.SetValidator(ctx, validator)
+		k.SetValidatorByConsAddr(ctx, validator)
+
+		rotation.Height = ctx.BlockHeight()
+		rotation.Time = ctx.BlockTime()
+		k.SetConsPubKeyRotation(ctx, rotation)
+		k.InsertConsPubKeyRotationQueue(ctx, rotation, rotation.Time.Add(k.UnbondingTime(ctx)))
+
+		k.AfterConsPubKeyRotated(ctx, rotation.GetOldConsAddr(), rotation.GetNewConsAddr(), validator.OperatorAddress)
+
+		if store.Has(types.GetLastValidatorPowerKey(validator.OperatorAddress)) {
+			bonded = append(bonded, rotation)
+		}
+	}
+
+	return bonded
+}
+
+// PruneMatureConsPubKeyRotations removes the consensus pubkey rotations which
+// took effect more than an unbonding period ago, along with the mapping of the
+// old consensus address to the validator. Evidence of infractions committed
+// with the old pubkey is no longer handled afterwards.
+func (k Keeper) PruneMatureConsPubKeyRotations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(
+		types.ConsPubKeyRotationQueueKey,
+		sdk.PrefixEndBytes(types.GetConsPubKeyRotationQueueTimeKey(ctx.BlockTime())),
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotation := types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value())
+
+		// the old address may have been claimed again once the validator was removed
+		oldConsAddrKey := types.GetValidatorByConsAddrKey(rotation.GetOldConsAddr())
+		if bytes.Equal(store.Get(oldConsAddrKey), rotation.ValidatorAddress) {
+			store.Delete(oldConsAddrKey)
+		}
+
+		store.Delete(types.GetConsPubKeyRotationKey(rotation.ValidatorAddress, rotation.Height))
+		store.Delete(iterator.Key())
+
+		k.AfterConsPubKeyRotationPruned(ctx, rotation.GetOldConsAddr(), rotation.ValidatorAddress)
+	}
+}
+
+// consPubKeyRotationUpdates amends the validator set updates for the rotations
+// of validators which were part of the last validator set. Tendermint only
+// knows such a validator by its old pubkey, which must be removed from the
+// validator set while the new pubkey is added with the validator's power.
+func (k Keeper) consPubKeyRotationUpdates(
+	ctx sdk.Context, rotations []types.ConsPubKeyRotation, updates []abci.ValidatorUpdate,
+) []abci.ValidatorUpdate {
+	for _, rotation := range rotations {
+		oldPubKey := tmtypes.TM2PB.PubKey(rotation.GetOldConsPubKey())
+		newPubKey := tmtypes.TM2PB.PubKey(rotation.GetNewConsPubKey())
+
+		found := false
+		for i, update := range updates {
+			if !update.PubKey.Equal(newPubKey) {
+				continue
+			}
+
+			found = true
+			if update.Power == 0 {
+				// the validator leaves the validator set
+				updates[i].PubKey = oldPubKey
+			} else {
+				updates = append(updates, abci.ValidatorUpdate{PubKey: oldPubKey, Power: 0})
+			}
+
+			break
+		}
+
+		// the power of the validator did not change
+		if !found {
+			updates = append(updates,
+				abci.ValidatorUpdate{PubKey: oldPubKey, Power: 0},
+				abci.ValidatorUpdate{PubKey: newPubKey, Power: k.GetLastValidatorPower(ctx, rotation.ValidatorAddress)},
+			)
+		}
+	}
+
+	return updates
+}
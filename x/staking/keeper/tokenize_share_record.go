@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the ID of the last tokenize share
+// record created.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the ID of the last tokenize share record
+// created.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given ID.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	return types.MustUnmarshalTokenizeShareRecord(k.cdc, bz), true
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of the given
+// share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an
+// owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) types.TokenizeShareRecords {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIDsByOwnerKey(owner))
+	defer iterator.Close()
+
+	records := types.TokenizeShareRecords{}
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[1+sdk.AddrLen:])
+
+		record, found := k.GetTokenizeShareRecord(ctx, id)
+		if !found {
+			panic("tokenize share record of the owner index not found")
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// IterateTokenizeShareRecords iterates through the tokenize share records in
+// ascending ID order.
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, fn func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(types.MustUnmarshalTokenizeShareRecord(k.cdc, iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) types.TokenizeShareRecords {
+	records := types.TokenizeShareRecords{}
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// SetTokenizeShareRecord sets a tokenize share record along with its owner
+// and share token denom indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalTokenizeShareRecord(k.cdc, record)
+	store.Set(types.GetTokenizeShareRecordKey(record.ID), bz)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerKey(record.Owner, record.ID), []byte{})
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.ID))
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// indexes. The record is kept if the BeforeTokenizeShareRecordRemoved hook
+// fails.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	if err := k.BeforeTokenizeShareRecordRemoved(ctx, record.ID); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(record.ID))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerKey(record.Owner, record.ID))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator which
+// are tokenized.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var shares sdk.DecProto
+	k.cdc.MustUnmarshalBinaryBare(bz, &shares)
+
+	return shares.Dec
+}
+
+// SetValidatorLiquidShares sets the delegator shares of a validator which are
+// tokenized. The entry is removed once no share is tokenized anymore.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// TotalLiquidStakedTokens returns the amount of tokens delegated through
+// tokenize share records, valued at the current exchange rate of each
+// validator.
+func (k Keeper) TotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	total := sdk.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		validator, found := k.GetValidator(ctx, iterator.Key()[1:])
+		if !found {
+			continue
+		}
+
+		var shares sdk.DecProto
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &shares)
+
+		total = total.Add(validator.TokensFromShares(shares.Dec).TruncateInt())
+	}
+
+	return total
+}
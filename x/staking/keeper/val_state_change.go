@@ -24,11 +24,19 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbonded after the Endblocker (go from Bonded -> Unbonding during
 	// ApplyAndReturnValidatorSetUpdates and then Unbonding -> Unbonded during
 	// UnbondAllMatureValidatorQueue).
+	//
+	// The consensus pubkey rotations requested in this block are applied
+	// beforehand so that the updates refer to the new pubkeys.
+	rotations := k.ApplyPendingConsPubKeyRotations(ctx)
 	validatorUpdates := k.ApplyAndReturnValidatorSetUpdates(ctx)
+	validatorUpdates = k.consPubKeyRotationUpdates(ctx, rotations, validatorUpdates)
 
 	// Unbond all mature validators from the unbonding queue.
 	k.UnbondAllMatureValidatorQueue(ctx)
 
+	// Release the consensus addresses replaced more than an unbonding period ago.
+	k.PruneMatureConsPubKeyRotations(ctx)
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvPair := range matureUnbonds {
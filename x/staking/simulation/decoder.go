@@ -58,6 +58,34 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.PendingConsPubKeyRotationKey),
+			bytes.Equal(kvA.Key[:1], types.ConsPubKeyRotationKey),
+			bytes.Equal(kvA.Key[:1], types.ConsPubKeyRotationQueueKey):
+			var rotationA, rotationB types.ConsPubKeyRotation
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &rotationA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &rotationB)
+
+			return fmt.Sprintf("%v\n%v", rotationA, rotationB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordKey):
+			var recordA, recordB types.TokenizeShareRecord
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByDenomKey),
+			bytes.Equal(kvA.Key[:1], types.LastTokenizeShareRecordIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByOwnerKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Key[1+sdk.AddrLen:]), sdk.BigEndianToUint64(kvB.Key[1+sdk.AddrLen:]))
+		case bytes.Equal(kvA.Key[:1], types.ValidatorLiquidSharesKey):
+			var sharesA, sharesB sdk.DecProto
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &sharesA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &sharesB)
+
+			return fmt.Sprintf("%v\n%v", sharesA.Dec, sharesB.Dec)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
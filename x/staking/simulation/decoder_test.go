@@ -42,6 +42,8 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	rotation := types.NewConsPubKeyRotation(valAddr1, delPk1, ed25519.GenPrivKey().PubKey(), 10, bondTime)
+	record := types.NewTokenizeShareRecord(1, delAddr1, valAddr1)
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.LastTotalPowerKey, Value: cdc.MustMarshalBinaryBare(&sdk.IntProto{Int: sdk.OneInt()})},
@@ -50,6 +52,9 @@ func TestDecodeStore(t *testing.T) {
 		tmkv.Pair{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&del)},
 		tmkv.Pair{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&ubd)},
 		tmkv.Pair{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&red)},
+		tmkv.Pair{Key: types.GetConsPubKeyRotationKey(valAddr1, 10), Value: cdc.MustMarshalBinaryBare(&rotation)},
+		tmkv.Pair{Key: types.GetTokenizeShareRecordKey(1), Value: cdc.MustMarshalBinaryBare(&record)},
+		tmkv.Pair{Key: types.LastTokenizeShareRecordIDKey, Value: sdk.Uint64ToBigEndian(1)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -63,6 +68,9 @@ func TestDecodeStore(t *testing.T) {
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"ConsPubKeyRotation", fmt.Sprintf("%v\n%v", rotation, rotation)},
+		{"TokenizeShareRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"LastTokenizeShareRecordID", "1\n1"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
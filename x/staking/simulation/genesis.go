@@ -20,6 +20,11 @@ const (
 	unbondingTime     = "unbonding_time"
 	maxValidators     = "max_validators"
 	historicalEntries = "historical_entries"
+	keyRotationFee    = "key_rotation_fee"
+	maxKeyRotations   = "max_key_rotations"
+
+	globalLiquidStakingCap    = "global_liquid_staking_cap"
+	validatorLiquidStakingCap = "validator_liquid_staking_cap"
 )
 
 // GenUnbondingTime randomized UnbondingTime
@@ -37,6 +42,22 @@ func GetHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// GenKeyRotationFee randomized KeyRotationFee
+func GenKeyRotationFee(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(1000))))
+}
+
+// GenMaxKeyRotations randomized MaxKeyRotations between 0-3.
+func GenMaxKeyRotations(r *rand.Rand) uint32 {
+	return uint32(r.Intn(4))
+}
+
+// GenLiquidStakingCap randomized GlobalLiquidStakingCap and
+// ValidatorLiquidStakingCap between 0.1 and 1.
+func GenLiquidStakingCap(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(10)+1), 1)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -44,6 +65,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		unbondTime  time.Duration
 		maxVals     uint32
 		histEntries uint32
+		rotationFee sdk.Coins
+		maxRotation uint32
+
+		globalLiquidCap    sdk.Dec
+		validatorLiquidCap sdk.Dec
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -61,10 +87,33 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = GetHistEntries(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, keyRotationFee, &rotationFee, simState.Rand,
+		func(r *rand.Rand) { rotationFee = GenKeyRotationFee(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxKeyRotations, &maxRotation, simState.Rand,
+		func(r *rand.Rand) { maxRotation = GenMaxKeyRotations(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, globalLiquidStakingCap, &globalLiquidCap, simState.Rand,
+		func(r *rand.Rand) { globalLiquidCap = GenLiquidStakingCap(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, validatorLiquidStakingCap, &validatorLiquidCap, simState.Rand,
+		func(r *rand.Rand) { validatorLiquidCap = GenLiquidStakingCap(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom)
+	params := types.NewParams(
+		simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, rotationFee, maxRotation,
+		globalLiquidCap, validatorLiquidCap,
+	)
 
 	// validators & delegations
 	var (
@@ -2,8 +2,11 @@ package simulation
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
@@ -17,24 +20,36 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCreateValidator = "op_weight_msg_create_validator"
-	OpWeightMsgEditValidator   = "op_weight_msg_edit_validator"
-	OpWeightMsgDelegate        = "op_weight_msg_delegate"
-	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
-	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCreateValidator           = "op_weight_msg_create_validator"
+	OpWeightMsgEditValidator             = "op_weight_msg_edit_validator"
+	OpWeightMsgDelegate                  = "op_weight_msg_delegate"
+	OpWeightMsgUndelegate                = "op_weight_msg_undelegate"
+	OpWeightMsgBeginRedelegate           = "op_weight_msg_begin_redelegate"
+	OpWeightMsgRotateConsPubKey          = "op_weight_msg_rotate_cons_pubkey"
+	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgTokenizeShares            = "op_weight_msg_tokenize_shares"
+	OpWeightMsgRedeemTokensForShares     = "op_weight_msg_redeem_tokens_for_shares"
 )
 
+// maxSimTokenizeShareRecords is the maximum number of tokenize share records
+// created by the simulation
+const maxSimTokenizeShareRecords = 10
+
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
 	appParams simtypes.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
 	bk types.BankKeeper, k keeper.Keeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgCreateValidator int
-		weightMsgEditValidator   int
-		weightMsgDelegate        int
-		weightMsgUndelegate      int
-		weightMsgBeginRedelegate int
+		weightMsgCreateValidator           int
+		weightMsgEditValidator             int
+		weightMsgDelegate                  int
+		weightMsgUndelegate                int
+		weightMsgBeginRedelegate           int
+		weightMsgRotateConsPubKey          int
+		weightMsgCancelUnbondingDelegation int
+		weightMsgTokenizeShares            int
+		weightMsgRedeemTokensForShares     int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -67,6 +82,30 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgRotateConsPubKey, &weightMsgRotateConsPubKey, nil,
+		func(_ *rand.Rand) {
+			weightMsgRotateConsPubKey = simappparams.DefaultWeightMsgRotateConsPubKey
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgTokenizeShares, &weightMsgTokenizeShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgTokenizeShares = simappparams.DefaultWeightMsgTokenizeShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRedeemTokensForShares, &weightMsgRedeemTokensForShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedeemTokensForShares = simappparams.DefaultWeightMsgRedeemTokensForShares
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -88,6 +127,22 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgRotateConsPubKey,
+			SimulateMsgRotateConsPubKey(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			SimulateMsgCancelUnbondingDelegation(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTokenizeShares,
+			SimulateMsgTokenizeShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedeemTokensForShares,
+			SimulateMsgRedeemTokensForShares(ak, bk, k),
+		),
 	}
 }
 
@@ -323,6 +378,10 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
+		if isTokenizeShareRecordAddress(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "delegation is held by a tokenize share record"), nil, nil
+		}
+
 		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "keeper does have a max unbonding delegation entries"), nil, nil
 		}
@@ -405,6 +464,10 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
+		if isTokenizeShareRecordAddress(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "delegation is held by a tokenize share record"), nil, nil
+		}
+
 		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "receveing redelegation is not allowed"), nil, nil // skip
 		}
@@ -490,3 +553,284 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgRotateConsPubKey generates a MsgRotateConsPubKey with random values
+// nolint: interfacer
+func SimulateMsgRotateConsPubKey(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if len(k.GetAllValidators(ctx)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "number of validators equal zero"), nil, nil
+		}
+
+		val, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "unable to pick a validator"), nil, nil
+		}
+
+		address := val.GetOperator()
+
+		if _, found := k.GetPendingConsPubKeyRotation(ctx, address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "rotation already pending"), nil, nil
+		}
+
+		if uint32(len(k.GetConsPubKeyRotations(ctx, address))) >= k.MaxKeyRotations(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "too many rotations"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(address))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "unable to find account"), nil, fmt.Errorf("validator %s not found", address)
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		rotationFee := k.KeyRotationFee(ctx)
+		spendable, hasNeg := spendable.SafeSub(rotationFee)
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "insufficient funds for the rotation fee"), nil, nil
+		}
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateConsPubKey, "unable to generate fees"), nil, err
+		}
+
+		seed := make([]byte, 32)
+		r.Read(seed)
+		newPubKey := ed25519.GenPrivKeyFromSecret(seed).PubKey()
+
+		msg := types.NewMsgRotateConsPubKey(address, newPubKey)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation
+// with random values
+// nolint: interfacer
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		ubds := k.GetUnbondingDelegations(ctx, simAccount.Address, math.MaxUint16)
+		if len(ubds) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "no unbonding delegations"), nil, nil
+		}
+
+		ubd := ubds[r.Intn(len(ubds))]
+
+		validator, found := k.GetValidator(ctx, ubd.ValidatorAddress)
+		if !found || validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "validator cannot receive delegations"), nil, nil
+		}
+
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if entry.IsMature(ctx.BlockHeader().Time) || !entry.Balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unbonding delegation entry cannot be cancelled"), nil, nil
+		}
+
+		cancelAmt, err := simtypes.RandPositiveInt(r, entry.Balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "invalid cancel amount"), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			simAccount.Address, ubd.ValidatorAddress, entry.CreationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelAmt),
+		)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgTokenizeShares generates a MsgTokenizeShares with random values
+// nolint: interfacer
+func SimulateMsgTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// the gas cost of minting share tokens grows with the number of denoms
+		// of the total supply, so the number of records is bounded to stay
+		// within the simulation gas limit
+		if len(k.GetAllTokenizeShareRecords(ctx)) >= maxSimTokenizeShareRecords {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "too many tokenize share records"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		delegations := k.GetDelegatorDelegations(ctx, simAccount.Address, math.MaxUint16)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "no delegations"), nil, nil
+		}
+
+		delegation := delegations[r.Intn(len(delegations))]
+
+		validator, found := k.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found || validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "validator cannot receive delegations"), nil, nil
+		}
+
+		totalAmt := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		if !totalAmt.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "delegation has no tokens"), nil, nil
+		}
+
+		tokenizeAmt, err := simtypes.RandPositiveInt(r, totalAmt)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "invalid tokenize amount"), nil, err
+		}
+
+		owner, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgTokenizeShares(
+			simAccount.Address, delegation.ValidatorAddress, sdk.NewCoin(k.BondDenom(ctx), tokenizeAmt), owner.Address,
+		)
+
+		// skip the tokenization if it is rejected, e.g. because of a liquid staking cap
+		cacheCtx, _ := ctx.CacheContext()
+		if _, _, err := k.TokenizeShares(
+			cacheCtx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount, msg.TokenizedShareOwner,
+		); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "shares cannot be tokenized"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRedeemTokensForShares generates a MsgRedeemTokensForShares with
+// random values
+// nolint: interfacer
+func SimulateMsgRedeemTokensForShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		var shareTokens sdk.Coins
+		for _, coin := range spendable {
+			if _, found := k.GetTokenizeShareRecordByDenom(ctx, coin.Denom); found {
+				shareTokens = append(shareTokens, coin)
+			}
+		}
+
+		if len(shareTokens) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "no share tokens"), nil, nil
+		}
+
+		shareToken := shareTokens[r.Intn(len(shareTokens))]
+
+		redeemAmt, err := simtypes.RandPositiveInt(r, shareToken.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "invalid redeem amount"), nil, err
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(simAccount.Address, sdk.NewCoin(shareToken.Denom, redeemAmt))
+
+		// skip the redemption if it is rejected, e.g. because the validator is gone
+		cacheCtx, _ := ctx.CacheContext()
+		if _, _, err := k.RedeemTokensForShares(cacheCtx, msg.DelegatorAddress, msg.Amount); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "tokens cannot be redeemed"), nil, nil
+		}
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable.Sub(sdk.NewCoins(msg.Amount)))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// isTokenizeShareRecordAddress returns true if the address is the module
+// account of a tokenize share record, for which no simulation account exists.
+func isTokenizeShareRecordAddress(ctx sdk.Context, k keeper.Keeper, addr sdk.AccAddress) (found bool) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) (stop bool) {
+		found = record.GetModuleAddress().Equals(addr)
+		return found
+	})
+
+	return found
+}
@@ -31,5 +31,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", GetHistEntries(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxKeyRotations),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxKeyRotations(r))
+			},
+		),
 	}
 }
@@ -2,13 +2,17 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding    = "complete_unbonding"
-	EventTypeCompleteRedelegation = "complete_redelegation"
-	EventTypeCreateValidator      = "create_validator"
-	EventTypeEditValidator        = "edit_validator"
-	EventTypeDelegate             = "delegate"
-	EventTypeUnbond               = "unbond"
-	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteUnbonding         = "complete_unbonding"
+	EventTypeCompleteRedelegation      = "complete_redelegation"
+	EventTypeCreateValidator           = "create_validator"
+	EventTypeEditValidator             = "edit_validator"
+	EventTypeDelegate                  = "delegate"
+	EventTypeUnbond                    = "unbond"
+	EventTypeRedelegate                = "redelegate"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_shares"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -17,5 +21,11 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyOldConsPubKey     = "old_cons_pubkey"
+	AttributeKeyNewConsPubKey     = "new_cons_pubkey"
+	AttributeKeyFee               = "fee"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
 	AttributeValueCategory        = ModuleName
 )
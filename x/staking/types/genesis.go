@@ -9,14 +9,17 @@ import (
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	Params               Params                `json:"params" yaml:"params"`
-	LastTotalPower       sdk.Int               `json:"last_total_power" yaml:"last_total_power"`
-	LastValidatorPowers  []LastValidatorPower  `json:"last_validator_powers" yaml:"last_validator_powers"`
-	Validators           Validators            `json:"validators" yaml:"validators"`
-	Delegations          Delegations           `json:"delegations" yaml:"delegations"`
-	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
-	Redelegations        []Redelegation        `json:"redelegations" yaml:"redelegations"`
-	Exported             bool                  `json:"exported" yaml:"exported"`
+	Params                    Params                `json:"params" yaml:"params"`
+	LastTotalPower            sdk.Int               `json:"last_total_power" yaml:"last_total_power"`
+	LastValidatorPowers       []LastValidatorPower  `json:"last_validator_powers" yaml:"last_validator_powers"`
+	Validators                Validators            `json:"validators" yaml:"validators"`
+	Delegations               Delegations           `json:"delegations" yaml:"delegations"`
+	UnbondingDelegations      []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	Redelegations             []Redelegation        `json:"redelegations" yaml:"redelegations"`
+	ConsPubKeyRotations       []ConsPubKeyRotation  `json:"cons_pubkey_rotations" yaml:"cons_pubkey_rotations"`
+	TokenizeShareRecords      []TokenizeShareRecord `json:"tokenize_share_records" yaml:"tokenize_share_records"`
+	LastTokenizeShareRecordID uint64                `json:"last_tokenize_share_record_id" yaml:"last_tokenize_share_record_id"`
+	Exported                  bool                  `json:"exported" yaml:"exported"`
 }
 
 // LastValidatorPower required for validator set update logic
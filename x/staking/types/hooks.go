@@ -36,6 +36,16 @@ func (h MultiStakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAdd
 		h[i].AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
 	}
 }
+func (h MultiStakingHooks) AfterConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+	}
+}
+func (h MultiStakingHooks) AfterConsPubKeyRotationPruned(ctx sdk.Context, oldConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterConsPubKeyRotationPruned(ctx, oldConsAddr, valAddr)
+	}
+}
 func (h MultiStakingHooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	for i := range h {
 		h[i].BeforeDelegationCreated(ctx, delAddr, valAddr)
@@ -61,3 +71,11 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 		h[i].BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+func (h MultiStakingHooks) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error {
+	for i := range h {
+		if err := h[i].BeforeTokenizeShareRecordRemoved(ctx, recordID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
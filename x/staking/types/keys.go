@@ -20,6 +20,10 @@ const (
 
 	// RouterKey is the msg router key for the staking module
 	RouterKey = ModuleName
+
+	// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+	// accounts holding tokenized delegations
+	TokenizeShareModuleAccountPrefix = "tokenizeshare_"
 )
 
 var (
@@ -32,6 +36,9 @@ var (
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
 
+	PendingConsPubKeyRotationKey = []byte{0x24} // prefix for each key to a consensus pubkey rotation to apply at the end of the block
+	ConsPubKeyRotationKey        = []byte{0x25} // prefix for each key to an applied consensus pubkey rotation
+
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
 	UnbondingDelegationByValIndexKey = []byte{0x33} // prefix for each key for an unbonding-delegation, by validator operator
@@ -43,7 +50,15 @@ var (
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
+	ConsPubKeyRotationQueueKey = []byte{0x44} // prefix for the timestamps in consensus pubkey rotation queue
+
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordKey          = []byte{0x61} // prefix for each key to a tokenize share record
+	TokenizeShareRecordIDByOwnerKey = []byte{0x62} // prefix for each key to a tokenize share record ID, by owner
+	TokenizeShareRecordIDByDenomKey = []byte{0x63} // prefix for each key to a tokenize share record ID, by share token denom
+	LastTokenizeShareRecordIDKey    = []byte{0x64} // key for the last tokenize share record ID
+	ValidatorLiquidSharesKey        = []byte{0x65} // prefix for each key to the tokenized shares of a validator
 )
 
 // gets the key for the validator with address
@@ -126,6 +141,36 @@ func GetValidatorQueueTimeKey(timestamp time.Time) []byte {
 	return append(ValidatorQueueKey, bz...)
 }
 
+// gets the key for the consensus pubkey rotation pending for a validator
+// VALUE: staking/ConsPubKeyRotation
+func GetPendingConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, valAddr.Bytes()...)
+}
+
+// gets the key for the consensus pubkey rotation of a validator applied at
+// the given height
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetConsPubKeyRotationsKey(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// gets the prefix for all the applied consensus pubkey rotations of a validator
+func GetConsPubKeyRotationsKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, valAddr.Bytes()...)
+}
+
+// gets the prefix for all consensus pubkey rotations to prune at a given time
+func GetConsPubKeyRotationQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ConsPubKeyRotationQueueKey, bz...)
+}
+
+// gets the key for a consensus pubkey rotation to prune at a given time
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationQueueKey(timestamp time.Time, valAddr sdk.ValAddress) []byte {
+	return append(GetConsPubKeyRotationQueueTimeKey(timestamp), valAddr.Bytes()...)
+}
+
 //______________________________________________________________________________
 
 // gets the key for delegator bond with validator
@@ -293,3 +338,38 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+//________________________________________________________________________________
+
+// GetTokenizeShareRecordKey gets the key for a tokenize share record
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerKey gets the prefix keyspace for the IDs of
+// the tokenize share records of an owner
+func GetTokenizeShareRecordIDsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerKey, owner.Bytes()...)
+}
+
+// GetTokenizeShareRecordIDByOwnerKey gets the index-key for a tokenize share
+// record, stored by owner
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey gets the index-key for a tokenize share
+// record, stored by share token denom
+// VALUE: tokenize share record ID (big endian)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomKey, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey gets the key for the tokenized shares of a
+// validator
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, valAddr.Bytes()...)
+}
@@ -21,12 +21,17 @@ const (
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
 	QueryHistoricalInfo                = "historicalInfo"
+	QueryConsPubKeyRotations           = "consPubKeyRotations"
+	QueryTokenizeShareRecord           = "tokenizeShareRecord"
+	QueryTokenizeShareRecordsByOwner   = "tokenizeShareRecordsByOwner"
+	QueryTotalLiquidStaked             = "totalLiquidStaked"
 )
 
 // defines the params for the following queries:
 // - 'custom/staking/delegatorDelegations'
 // - 'custom/staking/delegatorUnbondingDelegations'
 // - 'custom/staking/delegatorValidators'
+// - 'custom/staking/tokenizeShareRecordsByOwner'
 type QueryDelegatorParams struct {
 	DelegatorAddr sdk.AccAddress
 }
@@ -42,6 +47,7 @@ func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams
 // - 'custom/staking/validatorDelegations'
 // - 'custom/staking/validatorUnbondingDelegations'
 // - 'custom/staking/validatorRedelegations'
+// - 'custom/staking/consPubKeyRotations'
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
 	Page, Limit   int
@@ -108,3 +114,14 @@ type QueryHistoricalInfoParams struct {
 func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
 	return QueryHistoricalInfoParams{height}
 }
+
+// QueryTokenizeShareRecordParams defines the params for the following queries:
+// - 'custom/staking/tokenizeShareRecord'
+type QueryTokenizeShareRecordParams struct {
+	ID uint64
+}
+
+// NewQueryTokenizeShareRecordParams creates a new QueryTokenizeShareRecordParams instance
+func NewQueryTokenizeShareRecordParams(id uint64) QueryTokenizeShareRecordParams {
+	return QueryTokenizeShareRecordParams{id}
+}
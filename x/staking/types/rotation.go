@@ -0,0 +1,79 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewConsPubKeyRotation creates a new ConsPubKeyRotation instance. The height
+// and time are the ones of the block in which the rotation takes effect.
+func NewConsPubKeyRotation(
+	valAddr sdk.ValAddress, oldPubKey, newPubKey crypto.PubKey, height int64, rotationTime time.Time,
+) ConsPubKeyRotation {
+	return ConsPubKeyRotation{
+		ValidatorAddress: valAddr,
+		OldPubkey:        sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, oldPubKey),
+		NewPubkey:        sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, newPubKey),
+		Height:           height,
+		Time:             rotationTime,
+	}
+}
+
+// MustMarshalConsPubKeyRotation returns the consensus pubkey rotation bytes.
+// Panics if fails.
+func MustMarshalConsPubKeyRotation(cdc codec.Marshaler, rotation ConsPubKeyRotation) []byte {
+	return cdc.MustMarshalBinaryBare(&rotation)
+}
+
+// MustUnmarshalConsPubKeyRotation unmarshals a consensus pubkey rotation from
+// a store value. Panics if fails.
+func MustUnmarshalConsPubKeyRotation(cdc codec.Marshaler, value []byte) ConsPubKeyRotation {
+	var rotation ConsPubKeyRotation
+	cdc.MustUnmarshalBinaryBare(value, &rotation)
+
+	return rotation
+}
+
+// GetOldConsPubKey returns the consensus pubkey replaced by the rotation.
+func (r ConsPubKeyRotation) GetOldConsPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.OldPubkey)
+}
+
+// GetNewConsPubKey returns the consensus pubkey set by the rotation.
+func (r ConsPubKeyRotation) GetNewConsPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.NewPubkey)
+}
+
+// GetOldConsAddr returns the consensus address replaced by the rotation.
+func (r ConsPubKeyRotation) GetOldConsAddr() sdk.ConsAddress {
+	return sdk.ConsAddress(r.GetOldConsPubKey().Address())
+}
+
+// GetNewConsAddr returns the consensus address set by the rotation.
+func (r ConsPubKeyRotation) GetNewConsAddr() sdk.ConsAddress {
+	return sdk.ConsAddress(r.GetNewConsPubKey().Address())
+}
+
+// String returns a human readable string representation of a consensus
+// pubkey rotation.
+func (r ConsPubKeyRotation) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// ConsPubKeyRotations is a collection of consensus pubkey rotations
+type ConsPubKeyRotations []ConsPubKeyRotation
+
+func (r ConsPubKeyRotations) String() (out string) {
+	for _, rotation := range r {
+		out += rotation.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
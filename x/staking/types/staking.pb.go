@@ -372,6 +372,255 @@ func (m *MsgUndelegate) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgCancelUnbondingDelegation defines an SDK message for re-bonding part or
+// all of an unbonding delegation entry back to its validator. The entry is
+// identified by its creation height.
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Amount           types.Coin                                    `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	CreationHeight   int64                                         `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+}
+
+func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbondingDelegation{} }
+func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{5}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnbondingDelegation proto.InternalMessageInfo
+
+func (m *MsgCancelUnbondingDelegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgCancelUnbondingDelegation) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgCancelUnbondingDelegation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgCancelUnbondingDelegation) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+// MsgTokenizeShares defines an SDK message for tokenizing an amount of a
+// delegation into share tokens of the validator.
+type MsgTokenizeShares struct {
+	DelegatorAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Amount              types.Coin                                    `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	TokenizedShareOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=tokenized_share_owner,json=tokenizedShareOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"tokenized_share_owner,omitempty" yaml:"tokenized_share_owner"`
+}
+
+func (m *MsgTokenizeShares) Reset()         { *m = MsgTokenizeShares{} }
+func (m *MsgTokenizeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeShares) ProtoMessage()    {}
+func (*MsgTokenizeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{6}
+}
+func (m *MsgTokenizeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenizeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeShares.Merge(m, src)
+}
+func (m *MsgTokenizeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenizeShares proto.InternalMessageInfo
+
+func (m *MsgTokenizeShares) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgTokenizeShares) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgTokenizeShares) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgTokenizeShares) GetTokenizedShareOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.TokenizedShareOwner
+	}
+	return nil
+}
+
+// MsgRedeemTokensForShares defines an SDK message for redeeming share tokens
+// back into a delegation to the validator.
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Amount           types.Coin                                    `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForShares) Reset()         { *m = MsgRedeemTokensForShares{} }
+func (m *MsgRedeemTokensForShares) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForShares) ProtoMessage()    {}
+func (*MsgRedeemTokensForShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{7}
+}
+func (m *MsgRedeemTokensForShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemTokensForShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemTokensForShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemTokensForShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForShares.Merge(m, src)
+}
+func (m *MsgRedeemTokensForShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemTokensForShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemTokensForShares proto.InternalMessageInfo
+
+func (m *MsgRedeemTokensForShares) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgRedeemTokensForShares) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgRotateConsPubKey defines an SDK message for rotating the consensus public
+// key of an existing validator.
+type MsgRotateConsPubKey struct {
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"address"`
+	NewPubkey        string                                        `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty" yaml:"new_pubkey"`
+}
+
+func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
+func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKey) ProtoMessage()    {}
+func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{8}
+}
+func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKey.Merge(m, src)
+}
+func (m *MsgRotateConsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKey proto.InternalMessageInfo
+
+func (m *MsgRotateConsPubKey) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgRotateConsPubKey) GetNewPubkey() string {
+	if m != nil {
+		return m.NewPubkey
+	}
+	return ""
+}
+
 // HistoricalInfo contains the historical information that gets stored at
 // each height.
 type HistoricalInfo struct {
@@ -383,7 +632,7 @@ func (m *HistoricalInfo) Reset()         { *m = HistoricalInfo{} }
 func (m *HistoricalInfo) String() string { return proto.CompactTextString(m) }
 func (*HistoricalInfo) ProtoMessage()    {}
 func (*HistoricalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{5}
+	return fileDescriptor_146003fcdb99b683, []int{9}
 }
 func (m *HistoricalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +686,7 @@ type CommissionRates struct {
 func (m *CommissionRates) Reset()      { *m = CommissionRates{} }
 func (*CommissionRates) ProtoMessage() {}
 func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{6}
+	return fileDescriptor_146003fcdb99b683, []int{10}
 }
 func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,7 +724,7 @@ type Commission struct {
 func (m *Commission) Reset()      { *m = Commission{} }
 func (*Commission) ProtoMessage() {}
 func (*Commission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{7}
+	return fileDescriptor_146003fcdb99b683, []int{11}
 }
 func (m *Commission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +772,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{8}
+	return fileDescriptor_146003fcdb99b683, []int{12}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +860,7 @@ type Validator struct {
 func (m *Validator) Reset()      { *m = Validator{} }
 func (*Validator) ProtoMessage() {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{9}
+	return fileDescriptor_146003fcdb99b683, []int{13}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +900,7 @@ type DVPair struct {
 func (m *DVPair) Reset()      { *m = DVPair{} }
 func (*DVPair) ProtoMessage() {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{10}
+	return fileDescriptor_146003fcdb99b683, []int{14}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -703,7 +952,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{11}
+	return fileDescriptor_146003fcdb99b683, []int{15}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +1001,7 @@ type DVVTriplet struct {
 func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
 func (*DVVTriplet) ProtoMessage() {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{12}
+	return fileDescriptor_146003fcdb99b683, []int{16}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1060,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{13}
+	return fileDescriptor_146003fcdb99b683, []int{17}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +1108,7 @@ type Delegation struct {
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{14}
+	return fileDescriptor_146003fcdb99b683, []int{18}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +1162,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{15}
+	return fileDescriptor_146003fcdb99b683, []int{19}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1223,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{16}
+	return fileDescriptor_146003fcdb99b683, []int{20}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1277,7 @@ type RedelegationEntry struct {
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{17}
+	return fileDescriptor_146003fcdb99b683, []int{21}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1332,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{18}
+	return fileDescriptor_146003fcdb99b683, []int{22}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,17 +1391,21 @@ func (m *Redelegation) GetEntries() []RedelegationEntry {
 
 // Params defines the parameters for the staking module.
 type Params struct {
-	UnbondingTime     time.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time" yaml:"unbonding_time"`
-	MaxValidators     uint32        `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty" yaml:"max_validators"`
-	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
-	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
-	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	UnbondingTime             time.Duration                            `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time" yaml:"unbonding_time"`
+	MaxValidators             uint32                                   `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty" yaml:"max_validators"`
+	MaxEntries                uint32                                   `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
+	HistoricalEntries         uint32                                   `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
+	BondDenom                 string                                   `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	KeyRotationFee            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=key_rotation_fee,json=keyRotationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	MaxKeyRotations           uint32                                   `protobuf:"varint,7,opt,name=max_key_rotations,json=maxKeyRotations,proto3" json:"max_key_rotations,omitempty" yaml:"max_key_rotations"`
+	GlobalLiquidStakingCap    github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{19}
+	return fileDescriptor_146003fcdb99b683, []int{23}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1216,160 +1469,355 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.MsgCreateValidator")
-	proto.RegisterType((*MsgEditValidator)(nil), "cosmos.staking.MsgEditValidator")
-	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.MsgDelegate")
-	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.MsgBeginRedelegate")
-	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.MsgUndelegate")
-	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.HistoricalInfo")
-	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.CommissionRates")
-	proto.RegisterType((*Commission)(nil), "cosmos.staking.Commission")
-	proto.RegisterType((*Description)(nil), "cosmos.staking.Description")
-	proto.RegisterType((*Validator)(nil), "cosmos.staking.Validator")
-	proto.RegisterType((*DVPair)(nil), "cosmos.staking.DVPair")
-	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.DVPairs")
-	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.DVVTriplet")
-	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.DVVTriplets")
-	proto.RegisterType((*Delegation)(nil), "cosmos.staking.Delegation")
-	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.UnbondingDelegation")
-	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.UnbondingDelegationEntry")
-	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.RedelegationEntry")
-	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.Redelegation")
-	proto.RegisterType((*Params)(nil), "cosmos.staking.Params")
+func (m *Params) GetKeyRotationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("cosmos/staking/staking.proto", fileDescriptor_146003fcdb99b683) }
-
-var fileDescriptor_146003fcdb99b683 = []byte{
-	// 1657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x92, 0x14, 0x25, 0x3d, 0x4a, 0xa4, 0xb4, 0xaa, 0x65, 0x4a, 0xb6, 0xb9, 0xf2, 0x1e,
-	0x0a, 0xa1, 0xa8, 0x29, 0xd4, 0x2d, 0x60, 0x40, 0x6d, 0x81, 0x8a, 0xa4, 0x04, 0x09, 0xb5, 0x00,
-	0x77, 0x65, 0xeb, 0xd0, 0x16, 0x20, 0x86, 0xbb, 0xa3, 0xd5, 0x56, 0xdc, 0x5d, 0x76, 0x67, 0x68,
-	0x4b, 0x45, 0xaf, 0x05, 0x8a, 0xa2, 0x45, 0x7d, 0xf4, 0xd1, 0xc8, 0x1f, 0x90, 0x1c, 0x93, 0xfc,
-	0x07, 0xce, 0xcd, 0xc8, 0x21, 0x08, 0x72, 0x60, 0x12, 0xfb, 0x90, 0x9c, 0x79, 0xc8, 0x21, 0xa7,
-	0x60, 0x3e, 0xf6, 0x43, 0x4b, 0x2a, 0xa2, 0x14, 0xc7, 0x31, 0x60, 0x5d, 0x6c, 0xce, 0xdb, 0xdf,
-	0x7b, 0x6f, 0xe6, 0x7d, 0xcf, 0x08, 0xae, 0x9b, 0x3e, 0x71, 0x7d, 0xb2, 0x4a, 0x28, 0x3a, 0x74,
-	0x3c, 0x3b, 0xfc, 0xbf, 0xda, 0x09, 0x7c, 0xea, 0xab, 0x45, 0xf1, 0xb5, 0x2a, 0xa9, 0x4b, 0x3f,
-	0xb3, 0x7d, 0xdb, 0xe7, 0x9f, 0x56, 0xd9, 0x2f, 0x81, 0x5a, 0xba, 0x49, 0xb1, 0x67, 0xe1, 0xc0,
-	0x75, 0x3c, 0xba, 0x8a, 0x5a, 0xa6, 0xb3, 0x4a, 0x8f, 0x3b, 0x98, 0x88, 0x7f, 0x25, 0x44, 0xb3,
-	0x7d, 0xdf, 0x6e, 0xe3, 0x55, 0xbe, 0x6a, 0x75, 0xf7, 0x57, 0xa9, 0xe3, 0x62, 0x42, 0x91, 0xdb,
-	0x91, 0x80, 0x4a, 0x1a, 0x60, 0x75, 0x03, 0x44, 0x1d, 0xdf, 0x93, 0xdf, 0xe7, 0xe5, 0x3e, 0xe5,
-	0x86, 0x38, 0x51, 0xef, 0xe5, 0x40, 0xdd, 0x21, 0x76, 0x3d, 0xc0, 0x88, 0xe2, 0x3d, 0xd4, 0x76,
-	0x2c, 0x44, 0xfd, 0x40, 0xad, 0x43, 0xc1, 0xc2, 0xc4, 0x0c, 0x9c, 0x0e, 0x13, 0x50, 0x56, 0x96,
-	0x95, 0x95, 0xc2, 0xed, 0x6b, 0xd5, 0x93, 0x67, 0xa9, 0x36, 0x62, 0x48, 0x2d, 0xf7, 0xac, 0xa7,
-	0x8d, 0x19, 0x49, 0x2e, 0x75, 0x03, 0xc0, 0xf4, 0x5d, 0xd7, 0x21, 0x84, 0xc9, 0xc8, 0x70, 0x19,
-	0x5a, 0x5a, 0x46, 0x3d, 0x42, 0x18, 0x88, 0x62, 0x22, 0xe5, 0x24, 0x18, 0xd5, 0x7f, 0xc2, 0xbc,
-	0xeb, 0x78, 0x4d, 0x82, 0xdb, 0xfb, 0x4d, 0x0b, 0xb7, 0xb1, 0xcd, 0x0f, 0x55, 0xce, 0x2e, 0x2b,
-	0x2b, 0x53, 0xb5, 0xbb, 0x0c, 0xfe, 0x59, 0x4f, 0xfb, 0xb9, 0xed, 0xd0, 0x83, 0x6e, 0xab, 0x6a,
-	0xfa, 0xee, 0xea, 0x89, 0x73, 0xde, 0x22, 0xd6, 0xa1, 0xb4, 0xe3, 0xb6, 0x47, 0xfb, 0x3d, 0x6d,
-	0xe9, 0x18, 0xb9, 0xed, 0x35, 0x7d, 0x88, 0x48, 0xdd, 0x98, 0x73, 0x1d, 0x6f, 0x17, 0xb7, 0xf7,
-	0x1b, 0x11, 0x4d, 0xfd, 0x07, 0xcc, 0x49, 0x84, 0x1f, 0x34, 0x91, 0x65, 0x05, 0x98, 0x90, 0x72,
-	0x6e, 0x59, 0x59, 0x99, 0xae, 0xed, 0xf4, 0x7b, 0x5a, 0x59, 0x48, 0x1b, 0x80, 0xe8, 0xdf, 0xf6,
-	0xb4, 0x5b, 0x23, 0xec, 0x69, 0xdd, 0x34, 0xd7, 0x05, 0x87, 0x31, 0x1b, 0x09, 0x91, 0x14, 0xa6,
-	0xfb, 0x61, 0xe8, 0x92, 0x48, 0xf7, 0x78, 0x5a, 0xf7, 0x00, 0x64, 0x54, 0xdd, 0x7b, 0xa8, 0x1d,
-	0xe9, 0x8e, 0x84, 0x84, 0xba, 0x17, 0x20, 0xdf, 0xe9, 0xb6, 0x0e, 0xf1, 0x71, 0x39, 0xcf, 0x0c,
-	0x6d, 0xc8, 0x95, 0xba, 0x02, 0xe3, 0x0f, 0x51, 0xbb, 0x8b, 0xcb, 0x13, 0xdc, 0x9f, 0xd3, 0xa1,
-	0x3f, 0xeb, 0xbe, 0x13, 0x06, 0x81, 0x00, 0xac, 0xe5, 0xbe, 0x7e, 0xaa, 0x29, 0xfa, 0x07, 0x59,
-	0x98, 0xdd, 0x21, 0xf6, 0x86, 0xe5, 0xd0, 0x57, 0x1c, 0x5e, 0x9d, 0x61, 0xd6, 0xc9, 0x70, 0xeb,
-	0xd4, 0xfb, 0x3d, 0xad, 0x28, 0xac, 0xf3, 0x2a, 0x6d, 0xe2, 0x42, 0x29, 0x8e, 0xcb, 0x66, 0x80,
-	0x28, 0x96, 0x51, 0xd8, 0x18, 0x31, 0x02, 0x1b, 0xd8, 0xec, 0xf7, 0xb4, 0x05, 0xb1, 0xb3, 0x94,
-	0x28, 0xdd, 0x28, 0x9a, 0x27, 0x72, 0x41, 0x3d, 0x1a, 0x1e, 0xf8, 0x39, 0xae, 0x72, 0xeb, 0x47,
-	0x0c, 0x7a, 0xe9, 0xba, 0xf7, 0x33, 0x50, 0xd8, 0x21, 0xb6, 0xa4, 0xe3, 0xe1, 0xa9, 0xa0, 0xfc,
-	0x84, 0xa9, 0x90, 0x79, 0x3d, 0xa9, 0xf0, 0x0b, 0xc8, 0x23, 0xd7, 0xef, 0x7a, 0x94, 0x7b, 0x7b,
-	0x78, 0xcc, 0x4b, 0x84, 0xb4, 0xdc, 0xc7, 0x59, 0x5e, 0x55, 0x6b, 0xd8, 0x76, 0x3c, 0x03, 0x5b,
-	0x6f, 0x82, 0x01, 0xff, 0xa5, 0xc0, 0x95, 0xd8, 0x3c, 0x24, 0x30, 0x53, 0x56, 0xfc, 0x53, 0xbf,
-	0xa7, 0x5d, 0x4f, 0x5b, 0x31, 0x01, 0xbb, 0x80, 0x25, 0xe7, 0x23, 0x41, 0xbb, 0x81, 0x39, 0x7c,
-	0x1f, 0x16, 0xa1, 0xd1, 0x3e, 0xb2, 0xa7, 0xef, 0x23, 0x01, 0xfb, 0x41, 0xfb, 0x68, 0x10, 0x3a,
-	0xe8, 0xd4, 0xdc, 0x88, 0x4e, 0xfd, 0x30, 0x03, 0x33, 0x3b, 0xc4, 0x7e, 0xe0, 0x59, 0x97, 0x09,
-	0x71, 0xde, 0x84, 0xf8, 0xaf, 0x02, 0xc5, 0x2d, 0x87, 0x50, 0x3f, 0x70, 0x4c, 0xd4, 0xde, 0xf6,
-	0xf6, 0x7d, 0xf5, 0xb7, 0x90, 0x3f, 0xc0, 0xc8, 0xc2, 0x81, 0x2c, 0xff, 0x37, 0xaa, 0xf1, 0x0c,
-	0x54, 0x65, 0x33, 0x50, 0x55, 0xec, 0x64, 0x8b, 0x83, 0x42, 0xa9, 0x82, 0x45, 0xbd, 0x03, 0xf9,
-	0x87, 0xa8, 0x4d, 0x30, 0x2d, 0x67, 0x96, 0xb3, 0x2b, 0x85, 0xdb, 0x8b, 0xe9, 0xde, 0x11, 0xf5,
-	0x9a, 0x90, 0x51, 0xc0, 0xe5, 0x76, 0xde, 0xcb, 0x40, 0x29, 0x35, 0x78, 0xa8, 0x35, 0xc8, 0xf1,
-	0x8a, 0xae, 0xf0, 0xf2, 0x5a, 0x3d, 0xc7, 0x5c, 0xd1, 0xc0, 0xa6, 0xc1, 0x79, 0xd5, 0xbf, 0xc2,
-	0xa4, 0x8b, 0x8e, 0x44, 0x67, 0xc8, 0x70, 0x39, 0xeb, 0xe7, 0x93, 0xd3, 0xef, 0x69, 0x25, 0x59,
-	0xaa, 0xa5, 0x1c, 0xdd, 0x98, 0x70, 0xd1, 0x11, 0xef, 0x07, 0x1d, 0x28, 0x31, 0xaa, 0x79, 0x80,
-	0x3c, 0x1b, 0x27, 0xdb, 0xcf, 0xd6, 0xb9, 0x95, 0x2c, 0xc4, 0x4a, 0x12, 0xe2, 0x74, 0x63, 0xc6,
-	0x45, 0x47, 0x75, 0x4e, 0x60, 0x1a, 0xd7, 0x26, 0x9f, 0x3c, 0xd5, 0xc6, 0xb8, 0xc5, 0x3e, 0x52,
-	0x00, 0x62, 0x8b, 0xa9, 0xf7, 0x61, 0x36, 0xd5, 0xbe, 0x88, 0x74, 0xe3, 0x99, 0x03, 0xde, 0x24,
-	0xdb, 0xec, 0xf3, 0x9e, 0xa6, 0x18, 0x25, 0x33, 0xe5, 0x82, 0xbf, 0x40, 0xa1, 0xdb, 0xb1, 0x10,
-	0xc5, 0x4d, 0x36, 0xdb, 0xca, 0x89, 0x71, 0xa9, 0x2a, 0xe6, 0xda, 0x6a, 0x38, 0xd7, 0x56, 0xef,
-	0x87, 0x83, 0x6f, 0xad, 0xc2, 0x64, 0xf5, 0x7b, 0x9a, 0x2a, 0x8e, 0x93, 0x60, 0xd6, 0x1f, 0x7f,
-	0xae, 0x29, 0x06, 0x08, 0x0a, 0x63, 0x38, 0x79, 0x96, 0x42, 0x62, 0xb6, 0x50, 0xcb, 0x30, 0xe1,
-	0xfa, 0x9e, 0x73, 0x28, 0x43, 0x71, 0xca, 0x08, 0x97, 0xea, 0x12, 0x4c, 0x3a, 0x16, 0xf6, 0xa8,
-	0x43, 0x8f, 0x85, 0x3f, 0x8d, 0x68, 0xcd, 0xb8, 0x1e, 0xe1, 0x16, 0x71, 0x42, 0x2f, 0x18, 0xe1,
-	0x52, 0xdd, 0x84, 0x59, 0x82, 0xcd, 0x6e, 0xe0, 0xd0, 0xe3, 0xa6, 0xe9, 0x7b, 0x14, 0x99, 0x54,
-	0x36, 0xed, 0x6b, 0xfd, 0x9e, 0x76, 0x55, 0xec, 0x35, 0x8d, 0xd0, 0x8d, 0x52, 0x48, 0xaa, 0x0b,
-	0x0a, 0xd3, 0x60, 0x61, 0x8a, 0x9c, 0xb6, 0x18, 0xfa, 0xa6, 0x8c, 0x70, 0x99, 0x38, 0xcb, 0xbb,
-	0x13, 0x30, 0x15, 0xcf, 0x55, 0x8f, 0x60, 0xd6, 0xef, 0xe0, 0x60, 0x48, 0x3d, 0xba, 0x1b, 0x6b,
-	0x4e, 0x23, 0x2e, 0x50, 0x12, 0x4a, 0xa1, 0x8c, 0xb0, 0x22, 0x6c, 0xb2, 0x78, 0xf0, 0x08, 0xf6,
-	0x48, 0x97, 0x34, 0xe5, 0xdc, 0x98, 0x49, 0x1f, 0x39, 0x8d, 0xd0, 0x59, 0x04, 0x48, 0xd2, 0x3d,
-	0x31, 0x5d, 0x2e, 0x40, 0xfe, 0x6f, 0xc8, 0x69, 0x63, 0x8b, 0xdb, 0x74, 0xd2, 0x90, 0x2b, 0x75,
-	0x1b, 0xf2, 0x84, 0x22, 0xda, 0x15, 0xa3, 0xf7, 0x78, 0xed, 0x57, 0x23, 0xee, 0xb9, 0xe6, 0x7b,
-	0xd6, 0x2e, 0x67, 0x34, 0xa4, 0x00, 0x75, 0x13, 0xf2, 0xd4, 0x3f, 0xc4, 0x9e, 0x34, 0xea, 0xb9,
-	0x32, 0x7d, 0xdb, 0xa3, 0x86, 0xe4, 0x56, 0x29, 0xc4, 0x45, 0xb9, 0x49, 0x0e, 0x50, 0x80, 0x89,
-	0x18, 0x95, 0x6b, 0xdb, 0xe7, 0x4e, 0xc7, 0xab, 0xe9, 0x4e, 0x21, 0xe4, 0xe9, 0x46, 0x29, 0x22,
-	0xed, 0x72, 0x4a, 0x7a, 0x72, 0x9e, 0xb8, 0xd0, 0xe4, 0xbc, 0x09, 0xb3, 0x5d, 0xaf, 0xe5, 0x7b,
-	0x96, 0xe3, 0xd9, 0xcd, 0x03, 0xec, 0xd8, 0x07, 0xb4, 0x3c, 0xb9, 0xac, 0xac, 0x64, 0x93, 0xde,
-	0x4a, 0x23, 0x74, 0xa3, 0x14, 0x91, 0xb6, 0x38, 0x45, 0xb5, 0xa0, 0x18, 0xa3, 0x78, 0xca, 0x4e,
-	0x9d, 0x99, 0xb2, 0x37, 0x65, 0xca, 0x5e, 0x49, 0x6b, 0x89, 0xb3, 0x76, 0x26, 0x22, 0x32, 0x36,
-	0xf5, 0x0f, 0x27, 0xae, 0x91, 0x20, 0x35, 0x9c, 0x5a, 0x65, 0x46, 0xbf, 0x41, 0x16, 0x5e, 0xcb,
-	0x0d, 0x72, 0x6d, 0xfa, 0xdf, 0x4f, 0xb5, 0xb1, 0x28, 0x61, 0xff, 0x93, 0x81, 0x7c, 0x63, 0xef,
-	0x1e, 0x72, 0x82, 0xb7, 0x75, 0x7c, 0x48, 0x54, 0xaf, 0xdf, 0xc3, 0x84, 0xb0, 0x05, 0x51, 0x6f,
-	0xc3, 0x78, 0x87, 0xfd, 0x28, 0x2b, 0xbc, 0xa1, 0x2f, 0x0c, 0x84, 0x34, 0xc7, 0x85, 0x37, 0x4c,
-	0x0e, 0xd5, 0xdf, 0xc9, 0x02, 0x34, 0xf6, 0xf6, 0xee, 0x07, 0x4e, 0xa7, 0x8d, 0xe9, 0xe5, 0x78,
-	0xfd, 0xe6, 0x8c, 0xd7, 0x09, 0x1f, 0xff, 0x11, 0x0a, 0xb1, 0x8f, 0x88, 0xfa, 0x3b, 0x98, 0xa4,
-	0xf2, 0xb7, 0x74, 0xf5, 0xd2, 0xa0, 0xab, 0x43, 0xb8, 0x74, 0x77, 0xc4, 0xa1, 0x7f, 0x92, 0x01,
-	0x38, 0xeb, 0x71, 0xe6, 0x2d, 0x18, 0xc0, 0x37, 0x21, 0x2f, 0x3b, 0x4e, 0xf6, 0x42, 0xd3, 0xaa,
-	0xe4, 0x4e, 0x78, 0xe9, 0xcb, 0x0c, 0xcc, 0x3f, 0x08, 0xcb, 0xee, 0xa5, 0x85, 0xd5, 0x2d, 0x98,
-	0xc0, 0x1e, 0x0d, 0x1c, 0x6e, 0x62, 0x16, 0xa5, 0x2b, 0xe9, 0x28, 0x1d, 0x62, 0xad, 0x0d, 0x8f,
-	0x06, 0xc7, 0x32, 0x66, 0x43, 0xf6, 0x84, 0x8d, 0xff, 0x9f, 0x85, 0xf2, 0x69, 0x5c, 0x6a, 0x1d,
-	0x4a, 0x66, 0x80, 0x39, 0x21, 0x6c, 0xc9, 0x0a, 0x6f, 0xc9, 0x4b, 0x89, 0x17, 0xa3, 0x93, 0x00,
-	0xdd, 0x28, 0x86, 0x14, 0xd9, 0x90, 0x6d, 0xfe, 0x40, 0xc5, 0x52, 0x85, 0xa1, 0x46, 0x1c, 0xa2,
-	0x75, 0xd9, 0x91, 0xe3, 0x67, 0xa9, 0xa4, 0x00, 0xd1, 0x92, 0x8b, 0x31, 0x95, 0xf7, 0xe4, 0xbf,
-	0x43, 0xc9, 0xf1, 0x1c, 0xea, 0xa0, 0x76, 0xb3, 0x85, 0xda, 0xc8, 0x33, 0x2f, 0x72, 0x15, 0x11,
-	0xdd, 0x54, 0xaa, 0x4d, 0x89, 0xd3, 0x8d, 0xa2, 0xa4, 0xd4, 0x04, 0x81, 0x79, 0x24, 0x54, 0x95,
-	0xbb, 0xd0, 0xe0, 0x16, 0xb2, 0x27, 0x3c, 0xf2, 0xbf, 0x2c, 0xcc, 0x45, 0xef, 0x33, 0x97, 0xae,
-	0x18, 0xd5, 0x15, 0x3b, 0x00, 0xa2, 0x80, 0xb0, 0xce, 0x71, 0x01, 0x6f, 0xb0, 0x12, 0x34, 0x25,
-	0x24, 0x34, 0x08, 0x4d, 0xf8, 0xe3, 0xab, 0x2c, 0x4c, 0x27, 0xfd, 0x71, 0xd9, 0xd2, 0xdf, 0xa0,
-	0x17, 0xb3, 0xf5, 0xb8, 0x24, 0xe6, 0x78, 0x49, 0xbc, 0x99, 0x2e, 0x89, 0x03, 0xa9, 0x74, 0x7a,
-	0x2d, 0xfc, 0x26, 0x03, 0xf9, 0x7b, 0x28, 0x40, 0x2e, 0x51, 0xcd, 0x81, 0x5b, 0x84, 0x78, 0x49,
-	0x58, 0x1c, 0x48, 0x94, 0x86, 0xfc, 0x83, 0xd6, 0x19, 0x97, 0x88, 0x27, 0x43, 0x2f, 0x11, 0x45,
-	0x17, 0x1d, 0x35, 0xa3, 0x73, 0x09, 0x27, 0xce, 0xd4, 0x16, 0x63, 0x29, 0x27, 0xbf, 0x8b, 0xb7,
-	0x90, 0xe8, 0x6a, 0x4d, 0xd4, 0x3b, 0x50, 0x60, 0x88, 0xb8, 0x2b, 0x30, 0xf6, 0x85, 0xf8, 0xf1,
-	0x21, 0xf1, 0x51, 0x37, 0xc0, 0x45, 0x47, 0x1b, 0x62, 0xa1, 0xde, 0x05, 0xf5, 0x20, 0x7a, 0xfa,
-	0x6a, 0xc6, 0x26, 0x64, 0xfc, 0x37, 0xfa, 0x3d, 0x6d, 0x51, 0xf0, 0x0f, 0x62, 0x74, 0x63, 0x2e,
-	0x26, 0x86, 0xd2, 0x7e, 0x03, 0xc0, 0xce, 0xd5, 0xb4, 0xb0, 0xe7, 0xbb, 0xf2, 0x0a, 0x7b, 0xa5,
-	0xdf, 0xd3, 0xe6, 0x84, 0x94, 0xf8, 0x9b, 0x6e, 0x4c, 0xb1, 0x45, 0x83, 0xfd, 0x8e, 0x0d, 0x5f,
-	0xdb, 0x7c, 0xf6, 0xa2, 0xa2, 0x3c, 0x7f, 0x51, 0x51, 0xbe, 0x78, 0x51, 0x51, 0x1e, 0xbf, 0xac,
-	0x8c, 0x3d, 0x7f, 0x59, 0x19, 0xfb, 0xf4, 0x65, 0x65, 0xec, 0xcf, 0xbf, 0xfc, 0xde, 0x18, 0x39,
-	0x8a, 0xfe, 0xbe, 0xc9, 0xa3, 0xa5, 0x95, 0xe7, 0x5e, 0xf9, 0xf5, 0x77, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x28, 0x97, 0x39, 0x40, 0xfe, 0x1c, 0x00, 0x00,
+func (m *Params) GetMaxKeyRotations() uint32 {
+	if m != nil {
+		return m.MaxKeyRotations
+	}
+	return 0
 }
 
-func (this *MsgCreateValidator) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+// ConsPubKeyRotation defines a rotation of the consensus public key of a
+// validator. The old consensus address keeps referring to the validator until
+// the rotation is pruned, one unbonding period after it took effect.
+type ConsPubKeyRotation struct {
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	OldPubkey        string                                        `protobuf:"bytes,2,opt,name=old_pubkey,json=oldPubkey,proto3" json:"old_pubkey,omitempty" yaml:"old_pubkey"`
+	NewPubkey        string                                        `protobuf:"bytes,3,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty" yaml:"new_pubkey"`
+	Height           int64                                         `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time             time.Time                                     `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
 
-	that1, ok := that.(*MsgCreateValidator)
-	if !ok {
-		that2, ok := that.(MsgCreateValidator)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ConsPubKeyRotation) Reset()      { *m = ConsPubKeyRotation{} }
+func (*ConsPubKeyRotation) ProtoMessage() {}
+func (*ConsPubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{24}
+}
+func (m *ConsPubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ConsPubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotation.Merge(m, src)
+}
+func (m *ConsPubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotation proto.InternalMessageInfo
+
+func (m *ConsPubKeyRotation) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if !this.Description.Equal(&that1.Description) {
-		return false
+	return nil
+}
+
+func (m *ConsPubKeyRotation) GetOldPubkey() string {
+	if m != nil {
+		return m.OldPubkey
+	}
+	return ""
+}
+
+func (m *ConsPubKeyRotation) GetNewPubkey() string {
+	if m != nil {
+		return m.NewPubkey
+	}
+	return ""
+}
+
+func (m *ConsPubKeyRotation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConsPubKeyRotation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// TokenizeShareRecord defines a delegation tokenized into share tokens. The
+// delegation is held by a module account dedicated to the record, whose
+// rewards are paid to the owner of the record.
+type TokenizeShareRecord struct {
+	ID            uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	ModuleAccount string                                        `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	Validator     github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,4,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()      { *m = TokenizeShareRecord{} }
+func (*TokenizeShareRecord) ProtoMessage() {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{25}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.MsgCreateValidator")
+	proto.RegisterType((*MsgEditValidator)(nil), "cosmos.staking.MsgEditValidator")
+	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.MsgDelegate")
+	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.MsgBeginRedelegate")
+	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.MsgUndelegate")
+	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "cosmos.staking.MsgCancelUnbondingDelegation")
+	proto.RegisterType((*MsgTokenizeShares)(nil), "cosmos.staking.MsgTokenizeShares")
+	proto.RegisterType((*MsgRedeemTokensForShares)(nil), "cosmos.staking.MsgRedeemTokensForShares")
+	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.staking.MsgRotateConsPubKey")
+	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.HistoricalInfo")
+	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.CommissionRates")
+	proto.RegisterType((*Commission)(nil), "cosmos.staking.Commission")
+	proto.RegisterType((*Description)(nil), "cosmos.staking.Description")
+	proto.RegisterType((*Validator)(nil), "cosmos.staking.Validator")
+	proto.RegisterType((*DVPair)(nil), "cosmos.staking.DVPair")
+	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.DVPairs")
+	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.DVVTriplet")
+	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.DVVTriplets")
+	proto.RegisterType((*Delegation)(nil), "cosmos.staking.Delegation")
+	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.UnbondingDelegation")
+	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.UnbondingDelegationEntry")
+	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.RedelegationEntry")
+	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.Redelegation")
+	proto.RegisterType((*Params)(nil), "cosmos.staking.Params")
+	proto.RegisterType((*ConsPubKeyRotation)(nil), "cosmos.staking.ConsPubKeyRotation")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.TokenizeShareRecord")
+}
+
+func init() { proto.RegisterFile("cosmos/staking/staking.proto", fileDescriptor_146003fcdb99b683) }
+
+var fileDescriptor_146003fcdb99b683 = []byte{
+	// 2094 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4b, 0x6c, 0x5b, 0x59,
+	0x35, 0xcf, 0x76, 0x9c, 0xf8, 0xa4, 0x8d, 0x93, 0x1b, 0x9a, 0x71, 0x32, 0x9d, 0xbc, 0xf4, 0x21,
+	0xa1, 0x08, 0x31, 0x8e, 0xa6, 0x54, 0x1a, 0x54, 0x40, 0x22, 0xb6, 0x1b, 0x12, 0xb5, 0xd1, 0x94,
+	0x97, 0x36, 0x0b, 0x40, 0xb2, 0xae, 0xdf, 0xbb, 0x71, 0x1e, 0x79, 0x1f, 0xcf, 0xbb, 0xd7, 0x4d,
+	0x32, 0x62, 0x87, 0x90, 0x10, 0xa2, 0x62, 0x24, 0x24, 0x34, 0xcb, 0x8a, 0x25, 0x0b, 0x58, 0x02,
+	0x6b, 0x84, 0x54, 0x76, 0x15, 0x0b, 0x34, 0x62, 0xe1, 0x81, 0x76, 0x01, 0x3b, 0x24, 0x2f, 0x59,
+	0xa1, 0xfb, 0x79, 0x1f, 0x3f, 0x3b, 0xd4, 0x0e, 0x33, 0xa5, 0x12, 0xd9, 0xb4, 0xbe, 0xe7, 0x9e,
+	0x73, 0xee, 0xb9, 0xe7, 0xdc, 0xf3, 0x7d, 0x81, 0xeb, 0x56, 0x40, 0xbd, 0x80, 0x6e, 0x52, 0x86,
+	0x8f, 0x1d, 0xbf, 0x1d, 0xfd, 0x5f, 0xed, 0x84, 0x01, 0x0b, 0xd0, 0xbc, 0xdc, 0xad, 0x2a, 0xe8,
+	0xea, 0xe7, 0xda, 0x41, 0x3b, 0x10, 0x5b, 0x9b, 0xfc, 0x97, 0xc4, 0x5a, 0xbd, 0xc1, 0x88, 0x6f,
+	0x93, 0xd0, 0x73, 0x7c, 0xb6, 0x89, 0x5b, 0x96, 0xb3, 0xc9, 0xce, 0x3a, 0x84, 0xca, 0x7f, 0x15,
+	0x8a, 0xde, 0x0e, 0x82, 0xb6, 0x4b, 0x36, 0xc5, 0xaa, 0xd5, 0x3d, 0xdc, 0x64, 0x8e, 0x47, 0x28,
+	0xc3, 0x5e, 0x47, 0x21, 0xac, 0x65, 0x11, 0xec, 0x6e, 0x88, 0x99, 0x13, 0xf8, 0x6a, 0x7f, 0x49,
+	0xc9, 0xa9, 0x04, 0x12, 0x40, 0xa3, 0x57, 0x00, 0xb4, 0x47, 0xdb, 0xf5, 0x90, 0x60, 0x46, 0x0e,
+	0xb0, 0xeb, 0xd8, 0x98, 0x05, 0x21, 0xaa, 0xc3, 0x9c, 0x4d, 0xa8, 0x15, 0x3a, 0x1d, 0xce, 0xa0,
+	0xa2, 0xad, 0x6b, 0x1b, 0x73, 0x37, 0xdf, 0xac, 0x0e, 0xde, 0xa5, 0xda, 0x48, 0x50, 0x6a, 0x85,
+	0xa7, 0x3d, 0x7d, 0xca, 0x4c, 0x53, 0xa1, 0x3b, 0x00, 0x56, 0xe0, 0x79, 0x0e, 0xa5, 0x9c, 0x47,
+	0x4e, 0xf0, 0xd0, 0xb3, 0x3c, 0xea, 0x31, 0x86, 0x89, 0x19, 0xa1, 0x8a, 0x4f, 0x8a, 0x10, 0x7d,
+	0x1f, 0x96, 0x3c, 0xc7, 0x6f, 0x52, 0xe2, 0x1e, 0x36, 0x6d, 0xe2, 0x92, 0xb6, 0xb8, 0x54, 0x25,
+	0xbf, 0xae, 0x6d, 0x94, 0x6a, 0xf7, 0x38, 0xfa, 0x5f, 0x7a, 0xfa, 0x17, 0xda, 0x0e, 0x3b, 0xea,
+	0xb6, 0xaa, 0x56, 0xe0, 0x6d, 0x0e, 0xdc, 0xf3, 0x6d, 0x6a, 0x1f, 0x2b, 0x3d, 0xee, 0xfa, 0xac,
+	0xdf, 0xd3, 0x57, 0xcf, 0xb0, 0xe7, 0xde, 0x36, 0x46, 0xb0, 0x34, 0xcc, 0x45, 0xcf, 0xf1, 0xf7,
+	0x89, 0x7b, 0xd8, 0x88, 0x61, 0xe8, 0x03, 0x58, 0x54, 0x18, 0x41, 0xd8, 0xc4, 0xb6, 0x1d, 0x12,
+	0x4a, 0x2b, 0x85, 0x75, 0x6d, 0xe3, 0x4a, 0x6d, 0xaf, 0xdf, 0xd3, 0x2b, 0x92, 0xdb, 0x10, 0x8a,
+	0xf1, 0xaf, 0x9e, 0xfe, 0xf6, 0x18, 0x32, 0x6d, 0x59, 0xd6, 0x96, 0xa4, 0x30, 0x17, 0x62, 0x26,
+	0x0a, 0xc2, 0xcf, 0x7e, 0x14, 0x99, 0x24, 0x3e, 0x7b, 0x3a, 0x7b, 0xf6, 0x10, 0xca, 0xb8, 0x67,
+	0x1f, 0x60, 0x37, 0x3e, 0x3b, 0x66, 0x12, 0x9d, 0xbd, 0x0c, 0xc5, 0x4e, 0xb7, 0x75, 0x4c, 0xce,
+	0x2a, 0x45, 0xae, 0x68, 0x53, 0xad, 0xd0, 0x06, 0x4c, 0x3f, 0xc2, 0x6e, 0x97, 0x54, 0x66, 0x84,
+	0x3d, 0xaf, 0x44, 0xf6, 0xac, 0x07, 0x4e, 0xf4, 0x08, 0x24, 0xc2, 0xed, 0xc2, 0x3f, 0x9e, 0xe8,
+	0x9a, 0xf1, 0xdb, 0x3c, 0x2c, 0xec, 0xd1, 0xf6, 0x1d, 0xdb, 0x61, 0x9f, 0xf2, 0xf3, 0xea, 0x8c,
+	0xd2, 0x4e, 0x4e, 0x68, 0xa7, 0xde, 0xef, 0xe9, 0xf3, 0x52, 0x3b, 0x9f, 0xa6, 0x4e, 0x3c, 0x28,
+	0x27, 0xef, 0xb2, 0x19, 0x62, 0x46, 0xd4, 0x2b, 0x6c, 0x8c, 0xf9, 0x02, 0x1b, 0xc4, 0xea, 0xf7,
+	0xf4, 0x65, 0x29, 0x59, 0x86, 0x95, 0x61, 0xce, 0x5b, 0x03, 0xbe, 0x80, 0x4e, 0x47, 0x3f, 0xfc,
+	0x82, 0x38, 0x72, 0xe7, 0x33, 0x7c, 0xf4, 0xca, 0x74, 0xbf, 0xc9, 0xc1, 0xdc, 0x1e, 0x6d, 0x2b,
+	0x38, 0x19, 0xed, 0x0a, 0xda, 0xff, 0xd0, 0x15, 0x72, 0xaf, 0xc6, 0x15, 0xbe, 0x08, 0x45, 0xec,
+	0x05, 0x5d, 0x9f, 0x55, 0xf2, 0xe7, 0xbe, 0x79, 0x85, 0xa1, 0x34, 0xf7, 0xa7, 0xbc, 0x88, 0xaa,
+	0x35, 0xd2, 0x76, 0x7c, 0x93, 0xd8, 0xaf, 0x83, 0x02, 0x7f, 0xa8, 0xc1, 0xb5, 0x44, 0x3d, 0x34,
+	0xb4, 0x32, 0x5a, 0xfc, 0x56, 0xbf, 0xa7, 0x5f, 0xcf, 0x6a, 0x31, 0x85, 0x76, 0x01, 0x4d, 0x2e,
+	0xc5, 0x8c, 0xf6, 0x43, 0x6b, 0xb4, 0x1c, 0x36, 0x65, 0xb1, 0x1c, 0xf9, 0xf3, 0xe5, 0x48, 0xa1,
+	0xfd, 0x57, 0x72, 0x34, 0x28, 0x1b, 0x36, 0x6a, 0x61, 0x4c, 0xa3, 0xfe, 0x2e, 0x07, 0x57, 0xf7,
+	0x68, 0xfb, 0xa1, 0x6f, 0x5f, 0x3a, 0xc4, 0xa4, 0x0e, 0xf1, 0x38, 0x0f, 0xd7, 0x79, 0x99, 0x81,
+	0x7d, 0x8b, 0xb8, 0x0f, 0xfd, 0x56, 0xe0, 0xdb, 0x8e, 0xdf, 0x7e, 0x59, 0x9a, 0xbd, 0x54, 0x65,
+	0x5a, 0x95, 0xa8, 0x0e, 0x65, 0x2b, 0x24, 0x42, 0x5f, 0xcd, 0x23, 0xe2, 0xb4, 0x8f, 0xe4, 0xdb,
+	0xcd, 0xd7, 0x56, 0x53, 0x49, 0x65, 0x10, 0x81, 0x27, 0x15, 0x05, 0xd9, 0x11, 0x00, 0x65, 0x8f,
+	0x3f, 0xe4, 0x61, 0x71, 0x8f, 0xb6, 0x1f, 0x04, 0xc7, 0xc4, 0x77, 0x3e, 0x20, 0xfb, 0x47, 0x38,
+	0x24, 0xf4, 0xd2, 0x08, 0x63, 0x18, 0x81, 0xc7, 0x2f, 0xa6, 0xd4, 0x66, 0x37, 0x29, 0x57, 0x5c,
+	0x33, 0x38, 0xf1, 0x49, 0x58, 0x29, 0x64, 0xe3, 0xd7, 0x48, 0xb4, 0x0b, 0x28, 0x6b, 0x29, 0x66,
+	0x24, 0xec, 0xf4, 0x1e, 0x67, 0xa3, 0xec, 0xf8, 0x54, 0x83, 0xca, 0x1e, 0x6d, 0xf3, 0x1c, 0x43,
+	0x3c, 0x61, 0x4d, 0xba, 0x1d, 0x84, 0xaf, 0x81, 0x39, 0x13, 0x95, 0xe6, 0xc6, 0x0c, 0x11, 0xbf,
+	0xd7, 0x60, 0x89, 0x5f, 0x25, 0x60, 0x98, 0x91, 0x7a, 0xe0, 0xd3, 0xfb, 0xdd, 0xd6, 0x5d, 0x72,
+	0x36, 0xba, 0xcc, 0xd3, 0x3e, 0xcb, 0x32, 0xef, 0x16, 0x80, 0x4f, 0x4e, 0x9a, 0xaa, 0xfc, 0xcd,
+	0x89, 0x72, 0xeb, 0x5a, 0xbf, 0xa7, 0x2f, 0xca, 0xa3, 0x92, 0x3d, 0xc3, 0x2c, 0xf9, 0xe4, 0xe4,
+	0xbe, 0xf8, 0xad, 0x6e, 0xf1, 0x13, 0x0d, 0xe6, 0x77, 0x1c, 0xca, 0x82, 0xd0, 0xb1, 0xb0, 0xbb,
+	0xeb, 0x1f, 0x06, 0xe8, 0xab, 0x50, 0x3c, 0x22, 0xd8, 0x26, 0xa1, 0xaa, 0x73, 0xdf, 0xaa, 0x26,
+	0xcd, 0x5e, 0x95, 0x37, 0x7b, 0x55, 0x29, 0xd3, 0x8e, 0x40, 0x8a, 0x74, 0x23, 0x49, 0xd0, 0xbb,
+	0x50, 0x7c, 0x84, 0x5d, 0x4a, 0xb8, 0x1e, 0xf3, 0x1b, 0x73, 0x37, 0x57, 0xb2, 0x45, 0x72, 0x5c,
+	0x54, 0x47, 0x84, 0x12, 0x5d, 0x89, 0xf3, 0xeb, 0x1c, 0x94, 0x33, 0x1d, 0x16, 0xaa, 0x41, 0x41,
+	0x94, 0xae, 0x9a, 0xb8, 0x58, 0x75, 0x82, 0x06, 0xaa, 0x41, 0x2c, 0x53, 0xd0, 0xa2, 0xef, 0xc2,
+	0xac, 0x87, 0x4f, 0x65, 0x09, 0x2c, 0x15, 0xb4, 0x35, 0x19, 0x9f, 0x7e, 0x4f, 0x2f, 0xab, 0x9a,
+	0x54, 0xf1, 0x31, 0xcc, 0x19, 0x0f, 0x9f, 0x8a, 0xc2, 0xb7, 0x03, 0x65, 0x0e, 0xb5, 0x8e, 0xb0,
+	0xdf, 0x26, 0xe9, 0x3a, 0x7b, 0x67, 0xe2, 0x43, 0x96, 0x93, 0x43, 0x52, 0xec, 0x0c, 0xf3, 0xaa,
+	0x87, 0x4f, 0xeb, 0x02, 0xc0, 0x4f, 0xbc, 0x3d, 0xfb, 0xd1, 0x13, 0x7d, 0x4a, 0x68, 0xec, 0x8f,
+	0x1a, 0x40, 0xa2, 0x31, 0xf4, 0x00, 0x16, 0x32, 0x75, 0x3a, 0xad, 0x68, 0xe3, 0x75, 0xb2, 0xb3,
+	0x5c, 0xd8, 0x67, 0x3d, 0x5d, 0x33, 0xcb, 0x56, 0xc6, 0x04, 0xdf, 0x81, 0xb9, 0x6e, 0xc7, 0xc6,
+	0x8c, 0x34, 0x79, 0x13, 0xaf, 0x5c, 0x64, 0xb5, 0x2a, 0x1b, 0xf8, 0x6a, 0xd4, 0xc0, 0x57, 0x1f,
+	0x44, 0x1d, 0x7e, 0x6d, 0x8d, 0xf3, 0xea, 0xf7, 0x74, 0x24, 0xaf, 0x93, 0x22, 0x36, 0x3e, 0xfc,
+	0x44, 0xd7, 0x4c, 0x90, 0x10, 0x4e, 0x30, 0x78, 0x97, 0xb9, 0x54, 0x13, 0x85, 0x2a, 0x30, 0xe3,
+	0x05, 0xbe, 0x73, 0xac, 0x9e, 0x62, 0xc9, 0x8c, 0x96, 0x68, 0x15, 0x66, 0x1d, 0x9b, 0xf8, 0xcc,
+	0x61, 0xea, 0xc1, 0x9b, 0xf1, 0x9a, 0x53, 0x9d, 0x90, 0x16, 0x75, 0x22, 0x2b, 0x98, 0xd1, 0x12,
+	0x6d, 0xc3, 0x02, 0x25, 0x56, 0x37, 0x74, 0xd8, 0x59, 0xd3, 0x0a, 0x7c, 0x86, 0x2d, 0xa6, 0xba,
+	0x93, 0x37, 0xfb, 0x3d, 0xfd, 0x0d, 0x29, 0x6b, 0x16, 0xc3, 0x30, 0xcb, 0x11, 0xa8, 0x2e, 0x21,
+	0xfc, 0x04, 0x9b, 0x30, 0xec, 0xb8, 0xb2, 0xbb, 0x2d, 0x99, 0xd1, 0x32, 0x75, 0x97, 0x5f, 0xcd,
+	0x40, 0x29, 0x69, 0x20, 0x4f, 0x60, 0x21, 0xe8, 0x90, 0x70, 0x44, 0x4c, 0xb8, 0x97, 0x9c, 0x9c,
+	0xc5, 0xb8, 0x40, 0x70, 0x28, 0x47, 0x3c, 0xa2, 0xd8, 0xb0, 0xcd, 0xdf, 0x83, 0x4f, 0x89, 0x4f,
+	0xbb, 0x74, 0x30, 0x42, 0xa4, 0xae, 0x9c, 0xc5, 0x30, 0xcc, 0x72, 0x0c, 0x92, 0xd1, 0x82, 0xb7,
+	0xd7, 0xdf, 0xc3, 0x8e, 0x4b, 0x6c, 0xa1, 0xd3, 0x59, 0x53, 0xad, 0xd0, 0x2e, 0x14, 0x29, 0xc3,
+	0xac, 0x2b, 0x67, 0x0c, 0xd3, 0xb5, 0x77, 0xc6, 0x94, 0xb9, 0x16, 0xf8, 0xf6, 0xbe, 0x20, 0x34,
+	0x15, 0x03, 0xb4, 0x0d, 0x45, 0x91, 0x38, 0x94, 0x52, 0x27, 0xf2, 0xf4, 0x5d, 0x9f, 0x99, 0x8a,
+	0x1a, 0x31, 0x48, 0xc2, 0xbb, 0xcc, 0x64, 0x54, 0xce, 0x04, 0x6a, 0xbb, 0x13, 0xbb, 0xe3, 0x1b,
+	0xd9, 0x9c, 0x23, 0xf9, 0x19, 0x66, 0x39, 0x06, 0xa9, 0xe4, 0x95, 0x19, 0x11, 0xcc, 0x5c, 0x68,
+	0x44, 0xb0, 0x0d, 0x0b, 0xdd, 0xa8, 0xd8, 0x8c, 0x4a, 0xa6, 0x59, 0x51, 0x32, 0xa5, 0xac, 0x95,
+	0xc5, 0x30, 0xcc, 0x72, 0x0c, 0x92, 0x45, 0x13, 0xb2, 0x61, 0x3e, 0xc1, 0x12, 0x2e, 0x5b, 0x7a,
+	0xa9, 0xcb, 0xde, 0x50, 0x2e, 0x7b, 0x2d, 0x7b, 0x4a, 0xe2, 0xb5, 0x57, 0x63, 0x20, 0x27, 0x43,
+	0xdf, 0x18, 0x98, 0x97, 0x81, 0x3a, 0xe1, 0xdc, 0x28, 0x33, 0xfe, 0xa8, 0x6c, 0xee, 0x95, 0x8c,
+	0xca, 0x6e, 0x5f, 0xf9, 0xd1, 0x13, 0x7d, 0x2a, 0x76, 0xd8, 0x1f, 0xe7, 0xa0, 0xd8, 0x38, 0xb8,
+	0x8f, 0x9d, 0xf0, 0xff, 0xb5, 0xae, 0x4c, 0x45, 0xaf, 0xaf, 0xc3, 0x8c, 0xd4, 0x05, 0x45, 0x37,
+	0x61, 0xba, 0xc3, 0x7f, 0x54, 0x34, 0x91, 0xd0, 0x97, 0x87, 0x9e, 0xb4, 0xc0, 0x8b, 0x46, 0x69,
+	0x02, 0xd5, 0xf8, 0x45, 0x1e, 0xa0, 0x71, 0x70, 0xf0, 0x20, 0x74, 0x3a, 0x2e, 0x61, 0x97, 0x73,
+	0x84, 0xd7, 0x67, 0x8e, 0x90, 0xb2, 0xf1, 0x5d, 0x98, 0x4b, 0x6c, 0x44, 0xd1, 0xd7, 0x60, 0x96,
+	0xa9, 0xdf, 0xca, 0xd4, 0xab, 0xc3, 0xa6, 0x8e, 0xd0, 0x95, 0xb9, 0x63, 0x0a, 0xe3, 0xcf, 0x39,
+	0x80, 0xcb, 0xf6, 0x98, 0xe7, 0x30, 0x95, 0x71, 0xf2, 0x17, 0xaa, 0x56, 0x15, 0x75, 0xca, 0x4a,
+	0x7f, 0xcb, 0xc1, 0xd2, 0xe5, 0x00, 0x22, 0x39, 0x7b, 0x07, 0x66, 0x88, 0xcf, 0x42, 0x47, 0xa8,
+	0x98, 0xbf, 0xd2, 0x8d, 0xec, 0x2b, 0x1d, 0xa1, 0xad, 0x3b, 0x3e, 0x0b, 0xcf, 0xd4, 0x9b, 0x8d,
+	0xc8, 0x53, 0x3a, 0xfe, 0x69, 0x1e, 0x2a, 0xe7, 0x51, 0x8d, 0x9a, 0x62, 0x68, 0x93, 0x4e, 0x31,
+	0x50, 0x5b, 0x4c, 0xe2, 0xb9, 0xab, 0x70, 0xac, 0x31, 0x8b, 0x68, 0x43, 0x65, 0xe4, 0x64, 0xfe,
+	0x9e, 0x66, 0x20, 0x53, 0xf2, 0x7c, 0x02, 0x15, 0x39, 0xf9, 0x7d, 0x28, 0x3b, 0xbe, 0xc3, 0x1c,
+	0xec, 0x36, 0x5b, 0xd8, 0xc5, 0xbe, 0x75, 0x91, 0x56, 0x44, 0x66, 0x53, 0x75, 0x6c, 0x86, 0x9d,
+	0x61, 0xce, 0x2b, 0x48, 0x4d, 0x02, 0xb8, 0x45, 0xa2, 0xa3, 0x0a, 0x17, 0x2a, 0xdc, 0x22, 0xf2,
+	0x94, 0x45, 0x1e, 0xe7, 0x61, 0x31, 0x1e, 0x44, 0x5f, 0x9a, 0x62, 0x5c, 0x53, 0xec, 0x01, 0xc8,
+	0x00, 0xc2, 0x33, 0x47, 0xa5, 0x70, 0xa1, 0x10, 0x54, 0x92, 0x1c, 0x1a, 0x94, 0xa5, 0xec, 0xf1,
+	0xf7, 0x3c, 0x5c, 0x49, 0xdb, 0xe3, 0x32, 0xa5, 0xbf, 0x46, 0x9f, 0x06, 0xb6, 0x92, 0x90, 0x58,
+	0x10, 0x21, 0xf1, 0x46, 0x36, 0x24, 0x0e, 0xb9, 0xd2, 0xf9, 0xb1, 0xf0, 0x9f, 0x45, 0x28, 0xde,
+	0xc7, 0x21, 0xf6, 0x28, 0xb2, 0x86, 0xba, 0x08, 0x39, 0x49, 0x58, 0x19, 0x72, 0x94, 0x86, 0xfa,
+	0x72, 0xff, 0x92, 0x26, 0xe2, 0xa3, 0x91, 0x4d, 0xc4, 0x3c, 0x1f, 0x76, 0xc4, 0xf7, 0x92, 0x46,
+	0xbc, 0x5a, 0x5b, 0x49, 0xb8, 0x0c, 0xee, 0xcb, 0x59, 0x48, 0xdc, 0x5a, 0x53, 0xf4, 0x2e, 0xcc,
+	0x71, 0x8c, 0x24, 0x2b, 0x70, 0xf2, 0xe5, 0x64, 0xf8, 0x90, 0xda, 0x34, 0x4c, 0xf0, 0xf0, 0xe9,
+	0x1d, 0xb9, 0x40, 0xf7, 0x00, 0x1d, 0xc5, 0xa3, 0xaf, 0x66, 0xa2, 0x42, 0x4e, 0xff, 0x56, 0xbf,
+	0xa7, 0xaf, 0x48, 0xfa, 0x61, 0x1c, 0xc3, 0x5c, 0x4c, 0x80, 0x11, 0xb7, 0x5b, 0x00, 0xfc, 0x5e,
+	0x4d, 0x9b, 0xf8, 0x81, 0x57, 0x99, 0xce, 0x4e, 0xe1, 0x92, 0x3d, 0xc3, 0x2c, 0xf1, 0x45, 0x83,
+	0xff, 0x46, 0x3f, 0xd0, 0x60, 0xe1, 0x98, 0x9c, 0x35, 0xc3, 0x80, 0xc9, 0xb0, 0x75, 0x48, 0x48,
+	0xa5, 0xb8, 0x9e, 0x1f, 0x1a, 0x41, 0xde, 0x55, 0x9a, 0x55, 0x4d, 0x60, 0x96, 0xc6, 0xf8, 0xe5,
+	0x27, 0xfa, 0xc6, 0x18, 0x6f, 0x89, 0xf3, 0xa2, 0xe6, 0xfc, 0x31, 0x39, 0x33, 0x15, 0xf5, 0x36,
+	0xe1, 0x21, 0x7c, 0x91, 0x6b, 0x29, 0xcd, 0x94, 0x8a, 0x16, 0xf6, 0x6a, 0xed, 0x7a, 0xe2, 0xcd,
+	0x43, 0x28, 0x86, 0xc9, 0xe7, 0x5e, 0x77, 0x13, 0x5e, 0x14, 0x3d, 0xd6, 0x60, 0xa5, 0xed, 0x06,
+	0x2d, 0xec, 0x36, 0x5d, 0xe7, 0xfd, 0xae, 0x63, 0x37, 0xd5, 0x1b, 0x6c, 0x5a, 0xb8, 0x23, 0x7a,
+	0xd9, 0x52, 0xcd, 0x9c, 0xb8, 0x0d, 0x5f, 0x97, 0x02, 0x9c, 0xcb, 0xd8, 0x30, 0x97, 0xe5, 0xde,
+	0x3d, 0xb1, 0xb5, 0x2f, 0x77, 0xea, 0xb8, 0x83, 0x7e, 0xae, 0xc1, 0xf5, 0xc4, 0xf9, 0x46, 0x88,
+	0x54, 0x12, 0x22, 0x3d, 0x9c, 0x58, 0xa4, 0xcf, 0x67, 0x1d, 0x7b, 0x94, 0x54, 0x2b, 0xf1, 0x76,
+	0x56, 0xb0, 0x94, 0xc7, 0x7d, 0x9c, 0x03, 0x94, 0xcc, 0x8f, 0x23, 0x55, 0x8e, 0x2e, 0xb2, 0xb4,
+	0x57, 0x53, 0x64, 0xdd, 0x02, 0x08, 0x5c, 0xfb, 0xdc, 0x89, 0x72, 0xb2, 0x67, 0x98, 0xa5, 0xc0,
+	0xb5, 0xd5, 0x8c, 0x68, 0x70, 0x0e, 0x9d, 0x1f, 0x6f, 0x0e, 0xcd, 0x27, 0x4b, 0xe9, 0x8f, 0x43,
+	0xa6, 0x5a, 0xa1, 0xaf, 0x40, 0x41, 0xc4, 0x9c, 0xe9, 0x97, 0x26, 0x67, 0x31, 0xb8, 0x14, 0x29,
+	0x58, 0x50, 0xa4, 0x54, 0xfb, 0xb3, 0x1c, 0x2c, 0x0d, 0x7c, 0x33, 0x32, 0x89, 0x15, 0x84, 0x36,
+	0x5a, 0x86, 0x9c, 0x63, 0x0b, 0x65, 0x16, 0x6a, 0xc5, 0xe7, 0x3d, 0x3d, 0xb7, 0xdb, 0x30, 0x73,
+	0x8e, 0x8d, 0xbe, 0x09, 0xd3, 0xf2, 0xdb, 0x88, 0x4c, 0x24, 0xef, 0x4c, 0x9e, 0xad, 0x24, 0xbd,
+	0x88, 0x6a, 0x81, 0xdd, 0x75, 0x49, 0x13, 0x5b, 0x56, 0xfc, 0xa5, 0xa6, 0x34, 0x10, 0xd5, 0x06,
+	0xf6, 0x79, 0x54, 0x13, 0x80, 0x2d, 0xb9, 0x46, 0xef, 0x41, 0x29, 0x36, 0x4b, 0xa5, 0x30, 0x91,
+	0x38, 0x29, 0xd3, 0x26, 0x3c, 0x12, 0xad, 0xd4, 0xb6, 0x9f, 0x3e, 0x5f, 0xd3, 0x9e, 0x3d, 0x5f,
+	0xd3, 0xfe, 0xfa, 0x7c, 0x4d, 0xfb, 0xf0, 0xc5, 0xda, 0xd4, 0xb3, 0x17, 0x6b, 0x53, 0x1f, 0xbf,
+	0x58, 0x9b, 0xfa, 0xf6, 0x97, 0xfe, 0x23, 0xf7, 0xd3, 0xf8, 0x4f, 0xc6, 0xc4, 0x39, 0xad, 0xa2,
+	0xb0, 0xc5, 0x97, 0xff, 0x3d, 0x00, 0xed, 0x76, 0xcf, 0x60, 0x51, 0x26, 0x00, 0x00,
+}
+
+func (this *MsgCreateValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreateValidator)
+	if !ok {
+		that2, ok := that.(MsgCreateValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Description.Equal(&that1.Description) {
+		return false
 	}
 	if !this.Commission.Equal(&that1.Commission) {
 		return false
@@ -1525,6 +1973,126 @@ func (this *MsgUndelegate) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgCancelUnbondingDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelUnbondingDelegation)
+	if !ok {
+		that2, ok := that.(MsgCancelUnbondingDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	return true
+}
+func (this *MsgTokenizeShares) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTokenizeShares)
+	if !ok {
+		that2, ok := that.(MsgTokenizeShares)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if !bytes.Equal(this.TokenizedShareOwner, that1.TokenizedShareOwner) {
+		return false
+	}
+	return true
+}
+func (this *MsgRedeemTokensForShares) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRedeemTokensForShares)
+	if !ok {
+		that2, ok := that.(MsgRedeemTokensForShares)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *MsgRotateConsPubKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRotateConsPubKey)
+	if !ok {
+		that2, ok := that.(MsgRotateConsPubKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if this.NewPubkey != that1.NewPubkey {
+		return false
+	}
+	return true
+}
 func (this *HistoricalInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1964,34 +2532,120 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if len(this.KeyRotationFee) != len(that1.KeyRotationFee) {
+		return false
+	}
+	for i := range this.KeyRotationFee {
+		if !this.KeyRotationFee[i].Equal(&that1.KeyRotationFee[i]) {
+			return false
+		}
+	}
+	if this.MaxKeyRotations != that1.MaxKeyRotations {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
 	return true
 }
-func (m *MsgCreateValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ConsPubKeyRotation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *MsgCreateValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	that1, ok := that.(*ConsPubKeyRotation)
+	if !ok {
+		that2, ok := that.(ConsPubKeyRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if this.OldPubkey != that1.OldPubkey {
+		return false
+	}
+	if this.NewPubkey != that1.NewPubkey {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if !bytes.Equal(this.Validator, that1.Validator) {
+		return false
+	}
+	return true
+}
+func (m *MsgCreateValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
@@ -2262,6 +2916,189 @@ func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenizedShareOwner) > 0 {
+		i -= len(m.TokenizedShareOwner)
+		copy(dAtA[i:], m.TokenizedShareOwner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.TokenizedShareOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateConsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewPubkey) > 0 {
+		i -= len(m.NewPubkey)
+		copy(dAtA[i:], m.NewPubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NewPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2382,12 +3219,12 @@ func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
-	if err9 != nil {
-		return 0, err9
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -2501,12 +3338,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2869,12 +3706,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err14 != nil {
-		return 0, err14
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n14
-	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i -= n17
+	i = encodeVarintStaking(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2925,12 +3762,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err15 != nil {
-		return 0, err15
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n15
-	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3019,6 +3856,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MaxKeyRotations != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxKeyRotations))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.KeyRotationFee) > 0 {
+		for iNdEx := len(m.KeyRotationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3041,17 +3917,123 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err16 != nil {
-		return 0, err16
+	n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n16
-	i = encodeVarintStaking(dAtA, i, uint64(n16))
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsPubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintStaking(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewPubkey) > 0 {
+		i -= len(m.NewPubkey)
+		copy(dAtA[i:], m.NewPubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NewPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldPubkey) > 0 {
+		i -= len(m.OldPubkey)
+		copy(dAtA[i:], m.OldPubkey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OldPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3176,6 +4158,83 @@ func (m *MsgUndelegate) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *MsgTokenizeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.TokenizedShareOwner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemTokensForShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MsgRotateConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.NewPubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func (m *HistoricalInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3482,6 +4541,69 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	if len(m.KeyRotationFee) > 0 {
+		for _, e := range m.KeyRotationFee {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.MaxKeyRotations != 0 {
+		n += 1 + sovStaking(uint64(m.MaxKeyRotations))
+	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ConsPubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.OldPubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.NewPubkey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovStaking(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -4465,7 +5587,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4488,17 +5610,17 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4508,30 +5630,31 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4541,31 +5664,83 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valset = append(m.Valset, Validator{})
-			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthStaking
@@ -4585,7 +5760,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4608,17 +5783,17 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4628,31 +5803,31 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4662,31 +5837,31 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4696,26 +5871,59 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedShareOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizedShareOwner = append(m.TokenizedShareOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenizedShareOwner == nil {
+				m.TokenizedShareOwner = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4740,7 +5948,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemTokensForShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4763,17 +5971,17 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4783,28 +5991,29 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4831,7 +6040,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4859,7 +6068,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4882,17 +6091,17 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4902,27 +6111,29 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4950,13 +6161,66 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.NewPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4966,29 +6230,30 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4998,55 +6263,25 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Valset = append(m.Valset, Validator{})
+			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5072,7 +6307,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5095,17 +6330,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5115,29 +6350,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = append(m.OperatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.OperatorAddress == nil {
-				m.OperatorAddress = []byte{}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5165,13 +6400,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5181,17 +6418,84 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.Status = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5201,16 +6505,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= github_com_cosmos_cosmos_sdk_types.BondStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5220,29 +6538,81 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5270,15 +6640,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5288,49 +6656,1006 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = append(m.OperatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OperatorAddress == nil {
+				m.OperatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= github_com_cosmos_cosmos_sdk_types.BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSrcAddress == nil {
+				m.ValidatorSrcAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorDstAddress == nil {
+				m.ValidatorDstAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5340,30 +7665,31 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5373,28 +7699,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5422,7 +7749,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5450,7 +7777,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPair) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5473,10 +7800,10 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5547,62 +7874,9 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5629,8 +7903,8 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5658,7 +7932,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5681,17 +7955,17 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			var byteLen int
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5701,31 +7975,16 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DelegatorAddress == nil {
-				m.DelegatorAddress = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5735,31 +7994,30 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorSrcAddress == nil {
-				m.ValidatorSrcAddress = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5769,84 +8027,31 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorDstAddress == nil {
-				m.ValidatorDstAddress = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5856,23 +8061,23 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5900,7 +8105,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5923,17 +8128,36 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5943,31 +8167,30 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DelegatorAddress == nil {
-				m.DelegatorAddress = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5977,29 +8200,29 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddress == nil {
-				m.ValidatorAddress = []byte{}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6027,7 +8250,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6055,7 +8278,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6078,10 +8301,10 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6120,7 +8343,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6147,12 +8370,46 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddress == nil {
-				m.ValidatorAddress = []byte{}
+			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSrcAddress == nil {
+				m.ValidatorSrcAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorDstAddress == nil {
+				m.ValidatorDstAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
@@ -6181,7 +8438,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			m.Entries = append(m.Entries, RedelegationEntry{})
 			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -6210,7 +8467,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6233,34 +8490,15 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6287,15 +8525,15 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
 			}
-			var stringLen uint64
+			m.MaxValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6305,29 +8543,52 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxValidators |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6355,85 +8616,11 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6460,13 +8647,33 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyRotationFee = append(m.KeyRotationFee, types.Coin{})
+			if err := m.KeyRotationFee[len(m.KeyRotationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeyRotations", wireType)
+			}
+			m.MaxKeyRotations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxKeyRotations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6494,13 +8701,13 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6528,7 +8735,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6556,7 +8763,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6579,15 +8786,15 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6614,16 +8821,16 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DelegatorAddress == nil {
-				m.DelegatorAddress = []byte{}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPubkey", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6633,31 +8840,29 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorSrcAddress == nil {
-				m.ValidatorSrcAddress = []byte{}
-			}
+			m.OldPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6667,29 +8872,46 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorDstAddress == nil {
-				m.ValidatorDstAddress = []byte{}
-			}
+			m.NewPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6716,8 +8938,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6745,7 +8966,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6768,17 +8989,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6788,30 +9009,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.MaxValidators = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6821,16 +9028,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
-			m.MaxEntries = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6840,35 +9062,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6878,23 +9094,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance. The
+// record's delegation is held by a module account named after its ID.
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		ID:            id,
+		Owner:         owner,
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     valAddr,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// record's delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denomination of the share tokens of the
+// record, i.e. the validator operator address followed by the record ID.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return strings.ToLower(r.Validator.String()) + "/" + strconv.FormatUint(r.ID, 10)
+}
+
+// String returns a human readable string representation of a tokenize share
+// record.
+func (r TokenizeShareRecord) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// MustMarshalTokenizeShareRecord returns the tokenize share record bytes.
+// Panics if fails.
+func MustMarshalTokenizeShareRecord(cdc codec.Marshaler, record TokenizeShareRecord) []byte {
+	return cdc.MustMarshalBinaryBare(&record)
+}
+
+// MustUnmarshalTokenizeShareRecord unmarshals a tokenize share record from a
+// store value. Panics if fails.
+func MustUnmarshalTokenizeShareRecord(cdc codec.Marshaler, value []byte) TokenizeShareRecord {
+	var record TokenizeShareRecord
+	cdc.MustUnmarshalBinaryBare(value, &record)
+
+	return record
+}
+
+// TokenizeShareRecords is a collection of tokenize share records
+type TokenizeShareRecords []TokenizeShareRecord
+
+func (r TokenizeShareRecords) String() (out string) {
+	for _, record := range r {
+		out += record.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}